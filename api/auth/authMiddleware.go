@@ -0,0 +1,278 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	chainAPIShared "github.com/multiversx/mx-chain-go/api/shared"
+)
+
+const (
+	// AdminScope grants access to all the routes. It is also the scope of the routes without a configured scope
+	AdminScope = "admin"
+	// PublicScope marks the routes that do not require authentication
+	PublicScope = "public"
+
+	// KeyNameHeader holds the name of the token used to sign the request
+	KeyNameHeader = "X-Api-Key-Name"
+	// TimestampHeader holds the unix timestamp, in seconds, at which the request was signed
+	TimestampHeader = "X-Api-Timestamp"
+	// SignatureHeader holds the hex encoded HMAC-SHA256 signature of the request
+	SignatureHeader = "X-Api-Signature"
+
+	bearerPrefix = "Bearer "
+)
+
+// ArgsAuthMiddleware is the DTO used to create a new auth middleware
+type ArgsAuthMiddleware struct {
+	Config      config.ApiAuthConfig
+	APIPackages map[string]config.APIPackageConfig
+}
+
+type apiToken struct {
+	name   string
+	secret []byte
+	scopes map[string]struct{}
+}
+
+type authMiddleware struct {
+	tokens         []*apiToken
+	tokensByName   map[string]*apiToken
+	routesScopes   map[string]string
+	maxClockSkew   time.Duration
+	getTimeHandler func() time.Time
+}
+
+// NewAuthMiddleware creates a middleware that authenticates the requests either with a static bearer token or with
+// an HMAC signature and checks that the token's scopes grant access to the requested route
+func NewAuthMiddleware(args ArgsAuthMiddleware) (*authMiddleware, error) {
+	if args.Config.MaxClockSkewInSeconds == 0 {
+		return nil, ErrInvalidClockSkew
+	}
+	if len(args.Config.Tokens) == 0 {
+		return nil, ErrNoAuthTokens
+	}
+
+	am := &authMiddleware{
+		tokens:         make([]*apiToken, 0, len(args.Config.Tokens)),
+		tokensByName:   make(map[string]*apiToken),
+		routesScopes:   createRoutesScopes(args.APIPackages),
+		maxClockSkew:   time.Duration(args.Config.MaxClockSkewInSeconds) * time.Second,
+		getTimeHandler: time.Now,
+	}
+
+	for _, tokenConfig := range args.Config.Tokens {
+		token, err := createApiToken(tokenConfig)
+		if err != nil {
+			return nil, err
+		}
+		_, found := am.tokensByName[token.name]
+		if found {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedTokenName, token.name)
+		}
+
+		am.tokens = append(am.tokens, token)
+		am.tokensByName[token.name] = token
+	}
+
+	return am, nil
+}
+
+func createApiToken(tokenConfig config.ApiTokenConfig) (*apiToken, error) {
+	if len(tokenConfig.Name) == 0 {
+		return nil, ErrEmptyTokenName
+	}
+	if len(tokenConfig.Secret) == 0 {
+		return nil, fmt.Errorf("%w for token %s", ErrEmptyTokenSecret, tokenConfig.Name)
+	}
+	if len(tokenConfig.Scopes) == 0 {
+		return nil, fmt.Errorf("%w for token %s", ErrNoTokenScopes, tokenConfig.Name)
+	}
+
+	token := &apiToken{
+		name:   tokenConfig.Name,
+		secret: []byte(tokenConfig.Secret),
+		scopes: make(map[string]struct{}),
+	}
+	for _, scope := range tokenConfig.Scopes {
+		token.scopes[scope] = struct{}{}
+	}
+
+	return token, nil
+}
+
+func createRoutesScopes(apiPackages map[string]config.APIPackageConfig) map[string]string {
+	routesScopes := make(map[string]string)
+	for packageName, packageConfig := range apiPackages {
+		for _, route := range packageConfig.Routes {
+			scope := route.Scope
+			if len(scope) == 0 {
+				scope = AdminScope
+			}
+
+			routesScopes["/"+packageName+route.Name] = scope
+		}
+	}
+
+	return routesScopes
+}
+
+// MiddlewareHandlerFunc returns the handler func that authenticates and authorizes the requests
+func (am *authMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		fullPath := c.FullPath()
+		if len(fullPath) == 0 {
+			// unknown route, the router will respond with not found
+			c.Next()
+			return
+		}
+
+		scope := am.getRouteScope(fullPath)
+		if scope == PublicScope {
+			c.Next()
+			return
+		}
+
+		token, err := am.authenticate(c.Request)
+		if err != nil {
+			abortRequest(c, http.StatusUnauthorized, err)
+			return
+		}
+		if !token.hasScope(scope) {
+			abortRequest(c, http.StatusForbidden, fmt.Errorf("%w: token %s can not access %s", ErrForbidden, token.name, fullPath))
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// getRouteScope returns the scope required by the route. The routes not present in the config (e.g. pprof) require
+// the admin scope
+func (am *authMiddleware) getRouteScope(fullPath string) string {
+	scope, found := am.routesScopes[fullPath]
+	if !found {
+		return AdminScope
+	}
+
+	return scope
+}
+
+func (am *authMiddleware) authenticate(request *http.Request) (*apiToken, error) {
+	authorization := request.Header.Get("Authorization")
+	if strings.HasPrefix(authorization, bearerPrefix) {
+		return am.authenticateBearer(strings.TrimPrefix(authorization, bearerPrefix))
+	}
+	if len(request.Header.Get(SignatureHeader)) > 0 {
+		return am.authenticateSignature(request)
+	}
+
+	return nil, ErrUnauthorized
+}
+
+func (am *authMiddleware) authenticateBearer(secret string) (*apiToken, error) {
+	var matchingToken *apiToken
+	for _, token := range am.tokens {
+		// all the tokens are compared so the response time does not depend on the matching token
+		if subtle.ConstantTimeCompare([]byte(secret), token.secret) == 1 {
+			matchingToken = token
+		}
+	}
+	if matchingToken == nil {
+		return nil, ErrUnauthorized
+	}
+
+	return matchingToken, nil
+}
+
+func (am *authMiddleware) authenticateSignature(request *http.Request) (*apiToken, error) {
+	token, found := am.tokensByName[request.Header.Get(KeyNameHeader)]
+	if !found {
+		return nil, ErrUnauthorized
+	}
+
+	timestampString := request.Header.Get(TimestampHeader)
+	timestamp, err := strconv.ParseInt(timestampString, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid timestamp", ErrUnauthorized)
+	}
+	skew := am.getTimeHandler().Sub(time.Unix(timestamp, 0))
+	if skew > am.maxClockSkew || skew < -am.maxClockSkew {
+		return nil, fmt.Errorf("%w: timestamp outside the allowed clock skew", ErrUnauthorized)
+	}
+
+	body, err := readBody(request)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnauthorized, err.Error())
+	}
+
+	expectedSignature := ComputeRequestSignature(token.secret, request.Method, request.URL.RequestURI(), timestampString, body)
+	if !hmac.Equal([]byte(expectedSignature), []byte(request.Header.Get(SignatureHeader))) {
+		return nil, ErrUnauthorized
+	}
+
+	return token, nil
+}
+
+// readBody reads the request body and restores it so the route handlers can read it again
+func readBody(request *http.Request) ([]byte, error) {
+	if request.Body == nil {
+		return make([]byte, 0), nil
+	}
+
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		return nil, err
+	}
+	_ = request.Body.Close()
+	request.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// ComputeRequestSignature returns the hex encoded HMAC-SHA256 signature of a request. The signed payload is composed
+// of the method, the request URI (path and query), the timestamp and the hex encoded SHA256 hash of the body,
+// separated by new lines
+func ComputeRequestSignature(secret []byte, method string, requestURI string, timestamp string, body []byte) string {
+	bodyHash := sha256.Sum256(body)
+	payload := strings.Join([]string{method, requestURI, timestamp, hex.EncodeToString(bodyHash[:])}, "\n")
+
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(payload))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func abortRequest(c *gin.Context, status int, err error) {
+	c.AbortWithStatusJSON(
+		status,
+		chainAPIShared.GenericAPIResponse{
+			Data:  nil,
+			Error: err.Error(),
+			Code:  chainAPIShared.ReturnCodeRequestError,
+		},
+	)
+}
+
+func (token *apiToken) hasScope(scope string) bool {
+	_, isAdmin := token.scopes[AdminScope]
+	_, found := token.scopes[scope]
+
+	return isAdmin || found
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (am *authMiddleware) IsInterfaceNil() bool {
+	return am == nil
+}
@@ -0,0 +1,240 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	statusScope  = "status"
+	statusSecret = "status-secret"
+	adminSecret  = "admin-secret"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func createMockArgsAuthMiddleware() ArgsAuthMiddleware {
+	return ArgsAuthMiddleware{
+		Config: config.ApiAuthConfig{
+			Enabled:               true,
+			MaxClockSkewInSeconds: 30,
+			Tokens: []config.ApiTokenConfig{
+				{
+					Name:   "partner",
+					Secret: statusSecret,
+					Scopes: []string{statusScope},
+				},
+				{
+					Name:   "operator",
+					Secret: adminSecret,
+					Scopes: []string{AdminScope},
+				},
+			},
+		},
+		APIPackages: map[string]config.APIPackageConfig{
+			"node": {
+				Routes: []config.RouteConfig{
+					{Name: "/status", Open: true, Scope: statusScope},
+					{Name: "/peerinfo", Open: true},
+				},
+			},
+			"log": {
+				Routes: []config.RouteConfig{
+					{Name: "", Open: true, Scope: PublicScope},
+				},
+			},
+		},
+	}
+}
+
+func startWebServer(am *authMiddleware) *gin.Engine {
+	ws := gin.New()
+	ws.Use(am.MiddlewareHandlerFunc())
+
+	handler := func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	}
+	ws.GET("/node/status", handler)
+	ws.GET("/node/peerinfo", handler)
+	ws.GET("/log", handler)
+	ws.POST("/debug/pprof", handler)
+
+	return ws
+}
+
+func doRequest(ws *gin.Engine, req *http.Request) int {
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp.Code
+}
+
+func createSignedRequest(method string, target string, body string, keyName string, secret string, timestamp time.Time) *http.Request {
+	req, _ := http.NewRequest(method, target, strings.NewReader(body))
+	timestampString := strconv.FormatInt(timestamp.Unix(), 10)
+	req.Header.Set(KeyNameHeader, keyName)
+	req.Header.Set(TimestampHeader, timestampString)
+	req.Header.Set(SignatureHeader, ComputeRequestSignature([]byte(secret), method, req.URL.RequestURI(), timestampString, []byte(body)))
+
+	return req
+}
+
+func TestNewAuthMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid clock skew should error", func(t *testing.T) {
+		args := createMockArgsAuthMiddleware()
+		args.Config.MaxClockSkewInSeconds = 0
+
+		am, err := NewAuthMiddleware(args)
+		assert.True(t, check.IfNil(am))
+		assert.Equal(t, ErrInvalidClockSkew, err)
+	})
+	t.Run("no tokens should error", func(t *testing.T) {
+		args := createMockArgsAuthMiddleware()
+		args.Config.Tokens = nil
+
+		am, err := NewAuthMiddleware(args)
+		assert.True(t, check.IfNil(am))
+		assert.Equal(t, ErrNoAuthTokens, err)
+	})
+	t.Run("empty token name should error", func(t *testing.T) {
+		args := createMockArgsAuthMiddleware()
+		args.Config.Tokens[0].Name = ""
+
+		am, err := NewAuthMiddleware(args)
+		assert.True(t, check.IfNil(am))
+		assert.Equal(t, ErrEmptyTokenName, err)
+	})
+	t.Run("empty token secret should error", func(t *testing.T) {
+		args := createMockArgsAuthMiddleware()
+		args.Config.Tokens[0].Secret = ""
+
+		am, err := NewAuthMiddleware(args)
+		assert.True(t, check.IfNil(am))
+		assert.True(t, errors.Is(err, ErrEmptyTokenSecret))
+	})
+	t.Run("no token scopes should error", func(t *testing.T) {
+		args := createMockArgsAuthMiddleware()
+		args.Config.Tokens[0].Scopes = nil
+
+		am, err := NewAuthMiddleware(args)
+		assert.True(t, check.IfNil(am))
+		assert.True(t, errors.Is(err, ErrNoTokenScopes))
+	})
+	t.Run("duplicated token name should error", func(t *testing.T) {
+		args := createMockArgsAuthMiddleware()
+		args.Config.Tokens[1].Name = args.Config.Tokens[0].Name
+
+		am, err := NewAuthMiddleware(args)
+		assert.True(t, check.IfNil(am))
+		assert.True(t, errors.Is(err, ErrDuplicatedTokenName))
+	})
+	t.Run("should work", func(t *testing.T) {
+		am, err := NewAuthMiddleware(createMockArgsAuthMiddleware())
+		assert.False(t, check.IfNil(am))
+		assert.Nil(t, err)
+		assert.Equal(t, statusScope, am.getRouteScope("/node/status"))
+		assert.Equal(t, AdminScope, am.getRouteScope("/node/peerinfo"))
+		assert.Equal(t, PublicScope, am.getRouteScope("/log"))
+		assert.Equal(t, AdminScope, am.getRouteScope("/debug/pprof"))
+	})
+}
+
+func TestAuthMiddleware_BearerToken(t *testing.T) {
+	t.Parallel()
+
+	am, _ := NewAuthMiddleware(createMockArgsAuthMiddleware())
+	ws := startWebServer(am)
+
+	createRequest := func(target string, secret string) *http.Request {
+		req, _ := http.NewRequest(http.MethodGet, target, nil)
+		if len(secret) > 0 {
+			req.Header.Set("Authorization", "Bearer "+secret)
+		}
+
+		return req
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, doRequest(ws, createRequest("/node/status", "")))
+	assert.Equal(t, http.StatusUnauthorized, doRequest(ws, createRequest("/node/status", "wrong-secret")))
+	assert.Equal(t, http.StatusOK, doRequest(ws, createRequest("/node/status", statusSecret)))
+	assert.Equal(t, http.StatusForbidden, doRequest(ws, createRequest("/node/peerinfo", statusSecret)))
+	assert.Equal(t, http.StatusOK, doRequest(ws, createRequest("/node/peerinfo", adminSecret)))
+	assert.Equal(t, http.StatusOK, doRequest(ws, createRequest("/node/status", adminSecret)))
+	assert.Equal(t, http.StatusOK, doRequest(ws, createRequest("/log", "")))
+	assert.Equal(t, http.StatusNotFound, doRequest(ws, createRequest("/missing", "")))
+}
+
+func TestAuthMiddleware_SignedRequests(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1700000000, 0)
+	am, _ := NewAuthMiddleware(createMockArgsAuthMiddleware())
+	am.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+	ws := startWebServer(am)
+
+	t.Run("valid signature should work", func(t *testing.T) {
+		req := createSignedRequest(http.MethodGet, "/node/status?param=1", "", "partner", statusSecret, currentTime)
+		assert.Equal(t, http.StatusOK, doRequest(ws, req))
+	})
+	t.Run("valid signature with body should work and keep the body readable", func(t *testing.T) {
+		body := `{"key":"value"}`
+		bodyRead := ""
+		wsWithBody := gin.New()
+		wsWithBody.Use(am.MiddlewareHandlerFunc())
+		wsWithBody.POST("/debug/pprof", func(c *gin.Context) {
+			buff, _ := c.GetRawData()
+			bodyRead = string(buff)
+			c.String(http.StatusOK, "ok")
+		})
+
+		req := createSignedRequest(http.MethodPost, "/debug/pprof", body, "operator", adminSecret, currentTime)
+		require.Equal(t, http.StatusOK, doRequest(wsWithBody, req))
+		assert.Equal(t, body, bodyRead)
+	})
+	t.Run("unknown key name should return unauthorized", func(t *testing.T) {
+		req := createSignedRequest(http.MethodGet, "/node/status", "", "unknown", statusSecret, currentTime)
+		assert.Equal(t, http.StatusUnauthorized, doRequest(ws, req))
+	})
+	t.Run("wrong secret should return unauthorized", func(t *testing.T) {
+		req := createSignedRequest(http.MethodGet, "/node/status", "", "partner", adminSecret, currentTime)
+		assert.Equal(t, http.StatusUnauthorized, doRequest(ws, req))
+	})
+	t.Run("tampered request should return unauthorized", func(t *testing.T) {
+		req := createSignedRequest(http.MethodGet, "/node/status?param=1", "", "partner", statusSecret, currentTime)
+		req.URL.RawQuery = "param=2"
+		assert.Equal(t, http.StatusUnauthorized, doRequest(ws, req))
+	})
+	t.Run("invalid timestamp should return unauthorized", func(t *testing.T) {
+		req := createSignedRequest(http.MethodGet, "/node/status", "", "partner", statusSecret, currentTime)
+		req.Header.Set(TimestampHeader, "not a number")
+		assert.Equal(t, http.StatusUnauthorized, doRequest(ws, req))
+	})
+	t.Run("expired or future timestamp should return unauthorized", func(t *testing.T) {
+		req := createSignedRequest(http.MethodGet, "/node/status", "", "partner", statusSecret, currentTime.Add(-time.Minute))
+		assert.Equal(t, http.StatusUnauthorized, doRequest(ws, req))
+
+		req = createSignedRequest(http.MethodGet, "/node/status", "", "partner", statusSecret, currentTime.Add(time.Minute))
+		assert.Equal(t, http.StatusUnauthorized, doRequest(ws, req))
+	})
+	t.Run("missing scope should return forbidden", func(t *testing.T) {
+		req := createSignedRequest(http.MethodGet, "/node/peerinfo", "", "partner", statusSecret, currentTime)
+		assert.Equal(t, http.StatusForbidden, doRequest(ws, req))
+	})
+}
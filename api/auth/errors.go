@@ -0,0 +1,27 @@
+package auth
+
+import "errors"
+
+// ErrNoAuthTokens signals that the authentication was enabled without any token
+var ErrNoAuthTokens = errors.New("no auth tokens")
+
+// ErrEmptyTokenName signals that a token with an empty name was provided
+var ErrEmptyTokenName = errors.New("empty token name")
+
+// ErrDuplicatedTokenName signals that the same token name was provided more than once
+var ErrDuplicatedTokenName = errors.New("duplicated token name")
+
+// ErrEmptyTokenSecret signals that a token with an empty secret was provided
+var ErrEmptyTokenSecret = errors.New("empty token secret")
+
+// ErrNoTokenScopes signals that a token without any scope was provided
+var ErrNoTokenScopes = errors.New("no token scopes")
+
+// ErrInvalidClockSkew signals that an invalid maximum clock skew was provided
+var ErrInvalidClockSkew = errors.New("invalid maximum clock skew")
+
+// ErrUnauthorized signals that the request did not provide valid credentials
+var ErrUnauthorized = errors.New("unauthorized")
+
+// ErrForbidden signals that the provided credentials can not access the requested route
+var ErrForbidden = errors.New("forbidden")
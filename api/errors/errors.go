@@ -13,3 +13,6 @@ var ErrNilAntiFloodConfig = errors.New("nil antiflood config")
 
 // ErrNilApiConfig signals that a nil api config has been provided
 var ErrNilApiConfig = errors.New("nil api config")
+
+// ErrMissingTLSFiles signals that the TLS was enabled without providing the certificate and key files
+var ErrMissingTLSFiles = errors.New("missing TLS certificate or key file")
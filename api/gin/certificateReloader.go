@@ -0,0 +1,107 @@
+package gin
+
+import (
+	"crypto/tls"
+	"os"
+	"sync"
+	"time"
+
+	apiErrors "github.com/klever-io/klv-bridge-eth-go/api/errors"
+	"github.com/klever-io/klv-bridge-eth-go/config"
+)
+
+// certificateReloader provides the TLS certificate to the http server and reloads it from disk when the certificate
+// or the key files change, so renewed certificates are picked up without restarting the relayer
+type certificateReloader struct {
+	certificateFile string
+	keyFile         string
+	reloadInterval  time.Duration
+	getTimeHandler  func() time.Time
+
+	mutCertificate sync.Mutex
+	certificate    *tls.Certificate
+	lastModTime    time.Time
+	lastCheck      time.Time
+}
+
+func newCertificateReloader(tlsConfig config.ApiTLSConfig) (*certificateReloader, error) {
+	if len(tlsConfig.CertificateFile) == 0 || len(tlsConfig.KeyFile) == 0 {
+		return nil, apiErrors.ErrMissingTLSFiles
+	}
+
+	cr := &certificateReloader{
+		certificateFile: tlsConfig.CertificateFile,
+		keyFile:         tlsConfig.KeyFile,
+		reloadInterval:  time.Duration(tlsConfig.ReloadIntervalInSeconds) * time.Second,
+		getTimeHandler:  time.Now,
+	}
+
+	modTime, err := cr.getLatestModTime()
+	if err != nil {
+		return nil, err
+	}
+
+	certificate, err := tls.LoadX509KeyPair(cr.certificateFile, cr.keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	cr.certificate = &certificate
+	cr.lastModTime = modTime
+	cr.lastCheck = cr.getTimeHandler()
+
+	return cr, nil
+}
+
+// GetCertificate returns the current certificate, reloading it first if the reload interval passed and the files changed.
+// A failed reload keeps the previous certificate
+func (cr *certificateReloader) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cr.mutCertificate.Lock()
+	defer cr.mutCertificate.Unlock()
+
+	now := cr.getTimeHandler()
+	if cr.reloadInterval > 0 && now.Sub(cr.lastCheck) >= cr.reloadInterval {
+		cr.lastCheck = now
+		cr.reloadIfChanged()
+	}
+
+	return cr.certificate, nil
+}
+
+func (cr *certificateReloader) reloadIfChanged() {
+	modTime, err := cr.getLatestModTime()
+	if err != nil {
+		log.Error("can not check the TLS certificate files", "error", err)
+		return
+	}
+	if !modTime.After(cr.lastModTime) {
+		return
+	}
+
+	certificate, err := tls.LoadX509KeyPair(cr.certificateFile, cr.keyFile)
+	if err != nil {
+		log.Error("can not reload the TLS certificate, keeping the previous one", "error", err)
+		return
+	}
+
+	cr.certificate = &certificate
+	cr.lastModTime = modTime
+	log.Info("reloaded the TLS certificate", "certificate file", cr.certificateFile)
+}
+
+func (cr *certificateReloader) getLatestModTime() (time.Time, error) {
+	certificateInfo, err := os.Stat(cr.certificateFile)
+	if err != nil {
+		return time.Time{}, err
+	}
+	keyInfo, err := os.Stat(cr.keyFile)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if keyInfo.ModTime().After(certificateInfo.ModTime()) {
+		return keyInfo.ModTime(), nil
+	}
+
+	return certificateInfo.ModTime(), nil
+}
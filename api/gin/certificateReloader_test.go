@@ -0,0 +1,154 @@
+package gin
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	apiErrors "github.com/klever-io/klv-bridge-eth-go/api/errors"
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeSelfSignedCertificate(t *testing.T, dir string, commonName string) config.ApiTLSConfig {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+	}
+	certificateBytes, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.Nil(t, err)
+	keyBytes, err := x509.MarshalECPrivateKey(privateKey)
+	require.Nil(t, err)
+
+	tlsConfig := config.ApiTLSConfig{
+		Enabled:         true,
+		CertificateFile: filepath.Join(dir, "cert.pem"),
+		KeyFile:         filepath.Join(dir, "key.pem"),
+	}
+	err = os.WriteFile(tlsConfig.CertificateFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificateBytes}), 0600)
+	require.Nil(t, err)
+	err = os.WriteFile(tlsConfig.KeyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0600)
+	require.Nil(t, err)
+
+	return tlsConfig
+}
+
+func getCommonName(t *testing.T, cr *certificateReloader) string {
+	certificate, err := cr.GetCertificate(nil)
+	require.Nil(t, err)
+	parsed, err := x509.ParseCertificate(certificate.Certificate[0])
+	require.Nil(t, err)
+
+	return parsed.Subject.CommonName
+}
+
+func TestNewCertificateReloader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing files should error", func(t *testing.T) {
+		t.Parallel()
+
+		cr, err := newCertificateReloader(config.ApiTLSConfig{Enabled: true, KeyFile: "key.pem"})
+		assert.Nil(t, cr)
+		assert.Equal(t, apiErrors.ErrMissingTLSFiles, err)
+	})
+	t.Run("inexistent files should error", func(t *testing.T) {
+		t.Parallel()
+
+		cr, err := newCertificateReloader(config.ApiTLSConfig{Enabled: true, CertificateFile: "missing.pem", KeyFile: "missing.pem"})
+		assert.Nil(t, cr)
+		assert.NotNil(t, err)
+	})
+	t.Run("invalid files should error", func(t *testing.T) {
+		t.Parallel()
+
+		tlsConfig := writeSelfSignedCertificate(t, t.TempDir(), "first")
+		err := os.WriteFile(tlsConfig.KeyFile, []byte("invalid key"), 0600)
+		require.Nil(t, err)
+
+		cr, err := newCertificateReloader(tlsConfig)
+		assert.Nil(t, cr)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cr, err := newCertificateReloader(writeSelfSignedCertificate(t, t.TempDir(), "first"))
+		require.Nil(t, err)
+		assert.Equal(t, "first", getCommonName(t, cr))
+	})
+}
+
+func TestCertificateReloader_GetCertificate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("changed files should be reloaded after the reload interval", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		tlsConfig := writeSelfSignedCertificate(t, dir, "first")
+		tlsConfig.ReloadIntervalInSeconds = 60
+		cr, _ := newCertificateReloader(tlsConfig)
+		currentTime := time.Now()
+		cr.getTimeHandler = func() time.Time {
+			return currentTime
+		}
+
+		writeSelfSignedCertificate(t, dir, "second")
+		future := time.Now().Add(time.Minute)
+		_ = os.Chtimes(tlsConfig.CertificateFile, future, future)
+
+		assert.Equal(t, "first", getCommonName(t, cr)) // reload interval did not pass
+
+		currentTime = currentTime.Add(time.Minute * 2)
+		assert.Equal(t, "second", getCommonName(t, cr))
+	})
+	t.Run("invalid new files should keep the previous certificate", func(t *testing.T) {
+		t.Parallel()
+
+		tlsConfig := writeSelfSignedCertificate(t, t.TempDir(), "first")
+		tlsConfig.ReloadIntervalInSeconds = 1
+		cr, _ := newCertificateReloader(tlsConfig)
+		cr.getTimeHandler = func() time.Time {
+			return time.Now().Add(time.Hour)
+		}
+
+		err := os.WriteFile(tlsConfig.KeyFile, []byte("invalid key"), 0600)
+		require.Nil(t, err)
+		future := time.Now().Add(time.Minute)
+		_ = os.Chtimes(tlsConfig.KeyFile, future, future)
+
+		assert.Equal(t, "first", getCommonName(t, cr))
+	})
+	t.Run("zero reload interval should never reload", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		tlsConfig := writeSelfSignedCertificate(t, dir, "first")
+		cr, _ := newCertificateReloader(tlsConfig)
+		cr.getTimeHandler = func() time.Time {
+			return time.Now().Add(time.Hour)
+		}
+
+		writeSelfSignedCertificate(t, dir, "second")
+		future := time.Now().Add(time.Minute)
+		_ = os.Chtimes(tlsConfig.CertificateFile, future, future)
+
+		assert.Equal(t, "first", getCommonName(t, cr))
+	})
+}
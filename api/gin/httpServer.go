@@ -46,6 +46,16 @@ func (h *httpServer) Close() error {
 	return h.server.Shutdown(ctx)
 }
 
+// tlsServer starts the wrapped http.Server with TLS, the certificate being provided by the server's TLS config
+type tlsServer struct {
+	*http.Server
+}
+
+// ListenAndServe listens on the server's address and serves the requests over TLS
+func (server *tlsServer) ListenAndServe() error {
+	return server.ListenAndServeTLS("", "")
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (h *httpServer) IsInterfaceNil() bool {
 	return h == nil
@@ -2,23 +2,22 @@ package gin
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"sync"
 	"time"
 
-	"github.com/btcsuite/websocket"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-contrib/pprof"
 	"github.com/gin-gonic/gin"
+	"github.com/klever-io/klv-bridge-eth-go/api/auth"
 	apiErrors "github.com/klever-io/klv-bridge-eth-go/api/errors"
 	"github.com/klever-io/klv-bridge-eth-go/api/groups"
 	"github.com/klever-io/klv-bridge-eth-go/api/shared"
 	"github.com/klever-io/klv-bridge-eth-go/config"
 	"github.com/klever-io/klv-bridge-eth-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
-	"github.com/multiversx/mx-chain-core-go/marshal"
-	"github.com/multiversx/mx-chain-go/api/logs"
 	"github.com/multiversx/mx-chain-go/api/middleware"
 	chainShared "github.com/multiversx/mx-chain-go/api/shared"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -116,8 +115,12 @@ func (ws *webServer) StartHttpServer() error {
 
 	ws.registerRoutes(engine)
 
-	serverInstance := &http.Server{Addr: ws.facade.RestApiInterface(), Handler: engine}
-	log.Debug("creating gin web sever", "interface", ws.facade.RestApiInterface())
+	serverInstance, err := ws.createServer(engine)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("creating gin web sever", "interface", ws.facade.RestApiInterface(), "TLS", ws.apiConfig.TLS.Enabled)
 	ws.httpServer, err = NewHttpServer(serverInstance)
 	if err != nil {
 		return err
@@ -129,6 +132,27 @@ func (ws *webServer) StartHttpServer() error {
 	return nil
 }
 
+func (ws *webServer) createServer(engine *gin.Engine) (server, error) {
+	serverInstance := &http.Server{Addr: ws.facade.RestApiInterface(), Handler: engine}
+	if !ws.apiConfig.TLS.Enabled {
+		return serverInstance, nil
+	}
+
+	reloader, err := newCertificateReloader(ws.apiConfig.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	serverInstance.TLSConfig = &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: reloader.GetCertificate,
+	}
+
+	return &tlsServer{
+		Server: serverInstance,
+	}, nil
+}
+
 func (ws *webServer) createGroups() error {
 	groupsMap := make(map[string]shared.GroupHandler)
 
@@ -138,6 +162,39 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["node"] = nodeGroup
 
+	logGroup, err := groups.NewLogGroup(ws.apiConfig.LogStreaming.AuthToken)
+	if err != nil {
+		return err
+	}
+	groupsMap["log"] = logGroup
+
+	tokensFacade, ok := ws.facade.(shared.TokensFacadeHandler)
+	if ok {
+		tokensGroup, errCreate := groups.NewTokensGroup(tokensFacade)
+		if errCreate != nil {
+			return errCreate
+		}
+		groupsMap["tokens"] = tokensGroup
+	}
+
+	relayersFacade, ok := ws.facade.(shared.RelayersFacadeHandler)
+	if ok {
+		relayersGroup, errCreate := groups.NewRelayersGroup(relayersFacade)
+		if errCreate != nil {
+			return errCreate
+		}
+		groupsMap["relayers"] = relayersGroup
+	}
+
+	scCallsFacade, ok := ws.facade.(shared.ScCallsFacadeHandler)
+	if ok {
+		scCallsGroup, errCreate := groups.NewScCallsGroup(scCallsFacade)
+		if errCreate != nil {
+			return errCreate
+		}
+		groupsMap["sc-calls"] = scCallsGroup
+	}
+
 	ws.groups = groupsMap
 
 	return nil
@@ -173,39 +230,11 @@ func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 		groupHandler.RegisterRoutes(ginGroup, ws.apiConfig)
 	}
 
-	marshalizerForLogs := &marshal.GogoProtoMarshalizer{}
-	registerLoggerWsRoute(ginRouter, marshalizerForLogs)
-
 	if ws.facade.PprofEnabled() {
 		pprof.Register(ginRouter)
 	}
 }
 
-// registerLoggerWsRoute will register the log route
-func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer) {
-	upgrader := websocket.Upgrader{}
-
-	ws.GET("/log", func(c *gin.Context) {
-		upgrader.CheckOrigin = func(r *http.Request) bool {
-			return true
-		}
-
-		conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
-		if err != nil {
-			log.Error(err.Error())
-			return
-		}
-
-		ls, err := logs.NewLogSender(marshalizer, conn, log)
-		if err != nil {
-			log.Error(err.Error())
-			return
-		}
-
-		ls.StartSendingBlocking()
-	})
-}
-
 func (ws *webServer) createMiddlewareLimiters() ([]chainShared.MiddlewareProcessor, error) {
 	middlewares := make([]chainShared.MiddlewareProcessor, 0)
 
@@ -221,6 +250,19 @@ func (ws *webServer) createMiddlewareLimiters() ([]chainShared.MiddlewareProcess
 
 	middlewares = append(middlewares, antiFloodLimiters...)
 
+	if ws.apiConfig.Auth.Enabled {
+		argsAuthMiddleware := auth.ArgsAuthMiddleware{
+			Config:      ws.apiConfig.Auth,
+			APIPackages: ws.apiConfig.APIPackages,
+		}
+		authMiddleware, errCreate := auth.NewAuthMiddleware(argsAuthMiddleware)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		middlewares = append(middlewares, authMiddleware)
+	}
+
 	return middlewares, nil
 }
 
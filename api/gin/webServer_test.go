@@ -1,11 +1,13 @@
 package gin
 
 import (
+	"crypto/tls"
 	"errors"
 	"net/http"
 	"testing"
 	"time"
 
+	"github.com/klever-io/klv-bridge-eth-go/api/auth"
 	apiErrors "github.com/klever-io/klv-bridge-eth-go/api/errors"
 	"github.com/klever-io/klv-bridge-eth-go/api/shared"
 	"github.com/klever-io/klv-bridge-eth-go/config"
@@ -15,8 +17,11 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+const testAuthToken = "auth-token"
+
 func createMockArgsNewWebServer() ArgsNewWebServer {
 	return ArgsNewWebServer{
 		Facade: &facade.RelayerFacadeStub{
@@ -45,6 +50,18 @@ func createMockArgsNewWebServer() ArgsNewWebServer {
 	}
 }
 
+func createMockArgsNewWebServerWithLogRoute() ArgsNewWebServer {
+	args := createMockArgsNewWebServer()
+	args.ApiConfig.LogStreaming.AuthToken = testAuthToken
+	args.ApiConfig.APIPackages["log"] = config.APIPackageConfig{
+		Routes: []config.RouteConfig{
+			{Name: "", Open: true},
+		},
+	}
+
+	return args
+}
+
 func TestNewWebServerHandler(t *testing.T) {
 	t.Parallel()
 
@@ -108,8 +125,86 @@ func TestWebServer_StartHttpServer(t *testing.T) {
 		err := ws.StartHttpServer()
 		assert.Equal(t, middleware.ErrInvalidMaxNumRequests, err)
 	})
-	t.Run("upgrade on get returns error", func(t *testing.T) {
-		ws, _ := NewWebServerHandler(createMockArgsNewWebServer())
+	t.Run("TLS enabled without certificate files should error", func(t *testing.T) {
+		args := createMockArgsNewWebServer()
+		args.ApiConfig.TLS.Enabled = true
+		ws, _ := NewWebServerHandler(args)
+		assert.False(t, check.IfNil(ws))
+
+		err := ws.StartHttpServer()
+		assert.Equal(t, apiErrors.ErrMissingTLSFiles, err)
+	})
+	t.Run("auth enabled without tokens should error", func(t *testing.T) {
+		args := createMockArgsNewWebServer()
+		args.ApiConfig.Auth = config.ApiAuthConfig{
+			Enabled:               true,
+			MaxClockSkewInSeconds: 30,
+		}
+		ws, _ := NewWebServerHandler(args)
+		assert.False(t, check.IfNil(ws))
+
+		err := ws.StartHttpServer()
+		assert.Equal(t, auth.ErrNoAuthTokens, err)
+		_ = ws.Close()
+	})
+	t.Run("TLS and auth enabled should work", func(t *testing.T) {
+		args := createMockArgsNewWebServer()
+		args.AntiFloodConfig.Enabled = false
+		args.ApiConfig.TLS = writeSelfSignedCertificate(t, t.TempDir(), "localhost")
+		args.ApiConfig.Auth = config.ApiAuthConfig{
+			Enabled:               true,
+			MaxClockSkewInSeconds: 30,
+			Tokens: []config.ApiTokenConfig{
+				{
+					Name:   "partner",
+					Secret: "status-secret",
+					Scopes: []string{"status"},
+				},
+			},
+		}
+		args.ApiConfig.APIPackages["node"] = config.APIPackageConfig{
+			Routes: []config.RouteConfig{
+				{Name: "/status", Open: true, Scope: "status"},
+				{Name: "/status/list", Open: true},
+			},
+		}
+		ws, _ := NewWebServerHandler(args)
+		assert.False(t, check.IfNil(ws))
+
+		err := ws.StartHttpServer()
+		assert.Nil(t, err)
+
+		time.Sleep(2 * time.Second)
+
+		client := &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			},
+		}
+		doRequest := func(path string, secret string) int {
+			req, errCreate := http.NewRequest(http.MethodGet, "https://127.0.0.1:8080"+path, nil)
+			require.Nil(t, errCreate)
+			if len(secret) > 0 {
+				req.Header.Set("Authorization", "Bearer "+secret)
+			}
+
+			resp, errDo := client.Do(req)
+			require.Nil(t, errDo)
+			_ = resp.Body.Close()
+
+			return resp.StatusCode
+		}
+
+		assert.Equal(t, http.StatusUnauthorized, doRequest("/node/status", ""))
+		assert.Equal(t, http.StatusOK, doRequest("/node/status", "status-secret"))
+		assert.Equal(t, http.StatusForbidden, doRequest("/node/status/list", "status-secret"))
+
+		client.CloseIdleConnections()
+		err = ws.Close()
+		assert.Nil(t, err)
+	})
+	t.Run("log route without token returns unauthorized", func(t *testing.T) {
+		ws, _ := NewWebServerHandler(createMockArgsNewWebServerWithLogRoute())
 		assert.False(t, check.IfNil(ws))
 
 		err := ws.StartHttpServer()
@@ -119,6 +214,23 @@ func TestWebServer_StartHttpServer(t *testing.T) {
 
 		resp, err := http.Get("http://127.0.0.1:8080/log")
 		assert.Nil(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		time.Sleep(2 * time.Second)
+		err = ws.Close()
+		assert.Nil(t, err)
+	})
+	t.Run("upgrade on get returns error", func(t *testing.T) {
+		ws, _ := NewWebServerHandler(createMockArgsNewWebServerWithLogRoute())
+		assert.False(t, check.IfNil(ws))
+
+		err := ws.StartHttpServer()
+		assert.Nil(t, err)
+
+		time.Sleep(2 * time.Second)
+
+		resp, err := http.Get("http://127.0.0.1:8080/log?token=" + testAuthToken)
+		assert.Nil(t, err)
 		assert.Equal(t, http.StatusBadRequest, resp.StatusCode) // Bad request
 
 		time.Sleep(2 * time.Second)
@@ -126,7 +238,7 @@ func TestWebServer_StartHttpServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 	t.Run("should work", func(t *testing.T) {
-		ws, _ := NewWebServerHandler(createMockArgsNewWebServer())
+		ws, _ := NewWebServerHandler(createMockArgsNewWebServerWithLogRoute())
 		assert.False(t, check.IfNil(ws))
 
 		err := ws.StartHttpServer()
@@ -138,6 +250,7 @@ func TestWebServer_StartHttpServer(t *testing.T) {
 		req, err := http.NewRequest("GET", "http://127.0.0.1:8080/log", nil)
 		assert.Nil(t, err)
 
+		req.Header.Set("Authorization", "Bearer "+testAuthToken)
 		req.Header.Set("Sec-Websocket-Version", "13")
 		req.Header.Set("Connection", "upgrade")
 		req.Header.Set("Upgrade", "websocket")
@@ -4,3 +4,12 @@ import "errors"
 
 // ErrGettingMetrics signals that an error occurred while getting the metrics
 var ErrGettingMetrics = errors.New("error getting metrics")
+
+// ErrWrongFacadeType signals that the provided facade does not implement the methods required by the group
+var ErrWrongFacadeType = errors.New("wrong facade type")
+
+// ErrGettingPendingOperations signals that an error occurred while getting the pending SC calls
+var ErrGettingPendingOperations = errors.New("error getting pending operations")
+
+// ErrUnauthorized signals that the request did not provide a valid auth token
+var ErrUnauthorized = errors.New("unauthorized")
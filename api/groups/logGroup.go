@@ -0,0 +1,109 @@
+package groups
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/btcsuite/websocket"
+	"github.com/gin-gonic/gin"
+	"github.com/klever-io/klv-bridge-eth-go/api/logs"
+	"github.com/klever-io/klv-bridge-eth-go/api/shared"
+	chainAPIShared "github.com/multiversx/mx-chain-go/api/shared"
+)
+
+const (
+	// logPath is empty so the websocket is served directly on the group root, as /log
+	logPath             = ""
+	bearerPrefix        = "Bearer "
+	tokenQueryParameter = "token"
+)
+
+type logGroup struct {
+	*baseGroup
+	authToken string
+	upgrader  websocket.Upgrader
+}
+
+// NewLogGroup returns a new instance of logGroup. The clients must provide the auth token either as a bearer
+// Authorization header or as the token query parameter, an empty auth token rejects all the connections
+func NewLogGroup(authToken string) (*logGroup, error) {
+	lg := &logGroup{
+		baseGroup: &baseGroup{},
+		authToken: authToken,
+	}
+
+	endpoints := []*chainAPIShared.EndpointHandlerData{
+		{
+			Path:    logPath,
+			Method:  http.MethodGet,
+			Handler: lg.streamLogs,
+		},
+	}
+	lg.endpoints = endpoints
+
+	return lg, nil
+}
+
+// streamLogs upgrades the connection to a websocket and streams the log lines requested by the client's subscription
+func (lg *logGroup) streamLogs(c *gin.Context) {
+	if !lg.isAuthorized(c.Request) {
+		c.JSON(
+			http.StatusUnauthorized,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: ErrUnauthorized.Error(),
+				Code:  chainAPIShared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	conn, err := lg.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("can not upgrade the log websocket connection", "error", err)
+		return
+	}
+
+	argsLogSender := logs.ArgsLogSender{
+		Conn: conn,
+		Log:  log,
+	}
+	ls, err := logs.NewLogSender(argsLogSender)
+	if err != nil {
+		log.Error("can not create the log sender", "error", err)
+		_ = conn.Close()
+		return
+	}
+
+	ls.StartSendingBlocking()
+}
+
+func (lg *logGroup) isAuthorized(request *http.Request) bool {
+	if len(lg.authToken) == 0 {
+		return false
+	}
+
+	// the query parameter is checked as well so the header can carry the Rest API token when the auth is enabled
+	queryToken := request.URL.Query().Get(tokenQueryParameter)
+	isQueryTokenValid := subtle.ConstantTimeCompare([]byte(queryToken), []byte(lg.authToken)) == 1
+
+	headerToken := ""
+	authorization := request.Header.Get("Authorization")
+	if strings.HasPrefix(authorization, bearerPrefix) {
+		headerToken = strings.TrimPrefix(authorization, bearerPrefix)
+	}
+	isHeaderTokenValid := subtle.ConstantTimeCompare([]byte(headerToken), []byte(lg.authToken)) == 1
+
+	return isQueryTokenValid || isHeaderTokenValid
+}
+
+// UpdateFacade does nothing as the log group does not rely on the facade
+func (lg *logGroup) UpdateFacade(_ shared.FacadeHandler) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lg *logGroup) IsInterfaceNil() bool {
+	return lg == nil
+}
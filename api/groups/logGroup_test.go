@@ -0,0 +1,178 @@
+package groups
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/websocket"
+	"github.com/klever-io/klv-bridge-eth-go/api/logs"
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAuthToken = "auth-token"
+
+func getLogRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"log": {
+				Routes: []config.RouteConfig{
+					{Name: "", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func TestNewLogGroup(t *testing.T) {
+	t.Parallel()
+
+	lg, err := NewLogGroup(testAuthToken)
+	assert.False(t, check.IfNil(lg))
+	assert.Nil(t, err)
+	assert.Nil(t, lg.UpdateFacade(nil))
+}
+
+func TestLogGroup_StreamLogs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("closed route should not respond", func(t *testing.T) {
+		t.Parallel()
+
+		lg, _ := NewLogGroup(testAuthToken)
+		ws := startWebServer(lg, "log", config.ApiRoutesConfig{})
+
+		req, _ := http.NewRequest("GET", "/log?token="+testAuthToken, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusNotFound, resp.Code)
+	})
+	t.Run("missing token should return unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		lg, _ := NewLogGroup(testAuthToken)
+		ws := startWebServer(lg, "log", getLogRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/log", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &generalResponse{}
+		loadResponse(resp.Body, response)
+		require.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Equal(t, ErrUnauthorized.Error(), response.Error)
+	})
+	t.Run("wrong token should return unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		lg, _ := NewLogGroup(testAuthToken)
+		ws := startWebServer(lg, "log", getLogRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/log?token=wrong-token", nil)
+		req.Header.Set("Authorization", "Bearer wrong-token")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("empty configured token should reject all connections", func(t *testing.T) {
+		t.Parallel()
+
+		lg, _ := NewLogGroup("")
+		ws := startWebServer(lg, "log", getLogRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/log?token=", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("valid query token with a different bearer token should be accepted", func(t *testing.T) {
+		t.Parallel()
+
+		lg, _ := NewLogGroup(testAuthToken)
+		ws := startWebServer(lg, "log", getLogRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/log?token="+testAuthToken, nil)
+		req.Header.Set("Authorization", "Bearer rest-api-token")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusBadRequest, resp.Code) // passed the auth, failed the websocket upgrade
+	})
+	t.Run("valid token without websocket upgrade should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		lg, _ := NewLogGroup(testAuthToken)
+		ws := startWebServer(lg, "log", getLogRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/log", nil)
+		req.Header.Set("Authorization", "Bearer "+testAuthToken)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("should stream the subscribed log lines", func(t *testing.T) {
+		t.Parallel()
+
+		lg, _ := NewLogGroup(testAuthToken)
+		server := httptest.NewServer(startWebServer(lg, "log", getLogRoutesConfig()))
+		defer server.Close()
+
+		header := http.Header{}
+		header.Set("Authorization", "Bearer "+testAuthToken)
+		wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/log"
+		dialer := &websocket.Dialer{}
+		conn, _, err := dialer.Dial(wsURL, header)
+		require.Nil(t, err)
+		defer func() {
+			_ = conn.Close()
+		}()
+
+		loggerName := "logGroupTestLogger"
+		subscription, _ := json.Marshal(&logs.Subscription{LogLevel: loggerName + ":INFO"})
+		err = conn.WriteMessage(websocket.TextMessage, subscription)
+		require.Nil(t, err)
+
+		testLog := logger.GetOrCreate(loggerName)
+		testLog.SetLevel(logger.LogTrace)
+
+		frames := make(chan *logs.LogFrame, 1)
+		go func() {
+			_, message, errRead := conn.ReadMessage()
+			if errRead != nil {
+				return
+			}
+
+			frame := &logs.LogFrame{}
+			_ = json.Unmarshal(message, frame)
+			frames <- frame
+		}()
+
+		timeout := time.After(time.Second * 5)
+		for {
+			testLog.Debug("filtered line")
+			testLog.Info("streamed line")
+
+			select {
+			case frame := <-frames:
+				assert.Equal(t, loggerName, frame.Logger)
+				assert.Equal(t, "INFO", frame.Level)
+				assert.Equal(t, "streamed line", frame.Message)
+				return
+			case <-timeout:
+				require.Fail(t, "timeout waiting for the log frame")
+			case <-time.After(time.Millisecond * 10):
+			}
+		}
+	})
+}
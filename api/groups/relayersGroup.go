@@ -0,0 +1,88 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/klever-io/klv-bridge-eth-go/api/shared"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	chainAPIShared "github.com/multiversx/mx-chain-go/api/shared"
+)
+
+// relayersPath is empty so the health view is served directly on the group root, as /relayers
+const relayersPath = ""
+
+type relayersGroup struct {
+	*baseGroup
+	facade    shared.RelayersFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewRelayersGroup returns a new instance of relayersGroup
+func NewRelayersGroup(facade shared.RelayersFacadeHandler) (*relayersGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for relayers group", errors.ErrNilFacadeHandler)
+	}
+
+	rg := &relayersGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*chainAPIShared.EndpointHandlerData{
+		{
+			Path:    relayersPath,
+			Method:  http.MethodGet,
+			Handler: rg.relayers,
+		},
+	}
+	rg.endpoints = endpoints
+
+	return rg, nil
+}
+
+// relayers returns the health view of the relayers set
+func (rg *relayersGroup) relayers(c *gin.Context) {
+	snapshot := rg.getFacade().GetRelayersHealth()
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  snapshot,
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func (rg *relayersGroup) getFacade() shared.RelayersFacadeHandler {
+	rg.mutFacade.RLock()
+	defer rg.mutFacade.RUnlock()
+
+	return rg.facade
+}
+
+// UpdateFacade will update the facade
+func (rg *relayersGroup) UpdateFacade(newFacade shared.FacadeHandler) error {
+	if check.IfNil(newFacade) {
+		return errors.ErrNilFacadeHandler
+	}
+	relayersFacade, ok := newFacade.(shared.RelayersFacadeHandler)
+	if !ok {
+		return fmt.Errorf("%w for relayers group", ErrWrongFacadeType)
+	}
+
+	rg.mutFacade.Lock()
+	rg.facade = relayersFacade
+	rg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rg *relayersGroup) IsInterfaceNil() bool {
+	return rg == nil
+}
@@ -0,0 +1,150 @@
+package groups
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	mockFacade "github.com/klever-io/klv-bridge-eth-go/testsCommon/facade"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type relayersResponse struct {
+	Data  *core.RelayersHealthSnapshot `json:"data"`
+	Error string                       `json:"error"`
+}
+
+func getRelayersRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"relayers": {
+				Routes: []config.RouteConfig{
+					{Name: "", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func TestNewRelayersGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		rg, err := NewRelayersGroup(nil)
+
+		assert.True(t, check.IfNil(rg))
+		assert.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+	})
+	t.Run("should work", func(t *testing.T) {
+		rg, err := NewRelayersGroup(&mockFacade.RelayerFacadeStub{})
+
+		assert.False(t, check.IfNil(rg))
+		assert.Nil(t, err)
+	})
+}
+
+func TestGetRelayersHealth_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	snapshot := &core.RelayersHealthSnapshot{
+		Quorum:         2,
+		NumWhitelisted: 3,
+		NumJoined:      2,
+		NumLagging:     1,
+		IsQuorumAtRisk: false,
+		LastBatchID:    44,
+		Relayers: []*core.RelayerHealth{
+			{
+				Address:                "klv17la0vdplk320zvy9s7qps5j69ff2yl3dn0drmhyuw57dnhe23g5schkvag",
+				IsSelf:                 true,
+				IsCurrentLeader:        true,
+				LastJoinTimestamp:      1000,
+				LastSignatureTimestamp: 1100,
+				Signatures: []core.RelayerSignature{
+					{
+						MessageHash: "aabb",
+						BatchID:     44,
+						Timestamp:   1100,
+					},
+				},
+				NumBatchesAsLeader: 4,
+				LastBatchAsLeader:  44,
+			},
+			{
+				Address:             "klv1qqqqqqqqqqqqqpgqevhczyxnvn4ndgu8a2nd40ezhyagwqfwsg8s26azxp",
+				IsLagging:           true,
+				NumMissedSignatures: 2,
+				LastMissedBatchID:   44,
+			},
+		},
+	}
+	facade := mockFacade.RelayerFacadeStub{
+		GetRelayersHealthCalled: func() *core.RelayersHealthSnapshot {
+			return snapshot
+		},
+	}
+
+	rg, err := NewRelayersGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(rg, "relayers", getRelayersRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/relayers", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	relayersRsp := relayersResponse{}
+	loadResponse(resp.Body, &relayersRsp)
+
+	assert.Equal(t, snapshot, relayersRsp.Data)
+
+	require.Equal(t, resp.Code, http.StatusOK)
+	assert.Empty(t, relayersRsp.Error)
+}
+
+func TestGetRelayersHealth_ClosedRouteShouldNotRespond(t *testing.T) {
+	t.Parallel()
+
+	rg, err := NewRelayersGroup(&mockFacade.RelayerFacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(rg, "relayers", config.ApiRoutesConfig{})
+
+	req, _ := http.NewRequest("GET", "/relayers", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	require.Equal(t, resp.Code, http.StatusNotFound)
+}
+
+func TestRelayersGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		rg, _ := NewRelayersGroup(&mockFacade.RelayerFacadeStub{})
+
+		err := rg.UpdateFacade(nil)
+		assert.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("wrong facade type should error", func(t *testing.T) {
+		rg, _ := NewRelayersGroup(&mockFacade.RelayerFacadeStub{})
+
+		err := rg.UpdateFacade(&mockFacade.ScCallsFacadeStub{})
+		assert.True(t, errors.Is(err, ErrWrongFacadeType))
+	})
+	t.Run("should work", func(t *testing.T) {
+		rg, _ := NewRelayersGroup(&mockFacade.RelayerFacadeStub{})
+
+		newFacade := &mockFacade.RelayerFacadeStub{}
+
+		err := rg.UpdateFacade(newFacade)
+		assert.Nil(t, err)
+		assert.True(t, rg.facade == newFacade) // pointer testing
+	})
+}
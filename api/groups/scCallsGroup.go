@@ -0,0 +1,154 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/klever-io/klv-bridge-eth-go/api/shared"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	chainAPIShared "github.com/multiversx/mx-chain-go/api/shared"
+)
+
+const (
+	pendingOperationsPath = "/pending"
+	operationsResultsPath = "/results"
+	deadLettersPath       = "/dead-letters"
+	feesPath              = "/fees"
+)
+
+type scCallsGroup struct {
+	*baseGroup
+	facade    shared.ScCallsFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewScCallsGroup returns a new instance of scCallsGroup
+func NewScCallsGroup(facade shared.ScCallsFacadeHandler) (*scCallsGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for SC calls group", errors.ErrNilFacadeHandler)
+	}
+
+	sg := &scCallsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*chainAPIShared.EndpointHandlerData{
+		{
+			Path:    pendingOperationsPath,
+			Method:  http.MethodGet,
+			Handler: sg.pendingOperations,
+		},
+		{
+			Path:    operationsResultsPath,
+			Method:  http.MethodGet,
+			Handler: sg.operationsResults,
+		},
+		{
+			Path:    deadLettersPath,
+			Method:  http.MethodGet,
+			Handler: sg.deadLetters,
+		},
+		{
+			Path:    feesPath,
+			Method:  http.MethodGet,
+			Handler: sg.fees,
+		},
+	}
+	sg.endpoints = endpoints
+
+	return sg, nil
+}
+
+// pendingOperations returns the decoded SC calls waiting to be executed
+func (sg *scCallsGroup) pendingOperations(c *gin.Context) {
+	operations, err := sg.getFacade().GetPendingOperations(c.Request.Context())
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", ErrGettingPendingOperations.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  operations,
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// operationsResults returns the results of the latest executed SC calls
+func (sg *scCallsGroup) operationsResults(c *gin.Context) {
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  sg.getFacade().GetOperationsResults(),
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// deadLetters returns the SC calls that permanently failed and require manual resolution
+func (sg *scCallsGroup) deadLetters(c *gin.Context) {
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  sg.getFacade().GetDeadLetters(),
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// fees returns the fees spent on the SC calls executions
+func (sg *scCallsGroup) fees(c *gin.Context) {
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  sg.getFacade().GetFeesLedger(),
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func (sg *scCallsGroup) getFacade() shared.ScCallsFacadeHandler {
+	sg.mutFacade.RLock()
+	defer sg.mutFacade.RUnlock()
+
+	return sg.facade
+}
+
+// UpdateFacade will update the facade
+func (sg *scCallsGroup) UpdateFacade(newFacade shared.FacadeHandler) error {
+	if check.IfNil(newFacade) {
+		return errors.ErrNilFacadeHandler
+	}
+	scCallsFacade, ok := newFacade.(shared.ScCallsFacadeHandler)
+	if !ok {
+		return fmt.Errorf("%w for SC calls group", ErrWrongFacadeType)
+	}
+
+	sg.mutFacade.Lock()
+	sg.facade = scCallsFacade
+	sg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sg *scCallsGroup) IsInterfaceNil() bool {
+	return sg == nil
+}
@@ -0,0 +1,294 @@
+package groups
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	mockFacade "github.com/klever-io/klv-bridge-eth-go/testsCommon/facade"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pendingOperationsResponse struct {
+	Data  []*core.ScCallPendingOperation `json:"data"`
+	Error string                         `json:"error"`
+}
+
+type operationsResultsResponse struct {
+	Data  []*core.ScCallOperationResult `json:"data"`
+	Error string                        `json:"error"`
+}
+
+type feesResponse struct {
+	Data  core.ScCallsFeesLedger `json:"data"`
+	Error string                 `json:"error"`
+}
+
+type deadLettersResponse struct {
+	Data  []core.ScCallExecutionAttempts `json:"data"`
+	Error string                         `json:"error"`
+}
+
+func getScCallsRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"sc-calls": {
+				Routes: []config.RouteConfig{
+					{Name: pendingOperationsPath, Open: true},
+					{Name: operationsResultsPath, Open: true},
+					{Name: deadLettersPath, Open: true},
+					{Name: feesPath, Open: true},
+				},
+			},
+		},
+	}
+}
+
+func TestNewScCallsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		sg, err := NewScCallsGroup(nil)
+
+		assert.True(t, check.IfNil(sg))
+		assert.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+	})
+	t.Run("should work", func(t *testing.T) {
+		sg, err := NewScCallsGroup(&mockFacade.ScCallsFacadeStub{})
+
+		assert.False(t, check.IfNil(sg))
+		assert.Nil(t, err)
+	})
+}
+
+func TestGetPendingOperations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should return internal error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mockFacade.ScCallsFacadeStub{
+			GetPendingOperationsCalled: func(ctx context.Context) ([]*core.ScCallPendingOperation, error) {
+				return nil, expectedErr
+			},
+		}
+
+		sg, err := NewScCallsGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(sg, "sc-calls", getScCallsRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/sc-calls/pending", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		pendingRsp := pendingOperationsResponse{}
+		loadResponse(resp.Body, &pendingRsp)
+
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, pendingRsp.Error, ErrGettingPendingOperations.Error())
+		assert.Contains(t, pendingRsp.Error, expectedErr.Error())
+		assert.Nil(t, pendingRsp.Data)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		operations := []*core.ScCallPendingOperation{
+			{
+				ID:          1,
+				From:        "0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c",
+				To:          "klv1qqqqqqqqqqqqqpgqu2jcktadaq8mmytwglc704yfv7rezv5usg8sgzuah3",
+				Token:       "ETHUSDC-0g3a",
+				Amount:      "1000",
+				Nonce:       2,
+				GasLimit:    5000000,
+				RawCallData: "00",
+			},
+		}
+		facade := mockFacade.ScCallsFacadeStub{
+			GetPendingOperationsCalled: func(ctx context.Context) ([]*core.ScCallPendingOperation, error) {
+				return operations, nil
+			},
+		}
+
+		sg, err := NewScCallsGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(sg, "sc-calls", getScCallsRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/sc-calls/pending", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		pendingRsp := pendingOperationsResponse{}
+		loadResponse(resp.Body, &pendingRsp)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, pendingRsp.Error)
+		assert.Equal(t, operations, pendingRsp.Data)
+	})
+}
+
+func TestGetOperationsResults_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	results := []*core.ScCallOperationResult{
+		{
+			ID:        1,
+			TxHash:    "0102",
+			Status:    "executed",
+			Timestamp: 1234,
+		},
+		{
+			ID:        2,
+			Status:    "failed",
+			Error:     "execution error",
+			Timestamp: 1235,
+		},
+	}
+	facade := mockFacade.ScCallsFacadeStub{
+		GetOperationsResultsCalled: func() []*core.ScCallOperationResult {
+			return results
+		},
+	}
+
+	sg, err := NewScCallsGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(sg, "sc-calls", getScCallsRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/sc-calls/results", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	resultsRsp := operationsResultsResponse{}
+	loadResponse(resp.Body, &resultsRsp)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, resultsRsp.Error)
+	assert.Equal(t, results, resultsRsp.Data)
+}
+
+func TestGetDeadLetters_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	deadLetters := []core.ScCallExecutionAttempts{
+		{
+			ID:           3,
+			NumAttempts:  5,
+			LastError:    "execution error",
+			IsDeadLetter: true,
+		},
+	}
+	facade := mockFacade.ScCallsFacadeStub{
+		GetDeadLettersCalled: func() []core.ScCallExecutionAttempts {
+			return deadLetters
+		},
+	}
+
+	sg, err := NewScCallsGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(sg, "sc-calls", getScCallsRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/sc-calls/dead-letters", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	deadLettersRsp := deadLettersResponse{}
+	loadResponse(resp.Body, &deadLettersRsp)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, deadLettersRsp.Error)
+	assert.Equal(t, deadLetters, deadLettersRsp.Data)
+}
+
+func TestGetFees_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ledger := core.ScCallsFeesLedger{
+		TotalFees: 300,
+		FeesPerToken: map[string]int64{
+			"ETHUSDC-0g3a": 300,
+		},
+		FeesPerDestination: map[string]int64{
+			"klv1qqqqqqqqqqqqqpgqu2jcktadaq8mmytwglc704yfv7rezv5usg8sgzuah3": 300,
+		},
+		CurrentDay:       "2024-05-01",
+		CurrentDayFees:   100,
+		DailyBudget:      100,
+		IsBudgetExceeded: true,
+	}
+	facade := mockFacade.ScCallsFacadeStub{
+		GetFeesLedgerCalled: func() core.ScCallsFeesLedger {
+			return ledger
+		},
+	}
+
+	sg, err := NewScCallsGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(sg, "sc-calls", getScCallsRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/sc-calls/fees", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	feesRsp := feesResponse{}
+	loadResponse(resp.Body, &feesRsp)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, feesRsp.Error)
+	assert.Equal(t, ledger, feesRsp.Data)
+}
+
+func TestScCallsGroup_ClosedRoutesShouldNotRespond(t *testing.T) {
+	t.Parallel()
+
+	sg, err := NewScCallsGroup(&mockFacade.ScCallsFacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(sg, "sc-calls", config.ApiRoutesConfig{})
+
+	for _, path := range []string{pendingOperationsPath, operationsResultsPath, deadLettersPath, feesPath} {
+		req, _ := http.NewRequest("GET", "/sc-calls"+path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code, path)
+	}
+}
+
+func TestScCallsGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		sg, _ := NewScCallsGroup(&mockFacade.ScCallsFacadeStub{})
+
+		err := sg.UpdateFacade(nil)
+		assert.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("wrong facade type should error", func(t *testing.T) {
+		sg, _ := NewScCallsGroup(&mockFacade.ScCallsFacadeStub{})
+
+		err := sg.UpdateFacade(&mockFacade.RelayerFacadeStub{})
+		assert.True(t, errors.Is(err, ErrWrongFacadeType))
+	})
+	t.Run("should work", func(t *testing.T) {
+		sg, _ := NewScCallsGroup(&mockFacade.ScCallsFacadeStub{})
+
+		newFacade := &mockFacade.ScCallsFacadeStub{}
+
+		err := sg.UpdateFacade(newFacade)
+		assert.Nil(t, err)
+		assert.True(t, sg.facade == newFacade) // pointer testing
+	})
+}
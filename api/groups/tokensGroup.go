@@ -0,0 +1,88 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/klever-io/klv-bridge-eth-go/api/shared"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	chainAPIShared "github.com/multiversx/mx-chain-go/api/shared"
+)
+
+// tokensPath is empty so the registry is served directly on the group root, as /tokens
+const tokensPath = ""
+
+type tokensGroup struct {
+	*baseGroup
+	facade    shared.TokensFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewTokensGroup returns a new instance of tokensGroup
+func NewTokensGroup(facade shared.TokensFacadeHandler) (*tokensGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for tokens group", errors.ErrNilFacadeHandler)
+	}
+
+	tg := &tokensGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*chainAPIShared.EndpointHandlerData{
+		{
+			Path:    tokensPath,
+			Method:  http.MethodGet,
+			Handler: tg.tokens,
+		},
+	}
+	tg.endpoints = endpoints
+
+	return tg, nil
+}
+
+// tokens returns all the bridged tokens together with the detected inconsistencies
+func (tg *tokensGroup) tokens(c *gin.Context) {
+	snapshot := tg.getFacade().GetTokens()
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  snapshot,
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func (tg *tokensGroup) getFacade() shared.TokensFacadeHandler {
+	tg.mutFacade.RLock()
+	defer tg.mutFacade.RUnlock()
+
+	return tg.facade
+}
+
+// UpdateFacade will update the facade
+func (tg *tokensGroup) UpdateFacade(newFacade shared.FacadeHandler) error {
+	if check.IfNil(newFacade) {
+		return errors.ErrNilFacadeHandler
+	}
+	tokensFacade, ok := newFacade.(shared.TokensFacadeHandler)
+	if !ok {
+		return fmt.Errorf("%w for tokens group", ErrWrongFacadeType)
+	}
+
+	tg.mutFacade.Lock()
+	tg.facade = tokensFacade
+	tg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tg *tokensGroup) IsInterfaceNil() bool {
+	return tg == nil
+}
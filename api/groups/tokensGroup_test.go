@@ -0,0 +1,137 @@
+package groups
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	mockFacade "github.com/klever-io/klv-bridge-eth-go/testsCommon/facade"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tokensResponse struct {
+	Data  *core.TokensRegistrySnapshot `json:"data"`
+	Error string                       `json:"error"`
+}
+
+func getTokensRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"tokens": {
+				Routes: []config.RouteConfig{
+					{Name: "", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func TestNewTokensGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		tg, err := NewTokensGroup(nil)
+
+		assert.True(t, check.IfNil(tg))
+		assert.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+	})
+	t.Run("should work", func(t *testing.T) {
+		tg, err := NewTokensGroup(&mockFacade.RelayerFacadeStub{})
+
+		assert.False(t, check.IfNil(tg))
+		assert.Nil(t, err)
+	})
+}
+
+func TestGetTokens_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	snapshot := &core.TokensRegistrySnapshot{
+		LastUpdateTimestamp: 1234,
+		NumWarnings:         1,
+		Tokens: []*core.TokenRegistryEntry{
+			{
+				KDA: core.KDATokenInfo{
+					TokenID:    "ETHUSDC-0g3a",
+					Precision:  6,
+					IsMintBurn: true,
+				},
+				ERC20: core.ERC20TokenInfo{
+					Address:  "0x2222222222222222222222222222222222222222",
+					Decimals: 18,
+					IsNative: true,
+				},
+				Warnings: []string{"token is not whitelisted on the Ethereum safe"},
+			},
+		},
+	}
+	facade := mockFacade.RelayerFacadeStub{
+		GetTokensCalled: func() *core.TokensRegistrySnapshot {
+			return snapshot
+		},
+	}
+
+	tg, err := NewTokensGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(tg, "tokens", getTokensRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/tokens", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	tokensRsp := tokensResponse{}
+	loadResponse(resp.Body, &tokensRsp)
+
+	assert.Equal(t, snapshot, tokensRsp.Data)
+
+	require.Equal(t, resp.Code, http.StatusOK)
+	assert.Empty(t, tokensRsp.Error)
+}
+
+func TestGetTokens_ClosedRouteShouldNotRespond(t *testing.T) {
+	t.Parallel()
+
+	tg, err := NewTokensGroup(&mockFacade.RelayerFacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(tg, "tokens", config.ApiRoutesConfig{})
+
+	req, _ := http.NewRequest("GET", "/tokens", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	require.Equal(t, resp.Code, http.StatusNotFound)
+}
+
+func TestTokensGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		tg, _ := NewTokensGroup(&mockFacade.RelayerFacadeStub{})
+
+		err := tg.UpdateFacade(nil)
+		assert.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("wrong facade type should error", func(t *testing.T) {
+		tg, _ := NewTokensGroup(&mockFacade.RelayerFacadeStub{})
+
+		err := tg.UpdateFacade(&mockFacade.ScCallsFacadeStub{})
+		assert.True(t, errors.Is(err, ErrWrongFacadeType))
+	})
+	t.Run("should work", func(t *testing.T) {
+		tg, _ := NewTokensGroup(&mockFacade.RelayerFacadeStub{})
+
+		newFacade := &mockFacade.RelayerFacadeStub{}
+
+		err := tg.UpdateFacade(newFacade)
+		assert.Nil(t, err)
+		assert.True(t, tg.facade == newFacade) // pointer testing
+	})
+}
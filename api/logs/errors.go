@@ -0,0 +1,18 @@
+package logs
+
+import "errors"
+
+// ErrNilWsConn signals that a nil websocket connection was provided
+var ErrNilWsConn = errors.New("nil websocket connection")
+
+// ErrNilLogger signals that a nil logger was provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrWriterClosed signals that the log writer was closed
+var ErrWriterClosed = errors.New("log writer closed")
+
+// ErrWriterBusy signals that the log writer can not accept more data at the moment
+var ErrWriterBusy = errors.New("log writer busy")
+
+// ErrInvalidLogFormat signals that an invalid log frames format was requested
+var ErrInvalidLogFormat = errors.New("invalid log format")
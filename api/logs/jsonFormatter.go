@@ -0,0 +1,60 @@
+package logs
+
+import (
+	"encoding/json"
+	"strings"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// LogFrame is the JSON structure of a streamed log line
+type LogFrame struct {
+	Logger    string            `json:"logger"`
+	Level     string            `json:"level"`
+	Message   string            `json:"message"`
+	Fields    map[string]string `json:"fields,omitempty"`
+	Timestamp int64             `json:"timestamp"`
+}
+
+// jsonFormatter converts the log lines into JSON frames
+type jsonFormatter struct {
+}
+
+// Output converts the provided log line into a JSON frame. The log arguments, provided as key-value pairs,
+// are converted into the frame fields
+func (jf *jsonFormatter) Output(line logger.LogLineHandler) []byte {
+	if line == nil || line.IsInterfaceNil() {
+		return nil
+	}
+
+	frame := &LogFrame{
+		Logger:    line.GetLoggerName(),
+		Level:     strings.TrimSpace(logger.LogLevel(line.GetLogLevel()).String()),
+		Message:   strings.TrimSpace(line.GetMessage()),
+		Timestamp: line.GetTimestamp(),
+	}
+
+	args := line.GetArgs()
+	if len(args) > 0 {
+		frame.Fields = make(map[string]string, len(args)/2+1)
+	}
+	for i := 0; i < len(args); i += 2 {
+		value := ""
+		if i+1 < len(args) {
+			value = args[i+1]
+		}
+		frame.Fields[args[i]] = value
+	}
+
+	buff, err := json.Marshal(frame)
+	if err != nil {
+		return nil
+	}
+
+	return buff
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jf *jsonFormatter) IsInterfaceNil() bool {
+	return jf == nil
+}
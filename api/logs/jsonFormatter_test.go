@@ -0,0 +1,39 @@
+package logs
+
+import (
+	"encoding/json"
+	"testing"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJsonFormatter_Output(t *testing.T) {
+	t.Parallel()
+
+	jf := &jsonFormatter{}
+	t.Run("nil line should return nil", func(t *testing.T) {
+		assert.Nil(t, jf.Output(nil))
+	})
+	t.Run("should work", func(t *testing.T) {
+		line := createLogLine("EthereumKleverBlockchain-Base", logger.LogInfo)
+		line.Args = []string{"batch ID", "44", "dangling"}
+
+		frame := &LogFrame{}
+		err := json.Unmarshal(jf.Output(line), frame)
+		require.Nil(t, err)
+
+		expectedFrame := &LogFrame{
+			Logger:  "EthereumKleverBlockchain-Base",
+			Level:   "INFO",
+			Message: "message",
+			Fields: map[string]string{
+				"batch ID": "44",
+				"dangling": "",
+			},
+			Timestamp: 1234,
+		}
+		assert.Equal(t, expectedFrame, frame)
+	})
+}
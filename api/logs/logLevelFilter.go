@@ -0,0 +1,70 @@
+package logs
+
+import (
+	"strings"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	allLoggersPattern       = "*"
+	defaultLogLevelPatterns = "*:TRACE"
+)
+
+// logLevelFilter decides which log lines are streamed to a client. It uses the same patterns as the --log-level flag
+// (e.g. "*:WARN,EthereumKleverBlockchain-Base:INFO"), applied from left to right. The lines of the loggers not matched
+// by any pattern are dropped
+type logLevelFilter struct {
+	levels   []logger.LogLevel
+	patterns []string
+}
+
+func newLogLevelFilter(logLevelPatterns string) (*logLevelFilter, error) {
+	if len(logLevelPatterns) == 0 {
+		logLevelPatterns = defaultLogLevelPatterns
+	}
+
+	levels, patterns, err := logger.ParseLogLevelAndMatchingString(logLevelPatterns)
+	if err != nil {
+		return nil, err
+	}
+
+	return &logLevelFilter{
+		levels:   levels,
+		patterns: patterns,
+	}, nil
+}
+
+func (filter *logLevelFilter) shouldOutput(loggerName string, level logger.LogLevel) bool {
+	minLevel := logger.LogNone
+	for i, pattern := range filter.patterns {
+		if pattern == allLoggersPattern || strings.Contains(loggerName, pattern) {
+			minLevel = filter.levels[i]
+		}
+	}
+
+	return minLevel != logger.LogNone && level >= minLevel
+}
+
+// filteringFormatter drops the log lines rejected by the filter and formats the rest with the wrapped formatter
+type filteringFormatter struct {
+	filter    *logLevelFilter
+	formatter logger.Formatter
+}
+
+// Output returns nil for the filtered log lines, the formatted line otherwise
+func (ff *filteringFormatter) Output(line logger.LogLineHandler) []byte {
+	if line == nil || line.IsInterfaceNil() {
+		return nil
+	}
+	if !ff.filter.shouldOutput(line.GetLoggerName(), logger.LogLevel(line.GetLogLevel())) {
+		return nil
+	}
+
+	return ff.formatter.Output(line)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ff *filteringFormatter) IsInterfaceNil() bool {
+	return ff == nil
+}
@@ -0,0 +1,75 @@
+package logs
+
+import (
+	"testing"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLogLine(loggerName string, level logger.LogLevel) *logger.LogLineWrapper {
+	line := &logger.LogLineWrapper{}
+	line.LoggerName = loggerName
+	line.LogLevel = int32(level)
+	line.Message = "message"
+	line.Args = []string{"key", "value"}
+	line.Timestamp = 1234
+
+	return line
+}
+
+func TestNewLogLevelFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid pattern should error", func(t *testing.T) {
+		filter, err := newLogLevelFilter("*:NOT-A-LEVEL")
+		assert.NotNil(t, err)
+		assert.Nil(t, filter)
+	})
+	t.Run("empty patterns should output everything", func(t *testing.T) {
+		filter, err := newLogLevelFilter("")
+		require.Nil(t, err)
+
+		assert.True(t, filter.shouldOutput("p2p", logger.LogTrace))
+		assert.True(t, filter.shouldOutput("EthereumKleverBlockchain-Base", logger.LogError))
+	})
+}
+
+func TestLogLevelFilter_ShouldOutput(t *testing.T) {
+	t.Parallel()
+
+	t.Run("only the matching logger should be output", func(t *testing.T) {
+		filter, err := newLogLevelFilter("EthereumKleverBlockchain-Base:INFO")
+		require.Nil(t, err)
+
+		assert.False(t, filter.shouldOutput("EthereumKleverBlockchain-Base", logger.LogDebug))
+		assert.True(t, filter.shouldOutput("EthereumKleverBlockchain-Base", logger.LogInfo))
+		assert.True(t, filter.shouldOutput("EthereumKleverBlockchain-Base", logger.LogError))
+		assert.False(t, filter.shouldOutput("EthereumKleverBlockchain-Broadcaster", logger.LogError))
+	})
+	t.Run("patterns should be applied from left to right", func(t *testing.T) {
+		filter, err := newLogLevelFilter("*:WARN,Base:DEBUG,p2p:NONE")
+		require.Nil(t, err)
+
+		assert.False(t, filter.shouldOutput("api", logger.LogInfo))
+		assert.True(t, filter.shouldOutput("api", logger.LogWarning))
+		assert.True(t, filter.shouldOutput("EthereumKleverBlockchain-Base", logger.LogDebug))
+		assert.False(t, filter.shouldOutput("p2p", logger.LogError))
+	})
+}
+
+func TestFilteringFormatter_Output(t *testing.T) {
+	t.Parallel()
+
+	filter, _ := newLogLevelFilter("api:INFO")
+	ff := &filteringFormatter{
+		filter:    filter,
+		formatter: &jsonFormatter{},
+	}
+
+	assert.Nil(t, ff.Output(nil))
+	assert.Nil(t, ff.Output(createLogLine("api", logger.LogDebug)))
+	assert.Nil(t, ff.Output(createLogLine("p2p", logger.LogError)))
+	assert.NotEmpty(t, ff.Output(createLogLine("api", logger.LogInfo)))
+}
@@ -0,0 +1,133 @@
+package logs
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/websocket"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const subscriptionTimeout = time.Second * 10
+
+// ArgsLogSender is the DTO used to create a new log sender
+type ArgsLogSender struct {
+	Conn wsConn
+	Log  logger.Logger
+}
+
+type logSender struct {
+	conn wsConn
+	log  logger.Logger
+}
+
+// NewLogSender returns a new component that streams the relayer's log lines on the provided websocket connection,
+// filtered and formatted as requested in the client's subscription
+func NewLogSender(args ArgsLogSender) (*logSender, error) {
+	if args.Conn == nil {
+		return nil, ErrNilWsConn
+	}
+	if check.IfNil(args.Log) {
+		return nil, ErrNilLogger
+	}
+
+	return &logSender{
+		conn: args.Conn,
+		log:  args.Log,
+	}, nil
+}
+
+// StartSendingBlocking waits for the client's subscription and then streams the log lines until the connection is closed
+func (ls *logSender) StartSendingBlocking() {
+	defer func() {
+		_ = ls.conn.Close()
+	}()
+
+	subscription, err := ls.waitForSubscription()
+	if err != nil {
+		ls.log.Debug("invalid log websocket subscription", "error", err)
+		return
+	}
+
+	formatter, messageType, err := subscription.createFormatter()
+	if err != nil {
+		ls.log.Debug("invalid log websocket subscription", "error", err)
+		return
+	}
+
+	writer := newLogWriter()
+	err = logger.AddLogObserver(writer, formatter)
+	if err != nil {
+		ls.log.Error("can not register the log websocket writer", "error", err)
+		return
+	}
+	defer func() {
+		_ = logger.RemoveLogObserver(writer)
+		_ = writer.Close()
+	}()
+
+	ls.log.Info("log websocket subscription received", "log level", subscription.LogLevel, "format", subscription.Format)
+
+	go ls.monitorConnection(writer)
+	ls.doSendContinuously(writer, messageType)
+}
+
+func (ls *logSender) waitForSubscription() (*Subscription, error) {
+	err := ls.conn.SetReadDeadline(time.Now().Add(subscriptionTimeout))
+	if err != nil {
+		return nil, err
+	}
+
+	_, message, err := ls.conn.ReadMessage()
+	if err != nil {
+		return nil, err
+	}
+
+	subscription := &Subscription{}
+	err = json.Unmarshal(message, subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	return subscription, ls.conn.SetReadDeadline(time.Time{})
+}
+
+// monitorConnection reads (and discards) the client messages so the close frames are processed
+func (ls *logSender) monitorConnection(writer *logWriter) {
+	defer func() {
+		_ = writer.Close()
+	}()
+
+	for {
+		mt, _, err := ls.conn.ReadMessage()
+		if mt == websocket.CloseMessage || err != nil {
+			return
+		}
+	}
+}
+
+func (ls *logSender) doSendContinuously(writer *logWriter, messageType int) {
+	for {
+		data, ok := writer.ReadBlocking()
+		if !ok {
+			return
+		}
+
+		err := ls.conn.WriteMessage(messageType, data)
+		if err != nil {
+			isConnectionClosed := strings.Contains(err.Error(), "websocket: close sent")
+			if !isConnectionClosed {
+				ls.log.Debug("log websocket write error", "error", err)
+			}
+
+			return
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ls *logSender) IsInterfaceNil() bool {
+	return ls == nil
+}
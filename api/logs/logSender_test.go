@@ -0,0 +1,166 @@
+package logs
+
+import (
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/websocket"
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsLogSender() ArgsLogSender {
+	return ArgsLogSender{
+		Conn: &testsCommon.WsConnStub{},
+		Log:  logger.GetOrCreate("test"),
+	}
+}
+
+func TestNewLogSender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil connection should error", func(t *testing.T) {
+		args := createMockArgsLogSender()
+		args.Conn = nil
+
+		ls, err := NewLogSender(args)
+		assert.True(t, check.IfNil(ls))
+		assert.Equal(t, ErrNilWsConn, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		args := createMockArgsLogSender()
+		args.Log = nil
+
+		ls, err := NewLogSender(args)
+		assert.True(t, check.IfNil(ls))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		ls, err := NewLogSender(createMockArgsLogSender())
+		assert.False(t, check.IfNil(ls))
+		assert.Nil(t, err)
+	})
+}
+
+func TestLogSender_StartSendingBlocking(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid subscription should close the connection", func(t *testing.T) {
+		t.Parallel()
+
+		numCloseCalls := uint32(0)
+		args := createMockArgsLogSender()
+		args.Conn = &testsCommon.WsConnStub{
+			ReadMessageCalled: func() (int, []byte, error) {
+				return websocket.TextMessage, []byte("not a subscription"), nil
+			},
+			WriteMessageCalled: func(messageType int, data []byte) error {
+				assert.Fail(t, "should have not written")
+				return nil
+			},
+			CloseCalled: func() error {
+				atomic.AddUint32(&numCloseCalls, 1)
+				return nil
+			},
+		}
+
+		ls, _ := NewLogSender(args)
+		ls.StartSendingBlocking()
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCloseCalls))
+	})
+	t.Run("invalid format should close the connection", func(t *testing.T) {
+		t.Parallel()
+
+		subscription, _ := json.Marshal(&Subscription{Format: "xml"})
+		numCloseCalls := uint32(0)
+		args := createMockArgsLogSender()
+		args.Conn = &testsCommon.WsConnStub{
+			ReadMessageCalled: func() (int, []byte, error) {
+				return websocket.TextMessage, subscription, nil
+			},
+			CloseCalled: func() error {
+				atomic.AddUint32(&numCloseCalls, 1)
+				return nil
+			},
+		}
+
+		ls, _ := NewLogSender(args)
+		ls.StartSendingBlocking()
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCloseCalls))
+	})
+	t.Run("should stream the filtered log lines", func(t *testing.T) {
+		t.Parallel()
+
+		loggerName := "logSenderTestLogger"
+		subscription, _ := json.Marshal(&Subscription{LogLevel: loggerName + ":INFO", Format: JSONFormat})
+		connectionClosed := make(chan struct{})
+		frames := make(chan *LogFrame, 10)
+		numReads := uint32(0)
+		args := createMockArgsLogSender()
+		args.Conn = &testsCommon.WsConnStub{
+			ReadMessageCalled: func() (int, []byte, error) {
+				if atomic.AddUint32(&numReads, 1) == 1 {
+					return websocket.TextMessage, subscription, nil
+				}
+
+				<-connectionClosed
+				return websocket.CloseMessage, nil, errors.New("connection closed")
+			},
+			WriteMessageCalled: func(messageType int, data []byte) error {
+				assert.Equal(t, websocket.TextMessage, messageType)
+				frame := &LogFrame{}
+				err := json.Unmarshal(data, frame)
+				assert.Nil(t, err)
+
+				select {
+				case frames <- frame:
+				default:
+				}
+
+				return nil
+			},
+		}
+
+		ls, _ := NewLogSender(args)
+		senderDone := make(chan struct{})
+		go func() {
+			ls.StartSendingBlocking()
+			close(senderDone)
+		}()
+
+		testLog := logger.GetOrCreate(loggerName)
+		testLog.SetLevel(logger.LogTrace)
+		otherLog := logger.GetOrCreate("logSenderTestOtherLogger")
+		otherLog.SetLevel(logger.LogTrace)
+
+		var frame *LogFrame
+		for frame == nil {
+			testLog.Debug("filtered debug line")
+			otherLog.Error("filtered error line")
+			testLog.Info("streamed line", "key", "value")
+
+			select {
+			case frame = <-frames:
+			case <-time.After(time.Millisecond * 10):
+			}
+		}
+
+		assert.Equal(t, loggerName, frame.Logger)
+		assert.Equal(t, "INFO", frame.Level)
+		assert.Equal(t, "streamed line", frame.Message)
+		assert.Equal(t, map[string]string{"key": "value"}, frame.Fields)
+
+		close(connectionClosed)
+		select {
+		case <-senderDone:
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "sender should have stopped")
+		}
+	})
+}
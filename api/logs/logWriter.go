@@ -0,0 +1,62 @@
+package logs
+
+import "sync"
+
+const msgQueueSize = 100
+
+// logWriter is a chan based writer that does not block the logger subsystem when the client is slow,
+// the log lines that do not fit in the queue are dropped
+type logWriter struct {
+	mutChanClosed sync.RWMutex
+	chanClosed    bool
+	dataChan      chan []byte
+}
+
+func newLogWriter() *logWriter {
+	return &logWriter{
+		dataChan: make(chan []byte, msgQueueSize),
+	}
+}
+
+// Write will try to output the data on the channel. Empty data (filtered log lines) is ignored
+func (lw *logWriter) Write(p []byte) (n int, err error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	lw.mutChanClosed.RLock()
+	defer lw.mutChanClosed.RUnlock()
+
+	if lw.chanClosed {
+		return 0, ErrWriterClosed
+	}
+
+	select {
+	case lw.dataChan <- p:
+		return len(p), nil
+	default:
+		return 0, ErrWriterBusy
+	}
+}
+
+// Close closes the writer by closing the underlying chan. Subsequent calls will return ErrWriterClosed
+func (lw *logWriter) Close() error {
+	lw.mutChanClosed.Lock()
+	defer lw.mutChanClosed.Unlock()
+
+	if lw.chanClosed {
+		return ErrWriterClosed
+	}
+
+	lw.chanClosed = true
+	close(lw.dataChan)
+
+	return nil
+}
+
+// ReadBlocking blocks until new data is written or the writer is closed
+func (lw *logWriter) ReadBlocking() ([]byte, bool) {
+	data, ok := <-lw.dataChan
+
+	return data, ok
+}
@@ -0,0 +1,51 @@
+package logs
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/websocket"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	// JSONFormat streams the log lines as JSON frames
+	JSONFormat = "json"
+	// ProtoFormat streams the log lines as protobuf encoded LogLineWrapper frames, as understood by the log viewer tools
+	ProtoFormat = "proto"
+)
+
+// Subscription is the first message a client must send after the websocket connection is established.
+// LogLevel uses the --log-level flag patterns and only narrows the lines already emitted by the relayer, it does
+// not change the relayer's log levels. Empty values stream all the emitted lines as JSON frames
+type Subscription struct {
+	LogLevel string `json:"logLevel"`
+	Format   string `json:"format"`
+}
+
+func (subscription *Subscription) createFormatter() (logger.Formatter, int, error) {
+	filter, err := newLogLevelFilter(subscription.LogLevel)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	switch subscription.Format {
+	case "", JSONFormat:
+		return &filteringFormatter{
+			filter:    filter,
+			formatter: &jsonFormatter{},
+		}, websocket.TextMessage, nil
+	case ProtoFormat:
+		protoFormatter, errCreate := logger.NewLogLineWrapperFormatter(&marshal.GogoProtoMarshalizer{})
+		if errCreate != nil {
+			return nil, 0, errCreate
+		}
+
+		return &filteringFormatter{
+			filter:    filter,
+			formatter: protoFormatter,
+		}, websocket.BinaryMessage, nil
+	default:
+		return nil, 0, fmt.Errorf("%w: %q", ErrInvalidLogFormat, subscription.Format)
+	}
+}
@@ -1,6 +1,8 @@
 package shared
 
 import (
+	"context"
+
 	"github.com/gin-gonic/gin"
 	"github.com/klever-io/klv-bridge-eth-go/config"
 	"github.com/klever-io/klv-bridge-eth-go/core"
@@ -25,6 +27,27 @@ type FacadeHandler interface {
 	IsInterfaceNil() bool
 }
 
+// TokensFacadeHandler defines the methods implemented by a facade able to serve the bridged tokens registry
+type TokensFacadeHandler interface {
+	FacadeHandler
+	GetTokens() *core.TokensRegistrySnapshot
+}
+
+// RelayersFacadeHandler defines the methods implemented by a facade able to serve the relayers set health view
+type RelayersFacadeHandler interface {
+	FacadeHandler
+	GetRelayersHealth() *core.RelayersHealthSnapshot
+}
+
+// ScCallsFacadeHandler defines the methods implemented by a facade able to serve the SC calls executor information
+type ScCallsFacadeHandler interface {
+	FacadeHandler
+	GetPendingOperations(ctx context.Context) ([]*core.ScCallPendingOperation, error)
+	GetOperationsResults() []*core.ScCallOperationResult
+	GetDeadLetters() []core.ScCallExecutionAttempts
+	GetFeesLedger() core.ScCallsFeesLedger
+}
+
 // UpgradeableHttpServerHandler defines the actions that an upgradeable http server need to do
 type UpgradeableHttpServerHandler interface {
 	StartHttpServer() error
@@ -2,8 +2,8 @@ package ethKC
 
 import (
 	"context"
-	"encoding/binary"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
@@ -14,8 +14,10 @@ import (
 	"github.com/klever-io/klv-bridge-eth-go/core"
 	bridgeCore "github.com/klever-io/klv-bridge-eth-go/core"
 	"github.com/klever-io/klv-bridge-eth-go/core/batchProcessor"
+	"github.com/klever-io/klv-bridge-eth-go/tracing"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	logger "github.com/multiversx/mx-chain-logger-go"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // splits - represent the number of times we split the maximum interval
@@ -33,6 +35,11 @@ type ArgsBridgeExecutor struct {
 	StatusHandler              core.StatusHandler
 	SignaturesHolder           SignaturesHolder
 	BalanceValidator           BalanceValidator
+	BatchNotifier              BatchNotifier
+	KCActivityNotifier         KCActivityNotifier
+	CallDataValidator          CallDataValidator
+	BatchSignaturesTracker     BatchSignaturesTracker
+	LeaderLease                LeaderLease
 	MaxQuorumRetriesOnEthereum uint64
 	MaxQuorumRetriesOnKC       uint64
 	MaxRetriesOnWasProposed    uint64
@@ -47,6 +54,11 @@ type bridgeExecutor struct {
 	statusHandler              core.StatusHandler
 	sigsHolder                 SignaturesHolder
 	balanceValidator           BalanceValidator
+	batchNotifier              BatchNotifier
+	kcActivityNotifier         KCActivityNotifier
+	callDataValidator          CallDataValidator
+	batchSignaturesTracker     BatchSignaturesTracker
+	leaderLease                LeaderLease
 	maxQuorumRetriesOnEthereum uint64
 	maxQuorumRetriesOnKC       uint64
 	maxRetriesOnWasProposed    uint64
@@ -57,6 +69,8 @@ type bridgeExecutor struct {
 	quorumRetriesOnEthereum uint64
 	quorumRetriesOnKC       uint64
 	retriesOnWasProposed    uint64
+	missingEthereumBatchID  uint64
+	noPendingBatchOnKC      bool
 }
 
 // NewBridgeExecutor creates a bridge executor, which can be used for both half-bridges
@@ -95,6 +109,21 @@ func checkArgs(args ArgsBridgeExecutor) error {
 	if check.IfNil(args.BalanceValidator) {
 		return ErrNilBalanceValidator
 	}
+	if check.IfNil(args.BatchNotifier) {
+		return ErrNilBatchNotifier
+	}
+	if check.IfNil(args.KCActivityNotifier) {
+		return ErrNilKCActivityNotifier
+	}
+	if check.IfNil(args.CallDataValidator) {
+		return ErrNilCallDataValidator
+	}
+	if check.IfNil(args.BatchSignaturesTracker) {
+		return ErrNilBatchSignaturesTracker
+	}
+	if check.IfNil(args.LeaderLease) {
+		return ErrNilLeaderLease
+	}
 	if args.MaxQuorumRetriesOnEthereum < minRetries {
 		return fmt.Errorf("%w for args.MaxQuorumRetriesOnEthereum, got: %d, minimum: %d",
 			clients.ErrInvalidValue, args.MaxQuorumRetriesOnEthereum, minRetries)
@@ -120,6 +149,11 @@ func createBridgeExecutor(args ArgsBridgeExecutor) *bridgeExecutor {
 		timeForWaitOnEthereum:      args.TimeForWaitOnEthereum,
 		sigsHolder:                 args.SignaturesHolder,
 		balanceValidator:           args.BalanceValidator,
+		batchNotifier:              args.BatchNotifier,
+		kcActivityNotifier:         args.KCActivityNotifier,
+		callDataValidator:          args.CallDataValidator,
+		batchSignaturesTracker:     args.BatchSignaturesTracker,
+		leaderLease:                args.LeaderLease,
 		maxQuorumRetriesOnEthereum: args.MaxQuorumRetriesOnEthereum,
 		maxQuorumRetriesOnKC:       args.MaxQuorumRetriesOnKC,
 		maxRetriesOnWasProposed:    args.MaxRetriesOnWasProposed,
@@ -153,12 +187,23 @@ func (executor *bridgeExecutor) MyTurnAsLeader() bool {
 // GetBatchFromKC fetches the pending batch from KC
 func (executor *bridgeExecutor) GetBatchFromKC(ctx context.Context) (*bridgeCore.TransferBatch, error) {
 	batch, err := executor.kcClient.GetPendingBatch(ctx)
+	executor.noPendingBatchOnKC = errors.Is(err, clients.ErrNoPendingBatchAvailable)
 	if err == nil {
 		executor.statusHandler.SetIntMetric(core.MetricNumBatches, int(batch.ID)-1)
 	}
 	return batch, err
 }
 
+// WaitForNewBatchOnKC blocks until the activity notifier signals new activity on the Klever Blockchain safe contract,
+// but only if the previous fetch found no pending batch
+func (executor *bridgeExecutor) WaitForNewBatchOnKC(ctx context.Context) {
+	if !executor.noPendingBatchOnKC {
+		return
+	}
+
+	executor.kcActivityNotifier.WaitForSafeActivity(ctx)
+}
+
 // StoreBatchFromKC saves the pending batch from KC
 func (executor *bridgeExecutor) StoreBatchFromKC(batch *bridgeCore.TransferBatch) error {
 	if batch == nil {
@@ -267,7 +312,7 @@ func (executor *bridgeExecutor) ProposeTransferOnKC(ctx context.Context) error {
 		return err
 	}
 
-	executor.log.Info("proposed transfer", "hash", hash,
+	executor.log.Info("proposed transfer", "tx hash", hash,
 		"batch ID", executor.batch.ID, "action ID", executor.actionID)
 
 	return nil
@@ -308,7 +353,7 @@ func (executor *bridgeExecutor) ProposeSetStatusOnKC(ctx context.Context) error
 		return err
 	}
 
-	executor.log.Info("proposed set status", "hash", hash,
+	executor.log.Info("proposed set status", "tx hash", hash,
 		"batch ID", executor.batch.ID)
 
 	return nil
@@ -326,7 +371,7 @@ func (executor *bridgeExecutor) SignActionOnKC(ctx context.Context) error {
 		return err
 	}
 
-	executor.log.Info("signed proposed transfer", "hash", hash, "action ID", executor.actionID)
+	executor.log.Info("signed proposed transfer", "tx hash", hash, "action ID", executor.actionID)
 
 	return nil
 }
@@ -343,6 +388,9 @@ func (executor *bridgeExecutor) WaitForTransferConfirmation(ctx context.Context)
 		if executor.waitWithContextSucceeded(ctx) {
 			wasPerformed, _ = executor.WasTransferPerformedOnEthereum(ctx)
 		}
+		if !wasPerformed && executor.batch != nil {
+			executor.leaderLease.RenewLease(executor.batch.ID)
+		}
 	}
 }
 
@@ -399,7 +447,15 @@ func (executor *bridgeExecutor) GetBatchStatusesFromEthereum(ctx context.Context
 
 // WasActionPerformedOnKC returns true if the action was already performed
 func (executor *bridgeExecutor) WasActionPerformedOnKC(ctx context.Context) (bool, error) {
-	return executor.kcClient.WasExecuted(ctx, executor.actionID)
+	wasPerformed, err := executor.kcClient.WasExecuted(ctx, executor.actionID)
+	if err != nil {
+		return false, err
+	}
+	if wasPerformed && executor.batch != nil {
+		executor.leaderLease.ReleaseLease(executor.batch.ID)
+	}
+
+	return wasPerformed, nil
 }
 
 // PerformActionOnKC sends the perform-action transaction on the Klever Blockchain chain
@@ -413,8 +469,9 @@ func (executor *bridgeExecutor) PerformActionOnKC(ctx context.Context) error {
 		return err
 	}
 
-	executor.log.Info("sent perform action transaction", "hash", hash,
+	executor.log.Info("sent perform action transaction", "tx hash", hash,
 		"batch ID", executor.batch.ID, "action ID", executor.actionID)
+	executor.leaderLease.AnnounceLease(executor.batch.ID)
 
 	return nil
 }
@@ -446,6 +503,12 @@ func (executor *bridgeExecutor) GetAndStoreBatchFromEthereum(ctx context.Context
 		return err
 	}
 
+	executor.missingEthereumBatchID = 0
+	isBatchMissing := batch.ID != nonce || len(batch.Deposits) == 0
+	if isBatchMissing {
+		executor.missingEthereumBatchID = nonce
+	}
+
 	isBatchInvalid := batch.ID != nonce || len(batch.Deposits) == 0 || !isFinal
 	if isBatchInvalid {
 		return fmt.Errorf("%w, requested nonce: %d, fetched nonce: %d, num deposits: %d, isFinal: %v",
@@ -467,6 +530,16 @@ func (executor *bridgeExecutor) GetAndStoreBatchFromEthereum(ctx context.Context
 	return nil
 }
 
+// WaitForNewBatchOnEthereum blocks until the batch notifier signals a new batch, but only if the previous
+// fetch found that the provided batch does not exist yet. Batches that exist but are not final are polled normally
+func (executor *bridgeExecutor) WaitForNewBatchOnEthereum(ctx context.Context, nonce uint64) {
+	if executor.missingEthereumBatchID != nonce {
+		return
+	}
+
+	executor.batchNotifier.WaitForBatch(ctx, nonce)
+}
+
 // convertDepositAmountsToKda converts the deposit amounts from Ethereum decimals to KDA decimals
 func (executor *bridgeExecutor) convertDepositAmountsToKda(ctx context.Context, batch *bridgeCore.TransferBatch) (*bridgeCore.TransferBatch, error) {
 	if batch == nil {
@@ -506,6 +579,7 @@ func (executor *bridgeExecutor) addMetadataToTransfer(transfer *bridgeCore.Depos
 	for _, event := range events {
 		if event.DepositNonce.Uint64() == transfer.Nonce {
 			processData(transfer, event.CallData)
+			executor.validateCallData(transfer)
 			return transfer
 		}
 	}
@@ -517,10 +591,9 @@ func (executor *bridgeExecutor) addMetadataToTransfer(transfer *bridgeCore.Depos
 }
 
 func processData(transfer *bridgeCore.DepositTransfer, buff []byte) {
-	transfer.Data = buff
-	dataLen := len(transfer.Data)
+	transfer.Data = bridgeCore.ConvertEthereumCallData(buff)
+	dataLen := len(buff)
 	if dataLen == 0 {
-		transfer.Data = []byte{bridgeCore.MissingDataProtocolMarker}
 		transfer.DisplayableData = ""
 		return
 	}
@@ -529,14 +602,45 @@ func processData(transfer *bridgeCore.DepositTransfer, buff []byte) {
 		return
 	}
 
-	// we have a data field, add the marker & the correct length
-	transfer.DisplayableData = hex.EncodeToString(transfer.Data)
-	buff32 := make([]byte, bridgeCore.Uint32ArgBytes)
-	binary.BigEndian.PutUint32(buff32, uint32(dataLen))
+	transfer.DisplayableData = hex.EncodeToString(buff)
+}
+
+// validateCallData records the validation result of the SC call data on the transfer. The transfers with a call data
+// that can not be decoded are downgraded to plain transfers so they will not end up as failed SC calls. The transfer
+// data is part of the signed batch so the configured limits, that can differ between the relayers, are only recorded
+// and never change it
+func (executor *bridgeExecutor) validateCallData(transfer *bridgeCore.DepositTransfer) {
+	if len(transfer.Data) == 0 || transfer.Data[0] != bridgeCore.DataPresentProtocolMarker {
+		return
+	}
+
+	err := executor.callDataValidator.ValidateCallData(transfer.Data)
+	if err != nil {
+		executor.recordInvalidCallData(transfer, err, true)
+		return
+	}
+
+	err = executor.callDataValidator.CheckConfiguredLimits(transfer.Data)
+	if err != nil {
+		executor.recordInvalidCallData(transfer, err, false)
+		return
+	}
 
-	prefix := append([]byte{bridgeCore.DataPresentProtocolMarker}, buff32...)
+	transfer.CallDataValidation = bridgeCore.CallDataValid
+}
+
+func (executor *bridgeExecutor) recordInvalidCallData(transfer *bridgeCore.DepositTransfer, err error, downgrade bool) {
+	executor.statusHandler.AddIntMetric(core.MetricNumInvalidCallData, 1)
+	transfer.CallDataValidation = bridgeCore.CallDataInvalid
+	transfer.CallDataValidationReason = err.Error()
+	if downgrade {
+		transfer.CallDataValidation = bridgeCore.CallDataDowngraded
+		transfer.Data = []byte{bridgeCore.MissingDataProtocolMarker}
+	}
 
-	transfer.Data = append(prefix, transfer.Data...)
+	executor.log.Warn("invalid SC call data on deposit", "deposit nonce", transfer.Nonce,
+		"validation", transfer.CallDataValidation, "reason", transfer.CallDataValidationReason,
+		"data", transfer.DisplayableData)
 }
 
 // WasTransferPerformedOnEthereum returns true if the batch was performed on Ethereum
@@ -545,7 +649,18 @@ func (executor *bridgeExecutor) WasTransferPerformedOnEthereum(ctx context.Conte
 		return false, ErrNilBatch
 	}
 
-	return executor.ethereumClient.WasExecuted(ctx, executor.batch.ID)
+	wasPerformed, err := executor.ethereumClient.WasExecuted(ctx, executor.batch.ID)
+	if err != nil {
+		return false, err
+	}
+	if wasPerformed {
+		executor.leaderLease.ReleaseLease(executor.batch.ID)
+		if executor.msgHash != (common.Hash{}) {
+			executor.sigsHolder.PruneExecutedSignatures(executor.msgHash.Bytes())
+		}
+	}
+
+	return wasPerformed, nil
 }
 
 // SignTransferOnEthereum generates the message hash for batch and broadcast the signature
@@ -564,6 +679,7 @@ func (executor *bridgeExecutor) SignTransferOnEthereum() error {
 		"batch ID", executor.batch.ID)
 
 	executor.msgHash = hash
+	executor.batchSignaturesTracker.RecordBatch(executor.batch.ID, hash.Bytes())
 	executor.ethereumClient.BroadcastSignatureForMessageHash(hash)
 	return nil
 }
@@ -590,8 +706,9 @@ func (executor *bridgeExecutor) PerformTransferOnEthereum(ctx context.Context) e
 		return err
 	}
 
-	executor.log.Info("sent execute transfer", "hash", hash,
+	executor.log.Info("sent execute transfer", "tx hash", hash,
 		"batch ID", executor.batch.ID)
+	executor.leaderLease.AnnounceLease(executor.batch.ID)
 
 	return nil
 }
@@ -640,7 +757,16 @@ func (executor *bridgeExecutor) getCumulatedTransfers(ethTokens []common.Address
 
 // ProcessQuorumReachedOnEthereum returns true if the proposed transfer reached the set quorum
 func (executor *bridgeExecutor) ProcessQuorumReachedOnEthereum(ctx context.Context) (bool, error) {
-	return executor.ethereumClient.IsQuorumReached(ctx, executor.msgHash)
+	isReached, err := executor.ethereumClient.IsQuorumReached(ctx, executor.msgHash)
+	if err != nil {
+		return false, err
+	}
+	if !isReached && executor.MyTurnAsLeader() {
+		// the leader might have missed some gossiped signatures, ask the other relayers directly
+		executor.ethereumClient.RequestSignaturesForMessageHash(executor.msgHash)
+	}
+
+	return isReached, nil
 }
 
 // ProcessMaxQuorumRetriesOnEthereum checks if the retries on Ethereum were reached and increments the counter
@@ -674,6 +800,23 @@ func (executor *bridgeExecutor) CheckEthereumClientAvailability(ctx context.Cont
 	return executor.ethereumClient.CheckClientAvailability(ctx)
 }
 
+// SpanAttributes returns the tracing attributes of the stored batch, action ID and message hash
+func (executor *bridgeExecutor) SpanAttributes() []attribute.KeyValue {
+	if executor.batch == nil {
+		return nil
+	}
+
+	attributes := []attribute.KeyValue{tracing.BatchID(executor.batch.ID)}
+	if executor.actionID != 0 {
+		attributes = append(attributes, tracing.ActionID(executor.actionID))
+	}
+	if executor.msgHash != (common.Hash{}) {
+		attributes = append(attributes, tracing.MessageHash(executor.msgHash.Bytes()))
+	}
+
+	return attributes
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (executor *bridgeExecutor) IsInterfaceNil() bool {
 	return executor == nil
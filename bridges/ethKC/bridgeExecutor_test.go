@@ -2,6 +2,7 @@ package ethKC
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
@@ -16,9 +17,12 @@ import (
 	"github.com/klever-io/klv-bridge-eth-go/core/batchProcessor"
 	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
 	bridgeTests "github.com/klever-io/klv-bridge-eth-go/testsCommon/bridge"
+	"github.com/klever-io/klv-bridge-eth-go/tracing"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var expectedErr = errors.New("expected error")
@@ -35,6 +39,11 @@ func createMockExecutorArgs() ArgsBridgeExecutor {
 		TimeForWaitOnEthereum:      time.Second,
 		SignaturesHolder:           &testsCommon.SignaturesHolderStub{},
 		BalanceValidator:           &testsCommon.BalanceValidatorStub{},
+		BatchNotifier:              &testsCommon.BatchNotifierStub{},
+		KCActivityNotifier:         &testsCommon.KCActivityNotifierStub{},
+		CallDataValidator:          &testsCommon.CallDataValidatorStub{},
+		BatchSignaturesTracker:     &testsCommon.BatchSignaturesTrackerStub{},
+		LeaderLease:                &testsCommon.LeaderLeaseStub{},
 		MaxQuorumRetriesOnEthereum: minRetries,
 		MaxQuorumRetriesOnKC:       minRetries,
 		MaxRetriesOnWasProposed:    minRetries,
@@ -124,6 +133,56 @@ func TestNewBridgeExecutor(t *testing.T) {
 		assert.True(t, check.IfNil(executor))
 		assert.Equal(t, ErrNilBalanceValidator, err)
 	})
+	t.Run("nil batch notifier", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.BatchNotifier = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilBatchNotifier, err)
+	})
+	t.Run("nil Klever Blockchain activity notifier", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.KCActivityNotifier = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilKCActivityNotifier, err)
+	})
+	t.Run("nil call data validator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.CallDataValidator = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilCallDataValidator, err)
+	})
+	t.Run("nil batch signatures tracker should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.BatchSignaturesTracker = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilBatchSignaturesTracker, err)
+	})
+	t.Run("nil leader lease should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.LeaderLease = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilLeaderLease, err)
+	})
 	t.Run("invalid MaxQuorumRetriesOnEthereum value", func(t *testing.T) {
 		t.Parallel()
 
@@ -530,9 +589,120 @@ func TestEthToKCBridgeExecutor_GetAndStoreBatchFromEthereum(t *testing.T) {
 		assert.Nil(t, err)
 		assert.True(t, expectedBatch == executor.GetStoredBatch()) // pointer testing
 		assert.Equal(t, depositData, executor.batch.Deposits[0].Data)
+		assert.Empty(t, executor.batch.Deposits[0].CallDataValidation)
+	})
+	t.Run("should record the call data validation result", func(t *testing.T) {
+		t.Parallel()
+
+		testCallDataValidation := func(validationErr error, limitsErr error) (*bridgeCore.DepositTransfer, int) {
+			args := createMockExecutorArgs()
+			statusHandler := testsCommon.NewStatusHandlerMock("test")
+			args.StatusHandler = statusHandler
+			depositNonce := uint64(100)
+			depositData := []byte("testData")
+			args.EthereumClient = &bridgeTests.EthereumClientStub{
+				GetBatchCalled: func(ctx context.Context, nonce uint64) (*bridgeCore.TransferBatch, bool, error) {
+					return &bridgeCore.TransferBatch{
+						ID: nonce,
+						Deposits: []*bridgeCore.DepositTransfer{
+							{
+								Nonce: depositNonce,
+							},
+						},
+					}, true, nil
+				},
+				GetBatchSCMetadataCalled: func(ctx context.Context, nonce uint64, blockNumber int64) ([]*contract.ERC20SafeERC20SCDeposit, error) {
+					return []*contract.ERC20SafeERC20SCDeposit{{
+						DepositNonce: big.NewInt(0).SetUint64(depositNonce),
+						CallData:     depositData,
+					}}, nil
+				},
+			}
+			args.CallDataValidator = &testsCommon.CallDataValidatorStub{
+				ValidateCallDataCalled: func(data []byte) error {
+					expectedData := append([]byte{bridgeCore.DataPresentProtocolMarker, 0, 0, 0, byte(len(depositData))}, depositData...)
+					assert.Equal(t, expectedData, data)
+
+					return validationErr
+				},
+				CheckConfiguredLimitsCalled: func(data []byte) error {
+					return limitsErr
+				},
+			}
+			executor, _ := NewBridgeExecutor(args)
+			err := executor.GetAndStoreBatchFromEthereum(context.Background(), 8346)
+			assert.Nil(t, err)
+
+			return executor.batch.Deposits[0], statusHandler.GetIntMetric(bridgeCore.MetricNumInvalidCallData)
+		}
+
+		deposit, numInvalid := testCallDataValidation(nil, nil)
+		assert.Equal(t, bridgeCore.CallDataValid, deposit.CallDataValidation)
+		assert.Empty(t, deposit.CallDataValidationReason)
+		assert.Equal(t, bridgeCore.DataPresentProtocolMarker, deposit.Data[0])
+		assert.Zero(t, numInvalid)
+
+		deposit, numInvalid = testCallDataValidation(expectedErr, nil)
+		assert.Equal(t, bridgeCore.CallDataDowngraded, deposit.CallDataValidation)
+		assert.Equal(t, expectedErr.Error(), deposit.CallDataValidationReason)
+		assert.Equal(t, []byte{bridgeCore.MissingDataProtocolMarker}, deposit.Data)
+		assert.Equal(t, hex.EncodeToString([]byte("testData")), deposit.DisplayableData)
+		assert.Equal(t, 1, numInvalid)
+
+		// the configured limits are local to each relayer, the data should never be downgraded because of them
+		deposit, numInvalid = testCallDataValidation(nil, expectedErr)
+		assert.Equal(t, bridgeCore.CallDataInvalid, deposit.CallDataValidation)
+		assert.Equal(t, expectedErr.Error(), deposit.CallDataValidationReason)
+		assert.Equal(t, bridgeCore.DataPresentProtocolMarker, deposit.Data[0])
+		assert.Equal(t, 1, numInvalid)
 	})
 }
 
+func TestEthToKCBridgeExecutor_WaitForNewBatchOnEthereum(t *testing.T) {
+	t.Parallel()
+
+	providedNonce := uint64(8346)
+	batchExists := false
+	args := createMockExecutorArgs()
+	args.EthereumClient = &bridgeTests.EthereumClientStub{
+		GetBatchCalled: func(ctx context.Context, nonce uint64) (*bridgeCore.TransferBatch, bool, error) {
+			if batchExists {
+				return &bridgeCore.TransferBatch{
+					ID:       nonce,
+					Deposits: []*bridgeCore.DepositTransfer{{}},
+				}, false, nil
+			}
+
+			return &bridgeCore.TransferBatch{}, false, nil
+		},
+	}
+	waitedBatches := make([]uint64, 0)
+	args.BatchNotifier = &testsCommon.BatchNotifierStub{
+		WaitForBatchCalled: func(ctx context.Context, batchID uint64) {
+			waitedBatches = append(waitedBatches, batchID)
+		},
+	}
+	executor, _ := NewBridgeExecutor(args)
+
+	// the batch was not fetched yet, it should not wait
+	executor.WaitForNewBatchOnEthereum(context.Background(), providedNonce)
+	assert.Empty(t, waitedBatches)
+
+	// the batch is missing, it should wait only for that batch
+	err := executor.GetAndStoreBatchFromEthereum(context.Background(), providedNonce)
+	assert.True(t, errors.Is(err, ErrFinalBatchNotFound))
+	executor.WaitForNewBatchOnEthereum(context.Background(), providedNonce+1)
+	executor.WaitForNewBatchOnEthereum(context.Background(), providedNonce)
+	assert.Equal(t, []uint64{providedNonce}, waitedBatches)
+
+	// the batch exists but is not final, it should not wait
+	batchExists = true
+	err = executor.GetAndStoreBatchFromEthereum(context.Background(), providedNonce)
+	assert.True(t, errors.Is(err, ErrFinalBatchNotFound))
+	executor.WaitForNewBatchOnEthereum(context.Background(), providedNonce)
+	assert.Equal(t, []uint64{providedNonce}, waitedBatches)
+}
+
 func TestEthToKCBridgeExecutor_GetLastExecutedEthBatchIDFromKC(t *testing.T) {
 	t.Parallel()
 
@@ -874,6 +1044,40 @@ func TestEthToKCBridgeExecutor_WasActionPerformedOnKC(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestEthToKCBridgeExecutor_WasActionPerformedOnKCShouldReleaseTheLease(t *testing.T) {
+	t.Parallel()
+
+	providedBatchID := uint64(112)
+	wasExecuted := false
+	var releasedBatches []uint64
+	args := createMockExecutorArgs()
+	args.KCClient = &bridgeTests.KCClientStub{
+		WasExecutedCalled: func(ctx context.Context, actionID uint64) (bool, error) {
+			return wasExecuted, nil
+		},
+	}
+	args.LeaderLease = &testsCommon.LeaderLeaseStub{
+		ReleaseLeaseCalled: func(batchID uint64) {
+			releasedBatches = append(releasedBatches, batchID)
+		},
+	}
+	executor, _ := NewBridgeExecutor(args)
+
+	// no batch stored
+	wasExecuted = true
+	_, _ = executor.WasActionPerformedOnKC(context.Background())
+	assert.Empty(t, releasedBatches)
+
+	executor.batch = &bridgeCore.TransferBatch{ID: providedBatchID}
+	wasExecuted = false
+	_, _ = executor.WasActionPerformedOnKC(context.Background())
+	assert.Empty(t, releasedBatches)
+
+	wasExecuted = true
+	_, _ = executor.WasActionPerformedOnKC(context.Background())
+	assert.Equal(t, []uint64{providedBatchID}, releasedBatches)
+}
+
 func TestEthToKCBridgeExecutor_PerformActionOnKC(t *testing.T) {
 	t.Parallel()
 
@@ -905,6 +1109,24 @@ func TestEthToKCBridgeExecutor_PerformActionOnKC(t *testing.T) {
 		err := executor.PerformActionOnKC(context.Background())
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("should announce the lease", func(t *testing.T) {
+		t.Parallel()
+
+		providedBatchID := uint64(113)
+		var announcedBatches []uint64
+		args := createMockExecutorArgs()
+		args.LeaderLease = &testsCommon.LeaderLeaseStub{
+			AnnounceLeaseCalled: func(batchID uint64) {
+				announcedBatches = append(announcedBatches, batchID)
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = &bridgeCore.TransferBatch{ID: providedBatchID}
+
+		err := executor.PerformActionOnKC(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []uint64{providedBatchID}, announcedBatches)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -927,6 +1149,33 @@ func TestEthToKCBridgeExecutor_PerformActionOnKC(t *testing.T) {
 		assert.Nil(t, err)
 		assert.True(t, wasCalled)
 	})
+	t.Run("should log the tx hash under the normalized argument name", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		var loggedArgs []interface{}
+		args.Log = &testsCommon.LoggerStub{
+			InfoCalled: func(message string, args ...interface{}) {
+				if message == "sent perform action transaction" {
+					loggedArgs = args
+				}
+			},
+		}
+		args.KCClient = &bridgeTests.KCClientStub{
+			PerformActionCalled: func(ctx context.Context, actionID uint64, batch *bridgeCore.TransferBatch) (string, error) {
+				return "0xabcd", nil
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+
+		err := executor.PerformActionOnKC(context.Background())
+		assert.Nil(t, err)
+		// the JSON log format maps the "tx hash" argument to the tx_hash key
+		require.GreaterOrEqual(t, len(loggedArgs), 2)
+		assert.Equal(t, "tx hash", loggedArgs[0])
+		assert.Equal(t, "0xabcd", loggedArgs[1])
+	})
 }
 
 func TestEthToKCBridgeExecutor_RetriesCountOnKC(t *testing.T) {
@@ -1015,6 +1264,47 @@ func TestKCToEthBridgeExecutor_GetAndStoreBatchFromKC(t *testing.T) {
 	})
 }
 
+func TestKCToEthBridgeExecutor_WaitForNewBatchOnKC(t *testing.T) {
+	t.Parallel()
+
+	var pendingBatchErr error
+	args := createMockExecutorArgs()
+	args.KCClient = &bridgeTests.KCClientStub{
+		GetPendingBatchCalled: func(ctx context.Context) (*bridgeCore.TransferBatch, error) {
+			return providedBatch, pendingBatchErr
+		},
+	}
+	numWaits := 0
+	args.KCActivityNotifier = &testsCommon.KCActivityNotifierStub{
+		WaitForSafeActivityCalled: func(ctx context.Context) {
+			numWaits++
+		},
+	}
+	executor, _ := NewBridgeExecutor(args)
+
+	// the batch was not fetched yet, it should not wait
+	executor.WaitForNewBatchOnKC(context.Background())
+	assert.Equal(t, 0, numWaits)
+
+	// no pending batch, it should wait
+	pendingBatchErr = fmt.Errorf("%w for test", clients.ErrNoPendingBatchAvailable)
+	_, _ = executor.GetBatchFromKC(context.Background())
+	executor.WaitForNewBatchOnKC(context.Background())
+	assert.Equal(t, 1, numWaits)
+
+	// other errors should not wait
+	pendingBatchErr = expectedErr
+	_, _ = executor.GetBatchFromKC(context.Background())
+	executor.WaitForNewBatchOnKC(context.Background())
+	assert.Equal(t, 1, numWaits)
+
+	// pending batch found, it should not wait
+	pendingBatchErr = nil
+	_, _ = executor.GetBatchFromKC(context.Background())
+	executor.WaitForNewBatchOnKC(context.Background())
+	assert.Equal(t, 1, numWaits)
+}
+
 func TestKCToEthBridgeExecutor_GetAndStoreActionIDForProposeSetStatusFromKC(t *testing.T) {
 	t.Parallel()
 
@@ -1234,6 +1524,65 @@ func TestKCToEthBridgeExecutor_WasTransferPerformedOnEthereum(t *testing.T) {
 		assert.Nil(t, err)
 		assert.True(t, wasCalled)
 	})
+	t.Run("should release the lease when performed", func(t *testing.T) {
+		t.Parallel()
+
+		providedBatchID := uint64(114)
+		wasExecuted := false
+		var releasedBatches []uint64
+		args := createMockExecutorArgs()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			WasExecutedCalled: func(ctx context.Context, batchID uint64) (bool, error) {
+				return wasExecuted, nil
+			},
+		}
+		args.LeaderLease = &testsCommon.LeaderLeaseStub{
+			ReleaseLeaseCalled: func(batchID uint64) {
+				releasedBatches = append(releasedBatches, batchID)
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = &bridgeCore.TransferBatch{ID: providedBatchID}
+
+		_, _ = executor.WasTransferPerformedOnEthereum(context.Background())
+		assert.Empty(t, releasedBatches)
+
+		wasExecuted = true
+		_, _ = executor.WasTransferPerformedOnEthereum(context.Background())
+		assert.Equal(t, []uint64{providedBatchID}, releasedBatches)
+	})
+	t.Run("should prune the signatures of the executed message hash", func(t *testing.T) {
+		t.Parallel()
+
+		wasExecuted := false
+		var prunedHashes [][]byte
+		args := createMockExecutorArgs()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			WasExecutedCalled: func(ctx context.Context, batchID uint64) (bool, error) {
+				return wasExecuted, nil
+			},
+		}
+		args.SignaturesHolder = &testsCommon.SignaturesHolderStub{
+			PruneExecutedSignaturesCalled: func(messageHash []byte) {
+				prunedHashes = append(prunedHashes, messageHash)
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = &bridgeCore.TransferBatch{ID: 115}
+
+		wasExecuted = true
+		_, _ = executor.WasTransferPerformedOnEthereum(context.Background())
+		assert.Empty(t, prunedHashes) // no message hash generated
+
+		executor.msgHash = common.HexToHash("0x1234")
+		wasExecuted = false
+		_, _ = executor.WasTransferPerformedOnEthereum(context.Background())
+		assert.Empty(t, prunedHashes)
+
+		wasExecuted = true
+		_, _ = executor.WasTransferPerformedOnEthereum(context.Background())
+		assert.Equal(t, [][]byte{executor.msgHash.Bytes()}, prunedHashes)
+	})
 }
 
 func TestKCToEthBridgeExecutor_SignTransferOnEthereum(t *testing.T) {
@@ -1268,23 +1617,35 @@ func TestKCToEthBridgeExecutor_SignTransferOnEthereum(t *testing.T) {
 
 		wasCalledGenerateMessageHashCalled := false
 		wasCalledBroadcastSignatureForMessageHashCalled := false
+		providedHash := common.HexToHash("0x1234")
 		args := createMockExecutorArgs()
 		args.EthereumClient = &bridgeTests.EthereumClientStub{
 			GenerateMessageHashCalled: func(batch *batchProcessor.ArgListsBatch, batchID uint64) (common.Hash, error) {
 				wasCalledGenerateMessageHashCalled = true
-				return common.Hash{}, nil
+				return providedHash, nil
 			},
 			BroadcastSignatureForMessageHashCalled: func(msgHash common.Hash) {
 				wasCalledBroadcastSignatureForMessageHashCalled = true
 			},
 		}
 
+		var recordedBatchID uint64
+		var recordedMessageHash []byte
+		args.BatchSignaturesTracker = &testsCommon.BatchSignaturesTrackerStub{
+			RecordBatchCalled: func(batchID uint64, messageHash []byte) {
+				recordedBatchID = batchID
+				recordedMessageHash = messageHash
+			},
+		}
+
 		executor, _ := NewBridgeExecutor(args)
 		executor.batch = providedBatch
 		err := executor.SignTransferOnEthereum()
 		assert.Nil(t, err)
 		assert.True(t, wasCalledGenerateMessageHashCalled)
 		assert.True(t, wasCalledBroadcastSignatureForMessageHashCalled)
+		assert.Equal(t, providedBatch.ID, recordedBatchID)
+		assert.Equal(t, providedHash.Bytes(), recordedMessageHash)
 	})
 }
 
@@ -1340,7 +1701,13 @@ func TestKCToEthBridgeExecutor_PerformTransferOnEthereum(t *testing.T) {
 		providedQuorum := 12
 		wasCalledGetQuorumSizeCalled := false
 		wasCalledExecuteTransferCalled := false
+		var announcedBatches []uint64
 		args := createMockExecutorArgs()
+		args.LeaderLease = &testsCommon.LeaderLeaseStub{
+			AnnounceLeaseCalled: func(batchID uint64) {
+				announcedBatches = append(announcedBatches, batchID)
+			},
+		}
 		args.EthereumClient = &bridgeTests.EthereumClientStub{
 			GetQuorumSizeCalled: func(ctx context.Context) (*big.Int, error) {
 				wasCalledGetQuorumSizeCalled = true
@@ -1370,6 +1737,7 @@ func TestKCToEthBridgeExecutor_PerformTransferOnEthereum(t *testing.T) {
 		assert.Nil(t, err)
 		assert.True(t, wasCalledGetQuorumSizeCalled)
 		assert.True(t, wasCalledExecuteTransferCalled)
+		assert.Equal(t, []uint64{providedBatch.ID}, announcedBatches)
 	})
 }
 
@@ -1410,6 +1778,40 @@ func TestKCToEthBridgeExecutor_IsQuorumReachedOnEthereum(t *testing.T) {
 		assert.True(t, wasCalled)
 		assert.True(t, isReached)
 	})
+	t.Run("quorum not reached should request signatures only if leader", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		requestedHashes := make([]common.Hash, 0)
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			IsQuorumReachedCalled: func(ctx context.Context, msgHash common.Hash) (bool, error) {
+				return false, nil
+			},
+			RequestSignaturesForMessageHashCalled: func(msgHash common.Hash) {
+				requestedHashes = append(requestedHashes, msgHash)
+			},
+		}
+		isLeader := false
+		args.TopologyProvider = &bridgeTests.TopologyProviderStub{
+			MyTurnAsLeaderCalled: func() bool {
+				return isLeader
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.msgHash = common.HexToHash("hash")
+
+		isReached, err := executor.ProcessQuorumReachedOnEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.False(t, isReached)
+		assert.Empty(t, requestedHashes)
+
+		isLeader = true
+		isReached, err = executor.ProcessQuorumReachedOnEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.False(t, isReached)
+		assert.Equal(t, []common.Hash{executor.msgHash}, requestedHashes)
+	})
 }
 
 func TestKCToEthBridgeExecutor_RetriesCountOnEthereum(t *testing.T) {
@@ -1473,6 +1875,12 @@ func TestWaitForTransferConfirmation(t *testing.T) {
 				return false, nil
 			},
 		}
+		numRenews := 0
+		args.LeaderLease = &testsCommon.LeaderLeaseStub{
+			RenewLeaseCalled: func(batchID uint64) {
+				numRenews++
+			},
+		}
 		executor, _ := NewBridgeExecutor(args)
 		executor.batch = &bridgeCore.TransferBatch{}
 
@@ -1482,6 +1890,7 @@ func TestWaitForTransferConfirmation(t *testing.T) {
 		executor.WaitForTransferConfirmation(ctx)
 
 		assert.Equal(t, 10, counter)
+		assert.Equal(t, 10, numRenews)
 	})
 
 	t.Run("WasTransferPerformedOnEthereum always returns true only after 4 checks", func(t *testing.T) {
@@ -1499,6 +1908,12 @@ func TestWaitForTransferConfirmation(t *testing.T) {
 				return false, nil
 			},
 		}
+		numRenews := 0
+		args.LeaderLease = &testsCommon.LeaderLeaseStub{
+			RenewLeaseCalled: func(batchID uint64) {
+				numRenews++
+			},
+		}
 		executor, _ := NewBridgeExecutor(args)
 		executor.batch = &bridgeCore.TransferBatch{}
 
@@ -1512,6 +1927,7 @@ func TestWaitForTransferConfirmation(t *testing.T) {
 
 		assert.True(t, elapsed < args.TimeForWaitOnEthereum)
 		assert.Equal(t, 5, counter)
+		assert.Equal(t, 4, numRenews)
 	})
 }
 
@@ -1899,3 +2315,22 @@ func TestBridgeExecutor_CheckAvailableTokens(t *testing.T) {
 		assert.Equal(t, expectedAmounts, checkedAmounts)
 	})
 }
+
+func TestBridgeExecutor_SpanAttributes(t *testing.T) {
+	t.Parallel()
+
+	executor, _ := NewBridgeExecutor(createMockExecutorArgs())
+	assert.Nil(t, executor.SpanAttributes())
+
+	executor.batch = &bridgeCore.TransferBatch{ID: 37}
+	assert.Equal(t, []attribute.KeyValue{tracing.BatchID(37)}, executor.SpanAttributes())
+
+	executor.actionID = 112
+	executor.msgHash = common.HexToHash("0x0102")
+	expectedAttributes := []attribute.KeyValue{
+		tracing.BatchID(37),
+		tracing.ActionID(112),
+		tracing.MessageHash(executor.msgHash.Bytes()),
+	}
+	assert.Equal(t, expectedAttributes, executor.SpanAttributes())
+}
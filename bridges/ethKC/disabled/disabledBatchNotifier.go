@@ -0,0 +1,20 @@
+package disabled
+
+import "context"
+
+type disabledBatchNotifier struct {
+}
+
+// NewDisabledBatchNotifier will return a disabled batch notifier instance
+func NewDisabledBatchNotifier() *disabledBatchNotifier {
+	return &disabledBatchNotifier{}
+}
+
+// WaitForBatch returns immediately
+func (disabled *disabledBatchNotifier) WaitForBatch(_ context.Context, _ uint64) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledBatchNotifier) IsInterfaceNil() bool {
+	return disabled == nil
+}
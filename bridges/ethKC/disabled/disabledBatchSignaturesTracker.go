@@ -0,0 +1,18 @@
+package disabled
+
+type disabledBatchSignaturesTracker struct {
+}
+
+// NewDisabledBatchSignaturesTracker will return a disabled batch signatures tracker instance
+func NewDisabledBatchSignaturesTracker() *disabledBatchSignaturesTracker {
+	return &disabledBatchSignaturesTracker{}
+}
+
+// RecordBatch does nothing
+func (disabled *disabledBatchSignaturesTracker) RecordBatch(_ uint64, _ []byte) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledBatchSignaturesTracker) IsInterfaceNil() bool {
+	return disabled == nil
+}
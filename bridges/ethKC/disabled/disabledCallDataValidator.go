@@ -0,0 +1,24 @@
+package disabled
+
+type disabledCallDataValidator struct {
+}
+
+// NewDisabledCallDataValidator will return a disabled call data validator instance
+func NewDisabledCallDataValidator() *disabledCallDataValidator {
+	return &disabledCallDataValidator{}
+}
+
+// ValidateCallData returns nil
+func (disabled *disabledCallDataValidator) ValidateCallData(_ []byte) error {
+	return nil
+}
+
+// CheckConfiguredLimits returns nil
+func (disabled *disabledCallDataValidator) CheckConfiguredLimits(_ []byte) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledCallDataValidator) IsInterfaceNil() bool {
+	return disabled == nil
+}
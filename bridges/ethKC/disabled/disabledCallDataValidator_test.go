@@ -0,0 +1,25 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledCallDataValidator_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledCallDataValidator()
+	assert.False(t, check.IfNil(disabled))
+	assert.Nil(t, disabled.ValidateCallData([]byte("data")))
+	assert.Nil(t, disabled.CheckConfiguredLimits([]byte("data")))
+}
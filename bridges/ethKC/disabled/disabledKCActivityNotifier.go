@@ -0,0 +1,20 @@
+package disabled
+
+import "context"
+
+type disabledKCActivityNotifier struct {
+}
+
+// NewDisabledKCActivityNotifier will return a disabled Klever Blockchain activity notifier instance
+func NewDisabledKCActivityNotifier() *disabledKCActivityNotifier {
+	return &disabledKCActivityNotifier{}
+}
+
+// WaitForSafeActivity returns immediately
+func (disabled *disabledKCActivityNotifier) WaitForSafeActivity(_ context.Context) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledKCActivityNotifier) IsInterfaceNil() bool {
+	return disabled == nil
+}
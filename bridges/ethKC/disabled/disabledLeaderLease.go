@@ -0,0 +1,37 @@
+package disabled
+
+import "github.com/klever-io/klv-bridge-eth-go/core"
+
+type disabledLeaderLease struct {
+}
+
+// NewDisabledLeaderLease will return a disabled leader lease instance
+func NewDisabledLeaderLease() *disabledLeaderLease {
+	return &disabledLeaderLease{}
+}
+
+// CurrentLeaseHolder returns nil
+func (disabled *disabledLeaderLease) CurrentLeaseHolder() []byte {
+	return nil
+}
+
+// AnnounceLease does nothing
+func (disabled *disabledLeaderLease) AnnounceLease(_ uint64) {
+}
+
+// RenewLease does nothing
+func (disabled *disabledLeaderLease) RenewLease(_ uint64) {
+}
+
+// ReleaseLease does nothing
+func (disabled *disabledLeaderLease) ReleaseLease(_ uint64) {
+}
+
+// ProcessLease does nothing
+func (disabled *disabledLeaderLease) ProcessLease(_ []byte, _ *core.LeaderLease) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledLeaderLease) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,29 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledLeaderLease_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledLeaderLease()
+	assert.False(t, check.IfNil(disabled))
+	disabled.AnnounceLease(1)
+	disabled.RenewLease(1)
+	disabled.ReleaseLease(1)
+	disabled.ProcessLease([]byte("pk"), &core.LeaderLease{})
+	assert.Nil(t, disabled.CurrentLeaseHolder())
+}
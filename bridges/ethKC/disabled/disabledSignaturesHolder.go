@@ -13,6 +13,10 @@ func (disabled *disabledSignaturesHolder) Signatures(_ []byte) [][]byte {
 	return make([][]byte, 0)
 }
 
+// PruneExecutedSignatures does nothing
+func (disabled *disabledSignaturesHolder) PruneExecutedSignatures(_ []byte) {
+}
+
 // ClearStoredSignatures does nothing
 func (disabled *disabledSignaturesHolder) ClearStoredSignatures() {
 }
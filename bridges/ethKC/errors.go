@@ -40,3 +40,27 @@ var ErrNilSignaturesHolder = errors.New("nil signatures holder")
 
 // ErrNilBalanceValidator signals that a nil balance validator was provided
 var ErrNilBalanceValidator = errors.New("nil balance validator")
+
+// ErrNilBatchNotifier signals that a nil batch notifier was provided
+var ErrNilBatchNotifier = errors.New("nil batch notifier")
+
+// ErrNilKCActivityNotifier signals that a nil Klever Blockchain activity notifier was provided
+var ErrNilKCActivityNotifier = errors.New("nil Klever Blockchain activity notifier")
+
+// ErrNilCallDataValidator signals that a nil call data validator was provided
+var ErrNilCallDataValidator = errors.New("nil call data validator")
+
+// ErrNilBatchSignaturesTracker signals that a nil batch signatures tracker was provided
+var ErrNilBatchSignaturesTracker = errors.New("nil batch signatures tracker")
+
+// ErrNilLeaderLease signals that a nil leader lease was provided
+var ErrNilLeaderLease = errors.New("nil leader lease")
+
+// ErrEmptyName signals that an empty name has been provided
+var ErrEmptyName = errors.New("empty name")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilTracer signals that a nil tracer was provided
+var ErrNilTracer = errors.New("nil tracer")
@@ -51,6 +51,7 @@ type EthereumClient interface {
 	GenerateMessageHash(batch *batchProcessor.ArgListsBatch, batchId uint64) (common.Hash, error)
 
 	BroadcastSignatureForMessageHash(msgHash common.Hash)
+	RequestSignaturesForMessageHash(msgHash common.Hash)
 	ExecuteTransfer(ctx context.Context, msgHash common.Hash, batch *batchProcessor.ArgListsBatch, batchId uint64, quorum int) (string, error)
 	GetTransactionsStatuses(ctx context.Context, batchId uint64) ([]byte, error)
 	GetQuorumSize(ctx context.Context) (*big.Int, error)
@@ -76,6 +77,7 @@ type TopologyProvider interface {
 // SignaturesHolder defines the operations for a component that can hold and manage signatures
 type SignaturesHolder interface {
 	Signatures(messageHash []byte) [][]byte
+	PruneExecutedSignatures(messageHash []byte)
 	ClearStoredSignatures()
 	IsInterfaceNil() bool
 }
@@ -85,3 +87,42 @@ type BalanceValidator interface {
 	CheckToken(ctx context.Context, ethToken common.Address, kdaToken []byte, amount *big.Int, direction batchProcessor.Direction) error
 	IsInterfaceNil() bool
 }
+
+// BatchNotifier defines the operations for a component able to signal when a new batch was created on Ethereum
+type BatchNotifier interface {
+	WaitForBatch(ctx context.Context, batchID uint64)
+	IsInterfaceNil() bool
+}
+
+// KCActivityNotifier defines the operations for a component able to signal activity on the Klever Blockchain safe contract
+type KCActivityNotifier interface {
+	WaitForSafeActivity(ctx context.Context)
+	IsInterfaceNil() bool
+}
+
+// BatchSignaturesTracker defines the operations for a component able to track the relayers signatures for each batch
+type BatchSignaturesTracker interface {
+	RecordBatch(batchID uint64, messageHash []byte)
+	IsInterfaceNil() bool
+}
+
+// LeaderLease defines the operations for a component able to announce the progress of the in-flight transactions
+type LeaderLease interface {
+	AnnounceLease(batchID uint64)
+	RenewLease(batchID uint64)
+	ReleaseLease(batchID uint64)
+	IsInterfaceNil() bool
+}
+
+// CallDataValidator defines the operations for a component able to validate the SC call data of the Ethereum deposits
+type CallDataValidator interface {
+	ValidateCallData(data []byte) error
+	CheckConfiguredLimits(data []byte) error
+	IsInterfaceNil() bool
+}
+
+type throttledWriter interface {
+	MarkDirty()
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,191 @@
+package ethKC
+
+import (
+	"sync"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/core/persistence"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	persistentSignaturesKeyPrefix  = "p2pSignatures_"
+	minTimeBetweenSignaturesWrites = time.Second
+	maxExecutedMessageHashes       = 100
+)
+
+type persistedSignature struct {
+	SignedMessage *core.SignedMessage     `json:"signedMessage"`
+	EthMessage    *core.EthereumSignature `json:"ethMessage"`
+}
+
+type signaturesHolderPersistenceData struct {
+	Signatures []persistedSignature `json:"signatures"`
+}
+
+// ArgsPersistentSignaturesHolder is the DTO used in the persistent signatures holder constructor
+type ArgsPersistentSignaturesHolder struct {
+	Name   string
+	Storer core.Storer
+	Log    logger.Logger
+}
+
+// persistentSignaturesHolder is a signaturesHolder that saves the gathered signatures so a restarted relayer
+// does not lose the signatures of the batch in flight. Only the signatures of the message hashes not yet executed
+// are kept: they are pruned for each message hash once it was executed on Ethereum. The signatures are saved at most
+// once every minTimeBetweenSignaturesWrites
+type persistentSignaturesHolder struct {
+	*signaturesHolder
+	mutExecuted         sync.RWMutex
+	executedHashes      map[string]struct{}
+	executedHashesOrder []string
+	key                 []byte
+	storer              core.Storer
+	marshaller          marshal.Marshalizer
+	writer              throttledWriter
+	log                 logger.Logger
+}
+
+// NewPersistentSignaturesHolder creates a new persistent signatures holder, loading the previously saved signatures
+func NewPersistentSignaturesHolder(args ArgsPersistentSignaturesHolder) (*persistentSignaturesHolder, error) {
+	if len(args.Name) == 0 {
+		return nil, ErrEmptyName
+	}
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Log) {
+		return nil, ErrNilLogger
+	}
+
+	psh := &persistentSignaturesHolder{
+		signaturesHolder:    NewSignatureHolder(),
+		executedHashes:      make(map[string]struct{}),
+		executedHashesOrder: make([]string, 0, maxExecutedMessageHashes),
+		key:                 []byte(persistentSignaturesKeyPrefix + args.Name),
+		storer:              args.Storer,
+		marshaller:          &marshal.JsonMarshalizer{},
+		log:                 args.Log,
+	}
+
+	argsWriter := persistence.ArgsThrottledWriter{
+		Storer:               args.Storer,
+		Key:                  psh.key,
+		MinTimeBetweenWrites: minTimeBetweenSignaturesWrites,
+		DataProvider:         psh.marshalSignatures,
+		Log:                  args.Log,
+	}
+	var err error
+	psh.writer, err = persistence.NewThrottledWriter(argsWriter)
+	if err != nil {
+		return nil, err
+	}
+
+	psh.tryLoadPersistedData()
+
+	return psh, nil
+}
+
+// ProcessNewMessage will store and persist the new messages. The messages of an already executed message hash
+// are ignored
+func (psh *persistentSignaturesHolder) ProcessNewMessage(msg *core.SignedMessage, ethMsg *core.EthereumSignature) {
+	if msg == nil || ethMsg == nil {
+		return
+	}
+	if psh.wasExecuted(ethMsg.MessageHash) {
+		return
+	}
+
+	psh.signaturesHolder.ProcessNewMessage(msg, ethMsg)
+	psh.writer.MarkDirty()
+}
+
+// PruneExecutedSignatures will remove the stored and persisted signatures of the provided message hash, already
+// executed. The signatures received later for this message hash are ignored
+func (psh *persistentSignaturesHolder) PruneExecutedSignatures(msgHash []byte) {
+	psh.markExecuted(msgHash)
+	psh.signaturesHolder.PruneExecutedSignatures(msgHash)
+	psh.writer.MarkDirty()
+}
+
+// ClearStoredSignatures will clear any stored and persisted signatures
+func (psh *persistentSignaturesHolder) ClearStoredSignatures() {
+	psh.signaturesHolder.ClearStoredSignatures()
+	psh.writer.MarkDirty()
+}
+
+func (psh *persistentSignaturesHolder) markExecuted(msgHash []byte) {
+	psh.mutExecuted.Lock()
+	defer psh.mutExecuted.Unlock()
+
+	_, found := psh.executedHashes[string(msgHash)]
+	if found {
+		return
+	}
+
+	if len(psh.executedHashesOrder) >= maxExecutedMessageHashes {
+		delete(psh.executedHashes, psh.executedHashesOrder[0])
+		psh.executedHashesOrder = psh.executedHashesOrder[1:]
+	}
+	psh.executedHashes[string(msgHash)] = struct{}{}
+	psh.executedHashesOrder = append(psh.executedHashesOrder, string(msgHash))
+}
+
+func (psh *persistentSignaturesHolder) wasExecuted(msgHash []byte) bool {
+	psh.mutExecuted.RLock()
+	defer psh.mutExecuted.RUnlock()
+
+	_, found := psh.executedHashes[string(msgHash)]
+
+	return found
+}
+
+func (psh *persistentSignaturesHolder) tryLoadPersistedData() {
+	buff, err := psh.storer.Get(psh.key)
+	if err != nil {
+		psh.log.Debug("persistentSignaturesHolder.tryLoadPersistedData reading from storer", "key", string(psh.key), "error", err)
+		return
+	}
+
+	persistedData := &signaturesHolderPersistenceData{}
+	err = psh.marshaller.Unmarshal(persistedData, buff)
+	if err != nil {
+		psh.log.Debug("persistentSignaturesHolder.tryLoadPersistedData loading from buffer", "key", string(psh.key), "error", err)
+		return
+	}
+
+	for _, signature := range persistedData.Signatures {
+		psh.signaturesHolder.ProcessNewMessage(signature.SignedMessage, signature.EthMessage)
+	}
+
+	psh.log.Info("loaded persisted P2P signatures", "key", string(psh.key), "num signatures", len(persistedData.Signatures))
+}
+
+func (psh *persistentSignaturesHolder) marshalSignatures() ([]byte, error) {
+	psh.signaturesHolder.mut.RLock()
+	persistedData := &signaturesHolderPersistenceData{
+		Signatures: make([]persistedSignature, 0, len(psh.signaturesHolder.signedMessages)),
+	}
+	for id, msg := range psh.signaturesHolder.signedMessages {
+		persistedData.Signatures = append(persistedData.Signatures, persistedSignature{
+			SignedMessage: msg,
+			EthMessage:    psh.signaturesHolder.ethMessagesByID[id],
+		})
+	}
+	psh.signaturesHolder.mut.RUnlock()
+
+	return psh.marshaller.Marshal(persistedData)
+}
+
+// Close will save the signatures not yet persisted
+func (psh *persistentSignaturesHolder) Close() error {
+	return psh.writer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (psh *persistentSignaturesHolder) IsInterfaceNil() bool {
+	return psh == nil
+}
@@ -0,0 +1,201 @@
+package ethKC
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsPersistentSignaturesHolder() ArgsPersistentSignaturesHolder {
+	return ArgsPersistentSignaturesHolder{
+		Name:   "test",
+		Storer: testsCommon.NewStorerMock(),
+		Log:    logger.GetOrCreate("test"),
+	}
+}
+
+func TestNewPersistentSignaturesHolder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPersistentSignaturesHolder()
+		args.Name = ""
+
+		psh, err := NewPersistentSignaturesHolder(args)
+		assert.True(t, check.IfNil(psh))
+		assert.Equal(t, ErrEmptyName, err)
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPersistentSignaturesHolder()
+		args.Storer = nil
+
+		psh, err := NewPersistentSignaturesHolder(args)
+		assert.True(t, check.IfNil(psh))
+		assert.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPersistentSignaturesHolder()
+		args.Log = nil
+
+		psh, err := NewPersistentSignaturesHolder(args)
+		assert.True(t, check.IfNil(psh))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		psh, err := NewPersistentSignaturesHolder(createMockArgsPersistentSignaturesHolder())
+		assert.False(t, check.IfNil(psh))
+		assert.Nil(t, err)
+	})
+}
+
+func TestPersistentSignaturesHolder_ShouldReloadTheSignatures(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPersistentSignaturesHolder()
+	psh, _ := NewPersistentSignaturesHolder(args)
+
+	msg1, ethMsg1 := generateSignedMessage(1), generateEthMessage(1)
+	msg2, ethMsg2 := generateSignedMessage(2), generateEthMessage(2)
+	psh.ProcessNewMessage(msg1, ethMsg1)
+	psh.ProcessNewMessage(msg2, ethMsg2)
+	psh.ProcessNewMessage(nil, ethMsg2)
+	err := psh.Close()
+	assert.Nil(t, err)
+
+	reloaded, _ := NewPersistentSignaturesHolder(args)
+	assert.ElementsMatch(t, []*core.SignedMessage{msg1, msg2}, reloaded.AllStoredSignatures())
+	assert.ElementsMatch(t, [][]byte{ethMsg1.Signature, ethMsg2.Signature}, reloaded.Signatures(ethMsg1.MessageHash))
+
+	args.Name = "other"
+	other, _ := NewPersistentSignaturesHolder(args)
+	assert.Empty(t, other.AllStoredSignatures())
+}
+
+func TestPersistentSignaturesHolder_ClearStoredSignaturesShouldPrune(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPersistentSignaturesHolder()
+	psh, _ := NewPersistentSignaturesHolder(args)
+
+	psh.ProcessNewMessage(generateSignedMessage(1), generateEthMessage(1))
+	psh.ClearStoredSignatures()
+	assert.Empty(t, psh.AllStoredSignatures())
+	_ = psh.Close()
+
+	reloaded, _ := NewPersistentSignaturesHolder(args)
+	assert.Empty(t, reloaded.AllStoredSignatures())
+	assert.Empty(t, reloaded.Signatures(generateEthMessage(1).MessageHash))
+}
+
+func TestPersistentSignaturesHolder_StorerErrorsShouldNotBlockProcessing(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPersistentSignaturesHolder()
+	args.Storer = &testsCommon.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return []byte("invalid data"), nil
+		},
+		PutCalled: func(key, data []byte) error {
+			return errors.New("expected error")
+		},
+	}
+	psh, _ := NewPersistentSignaturesHolder(args)
+	assert.Empty(t, psh.AllStoredSignatures())
+
+	msg, ethMsg := generateSignedMessage(1), generateEthMessage(1)
+	psh.ProcessNewMessage(msg, ethMsg)
+	assert.Equal(t, []*core.SignedMessage{msg}, psh.AllStoredSignatures())
+	assert.Equal(t, [][]byte{ethMsg.Signature}, psh.Signatures(ethMsg.MessageHash))
+}
+
+func TestPersistentSignaturesHolder_PruneExecutedSignaturesShouldPruneOnlyTheExecutedMessageHash(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPersistentSignaturesHolder()
+	psh, _ := NewPersistentSignaturesHolder(args)
+
+	msg1, ethMsg1 := generateSignedMessage(1), generateEthMessage(1)
+	msg2, ethMsg2 := generateSignedMessage(2), generateEthMessage(2)
+	ethMsg2.MessageHash = []byte("other message hash")
+	psh.ProcessNewMessage(msg1, ethMsg1)
+	psh.ProcessNewMessage(msg2, ethMsg2)
+
+	psh.PruneExecutedSignatures(ethMsg1.MessageHash)
+	assert.Equal(t, []*core.SignedMessage{msg2}, psh.AllStoredSignatures())
+	assert.Empty(t, psh.Signatures(ethMsg1.MessageHash))
+
+	// late signatures of the executed message hash are no longer stored
+	psh.ProcessNewMessage(generateSignedMessage(3), generateEthMessage(3))
+	assert.Equal(t, []*core.SignedMessage{msg2}, psh.AllStoredSignatures())
+	_ = psh.Close()
+
+	reloaded, _ := NewPersistentSignaturesHolder(args)
+	assert.Equal(t, []*core.SignedMessage{msg2}, reloaded.AllStoredSignatures())
+	assert.Equal(t, [][]byte{ethMsg2.Signature}, reloaded.Signatures(ethMsg2.MessageHash))
+	assert.Empty(t, reloaded.Signatures(ethMsg1.MessageHash))
+}
+
+func TestPersistentSignaturesHolder_ShouldThrottleTheWrites(t *testing.T) {
+	t.Parallel()
+
+	numPuts := uint32(0)
+	storer := testsCommon.NewStorerMock()
+	args := createMockArgsPersistentSignaturesHolder()
+	args.Storer = &testsCommon.StorerStub{
+		GetCalled: storer.Get,
+		PutCalled: func(key, data []byte) error {
+			atomic.AddUint32(&numPuts, 1)
+			return storer.Put(key, data)
+		},
+	}
+	psh, _ := NewPersistentSignaturesHolder(args)
+
+	// the first write is done right away
+	psh.ProcessNewMessage(generateSignedMessage(0), generateEthMessage(0))
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numPuts))
+
+	for i := uint64(1); i < 100; i++ {
+		psh.ProcessNewMessage(generateSignedMessage(i), generateEthMessage(i))
+	}
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numPuts))
+
+	_ = psh.Close()
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numPuts))
+
+	reloaded, _ := NewPersistentSignaturesHolder(args)
+	assert.Equal(t, 100, len(reloaded.AllStoredSignatures()))
+}
+
+func TestPersistentSignaturesHolder_ShouldKeepABoundedSetOfExecutedMessageHashes(t *testing.T) {
+	t.Parallel()
+
+	psh, _ := NewPersistentSignaturesHolder(createMockArgsPersistentSignaturesHolder())
+	for i := 0; i < maxExecutedMessageHashes+1; i++ {
+		psh.PruneExecutedSignatures([]byte(fmt.Sprintf("message hash %d", i)))
+	}
+	psh.PruneExecutedSignatures([]byte("message hash 1"))
+
+	assert.Equal(t, maxExecutedMessageHashes, len(psh.executedHashes))
+	assert.Equal(t, maxExecutedMessageHashes, len(psh.executedHashesOrder))
+	assert.False(t, psh.wasExecuted([]byte("message hash 0")))
+	assert.True(t, psh.wasExecuted([]byte(fmt.Sprintf("message hash %d", maxExecutedMessageHashes))))
+	_ = psh.Close()
+}
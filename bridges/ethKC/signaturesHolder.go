@@ -8,16 +8,18 @@ import (
 )
 
 type signaturesHolder struct {
-	mut            sync.RWMutex
-	signedMessages map[string]*core.SignedMessage
-	ethMessages    []*core.EthereumSignature
+	mut             sync.RWMutex
+	signedMessages  map[string]*core.SignedMessage
+	ethMessages     []*core.EthereumSignature
+	ethMessagesByID map[string]*core.EthereumSignature
 }
 
 // NewSignatureHolder creates a new signatureHolder
 func NewSignatureHolder() *signaturesHolder {
 	return &signaturesHolder{
-		signedMessages: make(map[string]*core.SignedMessage),
-		ethMessages:    make([]*core.EthereumSignature, 0),
+		signedMessages:  make(map[string]*core.SignedMessage),
+		ethMessages:     make([]*core.EthereumSignature, 0),
+		ethMessagesByID: make(map[string]*core.EthereumSignature),
 	}
 }
 
@@ -32,6 +34,7 @@ func (sh *signaturesHolder) ProcessNewMessage(msg *core.SignedMessage, ethMsg *c
 
 	sh.signedMessages[msg.UniqueID()] = msg
 	sh.ethMessages = append(sh.ethMessages, ethMsg)
+	sh.ethMessagesByID[msg.UniqueID()] = ethMsg
 }
 
 // AllStoredSignatures will return the stored signatures
@@ -67,6 +70,27 @@ func (sh *signaturesHolder) Signatures(msgHash []byte) [][]byte {
 	return result
 }
 
+// PruneExecutedSignatures will remove the stored signatures of the provided message hash, already executed
+func (sh *signaturesHolder) PruneExecutedSignatures(msgHash []byte) {
+	sh.mut.Lock()
+	defer sh.mut.Unlock()
+
+	remainingEthMessages := make([]*core.EthereumSignature, 0, len(sh.ethMessages))
+	for _, ethMsg := range sh.ethMessages {
+		if !bytes.Equal(ethMsg.MessageHash, msgHash) {
+			remainingEthMessages = append(remainingEthMessages, ethMsg)
+		}
+	}
+	sh.ethMessages = remainingEthMessages
+
+	for id, ethMsg := range sh.ethMessagesByID {
+		if bytes.Equal(ethMsg.MessageHash, msgHash) {
+			delete(sh.signedMessages, id)
+			delete(sh.ethMessagesByID, id)
+		}
+	}
+}
+
 // ClearStoredSignatures will clear any stored signatures
 func (sh *signaturesHolder) ClearStoredSignatures() {
 	sh.mut.Lock()
@@ -74,6 +98,7 @@ func (sh *signaturesHolder) ClearStoredSignatures() {
 
 	sh.signedMessages = make(map[string]*core.SignedMessage)
 	sh.ethMessages = make([]*core.EthereumSignature, 0)
+	sh.ethMessagesByID = make(map[string]*core.EthereumSignature)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -137,6 +137,26 @@ func TestSignatureHolder_Signatures(t *testing.T) {
 	})
 }
 
+func TestSignatureHolder_PruneExecutedSignatures(t *testing.T) {
+	t.Parallel()
+
+	msg, ethMsg := generateSignedMessage(0), generateEthMessage(0)
+	msg1, ethMsg1 := generateSignedMessage(1), generateEthMessage(1)
+	ethMsg1.MessageHash = []byte("other message hash")
+
+	sh := NewSignatureHolder()
+	sh.ProcessNewMessage(msg, ethMsg)
+	sh.ProcessNewMessage(msg1, ethMsg1)
+
+	sh.PruneExecutedSignatures([]byte("not stored"))
+	assert.Equal(t, 2, len(sh.AllStoredSignatures()))
+
+	sh.PruneExecutedSignatures(ethMsg.MessageHash)
+	assert.Equal(t, 0, len(sh.Signatures(ethMsg.MessageHash)))
+	assert.Equal(t, [][]byte{ethMsg1.Signature}, sh.Signatures(ethMsg1.MessageHash))
+	assert.Equal(t, []*core.SignedMessage{msg1}, sh.AllStoredSignatures())
+}
+
 func compareSignedMessageLists(t *testing.T, list1 []*core.SignedMessage, list2 []*core.SignedMessage) {
 	require.Equal(t, len(list1), len(list2))
 	for _, obj1 := range list1 {
@@ -30,6 +30,8 @@ func (step *getPendingStep) Execute(ctx context.Context) core.StepIdentifier {
 		return step.Identifier()
 	}
 
+	step.bridge.WaitForNewBatchOnEthereum(ctx, lastEthBatchExecuted+1)
+
 	err = step.bridge.GetAndStoreBatchFromEthereum(ctx, lastEthBatchExecuted+1)
 	if err != nil {
 		step.bridge.PrintInfo(logger.LogDebug, "cannot fetch eth batch", "batch ID", lastEthBatchExecuted+1, "message", err)
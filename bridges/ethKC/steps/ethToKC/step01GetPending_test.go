@@ -54,7 +54,12 @@ func TestExecuteGetPending(t *testing.T) {
 		bridgeStub.GetLastExecutedEthBatchIDFromKCCalled = func(ctx context.Context) (uint64, error) {
 			return 1122, nil
 		}
+		waitedNonce := uint64(0)
+		bridgeStub.WaitForNewBatchOnEthereumCalled = func(ctx context.Context, nonce uint64) {
+			waitedNonce = nonce
+		}
 		bridgeStub.GetAndStoreBatchFromEthereumCalled = func(ctx context.Context, nonce uint64) error {
+			assert.Equal(t, uint64(1123), waitedNonce)
 			return expectedError
 		}
 
@@ -65,6 +70,7 @@ func TestExecuteGetPending(t *testing.T) {
 		expectedStepIdentifier := step.Identifier()
 		stepIdentifier := step.Execute(context.Background())
 		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
+		assert.Equal(t, uint64(1123), waitedNonce)
 	})
 	t.Run("nil on GetStoredBatch", func(t *testing.T) {
 		bridgeStub := createStubExecutor()
@@ -46,6 +46,8 @@ type Executor interface {
 	ResetRetriesCountOnKC()
 
 	GetAndStoreBatchFromEthereum(ctx context.Context, nonce uint64) error
+	WaitForNewBatchOnEthereum(ctx context.Context, nonce uint64)
+	WaitForNewBatchOnKC(ctx context.Context)
 	WasTransferPerformedOnEthereum(ctx context.Context) (bool, error)
 	SignTransferOnEthereum() error
 	PerformTransferOnEthereum(ctx context.Context) error
@@ -15,6 +15,8 @@ type getPendingStep struct {
 
 // Execute will execute this step returning the next step to be executed
 func (step *getPendingStep) Execute(ctx context.Context) core.StepIdentifier {
+	step.bridge.WaitForNewBatchOnKC(ctx)
+
 	err := step.bridge.CheckKCClientAvailability(ctx)
 	if err != nil {
 		step.bridge.PrintInfo(logger.LogDebug, "Klever Blockchain client unavailable", "message", err)
@@ -26,7 +26,12 @@ func TestExecute_GetPending(t *testing.T) {
 	t.Run("error on GetBatchFromKC", func(t *testing.T) {
 		t.Parallel()
 		bridgeStub := createStubExecutorGetPending()
+		waited := false
+		bridgeStub.WaitForNewBatchOnKCCalled = func(ctx context.Context) {
+			waited = true
+		}
 		bridgeStub.GetBatchFromKCCalled = func(ctx context.Context) (*bridgeCore.TransferBatch, error) {
+			assert.True(t, waited)
 			return nil, expectedError
 		}
 
@@ -37,6 +42,7 @@ func TestExecute_GetPending(t *testing.T) {
 		expectedStepIdentifier := step.Identifier()
 		stepIdentifier := step.Execute(context.Background())
 		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
+		assert.True(t, waited)
 	})
 	t.Run("nil batch on GetBatchFromKC", func(t *testing.T) {
 		t.Parallel()
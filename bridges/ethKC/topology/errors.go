@@ -11,4 +11,14 @@ var (
 	errEmptyAddress             = errors.New("empty address")
 	errNilLogger                = errors.New("nil logger")
 	errNilAddressConverter      = errors.New("nil address converter")
+	errNilLeaderSelector        = errors.New("nil leader selector")
+	errNilLivenessProvider      = errors.New("nil liveness provider")
+	errInvalidMaxInactivity     = errors.New("invalid max inactivity")
+	errNilStakeProvider         = errors.New("nil stake provider")
+	errUnknownPolicy            = errors.New("unknown leader selection policy")
+	errNilLeaseProvider         = errors.New("nil lease provider")
+	errEmptyName                = errors.New("empty name")
+	errNilLeaseBroadcaster      = errors.New("nil lease broadcaster")
+	errInvalidGracePeriod       = errors.New("invalid grace period")
+	errInvalidMaxLeaseDuration  = errors.New("invalid max lease duration")
 )
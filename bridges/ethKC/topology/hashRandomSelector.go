@@ -2,6 +2,7 @@ package topology
 
 import (
 	"encoding/binary"
+	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/hashing/sha256"
 )
@@ -26,3 +27,17 @@ func (selector *hashRandomSelector) randomInt(seed uint64, max uint64) uint64 {
 
 	return result
 }
+
+func (selector *hashRandomSelector) randomBigInt(seed uint64, max *big.Int) *big.Int {
+	if max == nil || max.Sign() <= 0 {
+		return big.NewInt(0)
+	}
+
+	buff := make([]byte, uint64Size)
+	binary.BigEndian.PutUint64(buff, seed)
+
+	hashedSeed := hasher.Compute(string(buff))
+	result := big.NewInt(0).SetBytes(hashedSeed)
+
+	return result.Mod(result, max)
+}
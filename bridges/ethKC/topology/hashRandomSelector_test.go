@@ -2,6 +2,7 @@ package topology
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -58,3 +59,23 @@ func TestHashRandomSelector_randomIntDistribution(t *testing.T) {
 		assert.True(t, counter < maxCounter)
 	}
 }
+
+func TestHashRandomSelector_randomBigInt(t *testing.T) {
+	t.Parallel()
+
+	selector := &hashRandomSelector{}
+	seedValue := uint64(1641988500)
+
+	assert.Equal(t, big.NewInt(0), selector.randomBigInt(seedValue, nil))
+	assert.Equal(t, big.NewInt(0), selector.randomBigInt(seedValue, big.NewInt(0)))
+	assert.Equal(t, big.NewInt(0), selector.randomBigInt(seedValue, big.NewInt(-10)))
+	assert.Equal(t, 0, selector.randomBigInt(seedValue, big.NewInt(1)).Sign())
+
+	maxValue := big.NewInt(0).Exp(big.NewInt(10), big.NewInt(30), nil)
+	for i := uint64(0); i < 100; i++ {
+		value := selector.randomBigInt(seedValue+i, maxValue)
+		assert.True(t, value.Sign() >= 0)
+		assert.True(t, value.Cmp(maxValue) < 0)
+		assert.Equal(t, value, selector.randomBigInt(seedValue+i, maxValue))
+	}
+}
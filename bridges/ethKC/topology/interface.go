@@ -1,7 +1,45 @@
 package topology
 
+import (
+	"math/big"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/core"
+)
+
 // PublicKeysProvider defines the behavior of a provider able to return all public keys allowed to operate on the relayers network
 type PublicKeysProvider interface {
 	SortedPublicKeys() [][]byte
 	IsInterfaceNil() bool
 }
+
+// LeaderSelector defines the behavior of a component able to deterministically select the leader from the sorted
+// public keys, based on the provided seed
+type LeaderSelector interface {
+	SelectLeader(seed uint64, sortedPublicKeys [][]byte) ([]byte, uint64)
+	IsInterfaceNil() bool
+}
+
+// LivenessProvider defines the behavior of a component able to provide the moment of the last activity of a relayer
+type LivenessProvider interface {
+	LastActivity(publicKey []byte) time.Time
+	IsInterfaceNil() bool
+}
+
+// StakeProvider defines the behavior of a component able to provide the stake of a relayer
+type StakeProvider interface {
+	StakeOf(publicKey []byte) *big.Int
+	IsInterfaceNil() bool
+}
+
+// LeaseProvider defines the behavior of a component able to provide the relayer holding an active leader lease
+type LeaseProvider interface {
+	CurrentLeaseHolder() []byte
+	IsInterfaceNil() bool
+}
+
+// LeaseBroadcaster defines the behavior of a component able to broadcast the leader leases to the other relayers
+type LeaseBroadcaster interface {
+	BroadcastLease(lease *core.LeaderLease)
+	IsInterfaceNil() bool
+}
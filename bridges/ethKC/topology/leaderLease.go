@@ -0,0 +1,241 @@
+package topology
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// ArgsLeaderLease is the DTO used in the NewLeaderLease constructor function
+type ArgsLeaderLease struct {
+	Name             string
+	AddressBytes     []byte
+	Broadcaster      LeaseBroadcaster
+	Timer            core.Timer
+	GracePeriod      time.Duration
+	MaxLeaseDuration time.Duration
+	Log              logger.Logger
+	AddressConverter core.AddressConverter
+}
+
+type lease struct {
+	holder     []byte
+	batchID    uint64
+	acquiredAt int64
+	renewedAt  int64
+}
+
+// leaderLease tracks the lease of the leader that has an in-flight transaction on a half-bridge. The lease is
+// announced by the leader after sending the transaction and renewed while waiting for it. A lease is active if it
+// was renewed in the last grace period and it was not held for more than the max lease duration. Concurrent leases
+// are resolved in favor of the lowest public key, so all relayers end up with the same holder
+type leaderLease struct {
+	name             string
+	addressBytes     []byte
+	broadcaster      LeaseBroadcaster
+	timer            core.Timer
+	gracePeriod      int64
+	maxLeaseDuration int64
+	log              logger.Logger
+	addressConverter core.AddressConverter
+
+	mut          sync.RWMutex
+	currentLease *lease
+}
+
+// NewLeaderLease creates a new leaderLease instance
+func NewLeaderLease(args ArgsLeaderLease) (*leaderLease, error) {
+	err := checkLeaderLeaseArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &leaderLease{
+		name:             args.Name,
+		addressBytes:     args.AddressBytes,
+		broadcaster:      args.Broadcaster,
+		timer:            args.Timer,
+		gracePeriod:      int64(args.GracePeriod.Seconds()),
+		maxLeaseDuration: int64(args.MaxLeaseDuration.Seconds()),
+		log:              args.Log,
+		addressConverter: args.AddressConverter,
+	}, nil
+}
+
+func checkLeaderLeaseArgs(args ArgsLeaderLease) error {
+	if len(args.Name) == 0 {
+		return errEmptyName
+	}
+	if len(args.AddressBytes) == 0 {
+		return errEmptyAddress
+	}
+	if check.IfNil(args.Broadcaster) {
+		return errNilLeaseBroadcaster
+	}
+	if check.IfNil(args.Timer) {
+		return errNilTimer
+	}
+	if int64(args.GracePeriod.Seconds()) <= 0 {
+		return errInvalidGracePeriod
+	}
+	if args.MaxLeaseDuration < args.GracePeriod {
+		return errInvalidMaxLeaseDuration
+	}
+	if check.IfNil(args.Log) {
+		return errNilLogger
+	}
+	if check.IfNil(args.AddressConverter) {
+		return errNilAddressConverter
+	}
+
+	return nil
+}
+
+// AnnounceLease acquires or renews the own lease for the provided batch and announces it to the other relayers
+func (ll *leaderLease) AnnounceLease(batchID uint64) {
+	ll.mut.Lock()
+	announced := ll.updateLease(ll.addressBytes, batchID)
+	ll.mut.Unlock()
+
+	if !announced {
+		ll.log.Debug("leader lease: can not announce the lease", "batch ID", batchID)
+		return
+	}
+
+	ll.broadcaster.BroadcastLease(&core.LeaderLease{
+		Name:    ll.name,
+		BatchID: batchID,
+	})
+}
+
+// RenewLease renews and announces the own lease for the provided batch. Does nothing if the lease is not active or
+// it is not held by the current relayer
+func (ll *leaderLease) RenewLease(batchID uint64) {
+	ll.mut.Lock()
+	renewed := false
+	if ll.isOwnLeaseForBatch(batchID) && ll.isActive(ll.timer.NowUnix()) {
+		renewed = ll.updateLease(ll.addressBytes, batchID)
+	}
+	ll.mut.Unlock()
+
+	if !renewed {
+		return
+	}
+
+	ll.broadcaster.BroadcastLease(&core.LeaderLease{
+		Name:    ll.name,
+		BatchID: batchID,
+	})
+}
+
+// ReleaseLease releases the own lease for the provided batch and announces it to the other relayers. Does nothing
+// if the lease is not held by the current relayer
+func (ll *leaderLease) ReleaseLease(batchID uint64) {
+	ll.mut.Lock()
+	isOwnLease := ll.isOwnLeaseForBatch(batchID)
+	if isOwnLease {
+		ll.currentLease = nil
+	}
+	ll.mut.Unlock()
+
+	if !isOwnLease {
+		return
+	}
+
+	ll.log.Debug("leader lease: released", "batch ID", batchID)
+	ll.broadcaster.BroadcastLease(&core.LeaderLease{
+		Name:     ll.name,
+		BatchID:  batchID,
+		Released: true,
+	})
+}
+
+// ProcessLease processes a lease announced by another relayer
+func (ll *leaderLease) ProcessLease(publicKey []byte, receivedLease *core.LeaderLease) {
+	if receivedLease == nil || receivedLease.Name != ll.name || bytes.Equal(publicKey, ll.addressBytes) {
+		return
+	}
+
+	ll.mut.Lock()
+	defer ll.mut.Unlock()
+
+	if receivedLease.Released {
+		if ll.currentLease != nil && bytes.Equal(ll.currentLease.holder, publicKey) &&
+			ll.currentLease.batchID == receivedLease.BatchID {
+			ll.currentLease = nil
+		}
+
+		return
+	}
+
+	updated := ll.updateLease(publicKey, receivedLease.BatchID)
+	if updated {
+		ll.log.Trace("leader lease: received", "holder", ll.addressConverter.ToBech32StringSilent(publicKey),
+			"batch ID", receivedLease.BatchID)
+	}
+}
+
+// updateLease should be called under mutex protection
+func (ll *leaderLease) updateLease(holder []byte, batchID uint64) bool {
+	now := ll.timer.NowUnix()
+	if ll.currentLease != nil && bytes.Equal(ll.currentLease.holder, holder) && ll.currentLease.batchID == batchID {
+		if now-ll.currentLease.acquiredAt > ll.maxLeaseDuration {
+			return false
+		}
+
+		ll.currentLease.renewedAt = now
+		return true
+	}
+
+	if ll.isActive(now) && bytes.Compare(ll.currentLease.holder, holder) < 0 {
+		return false
+	}
+
+	ll.currentLease = &lease{
+		holder:     append([]byte{}, holder...),
+		batchID:    batchID,
+		acquiredAt: now,
+		renewedAt:  now,
+	}
+
+	return true
+}
+
+// isOwnLeaseForBatch should be called under mutex protection
+func (ll *leaderLease) isOwnLeaseForBatch(batchID uint64) bool {
+	return ll.currentLease != nil && bytes.Equal(ll.currentLease.holder, ll.addressBytes) &&
+		ll.currentLease.batchID == batchID
+}
+
+// isActive should be called under mutex protection
+func (ll *leaderLease) isActive(now int64) bool {
+	if ll.currentLease == nil {
+		return false
+	}
+	if now-ll.currentLease.renewedAt > ll.gracePeriod {
+		return false
+	}
+
+	return now-ll.currentLease.acquiredAt <= ll.maxLeaseDuration
+}
+
+// CurrentLeaseHolder returns the public key of the relayer holding the active lease or nil if there is no active lease
+func (ll *leaderLease) CurrentLeaseHolder() []byte {
+	ll.mut.RLock()
+	defer ll.mut.RUnlock()
+
+	if !ll.isActive(ll.timer.NowUnix()) {
+		return nil
+	}
+
+	return append([]byte{}, ll.currentLease.holder...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ll *leaderLease) IsInterfaceNil() bool {
+	return ll == nil
+}
@@ -0,0 +1,317 @@
+package topology
+
+import (
+	"bytes"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/core/converters"
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/assert"
+)
+
+const leaseName = "half-bridge"
+
+var (
+	ownAddress   = bytes.Repeat([]byte("2"), 32)
+	lowerAddress = bytes.Repeat([]byte("1"), 32)
+	upperAddress = bytes.Repeat([]byte("3"), 32)
+)
+
+func createMockArgsLeaderLease() ArgsLeaderLease {
+	addressConverter, _ := converters.NewAddressConverter()
+
+	return ArgsLeaderLease{
+		Name:             leaseName,
+		AddressBytes:     ownAddress,
+		Broadcaster:      &testsCommon.BroadcasterStub{},
+		Timer:            createTimerStubWithUnixValue(1000),
+		GracePeriod:      time.Minute,
+		MaxLeaseDuration: time.Minute * 10,
+		Log:              logger.GetOrCreate("test"),
+		AddressConverter: addressConverter,
+	}
+}
+
+func createLeaderLeaseWithTime(args ArgsLeaderLease, currentTime *int64) *leaderLease {
+	timer := testsCommon.NewTimerStub()
+	timer.NowUnixCalled = func() int64 {
+		return atomic.LoadInt64(currentTime)
+	}
+	args.Timer = timer
+
+	ll, _ := NewLeaderLease(args)
+
+	return ll
+}
+
+func TestNewLeaderLease(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLeaderLease()
+		args.Name = ""
+		ll, err := NewLeaderLease(args)
+
+		assert.True(t, check.IfNil(ll))
+		assert.Equal(t, errEmptyName, err)
+	})
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLeaderLease()
+		args.AddressBytes = nil
+		ll, err := NewLeaderLease(args)
+
+		assert.True(t, check.IfNil(ll))
+		assert.Equal(t, errEmptyAddress, err)
+	})
+	t.Run("nil broadcaster should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLeaderLease()
+		args.Broadcaster = nil
+		ll, err := NewLeaderLease(args)
+
+		assert.True(t, check.IfNil(ll))
+		assert.Equal(t, errNilLeaseBroadcaster, err)
+	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLeaderLease()
+		args.Timer = nil
+		ll, err := NewLeaderLease(args)
+
+		assert.True(t, check.IfNil(ll))
+		assert.Equal(t, errNilTimer, err)
+	})
+	t.Run("invalid grace period should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLeaderLease()
+		args.GracePeriod = time.Millisecond * 999
+		ll, err := NewLeaderLease(args)
+
+		assert.True(t, check.IfNil(ll))
+		assert.Equal(t, errInvalidGracePeriod, err)
+	})
+	t.Run("max lease duration lower than the grace period should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLeaderLease()
+		args.MaxLeaseDuration = args.GracePeriod - time.Second
+		ll, err := NewLeaderLease(args)
+
+		assert.True(t, check.IfNil(ll))
+		assert.Equal(t, errInvalidMaxLeaseDuration, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLeaderLease()
+		args.Log = nil
+		ll, err := NewLeaderLease(args)
+
+		assert.True(t, check.IfNil(ll))
+		assert.Equal(t, errNilLogger, err)
+	})
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLeaderLease()
+		args.AddressConverter = nil
+		ll, err := NewLeaderLease(args)
+
+		assert.True(t, check.IfNil(ll))
+		assert.Equal(t, errNilAddressConverter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ll, err := NewLeaderLease(createMockArgsLeaderLease())
+
+		assert.False(t, check.IfNil(ll))
+		assert.Nil(t, err)
+		assert.Nil(t, ll.CurrentLeaseHolder())
+	})
+}
+
+func TestLeaderLease_OwnLease(t *testing.T) {
+	t.Parallel()
+
+	t.Run("announce, renew and release should broadcast", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := int64(1000)
+		var broadcastLeases []*core.LeaderLease
+		args := createMockArgsLeaderLease()
+		args.Broadcaster = &testsCommon.BroadcasterStub{
+			BroadcastLeaseCalled: func(lease *core.LeaderLease) {
+				broadcastLeases = append(broadcastLeases, lease)
+			},
+		}
+		ll := createLeaderLeaseWithTime(args, &currentTime)
+
+		ll.AnnounceLease(37)
+		assert.Equal(t, ownAddress, ll.CurrentLeaseHolder())
+
+		atomic.StoreInt64(&currentTime, 1050)
+		ll.RenewLease(37)
+		atomic.StoreInt64(&currentTime, 1100)
+		assert.Equal(t, ownAddress, ll.CurrentLeaseHolder())
+
+		ll.ReleaseLease(37)
+		assert.Nil(t, ll.CurrentLeaseHolder())
+
+		expectedLeases := []*core.LeaderLease{
+			{Name: leaseName, BatchID: 37},
+			{Name: leaseName, BatchID: 37},
+			{Name: leaseName, BatchID: 37, Released: true},
+		}
+		assert.Equal(t, expectedLeases, broadcastLeases)
+	})
+	t.Run("renew and release for another batch should not broadcast", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := int64(1000)
+		numBroadcasts := 0
+		args := createMockArgsLeaderLease()
+		args.Broadcaster = &testsCommon.BroadcasterStub{
+			BroadcastLeaseCalled: func(lease *core.LeaderLease) {
+				numBroadcasts++
+			},
+		}
+		ll := createLeaderLeaseWithTime(args, &currentTime)
+
+		ll.RenewLease(37)
+		ll.ReleaseLease(37)
+		assert.Equal(t, 0, numBroadcasts)
+
+		ll.AnnounceLease(37)
+		ll.RenewLease(38)
+		ll.ReleaseLease(38)
+		assert.Equal(t, 1, numBroadcasts)
+		assert.Equal(t, ownAddress, ll.CurrentLeaseHolder())
+	})
+	t.Run("lease not renewed in the grace period should expire", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := int64(1000)
+		ll := createLeaderLeaseWithTime(createMockArgsLeaderLease(), &currentTime)
+
+		ll.AnnounceLease(37)
+		atomic.StoreInt64(&currentTime, 1060)
+		assert.Equal(t, ownAddress, ll.CurrentLeaseHolder())
+
+		atomic.StoreInt64(&currentTime, 1061)
+		assert.Nil(t, ll.CurrentLeaseHolder())
+
+		// an expired lease can not be renewed
+		ll.RenewLease(37)
+		assert.Nil(t, ll.CurrentLeaseHolder())
+	})
+	t.Run("lease held for more than the max duration should expire", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := int64(1000)
+		ll := createLeaderLeaseWithTime(createMockArgsLeaderLease(), &currentTime)
+
+		ll.AnnounceLease(37)
+		for i := int64(1); i <= 10; i++ {
+			atomic.StoreInt64(&currentTime, 1000+i*60)
+			ll.RenewLease(37)
+			assert.Equal(t, ownAddress, ll.CurrentLeaseHolder())
+		}
+
+		atomic.StoreInt64(&currentTime, 1601)
+		ll.RenewLease(37)
+		assert.Nil(t, ll.CurrentLeaseHolder())
+
+		// announcing again the same batch should not extend the lease
+		ll.AnnounceLease(37)
+		assert.Nil(t, ll.CurrentLeaseHolder())
+
+		// a new batch should start a new lease
+		ll.AnnounceLease(38)
+		assert.Equal(t, ownAddress, ll.CurrentLeaseHolder())
+	})
+}
+
+func TestLeaderLease_ProcessLease(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid leases should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := int64(1000)
+		ll := createLeaderLeaseWithTime(createMockArgsLeaderLease(), &currentTime)
+
+		ll.ProcessLease(upperAddress, nil)
+		ll.ProcessLease(upperAddress, &core.LeaderLease{Name: "other half-bridge", BatchID: 37})
+		ll.ProcessLease(ownAddress, &core.LeaderLease{Name: leaseName, BatchID: 37})
+		assert.Nil(t, ll.CurrentLeaseHolder())
+	})
+	t.Run("lease of another relayer should be tracked until released", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := int64(1000)
+		ll := createLeaderLeaseWithTime(createMockArgsLeaderLease(), &currentTime)
+
+		ll.ProcessLease(upperAddress, &core.LeaderLease{Name: leaseName, BatchID: 37})
+		assert.Equal(t, upperAddress, ll.CurrentLeaseHolder())
+
+		atomic.StoreInt64(&currentTime, 1050)
+		ll.ProcessLease(upperAddress, &core.LeaderLease{Name: leaseName, BatchID: 37})
+		atomic.StoreInt64(&currentTime, 1100)
+		assert.Equal(t, upperAddress, ll.CurrentLeaseHolder())
+
+		// only the holder can release the lease
+		ll.ProcessLease(lowerAddress, &core.LeaderLease{Name: leaseName, BatchID: 37, Released: true})
+		ll.ProcessLease(upperAddress, &core.LeaderLease{Name: leaseName, BatchID: 36, Released: true})
+		assert.Equal(t, upperAddress, ll.CurrentLeaseHolder())
+
+		ll.ProcessLease(upperAddress, &core.LeaderLease{Name: leaseName, BatchID: 37, Released: true})
+		assert.Nil(t, ll.CurrentLeaseHolder())
+	})
+	t.Run("lease of another relayer without progress should expire", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := int64(1000)
+		ll := createLeaderLeaseWithTime(createMockArgsLeaderLease(), &currentTime)
+
+		ll.ProcessLease(upperAddress, &core.LeaderLease{Name: leaseName, BatchID: 37})
+		atomic.StoreInt64(&currentTime, 1061)
+		assert.Nil(t, ll.CurrentLeaseHolder())
+	})
+	t.Run("concurrent leases should be resolved in favor of the lowest public key", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := int64(1000)
+		ll1 := createLeaderLeaseWithTime(createMockArgsLeaderLease(), &currentTime)
+		ll2 := createLeaderLeaseWithTime(createMockArgsLeaderLease(), &currentTime)
+
+		ll1.ProcessLease(upperAddress, &core.LeaderLease{Name: leaseName, BatchID: 37})
+		ll1.ProcessLease(lowerAddress, &core.LeaderLease{Name: leaseName, BatchID: 37})
+		ll2.ProcessLease(lowerAddress, &core.LeaderLease{Name: leaseName, BatchID: 37})
+		ll2.ProcessLease(upperAddress, &core.LeaderLease{Name: leaseName, BatchID: 37})
+		assert.Equal(t, lowerAddress, ll1.CurrentLeaseHolder())
+		assert.Equal(t, lowerAddress, ll2.CurrentLeaseHolder())
+
+		// the own lease should not replace an active lease of a lower public key
+		ll1.AnnounceLease(37)
+		assert.Equal(t, lowerAddress, ll1.CurrentLeaseHolder())
+
+		// but should replace the one of a higher public key
+		ll3 := createLeaderLeaseWithTime(createMockArgsLeaderLease(), &currentTime)
+		ll3.ProcessLease(upperAddress, &core.LeaderLease{Name: leaseName, BatchID: 37})
+		ll3.AnnounceLease(37)
+		assert.Equal(t, ownAddress, ll3.CurrentLeaseHolder())
+	})
+}
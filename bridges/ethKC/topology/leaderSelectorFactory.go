@@ -0,0 +1,56 @@
+package topology
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/core"
+)
+
+const (
+	// UniformPolicy selects each relayer with the same probability
+	UniformPolicy = "uniform"
+	// LivenessPolicy selects only from the relayers that gossiped recently
+	LivenessPolicy = "liveness"
+	// StakeWeightedPolicy selects each relayer with a probability proportional to its stake
+	StakeWeightedPolicy = "stake-weighted"
+)
+
+// ArgsLeaderSelectorFactory is the DTO used in the CreateLeaderSelector function
+type ArgsLeaderSelectorFactory struct {
+	Policy           string
+	LivenessProvider LivenessProvider
+	StakeProvider    StakeProvider
+	Timer            core.Timer
+	MaxInactivity    time.Duration
+}
+
+// CreateLeaderSelector creates the leader selector for the provided policy. An empty policy means the uniform one
+func CreateLeaderSelector(args ArgsLeaderSelectorFactory) (LeaderSelector, error) {
+	switch args.Policy {
+	case "", UniformPolicy:
+		return NewUniformLeaderSelector(), nil
+	case LivenessPolicy:
+		argsLivenessLeaderSelector := ArgsLivenessLeaderSelector{
+			LivenessProvider: args.LivenessProvider,
+			Timer:            args.Timer,
+			MaxInactivity:    args.MaxInactivity,
+		}
+
+		selector, err := NewLivenessLeaderSelector(argsLivenessLeaderSelector)
+		if err != nil {
+			return nil, err
+		}
+
+		return selector, nil
+	case StakeWeightedPolicy:
+		selector, err := NewStakeWeightedLeaderSelector(args.StakeProvider)
+		if err != nil {
+			return nil, err
+		}
+
+		return selector, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownPolicy, args.Policy)
+	}
+}
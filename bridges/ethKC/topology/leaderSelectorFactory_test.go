@@ -0,0 +1,77 @@
+package topology
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsLeaderSelectorFactory() ArgsLeaderSelectorFactory {
+	return ArgsLeaderSelectorFactory{
+		LivenessProvider: &testsCommon.LivenessProviderStub{},
+		StakeProvider:    &testsCommon.StakeProviderStub{},
+		Timer:            createTimerStubWithUnixValue(0),
+		MaxInactivity:    time.Minute * 15,
+	}
+}
+
+func TestCreateLeaderSelector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown policy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLeaderSelectorFactory()
+		args.Policy = "unknown"
+		selector, err := CreateLeaderSelector(args)
+
+		assert.True(t, selector == nil)
+		assert.True(t, errors.Is(err, errUnknownPolicy))
+		assert.Contains(t, err.Error(), "unknown")
+	})
+	t.Run("liveness policy with invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLeaderSelectorFactory()
+		args.Policy = LivenessPolicy
+		args.MaxInactivity = 0
+		selector, err := CreateLeaderSelector(args)
+
+		assert.True(t, selector == nil)
+		assert.Equal(t, errInvalidMaxInactivity, err)
+	})
+	t.Run("stake weighted policy with invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLeaderSelectorFactory()
+		args.Policy = StakeWeightedPolicy
+		args.StakeProvider = nil
+		selector, err := CreateLeaderSelector(args)
+
+		assert.True(t, selector == nil)
+		assert.Equal(t, errNilStakeProvider, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		policies := map[string]string{
+			"":                  "*topology.uniformLeaderSelector",
+			UniformPolicy:       "*topology.uniformLeaderSelector",
+			LivenessPolicy:      "*topology.livenessLeaderSelector",
+			StakeWeightedPolicy: "*topology.stakeWeightedLeaderSelector",
+		}
+
+		for policy, expectedType := range policies {
+			args := createMockArgsLeaderSelectorFactory()
+			args.Policy = policy
+			selector, err := CreateLeaderSelector(args)
+
+			assert.Nil(t, err)
+			assert.Equal(t, expectedType, fmt.Sprintf("%T", selector))
+		}
+	})
+}
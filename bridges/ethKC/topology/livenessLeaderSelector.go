@@ -0,0 +1,110 @@
+package topology
+
+import (
+	"sync"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// ArgsLivenessLeaderSelector is the DTO used in the NewLivenessLeaderSelector constructor function
+type ArgsLivenessLeaderSelector struct {
+	LivenessProvider LivenessProvider
+	Timer            core.Timer
+	MaxInactivity    time.Duration
+}
+
+type livenessLeaderSelector struct {
+	livenessProvider LivenessProvider
+	timer            core.Timer
+	maxInactivity    time.Duration
+	uniformSelector  *uniformLeaderSelector
+	mutReactivations sync.Mutex
+	// public key -> the moment it was seen active again after being inactive, 0 while still inactive
+	reactivations map[string]int64
+}
+
+// NewLivenessLeaderSelector creates a leader selector that picks only from the relayers that were active in the last
+// MaxInactivity interval. If none of them were active, it picks from all the provided public keys.
+// The activity is the one seen by this relayer so, around the MaxInactivity threshold, the relayers can disagree on
+// the active set. To limit this, a relayer that became inactive has to be active again for a full MaxInactivity
+// interval before it can be selected, so the relayers flapping between active and inactive are kept out
+func NewLivenessLeaderSelector(args ArgsLivenessLeaderSelector) (*livenessLeaderSelector, error) {
+	if check.IfNil(args.LivenessProvider) {
+		return nil, errNilLivenessProvider
+	}
+	if check.IfNil(args.Timer) {
+		return nil, errNilTimer
+	}
+	if int64(args.MaxInactivity.Seconds()) <= 0 {
+		return nil, errInvalidMaxInactivity
+	}
+
+	return &livenessLeaderSelector{
+		livenessProvider: args.LivenessProvider,
+		timer:            args.Timer,
+		maxInactivity:    args.MaxInactivity,
+		uniformSelector:  NewUniformLeaderSelector(),
+		reactivations:    make(map[string]int64),
+	}, nil
+}
+
+// SelectLeader returns the leader and its index in the sorted public keys. Returns nil if the list is empty
+func (lls *livenessLeaderSelector) SelectLeader(seed uint64, sortedPublicKeys [][]byte) ([]byte, uint64) {
+	now := lls.timer.NowUnix()
+
+	lls.mutReactivations.Lock()
+	activePublicKeys := make([][]byte, 0, len(sortedPublicKeys))
+	indexes := make([]uint64, 0, len(sortedPublicKeys))
+	for index, publicKey := range sortedPublicKeys {
+		if !lls.isActive(publicKey, now) {
+			continue
+		}
+
+		activePublicKeys = append(activePublicKeys, publicKey)
+		indexes = append(indexes, uint64(index))
+	}
+	lls.mutReactivations.Unlock()
+
+	if len(activePublicKeys) == 0 {
+		return lls.uniformSelector.SelectLeader(seed, sortedPublicKeys)
+	}
+
+	leader, index := lls.uniformSelector.SelectLeader(seed, activePublicKeys)
+
+	return leader, indexes[index]
+}
+
+func (lls *livenessLeaderSelector) isActive(publicKey []byte, now int64) bool {
+	maxInactivity := int64(lls.maxInactivity.Seconds())
+	lastActivity := lls.livenessProvider.LastActivity(publicKey)
+	if lastActivity.IsZero() {
+		return false
+	}
+
+	reactivation, wasInactive := lls.reactivations[string(publicKey)]
+	if lastActivity.Unix() < now-maxInactivity {
+		lls.reactivations[string(publicKey)] = 0
+		return false
+	}
+	if !wasInactive {
+		return true
+	}
+	if reactivation == 0 {
+		lls.reactivations[string(publicKey)] = now
+		return false
+	}
+	if now-reactivation < maxInactivity {
+		return false
+	}
+
+	delete(lls.reactivations, string(publicKey))
+
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lls *livenessLeaderSelector) IsInterfaceNil() bool {
+	return lls == nil
+}
@@ -0,0 +1,222 @@
+package topology
+
+import (
+	"testing"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsLivenessLeaderSelector() ArgsLivenessLeaderSelector {
+	return ArgsLivenessLeaderSelector{
+		LivenessProvider: &testsCommon.LivenessProviderStub{},
+		Timer:            createTimerStubWithUnixValue(10000),
+		MaxInactivity:    time.Minute * 15,
+	}
+}
+
+func TestNewLivenessLeaderSelector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil liveness provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLivenessLeaderSelector()
+		args.LivenessProvider = nil
+		lls, err := NewLivenessLeaderSelector(args)
+
+		assert.True(t, check.IfNil(lls))
+		assert.Equal(t, errNilLivenessProvider, err)
+	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLivenessLeaderSelector()
+		args.Timer = nil
+		lls, err := NewLivenessLeaderSelector(args)
+
+		assert.True(t, check.IfNil(lls))
+		assert.Equal(t, errNilTimer, err)
+	})
+	t.Run("invalid max inactivity should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLivenessLeaderSelector()
+		args.MaxInactivity = time.Millisecond * 999
+		lls, err := NewLivenessLeaderSelector(args)
+
+		assert.True(t, check.IfNil(lls))
+		assert.Equal(t, errInvalidMaxInactivity, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		lls, err := NewLivenessLeaderSelector(createMockArgsLivenessLeaderSelector())
+
+		assert.False(t, check.IfNil(lls))
+		assert.Nil(t, err)
+	})
+}
+
+func TestLivenessLeaderSelector_SelectLeader(t *testing.T) {
+	t.Parallel()
+
+	seed := uint64(1641988500)
+	sortedPublicKeys := createSortedPublicKeys(10)
+	uniformSelector := NewUniformLeaderSelector()
+
+	t.Run("empty list should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		lls, _ := NewLivenessLeaderSelector(createMockArgsLivenessLeaderSelector())
+
+		leader, index := lls.SelectLeader(seed, nil)
+		assert.Nil(t, leader)
+		assert.Equal(t, uint64(0), index)
+	})
+	t.Run("no active relayer should select from all relayers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLivenessLeaderSelector()
+		args.LivenessProvider = &testsCommon.LivenessProviderStub{
+			LastActivityCalled: func(publicKey []byte) time.Time {
+				// older than the max inactivity
+				return time.Unix(10000-901, 0)
+			},
+		}
+		lls, _ := NewLivenessLeaderSelector(args)
+
+		for i := uint64(0); i < 100; i++ {
+			expectedLeader, expectedIndex := uniformSelector.SelectLeader(seed+i, sortedPublicKeys)
+
+			leader, index := lls.SelectLeader(seed+i, sortedPublicKeys)
+			assert.Equal(t, expectedLeader, leader)
+			assert.Equal(t, expectedIndex, index)
+		}
+	})
+	t.Run("should select only the active relayers", func(t *testing.T) {
+		t.Parallel()
+
+		activePublicKeys := map[string]struct{}{
+			string(sortedPublicKeys[1]): {},
+			string(sortedPublicKeys[4]): {},
+			string(sortedPublicKeys[7]): {},
+		}
+
+		args := createMockArgsLivenessLeaderSelector()
+		args.LivenessProvider = &testsCommon.LivenessProviderStub{
+			LastActivityCalled: func(publicKey []byte) time.Time {
+				_, isActive := activePublicKeys[string(publicKey)]
+				if isActive {
+					return time.Unix(10000-900, 0)
+				}
+				if string(publicKey) == string(sortedPublicKeys[0]) {
+					return time.Unix(10000-901, 0)
+				}
+
+				return time.Time{}
+			},
+		}
+		lls, _ := NewLivenessLeaderSelector(args)
+
+		selected := make(map[string]int)
+		for i := uint64(0); i < 1000; i++ {
+			leader, index := lls.SelectLeader(seed+i, sortedPublicKeys)
+			assert.Equal(t, sortedPublicKeys[index], leader)
+
+			selected[string(leader)]++
+		}
+
+		assert.Equal(t, len(activePublicKeys), len(selected))
+		for publicKey := range selected {
+			_, isActive := activePublicKeys[publicKey]
+			assert.True(t, isActive)
+		}
+	})
+	t.Run("a relayer that became inactive should be selected only after a full max inactivity interval", func(t *testing.T) {
+		t.Parallel()
+
+		now := int64(10000)
+		lastActivity := int64(10000)
+		args := createMockArgsLivenessLeaderSelector()
+		timer := testsCommon.NewTimerStub()
+		timer.NowUnixCalled = func() int64 {
+			return now
+		}
+		args.Timer = timer
+		args.LivenessProvider = &testsCommon.LivenessProviderStub{
+			LastActivityCalled: func(publicKey []byte) time.Time {
+				if string(publicKey) == string(sortedPublicKeys[3]) {
+					return time.Unix(lastActivity, 0)
+				}
+
+				return time.Unix(now, 0)
+			},
+		}
+		lls, _ := NewLivenessLeaderSelector(args)
+		isSelectable := func() bool {
+			for i := uint64(0); i < 1000; i++ {
+				leader, _ := lls.SelectLeader(seed+i, sortedPublicKeys)
+				if string(leader) == string(sortedPublicKeys[3]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		assert.True(t, isSelectable())
+
+		now += 901
+		assert.False(t, isSelectable())
+
+		lastActivity = now
+		assert.False(t, isSelectable())
+
+		now += 899
+		lastActivity = now
+		assert.False(t, isSelectable())
+
+		now++
+		lastActivity = now
+		assert.True(t, isSelectable())
+	})
+	t.Run("a relayer flapping during the reactivation should restart it", func(t *testing.T) {
+		t.Parallel()
+
+		now := int64(10000)
+		lastActivity := int64(10000 - 901)
+		args := createMockArgsLivenessLeaderSelector()
+		timer := testsCommon.NewTimerStub()
+		timer.NowUnixCalled = func() int64 {
+			return now
+		}
+		args.Timer = timer
+		args.LivenessProvider = &testsCommon.LivenessProviderStub{
+			LastActivityCalled: func(publicKey []byte) time.Time {
+				return time.Unix(lastActivity, 0)
+			},
+		}
+		lls, _ := NewLivenessLeaderSelector(args)
+		publicKey := sortedPublicKeys[0]
+
+		assert.False(t, lls.isActive(publicKey, now))
+
+		lastActivity = now
+		assert.False(t, lls.isActive(publicKey, now))
+		assert.Equal(t, now, lls.reactivations[string(publicKey)])
+
+		now += 901
+		assert.False(t, lls.isActive(publicKey, now))
+		assert.Equal(t, int64(0), lls.reactivations[string(publicKey)])
+
+		lastActivity = now
+		assert.False(t, lls.isActive(publicKey, now))
+		now += 900
+		lastActivity = now
+		assert.True(t, lls.isActive(publicKey, now))
+		assert.Empty(t, lls.reactivations)
+	})
+}
@@ -0,0 +1,63 @@
+package topology
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+type stakeWeightedLeaderSelector struct {
+	stakeProvider   StakeProvider
+	selector        *hashRandomSelector
+	uniformSelector *uniformLeaderSelector
+}
+
+// NewStakeWeightedLeaderSelector creates a leader selector that picks each of the provided public keys with a
+// probability proportional to its stake. If no stake is known, it picks each of them with the same probability
+func NewStakeWeightedLeaderSelector(stakeProvider StakeProvider) (*stakeWeightedLeaderSelector, error) {
+	if check.IfNil(stakeProvider) {
+		return nil, errNilStakeProvider
+	}
+
+	return &stakeWeightedLeaderSelector{
+		stakeProvider:   stakeProvider,
+		selector:        &hashRandomSelector{},
+		uniformSelector: NewUniformLeaderSelector(),
+	}, nil
+}
+
+// SelectLeader returns the leader and its index in the sorted public keys. Returns nil if the list is empty
+func (swls *stakeWeightedLeaderSelector) SelectLeader(seed uint64, sortedPublicKeys [][]byte) ([]byte, uint64) {
+	stakes := make([]*big.Int, 0, len(sortedPublicKeys))
+	totalStake := big.NewInt(0)
+	for _, publicKey := range sortedPublicKeys {
+		stake := swls.stakeProvider.StakeOf(publicKey)
+		if stake == nil || stake.Sign() < 0 {
+			stake = big.NewInt(0)
+		}
+
+		stakes = append(stakes, stake)
+		totalStake.Add(totalStake, stake)
+	}
+
+	if totalStake.Sign() == 0 {
+		return swls.uniformSelector.SelectLeader(seed, sortedPublicKeys)
+	}
+
+	value := swls.selector.randomBigInt(seed, totalStake)
+	cumulatedStake := big.NewInt(0)
+	for index, stake := range stakes {
+		cumulatedStake.Add(cumulatedStake, stake)
+		if value.Cmp(cumulatedStake) < 0 {
+			return sortedPublicKeys[index], uint64(index)
+		}
+	}
+
+	// not reachable as the random value is lower than the total stake
+	return nil, 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (swls *stakeWeightedLeaderSelector) IsInterfaceNil() bool {
+	return swls == nil
+}
@@ -0,0 +1,113 @@
+package topology
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStakeWeightedLeaderSelector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil stake provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		swls, err := NewStakeWeightedLeaderSelector(nil)
+
+		assert.True(t, check.IfNil(swls))
+		assert.Equal(t, errNilStakeProvider, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		swls, err := NewStakeWeightedLeaderSelector(&testsCommon.StakeProviderStub{})
+
+		assert.False(t, check.IfNil(swls))
+		assert.Nil(t, err)
+	})
+}
+
+func TestStakeWeightedLeaderSelector_SelectLeader(t *testing.T) {
+	t.Parallel()
+
+	seed := uint64(1641988500)
+	sortedPublicKeys := createSortedPublicKeys(4)
+
+	t.Run("empty list should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		swls, _ := NewStakeWeightedLeaderSelector(&testsCommon.StakeProviderStub{})
+
+		leader, index := swls.SelectLeader(seed, nil)
+		assert.Nil(t, leader)
+		assert.Equal(t, uint64(0), index)
+	})
+	t.Run("no stakes should select uniformly", func(t *testing.T) {
+		t.Parallel()
+
+		swls, _ := NewStakeWeightedLeaderSelector(&testsCommon.StakeProviderStub{
+			StakeOfCalled: func(publicKey []byte) *big.Int {
+				return nil
+			},
+		})
+		uniformSelector := NewUniformLeaderSelector()
+
+		for i := uint64(0); i < 100; i++ {
+			expectedLeader, expectedIndex := uniformSelector.SelectLeader(seed+i, sortedPublicKeys)
+
+			leader, index := swls.SelectLeader(seed+i, sortedPublicKeys)
+			assert.Equal(t, expectedLeader, leader)
+			assert.Equal(t, expectedIndex, index)
+		}
+	})
+	t.Run("should select proportionally to the stake", func(t *testing.T) {
+		t.Parallel()
+
+		stakes := map[string]*big.Int{
+			string(sortedPublicKeys[0]): big.NewInt(1000),
+			string(sortedPublicKeys[1]): big.NewInt(0),
+			string(sortedPublicKeys[2]): big.NewInt(3000),
+			string(sortedPublicKeys[3]): big.NewInt(6000),
+		}
+		swls, _ := NewStakeWeightedLeaderSelector(&testsCommon.StakeProviderStub{
+			StakeOfCalled: func(publicKey []byte) *big.Int {
+				return stakes[string(publicKey)]
+			},
+		})
+
+		numSelections := 100000
+		selected := make(map[string]int)
+		for i := 0; i < numSelections; i++ {
+			leader, index := swls.SelectLeader(seed+uint64(i)*12, sortedPublicKeys)
+			assert.Equal(t, sortedPublicKeys[index], leader)
+
+			selected[string(leader)]++
+		}
+
+		assert.Zero(t, selected[string(sortedPublicKeys[1])])
+		assert.InDelta(t, 10000, selected[string(sortedPublicKeys[0])], 1000)
+		assert.InDelta(t, 30000, selected[string(sortedPublicKeys[2])], 1000)
+		assert.InDelta(t, 60000, selected[string(sortedPublicKeys[3])], 1000)
+	})
+	t.Run("should be deterministic", func(t *testing.T) {
+		t.Parallel()
+
+		stakeProvider := &testsCommon.StakeProviderStub{
+			StakeOfCalled: func(publicKey []byte) *big.Int {
+				return big.NewInt(int64(publicKey[0]))
+			},
+		}
+		swls1, _ := NewStakeWeightedLeaderSelector(stakeProvider)
+		swls2, _ := NewStakeWeightedLeaderSelector(stakeProvider)
+
+		for i := uint64(0); i < 100; i++ {
+			leader1, index1 := swls1.SelectLeader(seed+i, sortedPublicKeys)
+			leader2, index2 := swls2.SelectLeader(seed+i, sortedPublicKeys)
+			assert.Equal(t, leader1, leader2)
+			assert.Equal(t, index1, index2)
+		}
+	})
+}
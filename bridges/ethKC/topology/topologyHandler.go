@@ -17,6 +17,8 @@ type ArgsTopologyHandler struct {
 	AddressBytes       []byte
 	Log                logger.Logger
 	AddressConverter   core.AddressConverter
+	LeaderSelector     LeaderSelector
+	LeaseProvider      LeaseProvider
 }
 
 // topologyHandler implements topologyProvider for a specific relay
@@ -25,7 +27,8 @@ type topologyHandler struct {
 	timer              core.Timer
 	intervalForLeader  time.Duration
 	addressBytes       []byte
-	selector           *hashRandomSelector
+	leaderSelector     LeaderSelector
+	leaseProvider      LeaseProvider
 	log                logger.Logger
 	addressConverter   core.AddressConverter
 }
@@ -42,7 +45,8 @@ func NewTopologyHandler(args ArgsTopologyHandler) (*topologyHandler, error) {
 		timer:              args.Timer,
 		intervalForLeader:  args.IntervalForLeader,
 		addressBytes:       args.AddressBytes,
-		selector:           &hashRandomSelector{},
+		leaderSelector:     args.LeaderSelector,
+		leaseProvider:      args.LeaseProvider,
 		log:                args.Log,
 		addressConverter:   args.AddressConverter,
 	}, nil
@@ -50,31 +54,52 @@ func NewTopologyHandler(args ArgsTopologyHandler) (*topologyHandler, error) {
 
 // MyTurnAsLeader returns true if the current relay is leader
 func (t *topologyHandler) MyTurnAsLeader() bool {
-	sortedPublicKeys := t.publicKeysProvider.SortedPublicKeys()
-
-	if len(sortedPublicKeys) == 0 {
+	leaderAddress, index := t.computeLeader()
+	if len(leaderAddress) == 0 {
 		t.log.Warn("topology handler: can not compute my turn as leader as the list is empty")
 		return false
-	} else {
-		numberOfPeers := int64(len(sortedPublicKeys))
+	}
+
+	isLeader := bytes.Equal(leaderAddress, t.addressBytes)
+	msg := "topology handler"
+	if isLeader {
+		msg += " (my turn)"
+	}
 
-		seed := uint64(t.timer.NowUnix() / int64(t.intervalForLeader.Seconds()))
-		index := t.selector.randomInt(seed, uint64(numberOfPeers))
+	t.log.Debug(msg,
+		"leader", t.addressConverter.ToBech32StringSilent(leaderAddress),
+		"index", index,
+		"self address", t.addressConverter.ToBech32StringSilent(t.addressBytes))
 
-		leaderAddress := sortedPublicKeys[index]
-		isLeader := bytes.Equal(leaderAddress, t.addressBytes)
-		msg := "topology handler"
-		if isLeader {
-			msg += " (my turn)"
-		}
+	return isLeader
+}
 
-		t.log.Debug(msg,
-			"leader", t.addressConverter.ToBech32StringSilent(leaderAddress),
-			"index", index,
-			"self address", t.addressConverter.ToBech32StringSilent(t.addressBytes))
+// CurrentLeader returns the address of the current leader or nil if the leader can not be computed
+func (t *topologyHandler) CurrentLeader() []byte {
+	leaderAddress, _ := t.computeLeader()
+
+	return leaderAddress
+}
 
-		return isLeader
+func (t *topologyHandler) computeLeader() ([]byte, uint64) {
+	sortedPublicKeys := t.publicKeysProvider.SortedPublicKeys()
+	if len(sortedPublicKeys) == 0 {
+		return nil, 0
+	}
+
+	// the relayer holding an active lease keeps the leadership while its transaction is in flight
+	leaseHolder := t.leaseProvider.CurrentLeaseHolder()
+	if len(leaseHolder) > 0 {
+		for index, publicKey := range sortedPublicKeys {
+			if bytes.Equal(publicKey, leaseHolder) {
+				return publicKey, uint64(index)
+			}
+		}
 	}
+
+	seed := uint64(t.timer.NowUnix() / int64(t.intervalForLeader.Seconds()))
+
+	return t.leaderSelector.SelectLeader(seed, sortedPublicKeys)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -101,6 +126,12 @@ func checkArgs(args ArgsTopologyHandler) error {
 	if check.IfNil(args.AddressConverter) {
 		return errNilAddressConverter
 	}
+	if check.IfNil(args.LeaderSelector) {
+		return errNilLeaderSelector
+	}
+	if check.IfNil(args.LeaseProvider) {
+		return errNilLeaseProvider
+	}
 
 	return nil
 }
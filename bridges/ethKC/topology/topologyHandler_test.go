@@ -83,6 +83,26 @@ func TestNewTopologyHandler(t *testing.T) {
 		assert.True(t, check.IfNil(tph))
 		assert.Equal(t, errNilAddressConverter, err)
 	})
+	t.Run("nil leader selector", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTopologyHandler()
+		args.LeaderSelector = nil
+		tph, err := NewTopologyHandler(args)
+
+		assert.True(t, check.IfNil(tph))
+		assert.Equal(t, errNilLeaderSelector, err)
+	})
+	t.Run("nil lease provider", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTopologyHandler()
+		args.LeaseProvider = nil
+		tph, err := NewTopologyHandler(args)
+
+		assert.True(t, check.IfNil(tph))
+		assert.Equal(t, errNilLeaseProvider, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -97,6 +117,7 @@ func TestNewTopologyHandler(t *testing.T) {
 		assert.Equal(t, args.Timer, tph.timer)
 		assert.Equal(t, args.IntervalForLeader, tph.intervalForLeader)
 		assert.Equal(t, args.AddressBytes, tph.addressBytes)
+		assert.True(t, args.LeaderSelector == tph.leaderSelector) // pointer testing
 	})
 }
 
@@ -135,6 +156,74 @@ func TestMyTurnAsLeader(t *testing.T) {
 
 		assert.True(t, tph.MyTurnAsLeader())
 	})
+	t.Run("leader because of the active lease", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTopologyHandler()
+		args.AddressBytes = bytes.Repeat([]byte("2"), 32)
+		args.LeaseProvider = &testsCommon.LeaderLeaseStub{
+			CurrentLeaseHolderCalled: func() []byte {
+				return bytes.Repeat([]byte("2"), 32)
+			},
+		}
+		tph, _ := NewTopologyHandler(args)
+
+		assert.True(t, tph.MyTurnAsLeader())
+	})
+	t.Run("not leader because of the active lease", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTopologyHandler()
+		args.LeaseProvider = &testsCommon.LeaderLeaseStub{
+			CurrentLeaseHolderCalled: func() []byte {
+				return bytes.Repeat([]byte("2"), 32)
+			},
+		}
+		tph, _ := NewTopologyHandler(args)
+
+		assert.False(t, tph.MyTurnAsLeader())
+	})
+	t.Run("lease held by a relayer that is not whitelisted should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTopologyHandler()
+		args.LeaseProvider = &testsCommon.LeaderLeaseStub{
+			CurrentLeaseHolderCalled: func() []byte {
+				return bytes.Repeat([]byte("3"), 32)
+			},
+		}
+		tph, _ := NewTopologyHandler(args)
+
+		assert.True(t, tph.MyTurnAsLeader())
+	})
+}
+
+func TestCurrentLeader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("SortedPublicKeys empty should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTopologyHandler()
+		args.PublicKeysProvider = &testsCommon.BroadcasterStub{
+			SortedPublicKeysCalled: func() [][]byte {
+				return make([][]byte, 0)
+			},
+		}
+		tph, _ := NewTopologyHandler(args)
+
+		assert.Nil(t, tph.CurrentLeader())
+	})
+	t.Run("should return the leader regardless of the own address", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTopologyHandler()
+		leaderAddress := args.AddressBytes
+		args.AddressBytes = bytes.Repeat([]byte("3"), 32)
+		tph, _ := NewTopologyHandler(args)
+
+		assert.Equal(t, leaderAddress, tph.CurrentLeader())
+	})
 }
 
 func createTimerStubWithUnixValue(value int64) *testsCommon.TimerStub {
@@ -161,5 +250,7 @@ func createMockArgsTopologyHandler() ArgsTopologyHandler {
 		AddressBytes:      bytes.Repeat([]byte("1"), 32),
 		Log:               logger.GetOrCreate("test"),
 		AddressConverter:  addressConverter,
+		LeaderSelector:    NewUniformLeaderSelector(),
+		LeaseProvider:     &testsCommon.LeaderLeaseStub{},
 	}
 }
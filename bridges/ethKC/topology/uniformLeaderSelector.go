@@ -0,0 +1,28 @@
+package topology
+
+type uniformLeaderSelector struct {
+	selector *hashRandomSelector
+}
+
+// NewUniformLeaderSelector creates a leader selector that picks each of the provided public keys with the same probability
+func NewUniformLeaderSelector() *uniformLeaderSelector {
+	return &uniformLeaderSelector{
+		selector: &hashRandomSelector{},
+	}
+}
+
+// SelectLeader returns the leader and its index in the sorted public keys. Returns nil if the list is empty
+func (uls *uniformLeaderSelector) SelectLeader(seed uint64, sortedPublicKeys [][]byte) ([]byte, uint64) {
+	if len(sortedPublicKeys) == 0 {
+		return nil, 0
+	}
+
+	index := uls.selector.randomInt(seed, uint64(len(sortedPublicKeys)))
+
+	return sortedPublicKeys[index], index
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (uls *uniformLeaderSelector) IsInterfaceNil() bool {
+	return uls == nil
+}
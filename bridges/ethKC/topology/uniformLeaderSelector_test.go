@@ -0,0 +1,46 @@
+package topology
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createSortedPublicKeys(num int) [][]byte {
+	sortedPublicKeys := make([][]byte, 0, num)
+	for i := 0; i < num; i++ {
+		sortedPublicKeys = append(sortedPublicKeys, bytes.Repeat([]byte{byte('a' + i)}, 32))
+	}
+
+	return sortedPublicKeys
+}
+
+func TestUniformLeaderSelector_SelectLeader(t *testing.T) {
+	t.Parallel()
+
+	uls := NewUniformLeaderSelector()
+	assert.False(t, check.IfNil(uls))
+
+	t.Run("empty list should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		leader, index := uls.SelectLeader(1641988500, nil)
+		assert.Nil(t, leader)
+		assert.Equal(t, uint64(0), index)
+	})
+	t.Run("should select the same leader as the hash random selector", func(t *testing.T) {
+		t.Parallel()
+
+		seed := uint64(1641988500)
+		sortedPublicKeys := createSortedPublicKeys(10)
+		for i := uint64(0); i < 100; i++ {
+			expectedIndex := (&hashRandomSelector{}).randomInt(seed+i, 10)
+
+			leader, index := uls.SelectLeader(seed+i, sortedPublicKeys)
+			assert.Equal(t, expectedIndex, index)
+			assert.Equal(t, sortedPublicKeys[expectedIndex], leader)
+		}
+	})
+}
@@ -0,0 +1,193 @@
+package ethKC
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/klever-io/klv-bridge-eth-go/clients/ethereum/contract"
+	bridgeCore "github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/core/batchProcessor"
+	"github.com/klever-io/klv-bridge-eth-go/tracing"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type tracedEthereumClient struct {
+	ethereumClient EthereumClient
+	tracer         trace.Tracer
+}
+
+// NewTracedEthereumClient creates an Ethereum client decorator that records a tracing span for each call
+// of the inner client. The spans are tagged with the attributes carried by the provided context
+func NewTracedEthereumClient(ethereumClient EthereumClient, tracer trace.Tracer) (*tracedEthereumClient, error) {
+	if check.IfNil(ethereumClient) {
+		return nil, ErrNilEthereumClient
+	}
+	if tracer == nil {
+		return nil, ErrNilTracer
+	}
+
+	return &tracedEthereumClient{
+		ethereumClient: ethereumClient,
+		tracer:         tracer,
+	}, nil
+}
+
+// GetBatch calls the inner client's GetBatch method in a new tracing span
+func (client *tracedEthereumClient) GetBatch(ctx context.Context, nonce uint64) (*bridgeCore.TransferBatch, bool, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "EthereumClient.GetBatch", tracing.BatchID(nonce))
+	batch, isFinal, err := client.ethereumClient.GetBatch(ctx, nonce)
+	tracing.EndSpan(span, err)
+
+	return batch, isFinal, err
+}
+
+// WasExecuted calls the inner client's WasExecuted method in a new tracing span
+func (client *tracedEthereumClient) WasExecuted(ctx context.Context, batchID uint64) (bool, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "EthereumClient.WasExecuted", tracing.BatchID(batchID))
+	wasExecuted, err := client.ethereumClient.WasExecuted(ctx, batchID)
+	tracing.EndSpan(span, err)
+
+	return wasExecuted, err
+}
+
+// GenerateMessageHash calls the inner client's GenerateMessageHash method
+func (client *tracedEthereumClient) GenerateMessageHash(batch *batchProcessor.ArgListsBatch, batchId uint64) (common.Hash, error) {
+	return client.ethereumClient.GenerateMessageHash(batch, batchId)
+}
+
+// BroadcastSignatureForMessageHash calls the inner client's BroadcastSignatureForMessageHash method
+func (client *tracedEthereumClient) BroadcastSignatureForMessageHash(msgHash common.Hash) {
+	client.ethereumClient.BroadcastSignatureForMessageHash(msgHash)
+}
+
+// RequestSignaturesForMessageHash calls the inner client's RequestSignaturesForMessageHash method
+func (client *tracedEthereumClient) RequestSignaturesForMessageHash(msgHash common.Hash) {
+	client.ethereumClient.RequestSignaturesForMessageHash(msgHash)
+}
+
+// ExecuteTransfer calls the inner client's ExecuteTransfer method in a new tracing span
+func (client *tracedEthereumClient) ExecuteTransfer(ctx context.Context, msgHash common.Hash, batch *batchProcessor.ArgListsBatch, batchId uint64, quorum int) (string, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "EthereumClient.ExecuteTransfer", tracing.BatchID(batchId), tracing.MessageHash(msgHash.Bytes()))
+	hash, err := client.ethereumClient.ExecuteTransfer(ctx, msgHash, batch, batchId, quorum)
+	if err == nil {
+		span.SetAttributes(tracing.TxHash(hash))
+	}
+	tracing.EndSpan(span, err)
+
+	return hash, err
+}
+
+// GetTransactionsStatuses calls the inner client's GetTransactionsStatuses method in a new tracing span
+func (client *tracedEthereumClient) GetTransactionsStatuses(ctx context.Context, batchId uint64) ([]byte, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "EthereumClient.GetTransactionsStatuses", tracing.BatchID(batchId))
+	statuses, err := client.ethereumClient.GetTransactionsStatuses(ctx, batchId)
+	tracing.EndSpan(span, err)
+
+	return statuses, err
+}
+
+// GetQuorumSize calls the inner client's GetQuorumSize method in a new tracing span
+func (client *tracedEthereumClient) GetQuorumSize(ctx context.Context) (*big.Int, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "EthereumClient.GetQuorumSize")
+	quorum, err := client.ethereumClient.GetQuorumSize(ctx)
+	tracing.EndSpan(span, err)
+
+	return quorum, err
+}
+
+// IsQuorumReached calls the inner client's IsQuorumReached method in a new tracing span
+func (client *tracedEthereumClient) IsQuorumReached(ctx context.Context, msgHash common.Hash) (bool, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "EthereumClient.IsQuorumReached", tracing.MessageHash(msgHash.Bytes()))
+	reached, err := client.ethereumClient.IsQuorumReached(ctx, msgHash)
+	tracing.EndSpan(span, err)
+
+	return reached, err
+}
+
+// GetBatchSCMetadata calls the inner client's GetBatchSCMetadata method in a new tracing span
+func (client *tracedEthereumClient) GetBatchSCMetadata(ctx context.Context, nonce uint64, blockNumber int64) ([]*contract.ERC20SafeERC20SCDeposit, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "EthereumClient.GetBatchSCMetadata", tracing.BatchID(nonce))
+	deposits, err := client.ethereumClient.GetBatchSCMetadata(ctx, nonce, blockNumber)
+	tracing.EndSpan(span, err)
+
+	return deposits, err
+}
+
+// CheckClientAvailability calls the inner client's CheckClientAvailability method in a new tracing span
+func (client *tracedEthereumClient) CheckClientAvailability(ctx context.Context) error {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "EthereumClient.CheckClientAvailability")
+	err := client.ethereumClient.CheckClientAvailability(ctx)
+	tracing.EndSpan(span, err)
+
+	return err
+}
+
+// CheckRequiredBalance calls the inner client's CheckRequiredBalance method in a new tracing span
+func (client *tracedEthereumClient) CheckRequiredBalance(ctx context.Context, erc20Address common.Address, value *big.Int) error {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "EthereumClient.CheckRequiredBalance")
+	err := client.ethereumClient.CheckRequiredBalance(ctx, erc20Address, value)
+	tracing.EndSpan(span, err)
+
+	return err
+}
+
+// TotalBalances calls the inner client's TotalBalances method in a new tracing span
+func (client *tracedEthereumClient) TotalBalances(ctx context.Context, token common.Address) (*big.Int, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "EthereumClient.TotalBalances")
+	value, err := client.ethereumClient.TotalBalances(ctx, token)
+	tracing.EndSpan(span, err)
+
+	return value, err
+}
+
+// MintBalances calls the inner client's MintBalances method in a new tracing span
+func (client *tracedEthereumClient) MintBalances(ctx context.Context, token common.Address) (*big.Int, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "EthereumClient.MintBalances")
+	value, err := client.ethereumClient.MintBalances(ctx, token)
+	tracing.EndSpan(span, err)
+
+	return value, err
+}
+
+// BurnBalances calls the inner client's BurnBalances method in a new tracing span
+func (client *tracedEthereumClient) BurnBalances(ctx context.Context, token common.Address) (*big.Int, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "EthereumClient.BurnBalances")
+	value, err := client.ethereumClient.BurnBalances(ctx, token)
+	tracing.EndSpan(span, err)
+
+	return value, err
+}
+
+// MintBurnTokens calls the inner client's MintBurnTokens method in a new tracing span
+func (client *tracedEthereumClient) MintBurnTokens(ctx context.Context, token common.Address) (bool, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "EthereumClient.MintBurnTokens")
+	result, err := client.ethereumClient.MintBurnTokens(ctx, token)
+	tracing.EndSpan(span, err)
+
+	return result, err
+}
+
+// NativeTokens calls the inner client's NativeTokens method in a new tracing span
+func (client *tracedEthereumClient) NativeTokens(ctx context.Context, token common.Address) (bool, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "EthereumClient.NativeTokens")
+	result, err := client.ethereumClient.NativeTokens(ctx, token)
+	tracing.EndSpan(span, err)
+
+	return result, err
+}
+
+// WhitelistedTokens calls the inner client's WhitelistedTokens method in a new tracing span
+func (client *tracedEthereumClient) WhitelistedTokens(ctx context.Context, token common.Address) (bool, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "EthereumClient.WhitelistedTokens")
+	result, err := client.ethereumClient.WhitelistedTokens(ctx, token)
+	tracing.EndSpan(span, err)
+
+	return result, err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (client *tracedEthereumClient) IsInterfaceNil() bool {
+	return client == nil
+}
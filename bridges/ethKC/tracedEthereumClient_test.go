@@ -0,0 +1,130 @@
+package ethKC
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/klever-io/klv-bridge-eth-go/core/batchProcessor"
+	bridgeTests "github.com/klever-io/klv-bridge-eth-go/testsCommon/bridge"
+	"github.com/klever-io/klv-bridge-eth-go/tracing"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestNewTracedEthereumClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil client should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewTracedEthereumClient(nil, noop.NewTracerProvider().Tracer(""))
+		assert.True(t, check.IfNil(client))
+		assert.Equal(t, ErrNilEthereumClient, err)
+	})
+	t.Run("nil tracer should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewTracedEthereumClient(&bridgeTests.EthereumClientStub{}, nil)
+		assert.True(t, check.IfNil(client))
+		assert.Equal(t, ErrNilTracer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewTracedEthereumClient(&bridgeTests.EthereumClientStub{}, noop.NewTracerProvider().Tracer(""))
+		assert.False(t, check.IfNil(client))
+		assert.Nil(t, err)
+	})
+}
+
+func TestTracedEthereumClient_ShouldRecordASpanForEachCall(t *testing.T) {
+	t.Parallel()
+
+	tracer, recorder := createRecordingTracer()
+	broadcastCalled := false
+	requestCalled := false
+	stub := &bridgeTests.EthereumClientStub{
+		BroadcastSignatureForMessageHashCalled: func(msgHash common.Hash) {
+			broadcastCalled = true
+		},
+		RequestSignaturesForMessageHashCalled: func(msgHash common.Hash) {
+			requestCalled = true
+		},
+	}
+	client, _ := NewTracedEthereumClient(stub, tracer)
+	ctx := context.Background()
+	token := common.HexToAddress("0x1")
+
+	_, _, _ = client.GetBatch(ctx, 1)
+	_, _ = client.WasExecuted(ctx, 1)
+	_, _ = client.GenerateMessageHash(&batchProcessor.ArgListsBatch{}, 1)
+	client.BroadcastSignatureForMessageHash(common.Hash{})
+	client.RequestSignaturesForMessageHash(common.Hash{})
+	_, _ = client.ExecuteTransfer(ctx, common.Hash{}, &batchProcessor.ArgListsBatch{}, 1, 3)
+	_, _ = client.GetTransactionsStatuses(ctx, 1)
+	_, _ = client.GetQuorumSize(ctx)
+	_, _ = client.IsQuorumReached(ctx, common.Hash{})
+	_, _ = client.GetBatchSCMetadata(ctx, 1, 100)
+	_ = client.CheckClientAvailability(ctx)
+	_ = client.CheckRequiredBalance(ctx, token, big.NewInt(1))
+	_, _ = client.TotalBalances(ctx, token)
+	_, _ = client.MintBalances(ctx, token)
+	_, _ = client.BurnBalances(ctx, token)
+	_, _ = client.MintBurnTokens(ctx, token)
+	_, _ = client.NativeTokens(ctx, token)
+	_, _ = client.WhitelistedTokens(ctx, token)
+
+	assert.True(t, broadcastCalled)
+	assert.True(t, requestCalled)
+	expectedNames := []string{
+		"EthereumClient.GetBatch",
+		"EthereumClient.WasExecuted",
+		"EthereumClient.ExecuteTransfer",
+		"EthereumClient.GetTransactionsStatuses",
+		"EthereumClient.GetQuorumSize",
+		"EthereumClient.IsQuorumReached",
+		"EthereumClient.GetBatchSCMetadata",
+		"EthereumClient.CheckClientAvailability",
+		"EthereumClient.CheckRequiredBalance",
+		"EthereumClient.TotalBalances",
+		"EthereumClient.MintBalances",
+		"EthereumClient.BurnBalances",
+		"EthereumClient.MintBurnTokens",
+		"EthereumClient.NativeTokens",
+		"EthereumClient.WhitelistedTokens",
+	}
+	assert.Equal(t, expectedNames, spanNames(recorder.Ended()))
+}
+
+func TestTracedEthereumClient_ExecuteTransferShouldTagTheSpan(t *testing.T) {
+	t.Parallel()
+
+	tracer, recorder := createRecordingTracer()
+	stub := &bridgeTests.EthereumClientStub{
+		ExecuteTransferCalled: func(ctx context.Context, msgHash common.Hash, batch *batchProcessor.ArgListsBatch, batchId uint64, quorum int) (string, error) {
+			return "tx hash", nil
+		},
+	}
+	client, _ := NewTracedEthereumClient(stub, tracer)
+
+	msgHash := common.HexToHash("0x0102")
+	ctx := tracing.ContextWithAttributes(context.Background(), tracing.Direction("KleverBlockchainToEthereum"))
+	hash, err := client.ExecuteTransfer(ctx, msgHash, &batchProcessor.ArgListsBatch{}, 37, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, "tx hash", hash)
+
+	spans := recorder.Ended()
+	require.Equal(t, 1, len(spans))
+	expectedAttributes := []attribute.KeyValue{
+		tracing.Direction("KleverBlockchainToEthereum"),
+		tracing.BatchID(37),
+		tracing.MessageHash(msgHash.Bytes()),
+		tracing.TxHash("tx hash"),
+	}
+	assert.Equal(t, expectedAttributes, spans[0].Attributes())
+}
@@ -0,0 +1,312 @@
+package ethKC
+
+import (
+	"context"
+	"math/big"
+
+	bridgeCore "github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/tracing"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type tracedKCClient struct {
+	kcClient KCClient
+	tracer   trace.Tracer
+}
+
+// NewTracedKCClient creates a Klever Blockchain client decorator that records a tracing span for each call
+// of the inner client. The spans are tagged with the attributes carried by the provided context
+func NewTracedKCClient(kcClient KCClient, tracer trace.Tracer) (*tracedKCClient, error) {
+	if check.IfNil(kcClient) {
+		return nil, ErrNilKCClient
+	}
+	if tracer == nil {
+		return nil, ErrNilTracer
+	}
+
+	return &tracedKCClient{
+		kcClient: kcClient,
+		tracer:   tracer,
+	}, nil
+}
+
+func batchAttributes(batch *bridgeCore.TransferBatch) []attribute.KeyValue {
+	if batch == nil {
+		return nil
+	}
+
+	return []attribute.KeyValue{tracing.BatchID(batch.ID)}
+}
+
+// GetPendingBatch calls the inner client's GetPendingBatch method in a new tracing span
+func (client *tracedKCClient) GetPendingBatch(ctx context.Context) (*bridgeCore.TransferBatch, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.GetPendingBatch")
+	batch, err := client.kcClient.GetPendingBatch(ctx)
+	tracing.EndSpan(span, err)
+
+	return batch, err
+}
+
+// GetBatch calls the inner client's GetBatch method in a new tracing span
+func (client *tracedKCClient) GetBatch(ctx context.Context, batchID uint64) (*bridgeCore.TransferBatch, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.GetBatch", tracing.BatchID(batchID))
+	batch, err := client.kcClient.GetBatch(ctx, batchID)
+	tracing.EndSpan(span, err)
+
+	return batch, err
+}
+
+// GetCurrentBatchAsDataBytes calls the inner client's GetCurrentBatchAsDataBytes method in a new tracing span
+func (client *tracedKCClient) GetCurrentBatchAsDataBytes(ctx context.Context) ([][]byte, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.GetCurrentBatchAsDataBytes")
+	data, err := client.kcClient.GetCurrentBatchAsDataBytes(ctx)
+	tracing.EndSpan(span, err)
+
+	return data, err
+}
+
+// WasProposedTransfer calls the inner client's WasProposedTransfer method in a new tracing span
+func (client *tracedKCClient) WasProposedTransfer(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.WasProposedTransfer", batchAttributes(batch)...)
+	wasProposed, err := client.kcClient.WasProposedTransfer(ctx, batch)
+	tracing.EndSpan(span, err)
+
+	return wasProposed, err
+}
+
+// QuorumReached calls the inner client's QuorumReached method in a new tracing span
+func (client *tracedKCClient) QuorumReached(ctx context.Context, actionID uint64) (bool, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.QuorumReached", tracing.ActionID(actionID))
+	reached, err := client.kcClient.QuorumReached(ctx, actionID)
+	tracing.EndSpan(span, err)
+
+	return reached, err
+}
+
+// WasExecuted calls the inner client's WasExecuted method in a new tracing span
+func (client *tracedKCClient) WasExecuted(ctx context.Context, actionID uint64) (bool, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.WasExecuted", tracing.ActionID(actionID))
+	wasExecuted, err := client.kcClient.WasExecuted(ctx, actionID)
+	tracing.EndSpan(span, err)
+
+	return wasExecuted, err
+}
+
+// GetActionIDForProposeTransfer calls the inner client's GetActionIDForProposeTransfer method in a new tracing span
+func (client *tracedKCClient) GetActionIDForProposeTransfer(ctx context.Context, batch *bridgeCore.TransferBatch) (uint64, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.GetActionIDForProposeTransfer", batchAttributes(batch)...)
+	actionID, err := client.kcClient.GetActionIDForProposeTransfer(ctx, batch)
+	if err == nil {
+		span.SetAttributes(tracing.ActionID(actionID))
+	}
+	tracing.EndSpan(span, err)
+
+	return actionID, err
+}
+
+// WasProposedSetStatus calls the inner client's WasProposedSetStatus method in a new tracing span
+func (client *tracedKCClient) WasProposedSetStatus(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.WasProposedSetStatus", batchAttributes(batch)...)
+	wasProposed, err := client.kcClient.WasProposedSetStatus(ctx, batch)
+	tracing.EndSpan(span, err)
+
+	return wasProposed, err
+}
+
+// GetTransactionsStatuses calls the inner client's GetTransactionsStatuses method in a new tracing span
+func (client *tracedKCClient) GetTransactionsStatuses(ctx context.Context, batchID uint64) ([]byte, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.GetTransactionsStatuses", tracing.BatchID(batchID))
+	statuses, err := client.kcClient.GetTransactionsStatuses(ctx, batchID)
+	tracing.EndSpan(span, err)
+
+	return statuses, err
+}
+
+// GetActionIDForSetStatusOnPendingTransfer calls the inner client's GetActionIDForSetStatusOnPendingTransfer method in a new tracing span
+func (client *tracedKCClient) GetActionIDForSetStatusOnPendingTransfer(ctx context.Context, batch *bridgeCore.TransferBatch) (uint64, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.GetActionIDForSetStatusOnPendingTransfer", batchAttributes(batch)...)
+	actionID, err := client.kcClient.GetActionIDForSetStatusOnPendingTransfer(ctx, batch)
+	if err == nil {
+		span.SetAttributes(tracing.ActionID(actionID))
+	}
+	tracing.EndSpan(span, err)
+
+	return actionID, err
+}
+
+// GetLastExecutedEthBatchID calls the inner client's GetLastExecutedEthBatchID method in a new tracing span
+func (client *tracedKCClient) GetLastExecutedEthBatchID(ctx context.Context) (uint64, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.GetLastExecutedEthBatchID")
+	batchID, err := client.kcClient.GetLastExecutedEthBatchID(ctx)
+	tracing.EndSpan(span, err)
+
+	return batchID, err
+}
+
+// GetLastExecutedEthTxID calls the inner client's GetLastExecutedEthTxID method in a new tracing span
+func (client *tracedKCClient) GetLastExecutedEthTxID(ctx context.Context) (uint64, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.GetLastExecutedEthTxID")
+	txID, err := client.kcClient.GetLastExecutedEthTxID(ctx)
+	tracing.EndSpan(span, err)
+
+	return txID, err
+}
+
+// GetLastKCBatchID calls the inner client's GetLastKCBatchID method in a new tracing span
+func (client *tracedKCClient) GetLastKCBatchID(ctx context.Context) (uint64, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.GetLastKCBatchID")
+	batchID, err := client.kcClient.GetLastKCBatchID(ctx)
+	tracing.EndSpan(span, err)
+
+	return batchID, err
+}
+
+// GetCurrentNonce calls the inner client's GetCurrentNonce method in a new tracing span
+func (client *tracedKCClient) GetCurrentNonce(ctx context.Context) (uint64, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.GetCurrentNonce")
+	nonce, err := client.kcClient.GetCurrentNonce(ctx)
+	tracing.EndSpan(span, err)
+
+	return nonce, err
+}
+
+// ConvertEthToKdaAmount calls the inner client's ConvertEthToKdaAmount method in a new tracing span
+func (client *tracedKCClient) ConvertEthToKdaAmount(ctx context.Context, token []byte, amount *big.Int) (*big.Int, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.ConvertEthToKdaAmount")
+	value, err := client.kcClient.ConvertEthToKdaAmount(ctx, token, amount)
+	tracing.EndSpan(span, err)
+
+	return value, err
+}
+
+// ProposeSetStatus calls the inner client's ProposeSetStatus method in a new tracing span
+func (client *tracedKCClient) ProposeSetStatus(ctx context.Context, batch *bridgeCore.TransferBatch) (string, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.ProposeSetStatus", batchAttributes(batch)...)
+	hash, err := client.kcClient.ProposeSetStatus(ctx, batch)
+	if err == nil {
+		span.SetAttributes(tracing.TxHash(hash))
+	}
+	tracing.EndSpan(span, err)
+
+	return hash, err
+}
+
+// ProposeTransfer calls the inner client's ProposeTransfer method in a new tracing span
+func (client *tracedKCClient) ProposeTransfer(ctx context.Context, batch *bridgeCore.TransferBatch) (string, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.ProposeTransfer", batchAttributes(batch)...)
+	hash, err := client.kcClient.ProposeTransfer(ctx, batch)
+	if err == nil {
+		span.SetAttributes(tracing.TxHash(hash))
+	}
+	tracing.EndSpan(span, err)
+
+	return hash, err
+}
+
+// Sign calls the inner client's Sign method in a new tracing span
+func (client *tracedKCClient) Sign(ctx context.Context, actionID uint64) (string, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.Sign", tracing.ActionID(actionID))
+	hash, err := client.kcClient.Sign(ctx, actionID)
+	if err == nil {
+		span.SetAttributes(tracing.TxHash(hash))
+	}
+	tracing.EndSpan(span, err)
+
+	return hash, err
+}
+
+// WasSigned calls the inner client's WasSigned method in a new tracing span
+func (client *tracedKCClient) WasSigned(ctx context.Context, actionID uint64) (bool, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.WasSigned", tracing.ActionID(actionID))
+	wasSigned, err := client.kcClient.WasSigned(ctx, actionID)
+	tracing.EndSpan(span, err)
+
+	return wasSigned, err
+}
+
+// PerformAction calls the inner client's PerformAction method in a new tracing span
+func (client *tracedKCClient) PerformAction(ctx context.Context, actionID uint64, batch *bridgeCore.TransferBatch) (string, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.PerformAction", append(batchAttributes(batch), tracing.ActionID(actionID))...)
+	hash, err := client.kcClient.PerformAction(ctx, actionID, batch)
+	if err == nil {
+		span.SetAttributes(tracing.TxHash(hash))
+	}
+	tracing.EndSpan(span, err)
+
+	return hash, err
+}
+
+// CheckClientAvailability calls the inner client's CheckClientAvailability method in a new tracing span
+func (client *tracedKCClient) CheckClientAvailability(ctx context.Context) error {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.CheckClientAvailability")
+	err := client.kcClient.CheckClientAvailability(ctx)
+	tracing.EndSpan(span, err)
+
+	return err
+}
+
+// IsMintBurnToken calls the inner client's IsMintBurnToken method in a new tracing span
+func (client *tracedKCClient) IsMintBurnToken(ctx context.Context, token []byte) (bool, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.IsMintBurnToken")
+	result, err := client.kcClient.IsMintBurnToken(ctx, token)
+	tracing.EndSpan(span, err)
+
+	return result, err
+}
+
+// IsNativeToken calls the inner client's IsNativeToken method in a new tracing span
+func (client *tracedKCClient) IsNativeToken(ctx context.Context, token []byte) (bool, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.IsNativeToken")
+	result, err := client.kcClient.IsNativeToken(ctx, token)
+	tracing.EndSpan(span, err)
+
+	return result, err
+}
+
+// TotalBalances calls the inner client's TotalBalances method in a new tracing span
+func (client *tracedKCClient) TotalBalances(ctx context.Context, token []byte) (*big.Int, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.TotalBalances")
+	value, err := client.kcClient.TotalBalances(ctx, token)
+	tracing.EndSpan(span, err)
+
+	return value, err
+}
+
+// MintBalances calls the inner client's MintBalances method in a new tracing span
+func (client *tracedKCClient) MintBalances(ctx context.Context, token []byte) (*big.Int, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.MintBalances")
+	value, err := client.kcClient.MintBalances(ctx, token)
+	tracing.EndSpan(span, err)
+
+	return value, err
+}
+
+// BurnBalances calls the inner client's BurnBalances method in a new tracing span
+func (client *tracedKCClient) BurnBalances(ctx context.Context, token []byte) (*big.Int, error) {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.BurnBalances")
+	value, err := client.kcClient.BurnBalances(ctx, token)
+	tracing.EndSpan(span, err)
+
+	return value, err
+}
+
+// CheckRequiredBalance calls the inner client's CheckRequiredBalance method in a new tracing span
+func (client *tracedKCClient) CheckRequiredBalance(ctx context.Context, token []byte, value *big.Int) error {
+	ctx, span := tracing.StartSpan(ctx, client.tracer, "KCClient.CheckRequiredBalance")
+	err := client.kcClient.CheckRequiredBalance(ctx, token, value)
+	tracing.EndSpan(span, err)
+
+	return err
+}
+
+// Close closes the inner client
+func (client *tracedKCClient) Close() error {
+	return client.kcClient.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (client *tracedKCClient) IsInterfaceNil() bool {
+	return client == nil
+}
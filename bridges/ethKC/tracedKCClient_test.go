@@ -0,0 +1,208 @@
+package ethKC
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	bridgeCore "github.com/klever-io/klv-bridge-eth-go/core"
+	bridgeTests "github.com/klever-io/klv-bridge-eth-go/testsCommon/bridge"
+	"github.com/klever-io/klv-bridge-eth-go/tracing"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func createRecordingTracer() (trace.Tracer, *tracetest.SpanRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer(tracing.TracerName)
+
+	return tracer, recorder
+}
+
+func spanNames(spans []sdktrace.ReadOnlySpan) []string {
+	names := make([]string, 0, len(spans))
+	for _, span := range spans {
+		names = append(names, span.Name())
+	}
+
+	return names
+}
+
+func TestNewTracedKCClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil client should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewTracedKCClient(nil, noop.NewTracerProvider().Tracer(""))
+		assert.True(t, check.IfNil(client))
+		assert.Equal(t, ErrNilKCClient, err)
+	})
+	t.Run("nil tracer should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewTracedKCClient(&bridgeTests.KCClientStub{}, nil)
+		assert.True(t, check.IfNil(client))
+		assert.Equal(t, ErrNilTracer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewTracedKCClient(&bridgeTests.KCClientStub{}, noop.NewTracerProvider().Tracer(""))
+		assert.False(t, check.IfNil(client))
+		assert.Nil(t, err)
+	})
+}
+
+func TestTracedKCClient_ShouldRecordASpanForEachCall(t *testing.T) {
+	t.Parallel()
+
+	tracer, recorder := createRecordingTracer()
+	client, _ := NewTracedKCClient(&bridgeTests.KCClientStub{}, tracer)
+	ctx := context.Background()
+	batch := &bridgeCore.TransferBatch{ID: 1}
+
+	_, _ = client.GetPendingBatch(ctx)
+	_, _ = client.GetBatch(ctx, 1)
+	_, _ = client.GetCurrentBatchAsDataBytes(ctx)
+	_, _ = client.WasProposedTransfer(ctx, batch)
+	_, _ = client.QuorumReached(ctx, 1)
+	_, _ = client.WasExecuted(ctx, 1)
+	_, _ = client.GetActionIDForProposeTransfer(ctx, batch)
+	_, _ = client.WasProposedSetStatus(ctx, batch)
+	_, _ = client.GetTransactionsStatuses(ctx, 1)
+	_, _ = client.GetActionIDForSetStatusOnPendingTransfer(ctx, batch)
+	_, _ = client.GetLastExecutedEthBatchID(ctx)
+	_, _ = client.GetLastExecutedEthTxID(ctx)
+	_, _ = client.GetLastKCBatchID(ctx)
+	_, _ = client.GetCurrentNonce(ctx)
+	_, _ = client.ConvertEthToKdaAmount(ctx, []byte("token"), big.NewInt(1))
+	_, _ = client.ProposeSetStatus(ctx, batch)
+	_, _ = client.ProposeTransfer(ctx, batch)
+	_, _ = client.Sign(ctx, 1)
+	_, _ = client.WasSigned(ctx, 1)
+	_, _ = client.PerformAction(ctx, 1, batch)
+	_ = client.CheckClientAvailability(ctx)
+	_, _ = client.IsMintBurnToken(ctx, []byte("token"))
+	_, _ = client.IsNativeToken(ctx, []byte("token"))
+	_, _ = client.TotalBalances(ctx, []byte("token"))
+	_, _ = client.MintBalances(ctx, []byte("token"))
+	_, _ = client.BurnBalances(ctx, []byte("token"))
+	_ = client.CheckRequiredBalance(ctx, []byte("token"), big.NewInt(1))
+	_ = client.Close()
+
+	expectedNames := []string{
+		"KCClient.GetPendingBatch",
+		"KCClient.GetBatch",
+		"KCClient.GetCurrentBatchAsDataBytes",
+		"KCClient.WasProposedTransfer",
+		"KCClient.QuorumReached",
+		"KCClient.WasExecuted",
+		"KCClient.GetActionIDForProposeTransfer",
+		"KCClient.WasProposedSetStatus",
+		"KCClient.GetTransactionsStatuses",
+		"KCClient.GetActionIDForSetStatusOnPendingTransfer",
+		"KCClient.GetLastExecutedEthBatchID",
+		"KCClient.GetLastExecutedEthTxID",
+		"KCClient.GetLastKCBatchID",
+		"KCClient.GetCurrentNonce",
+		"KCClient.ConvertEthToKdaAmount",
+		"KCClient.ProposeSetStatus",
+		"KCClient.ProposeTransfer",
+		"KCClient.Sign",
+		"KCClient.WasSigned",
+		"KCClient.PerformAction",
+		"KCClient.CheckClientAvailability",
+		"KCClient.IsMintBurnToken",
+		"KCClient.IsNativeToken",
+		"KCClient.TotalBalances",
+		"KCClient.MintBalances",
+		"KCClient.BurnBalances",
+		"KCClient.CheckRequiredBalance",
+	}
+	assert.Equal(t, expectedNames, spanNames(recorder.Ended()))
+}
+
+func TestTracedKCClient_PerformAction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should tag the span with the context attributes, the action and the tx hash", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, recorder := createRecordingTracer()
+		wasCalled := false
+		stub := &bridgeTests.KCClientStub{
+			PerformActionCalled: func(ctx context.Context, actionID uint64, batch *bridgeCore.TransferBatch) (string, error) {
+				wasCalled = true
+				assert.Equal(t, uint64(112), actionID)
+				assert.True(t, trace.SpanFromContext(ctx).SpanContext().IsValid())
+				return "tx hash", nil
+			},
+		}
+		client, _ := NewTracedKCClient(stub, tracer)
+
+		ctx := tracing.ContextWithAttributes(context.Background(), tracing.Direction("EthereumToKleverBlockchain"))
+		hash, err := client.PerformAction(ctx, 112, &bridgeCore.TransferBatch{ID: 37})
+		assert.Nil(t, err)
+		assert.Equal(t, "tx hash", hash)
+		assert.True(t, wasCalled)
+
+		spans := recorder.Ended()
+		require.Equal(t, 1, len(spans))
+		expectedAttributes := []attribute.KeyValue{
+			tracing.Direction("EthereumToKleverBlockchain"),
+			tracing.BatchID(37),
+			tracing.ActionID(112),
+			tracing.TxHash("tx hash"),
+		}
+		assert.Equal(t, expectedAttributes, spans[0].Attributes())
+		assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	})
+	t.Run("error should be recorded", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, recorder := createRecordingTracer()
+		stub := &bridgeTests.KCClientStub{
+			PerformActionCalled: func(ctx context.Context, actionID uint64, batch *bridgeCore.TransferBatch) (string, error) {
+				return "", expectedErr
+			},
+		}
+		client, _ := NewTracedKCClient(stub, tracer)
+
+		_, err := client.PerformAction(context.Background(), 112, nil)
+		assert.Equal(t, expectedErr, err)
+
+		spans := recorder.Ended()
+		require.Equal(t, 1, len(spans))
+		assert.Equal(t, []attribute.KeyValue{tracing.ActionID(112)}, spans[0].Attributes())
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+		assert.Equal(t, expectedErr.Error(), spans[0].Status().Description)
+	})
+}
+
+func TestTracedKCClient_GetActionIDForProposeTransferShouldTagTheActionID(t *testing.T) {
+	t.Parallel()
+
+	tracer, recorder := createRecordingTracer()
+	stub := &bridgeTests.KCClientStub{
+		GetActionIDForProposeTransferCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) (uint64, error) {
+			return 112, nil
+		},
+	}
+	client, _ := NewTracedKCClient(stub, tracer)
+
+	actionID, err := client.GetActionIDForProposeTransfer(context.Background(), &bridgeCore.TransferBatch{ID: 37})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(112), actionID)
+
+	spans := recorder.Ended()
+	require.Equal(t, 1, len(spans))
+	assert.Equal(t, []attribute.KeyValue{tracing.BatchID(37), tracing.ActionID(112)}, spans[0].Attributes())
+}
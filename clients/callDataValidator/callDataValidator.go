@@ -0,0 +1,101 @@
+package callDataValidator
+
+import (
+	"fmt"
+
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/parsers"
+)
+
+// ArgsCallDataValidator represents the DTO struct used in the NewCallDataValidator constructor function
+type ArgsCallDataValidator struct {
+	Config config.CallDataValidationConfig
+}
+
+// callDataValidator checks that the call data attached to an Ethereum deposit can be decoded according to the bridge
+// protocol. Only this check causes a downgrade as it does not depend on the configuration, so all the relayers propose
+// the same batch. The configured limits and the function name checks are local and are only reported, a 0 value for
+// any of the limits disables that check
+type callDataValidator struct {
+	codec           callDataDecoder
+	maxCallDataSize uint64
+	minGasLimit     uint64
+	maxGasLimit     uint64
+	maxNumArguments uint64
+}
+
+// NewCallDataValidator creates a new instance of type callDataValidator
+func NewCallDataValidator(args ArgsCallDataValidator) (*callDataValidator, error) {
+	cfg := args.Config
+	if cfg.MaxGasLimit > 0 && cfg.MinGasLimit > cfg.MaxGasLimit {
+		return nil, fmt.Errorf("%w: minimum %d, maximum %d", ErrInvalidGasLimitInterval, cfg.MinGasLimit, cfg.MaxGasLimit)
+	}
+
+	return &callDataValidator{
+		codec:           &parsers.KCCodec{},
+		maxCallDataSize: cfg.MaxCallDataSizeInBytes,
+		minGasLimit:     cfg.MinGasLimit,
+		maxGasLimit:     cfg.MaxGasLimit,
+		maxNumArguments: cfg.MaxNumArguments,
+	}, nil
+}
+
+// ValidateCallData returns an error if the provided call data (marker and length included) can not be decoded
+// according to the bridge protocol. The result does not depend on the configuration
+func (validator *callDataValidator) ValidateCallData(data []byte) error {
+	_, err := validator.codec.DecodeCallData(data)
+
+	return err
+}
+
+// CheckConfiguredLimits returns an error describing why the provided call data (marker and length included) does not
+// fit the configured limits or holds a function name the contracts can not expose. The call data should have passed
+// the ValidateCallData check
+func (validator *callDataValidator) CheckConfiguredLimits(data []byte) error {
+	if validator.maxCallDataSize > 0 && uint64(len(data)) > validator.maxCallDataSize {
+		return fmt.Errorf("%w: size %d, maximum %d", ErrCallDataTooLarge, len(data), validator.maxCallDataSize)
+	}
+
+	callData, err := validator.codec.DecodeCallData(data)
+	if err != nil {
+		return err
+	}
+
+	if !isValidFunctionName(callData.Function) {
+		return fmt.Errorf("%w: %q", ErrInvalidFunctionName, callData.Function)
+	}
+	if callData.GasLimit < validator.minGasLimit {
+		return fmt.Errorf("%w: %d, minimum %d", ErrGasLimitOutOfRange, callData.GasLimit, validator.minGasLimit)
+	}
+	if validator.maxGasLimit > 0 && callData.GasLimit > validator.maxGasLimit {
+		return fmt.Errorf("%w: %d, maximum %d", ErrGasLimitOutOfRange, callData.GasLimit, validator.maxGasLimit)
+	}
+	if validator.maxNumArguments > 0 && uint64(len(callData.Arguments)) > validator.maxNumArguments {
+		return fmt.Errorf("%w: %d, maximum %d", ErrTooManyArguments, len(callData.Arguments), validator.maxNumArguments)
+	}
+
+	return nil
+}
+
+// isValidFunctionName returns true if the function name is not empty and contains only ASCII letters, digits and
+// underscores, as the contract endpoints do
+func isValidFunctionName(function string) bool {
+	if len(function) == 0 {
+		return false
+	}
+
+	for _, c := range []byte(function) {
+		isLetter := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+		isDigit := c >= '0' && c <= '9'
+		if !isLetter && !isDigit && c != '_' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (validator *callDataValidator) IsInterfaceNil() bool {
+	return validator == nil
+}
@@ -0,0 +1,176 @@
+package callDataValidator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	bridgeCore "github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/parsers"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsCallDataValidator() ArgsCallDataValidator {
+	return ArgsCallDataValidator{
+		Config: config.CallDataValidationConfig{
+			MaxCallDataSizeInBytes: 1000,
+			MinGasLimit:            1000,
+			MaxGasLimit:            100000,
+			MaxNumArguments:        3,
+		},
+	}
+}
+
+func encodeCallData(t *testing.T, function string, gasLimit uint64, arguments ...string) []byte {
+	codec := &parsers.KCCodec{}
+	buff, err := codec.EncodeCallData(parsers.CallData{
+		Type:      bridgeCore.DataPresentProtocolMarker,
+		Function:  function,
+		GasLimit:  gasLimit,
+		Arguments: arguments,
+	})
+	require.Nil(t, err)
+
+	return buff
+}
+
+func TestNewCallDataValidator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid gas limit interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCallDataValidator()
+		args.Config.MinGasLimit = args.Config.MaxGasLimit + 1
+
+		validator, err := NewCallDataValidator(args)
+		assert.ErrorIs(t, err, ErrInvalidGasLimitInterval)
+		assert.True(t, check.IfNil(validator))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		validator, err := NewCallDataValidator(createMockArgsCallDataValidator())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(validator))
+	})
+	t.Run("should work with all the limits disabled", func(t *testing.T) {
+		t.Parallel()
+
+		validator, err := NewCallDataValidator(ArgsCallDataValidator{})
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(validator))
+
+		args := make([]string, 100)
+		assert.Nil(t, validator.CheckConfiguredLimits(encodeCallData(t, "stake", 0, args...)))
+	})
+}
+
+func TestCallDataValidator_ValidateCallData(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := NewCallDataValidator(createMockArgsCallDataValidator())
+
+	t.Run("not decodable call data should error", func(t *testing.T) {
+		t.Parallel()
+
+		data := []byte{bridgeCore.DataPresentProtocolMarker, 0, 0, 0, 8}
+		data = append(data, "testData"...)
+
+		err := validator.ValidateCallData(data)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for function")
+	})
+	t.Run("trailing bytes should error", func(t *testing.T) {
+		t.Parallel()
+
+		data := append(encodeCallData(t, "stake", 5000, "a"), 0x01)
+
+		err := validator.ValidateCallData(data)
+		assert.NotNil(t, err)
+	})
+	t.Run("should not check the configured limits nor the function name", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, validator.ValidateCallData(encodeCallData(t, "stake", 0)))
+		assert.Nil(t, validator.ValidateCallData(encodeCallData(t, "stake", 100001, "a", "b", "c", "d")))
+		assert.Nil(t, validator.ValidateCallData(encodeCallData(t, "stake", 5000, strings.Repeat("a", 1000))))
+		assert.Nil(t, validator.ValidateCallData(encodeCallData(t, "", 5000)))
+		assert.Nil(t, validator.ValidateCallData(encodeCallData(t, "stake now", 5000)))
+
+		// a large call data with many arguments is valid as long as it can be decoded
+		args := make([]string, 1000)
+		for i := range args {
+			args[i] = strings.Repeat("a", 100)
+		}
+		assert.Nil(t, validator.ValidateCallData(encodeCallData(t, "stake", 5000, args...)))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, validator.ValidateCallData(encodeCallData(t, "stake_V2", 1000)))
+		assert.Nil(t, validator.ValidateCallData(encodeCallData(t, "stake", 100000, "a", "b", "c")))
+	})
+}
+
+func TestCallDataValidator_CheckConfiguredLimits(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := NewCallDataValidator(createMockArgsCallDataValidator())
+
+	t.Run("too large call data should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := validator.CheckConfiguredLimits(encodeCallData(t, "stake", 5000, strings.Repeat("a", 1000)))
+		assert.ErrorIs(t, err, ErrCallDataTooLarge)
+	})
+	t.Run("not decodable call data should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := validator.CheckConfiguredLimits([]byte{bridgeCore.DataPresentProtocolMarker, 0, 0})
+		assert.NotNil(t, err)
+	})
+	t.Run("empty function should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := validator.CheckConfiguredLimits(encodeCallData(t, "", 5000))
+		assert.ErrorIs(t, err, ErrInvalidFunctionName)
+	})
+	t.Run("function with invalid characters should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := validator.CheckConfiguredLimits(encodeCallData(t, "stake now", 5000))
+		assert.ErrorIs(t, err, ErrInvalidFunctionName)
+
+		err = validator.CheckConfiguredLimits(encodeCallData(t, string([]byte{'s', 0x00}), 5000))
+		assert.ErrorIs(t, err, ErrInvalidFunctionName)
+	})
+	t.Run("gas limit too low should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := validator.CheckConfiguredLimits(encodeCallData(t, "stake", 999))
+		assert.ErrorIs(t, err, ErrGasLimitOutOfRange)
+		assert.Contains(t, err.Error(), "minimum 1000")
+	})
+	t.Run("gas limit too high should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := validator.CheckConfiguredLimits(encodeCallData(t, "stake", 100001))
+		assert.ErrorIs(t, err, ErrGasLimitOutOfRange)
+		assert.Contains(t, err.Error(), "maximum 100000")
+	})
+	t.Run("too many arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := validator.CheckConfiguredLimits(encodeCallData(t, "stake", 5000, "a", "b", "c", "d"))
+		assert.ErrorIs(t, err, ErrTooManyArguments)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, validator.CheckConfiguredLimits(encodeCallData(t, "stake_V2", 1000)))
+		assert.Nil(t, validator.CheckConfiguredLimits(encodeCallData(t, "stake", 100000, "a", "b", "c")))
+	})
+}
@@ -0,0 +1,18 @@
+package callDataValidator
+
+import "errors"
+
+// ErrInvalidGasLimitInterval signals that the configured minimum gas limit is greater than the maximum gas limit
+var ErrInvalidGasLimitInterval = errors.New("invalid gas limit interval")
+
+// ErrCallDataTooLarge signals that the call data exceeds the maximum allowed size
+var ErrCallDataTooLarge = errors.New("call data too large")
+
+// ErrInvalidFunctionName signals that the call data contains an invalid function name
+var ErrInvalidFunctionName = errors.New("invalid function name")
+
+// ErrGasLimitOutOfRange signals that the call data contains a gas limit outside the allowed interval
+var ErrGasLimitOutOfRange = errors.New("gas limit out of range")
+
+// ErrTooManyArguments signals that the call data contains more arguments than allowed
+var ErrTooManyArguments = errors.New("too many arguments")
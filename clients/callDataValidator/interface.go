@@ -0,0 +1,7 @@
+package callDataValidator
+
+import "github.com/klever-io/klv-bridge-eth-go/parsers"
+
+type callDataDecoder interface {
+	DecodeCallData(buff []byte) (parsers.CallData, error)
+}
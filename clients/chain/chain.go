@@ -15,6 +15,11 @@ const (
 	kleverBlockchainRoleProviderLogIdTemplate        = "%sKleverBlockchain-KleverBlockchainRoleProvider"
 	evmCompatibleChainRoleProviderLogIdTemplate      = "%sKleverBlockchain-%sRoleProvider"
 	broadcasterLogIdTemplate                         = "%sKleverBlockchain-Broadcaster"
+	tokensRegistryLogIdTemplate                      = "%sKleverBlockchain-TokensRegistry"
+	tokensCacheLogIdTemplate                         = "%sKleverBlockchain-TokensCache"
+	relayersHealthLogIdTemplate                      = "%sKleverBlockchain-RelayersHealth"
+	evmCompatibleChainEventsSubscriberLogIdTemplate  = "%sKleverBlockchain-%sEventsSubscriber"
+	kleverBlockchainBlocksWatcherLogIdTemplate       = "%sKleverBlockchain-KleverBlockchainBlocksWatcher"
 )
 
 // Chain defines all the chain supported
@@ -83,3 +88,28 @@ func (c Chain) EvmCompatibleChainRoleProviderLogId() string {
 func (c Chain) BroadcasterLogId() string {
 	return fmt.Sprintf(broadcasterLogIdTemplate, c)
 }
+
+// TokensRegistryLogId returns the string using chain value and tokensRegistryLogIdTemplate
+func (c Chain) TokensRegistryLogId() string {
+	return fmt.Sprintf(tokensRegistryLogIdTemplate, c)
+}
+
+// TokensCacheLogId returns the string using chain value and tokensCacheLogIdTemplate
+func (c Chain) TokensCacheLogId() string {
+	return fmt.Sprintf(tokensCacheLogIdTemplate, c)
+}
+
+// RelayersHealthLogId returns the string using chain value and relayersHealthLogIdTemplate
+func (c Chain) RelayersHealthLogId() string {
+	return fmt.Sprintf(relayersHealthLogIdTemplate, c)
+}
+
+// EvmCompatibleChainEventsSubscriberLogId returns the string using chain value and evmCompatibleChainEventsSubscriberLogIdTemplate
+func (c Chain) EvmCompatibleChainEventsSubscriberLogId() string {
+	return fmt.Sprintf(evmCompatibleChainEventsSubscriberLogIdTemplate, c, c)
+}
+
+// KleverBlockchainBlocksWatcherLogId returns the string using chain value and kleverBlockchainBlocksWatcherLogIdTemplate
+func (c Chain) KleverBlockchainBlocksWatcherLogId() string {
+	return fmt.Sprintf(kleverBlockchainBlocksWatcherLogIdTemplate, c)
+}
@@ -51,8 +51,33 @@ func Test_broadcasterLogId(t *testing.T) {
 	assert.Equal(t, "BscKleverBlockchain-Broadcaster", Bsc.BroadcasterLogId())
 }
 
+func Test_tokensRegistryLogId(t *testing.T) {
+	assert.Equal(t, "EthereumKleverBlockchain-TokensRegistry", Ethereum.TokensRegistryLogId())
+	assert.Equal(t, "BscKleverBlockchain-TokensRegistry", Bsc.TokensRegistryLogId())
+}
+
+func Test_tokensCacheLogId(t *testing.T) {
+	assert.Equal(t, "EthereumKleverBlockchain-TokensCache", Ethereum.TokensCacheLogId())
+	assert.Equal(t, "BscKleverBlockchain-TokensCache", Bsc.TokensCacheLogId())
+}
+
+func Test_relayersHealthLogId(t *testing.T) {
+	assert.Equal(t, "EthereumKleverBlockchain-RelayersHealth", Ethereum.RelayersHealthLogId())
+	assert.Equal(t, "BscKleverBlockchain-RelayersHealth", Bsc.RelayersHealthLogId())
+}
+
 func TestToLower(t *testing.T) {
 	assert.Equal(t, "klv", KleverBlockchain.ToLower())
 	assert.Equal(t, "ethereum", Ethereum.ToLower())
 	assert.Equal(t, "bsc", Bsc.ToLower())
 }
+
+func Test_ethEventsSubscriberLogId(t *testing.T) {
+	assert.Equal(t, "EthereumKleverBlockchain-EthereumEventsSubscriber", Ethereum.EvmCompatibleChainEventsSubscriberLogId())
+	assert.Equal(t, "BscKleverBlockchain-BscEventsSubscriber", Bsc.EvmCompatibleChainEventsSubscriberLogId())
+}
+
+func Test_kleverBlockchainBlocksWatcherLogId(t *testing.T) {
+	assert.Equal(t, "EthereumKleverBlockchain-KleverBlockchainBlocksWatcher", Ethereum.KleverBlockchainBlocksWatcherLogId())
+	assert.Equal(t, "BscKleverBlockchain-KleverBlockchainBlocksWatcher", Bsc.KleverBlockchainBlocksWatcherLogId())
+}
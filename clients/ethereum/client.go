@@ -255,6 +255,11 @@ func (c *client) BroadcastSignatureForMessageHash(msgHash common.Hash) {
 	c.broadcaster.BroadcastSignature(signature, msgHash.Bytes())
 }
 
+// RequestSignaturesForMessageHash will ask the other relayers to directly send their signature for the provided message hash
+func (c *client) RequestSignaturesForMessageHash(msgHash common.Hash) {
+	c.broadcaster.RequestSignatures(msgHash.Bytes())
+}
+
 // GenerateMessageHash will generate the message hash based on the provided batch
 func (c *client) GenerateMessageHash(batch *batchProcessor.ArgListsBatch, batchId uint64) (common.Hash, error) {
 	return GenerateMessageHash(batch, batchId)
@@ -381,7 +386,7 @@ func (c *client) ExecuteTransfer(
 	}
 
 	txHash := tx.Hash().String()
-	c.log.Info("Executed transfer transaction", "batchID", batchID, "hash", txHash)
+	c.log.Info("Executed transfer transaction", "batchID", batchID, "tx hash", txHash)
 
 	return txHash, err
 }
@@ -522,6 +522,25 @@ func TestClient_BroadcastSignatureForMessageHash(t *testing.T) {
 	})
 }
 
+func TestClient_RequestSignaturesForMessageHash(t *testing.T) {
+	t.Parallel()
+
+	requestCalled := false
+	hash := common.HexToHash("hash")
+	args := createMockEthereumClientArgs()
+	args.Broadcaster = &testsCommon.BroadcasterStub{
+		RequestSignaturesCalled: func(messageHash []byte) {
+			assert.Equal(t, hash.Bytes(), messageHash)
+			requestCalled = true
+		},
+	}
+
+	c, _ := NewEthereumClient(args)
+	c.RequestSignaturesForMessageHash(hash)
+
+	assert.True(t, requestCalled)
+}
+
 func TestClient_WasExecuted(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,10 @@
+package eventsSubscriber
+
+import "errors"
+
+var (
+	errNilLogsSubscriber   = errors.New("nil logs subscriber")
+	errSubscriptionClosed  = errors.New("subscription closed")
+	errUnknownEvent        = errors.New("unknown event")
+	errMissingBatchIDTopic = errors.New("missing batch ID topic")
+)
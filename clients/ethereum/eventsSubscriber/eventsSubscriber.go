@@ -0,0 +1,268 @@
+package eventsSubscriber
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/klever-io/klv-bridge-eth-go/clients"
+	"github.com/klever-io/klv-bridge-eth-go/clients/ethereum/contract"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	minResubscribeInterval = time.Second
+	minMaxWaitTime         = time.Second
+	logsChanSize           = 100
+	depositEventName       = "ERC20Deposit"
+	scDepositEventName     = "ERC20SCDeposit"
+)
+
+// ArgsEventsSubscriber is the DTO used in the NewEventsSubscriber constructor function
+type ArgsEventsSubscriber struct {
+	Log                 logger.Logger
+	LogsSubscriber      LogsSubscriber
+	SafeContractAddress common.Address
+	ResubscribeInterval time.Duration
+	MaxWaitTime         time.Duration
+}
+
+// eventsSubscriber listens for the deposit events emitted by the Ethereum safe contract so the
+// Ethereum to Klever Blockchain flow can wait for new batches instead of repeatedly polling the contract
+type eventsSubscriber struct {
+	log                 logger.Logger
+	logsSubscriber      LogsSubscriber
+	safeContractAddress common.Address
+	resubscribeInterval time.Duration
+	maxWaitTime         time.Duration
+	safeAbi             abi.ABI
+	cancel              func()
+
+	mut             sync.RWMutex
+	isSubscribed    bool
+	lastSeenBatchID uint64
+	notifyChan      chan struct{}
+}
+
+// NewEventsSubscriber creates a new Ethereum events subscriber and starts its subscription loop
+func NewEventsSubscriber(args ArgsEventsSubscriber) (*eventsSubscriber, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	safeAbi, err := contract.ERC20SafeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	subscriber := &eventsSubscriber{
+		log:                 args.Log,
+		logsSubscriber:      args.LogsSubscriber,
+		safeContractAddress: args.SafeContractAddress,
+		resubscribeInterval: args.ResubscribeInterval,
+		maxWaitTime:         args.MaxWaitTime,
+		safeAbi:             *safeAbi,
+		notifyChan:          make(chan struct{}),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	subscriber.cancel = cancel
+	go subscriber.processLoop(ctx)
+
+	return subscriber, nil
+}
+
+func checkArgs(args ArgsEventsSubscriber) error {
+	if check.IfNil(args.Log) {
+		return clients.ErrNilLogger
+	}
+	if args.LogsSubscriber == nil {
+		return errNilLogsSubscriber
+	}
+	if args.ResubscribeInterval < minResubscribeInterval {
+		return fmt.Errorf("%w for ResubscribeInterval, got: %v, minimum: %v",
+			clients.ErrInvalidValue, args.ResubscribeInterval, minResubscribeInterval)
+	}
+	if args.MaxWaitTime < minMaxWaitTime {
+		return fmt.Errorf("%w for MaxWaitTime, got: %v, minimum: %v",
+			clients.ErrInvalidValue, args.MaxWaitTime, minMaxWaitTime)
+	}
+
+	return nil
+}
+
+func (subscriber *eventsSubscriber) processLoop(ctx context.Context) {
+	for {
+		err := subscriber.subscribeAndListen(ctx)
+		subscriber.setSubscribed(false)
+		if ctx.Err() != nil {
+			subscriber.log.Debug("Ethereum events subscriber's loop is closing...")
+			return
+		}
+
+		subscriber.log.Warn("Ethereum events subscription dropped, falling back to polling",
+			"error", err, "retrying after", subscriber.resubscribeInterval)
+
+		select {
+		case <-time.After(subscriber.resubscribeInterval):
+		case <-ctx.Done():
+			subscriber.log.Debug("Ethereum events subscriber's loop is closing...")
+			return
+		}
+	}
+}
+
+func (subscriber *eventsSubscriber) subscribeAndListen(ctx context.Context) error {
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{subscriber.safeContractAddress},
+		Topics: [][]common.Hash{
+			{
+				subscriber.safeAbi.Events[depositEventName].ID,
+				subscriber.safeAbi.Events[scDepositEventName].ID,
+			},
+		},
+	}
+
+	logsChan := make(chan types.Log, logsChanSize)
+	subscription, err := subscriber.logsSubscriber.SubscribeFilterLogs(ctx, query, logsChan)
+	if err != nil {
+		return err
+	}
+	defer subscription.Unsubscribe()
+
+	subscriber.log.Info("subscribed to the Ethereum safe deposit events", "safe", subscriber.safeContractAddress.String())
+	subscriber.setSubscribed(true)
+
+	for {
+		select {
+		case err = <-subscription.Err():
+			if err == nil {
+				err = errSubscriptionClosed
+			}
+			return err
+		case vLog := <-logsChan:
+			subscriber.processLog(vLog)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (subscriber *eventsSubscriber) processLog(vLog types.Log) {
+	if vLog.Removed {
+		subscriber.log.Debug("ignoring removed Ethereum log", "block", vLog.BlockNumber, "tx", vLog.TxHash.String())
+		return
+	}
+
+	batchID, err := subscriber.extractBatchID(vLog)
+	if err != nil {
+		subscriber.log.Warn("could not extract the batch ID from the Ethereum log",
+			"block", vLog.BlockNumber, "tx", vLog.TxHash.String(), "error", err)
+		return
+	}
+
+	subscriber.log.Debug("new deposit event on Ethereum", "batch ID", batchID, "block", vLog.BlockNumber)
+
+	subscriber.mut.Lock()
+	if batchID > subscriber.lastSeenBatchID {
+		subscriber.lastSeenBatchID = batchID
+	}
+	subscriber.notifyWaiters()
+	subscriber.mut.Unlock()
+}
+
+func (subscriber *eventsSubscriber) extractBatchID(vLog types.Log) (uint64, error) {
+	if len(vLog.Topics) == 0 {
+		return 0, errUnknownEvent
+	}
+
+	switch vLog.Topics[0] {
+	case subscriber.safeAbi.Events[depositEventName].ID:
+		event := new(contract.ERC20SafeERC20Deposit)
+		err := subscriber.safeAbi.UnpackIntoInterface(event, depositEventName, vLog.Data)
+		if err != nil {
+			return 0, err
+		}
+
+		return event.BatchId.Uint64(), nil
+	case subscriber.safeAbi.Events[scDepositEventName].ID:
+		// the batch ID is an indexed argument, so it is found in the topics and not in the data field
+		if len(vLog.Topics) < 2 {
+			return 0, errMissingBatchIDTopic
+		}
+
+		return big.NewInt(0).SetBytes(vLog.Topics[1].Bytes()).Uint64(), nil
+	default:
+		return 0, fmt.Errorf("%w with topic %s", errUnknownEvent, vLog.Topics[0].String())
+	}
+}
+
+func (subscriber *eventsSubscriber) setSubscribed(isSubscribed bool) {
+	subscriber.mut.Lock()
+	defer subscriber.mut.Unlock()
+
+	subscriber.isSubscribed = isSubscribed
+	// the waiters are released on any subscription change: they will either poll as a fallback or
+	// re-check the contract for the events possibly emitted while the subscription was down
+	subscriber.notifyWaiters()
+}
+
+// notifyWaiters should be called under mutex protection
+func (subscriber *eventsSubscriber) notifyWaiters() {
+	close(subscriber.notifyChan)
+	subscriber.notifyChan = make(chan struct{})
+}
+
+// WaitForBatch blocks until a deposit event for the provided batch ID (or a newer one) is seen, the maximum
+// wait time elapses or the context is done. It returns immediately if the subscription is not active
+func (subscriber *eventsSubscriber) WaitForBatch(ctx context.Context, batchID uint64) {
+	timer := time.NewTimer(subscriber.maxWaitTime)
+	defer timer.Stop()
+
+	subscriber.mut.RLock()
+	isSubscribed := subscriber.isSubscribed
+	lastSeenBatchID := subscriber.lastSeenBatchID
+	notifyChan := subscriber.notifyChan
+	subscriber.mut.RUnlock()
+
+	if !isSubscribed || lastSeenBatchID >= batchID {
+		return
+	}
+
+	subscriber.log.Debug("waiting for a deposit event on Ethereum", "batch ID", batchID, "last seen batch ID", lastSeenBatchID)
+
+	select {
+	case <-notifyChan:
+	case <-timer.C:
+		subscriber.log.Debug("no deposit event on Ethereum, polling the safe contract", "batch ID", batchID)
+	case <-ctx.Done():
+	}
+}
+
+// IsSubscribed returns true if the events subscription is active
+func (subscriber *eventsSubscriber) IsSubscribed() bool {
+	subscriber.mut.RLock()
+	defer subscriber.mut.RUnlock()
+
+	return subscriber.isSubscribed
+}
+
+// Close stops the subscription loop
+func (subscriber *eventsSubscriber) Close() error {
+	subscriber.cancel()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (subscriber *eventsSubscriber) IsInterfaceNil() bool {
+	return subscriber == nil
+}
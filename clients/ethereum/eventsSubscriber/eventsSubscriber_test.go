@@ -0,0 +1,319 @@
+package eventsSubscriber
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/klever-io/klv-bridge-eth-go/clients"
+	"github.com/klever-io/klv-bridge-eth-go/clients/ethereum/contract"
+	bridgeTests "github.com/klever-io/klv-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var safeContractAddress = common.HexToAddress("0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c")
+
+func createMockArgsEventsSubscriber() ArgsEventsSubscriber {
+	return ArgsEventsSubscriber{
+		Log:                 logger.GetOrCreate("test"),
+		LogsSubscriber:      &bridgeTests.LogsSubscriberStub{},
+		SafeContractAddress: safeContractAddress,
+		ResubscribeInterval: time.Second,
+		MaxWaitTime:         time.Second,
+	}
+}
+
+type subscriptionsHolder struct {
+	mut           sync.Mutex
+	numCalls      int
+	logsChans     []chan<- types.Log
+	subscriptions []*bridgeTests.SubscriptionStub
+}
+
+func (holder *subscriptionsHolder) subscribeFilterLogs(_ context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	holder.numCalls++
+	subscription := bridgeTests.NewSubscriptionStub()
+	holder.logsChans = append(holder.logsChans, ch)
+	holder.subscriptions = append(holder.subscriptions, subscription)
+
+	return subscription, nil
+}
+
+func (holder *subscriptionsHolder) last() (chan<- types.Log, *bridgeTests.SubscriptionStub) {
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	index := len(holder.logsChans) - 1
+
+	return holder.logsChans[index], holder.subscriptions[index]
+}
+
+func (holder *subscriptionsHolder) getNumCalls() int {
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	return holder.numCalls
+}
+
+func createDepositLog(t *testing.T, batchID int64) types.Log {
+	safeAbi, err := contract.ERC20SafeMetaData.GetAbi()
+	require.Nil(t, err)
+
+	data, err := safeAbi.Events[depositEventName].Inputs.Pack(big.NewInt(batchID), big.NewInt(1))
+	require.Nil(t, err)
+
+	return types.Log{
+		Address: safeContractAddress,
+		Topics:  []common.Hash{safeAbi.Events[depositEventName].ID},
+		Data:    data,
+	}
+}
+
+func createSCDepositLog(t *testing.T, batchID int64) types.Log {
+	safeAbi, err := contract.ERC20SafeMetaData.GetAbi()
+	require.Nil(t, err)
+
+	data, err := safeAbi.Events[scDepositEventName].Inputs.NonIndexed().Pack(big.NewInt(1), []byte("call data"))
+	require.Nil(t, err)
+
+	return types.Log{
+		Address: safeContractAddress,
+		Topics: []common.Hash{
+			safeAbi.Events[scDepositEventName].ID,
+			common.BigToHash(big.NewInt(batchID)),
+		},
+		Data: data,
+	}
+}
+
+func createSubscribedInstance(t *testing.T, holder *subscriptionsHolder, maxWaitTime time.Duration) *eventsSubscriber {
+	args := createMockArgsEventsSubscriber()
+	args.MaxWaitTime = maxWaitTime
+	args.LogsSubscriber = &bridgeTests.LogsSubscriberStub{
+		SubscribeFilterLogsCalled: holder.subscribeFilterLogs,
+	}
+
+	subscriber, err := NewEventsSubscriber(args)
+	require.Nil(t, err)
+	require.Eventually(t, subscriber.IsSubscribed, time.Second*5, time.Millisecond*10)
+
+	return subscriber
+}
+
+func waitForBatchAsync(subscriber *eventsSubscriber, batchID uint64) chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		subscriber.WaitForBatch(context.Background(), batchID)
+		close(done)
+	}()
+
+	return done
+}
+
+func isDone(done chan struct{}) bool {
+	select {
+	case <-done:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestNewEventsSubscriber(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsSubscriber()
+		args.Log = nil
+
+		subscriber, err := NewEventsSubscriber(args)
+		assert.True(t, check.IfNil(subscriber))
+		assert.Equal(t, clients.ErrNilLogger, err)
+	})
+	t.Run("nil logs subscriber should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsSubscriber()
+		args.LogsSubscriber = nil
+
+		subscriber, err := NewEventsSubscriber(args)
+		assert.True(t, check.IfNil(subscriber))
+		assert.Equal(t, errNilLogsSubscriber, err)
+	})
+	t.Run("invalid resubscribe interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsSubscriber()
+		args.ResubscribeInterval = time.Millisecond
+
+		subscriber, err := NewEventsSubscriber(args)
+		assert.True(t, check.IfNil(subscriber))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "for ResubscribeInterval"))
+	})
+	t.Run("invalid max wait time should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsSubscriber()
+		args.MaxWaitTime = time.Millisecond
+
+		subscriber, err := NewEventsSubscriber(args)
+		assert.True(t, check.IfNil(subscriber))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "for MaxWaitTime"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		subscriber, err := NewEventsSubscriber(createMockArgsEventsSubscriber())
+		assert.False(t, check.IfNil(subscriber))
+		assert.Nil(t, err)
+
+		_ = subscriber.Close()
+	})
+}
+
+func TestEventsSubscriber_WaitForBatch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("subscription not active should not wait", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsSubscriber()
+		args.MaxWaitTime = time.Hour
+		args.LogsSubscriber = &bridgeTests.LogsSubscriberStub{
+			SubscribeFilterLogsCalled: func(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+				return nil, errors.New("websocket not available")
+			},
+		}
+		subscriber, _ := NewEventsSubscriber(args)
+		defer func() {
+			_ = subscriber.Close()
+		}()
+
+		done := waitForBatchAsync(subscriber, 1)
+		assert.Eventually(t, func() bool {
+			return isDone(done)
+		}, time.Second, time.Millisecond*10)
+		assert.False(t, subscriber.IsSubscribed())
+	})
+	t.Run("should wait until the batch deposit event is received", func(t *testing.T) {
+		t.Parallel()
+
+		holder := &subscriptionsHolder{}
+		subscriber := createSubscribedInstance(t, holder, time.Hour)
+		defer func() {
+			_ = subscriber.Close()
+		}()
+
+		logsChan, _ := holder.last()
+		done := waitForBatchAsync(subscriber, 5)
+		time.Sleep(time.Millisecond * 100)
+		assert.False(t, isDone(done))
+
+		logsChan <- createDepositLog(t, 5)
+		assert.Eventually(t, func() bool {
+			return isDone(done)
+		}, time.Second, time.Millisecond*10)
+
+		// already seen batch should not wait
+		subscriber.WaitForBatch(context.Background(), 5)
+
+		done = waitForBatchAsync(subscriber, 6)
+		logsChan <- createSCDepositLog(t, 6)
+		assert.Eventually(t, func() bool {
+			return isDone(done)
+		}, time.Second, time.Millisecond*10)
+	})
+	t.Run("removed logs should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		holder := &subscriptionsHolder{}
+		subscriber := createSubscribedInstance(t, holder, time.Hour)
+		defer func() {
+			_ = subscriber.Close()
+		}()
+
+		logsChan, _ := holder.last()
+		removedLog := createDepositLog(t, 5)
+		removedLog.Removed = true
+		logsChan <- removedLog
+		logsChan <- types.Log{Topics: []common.Hash{common.HexToHash("0x1")}}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*200)
+		defer cancel()
+		start := time.Now()
+		subscriber.WaitForBatch(ctx, 5)
+		assert.GreaterOrEqual(t, time.Since(start), time.Millisecond*200)
+	})
+	t.Run("should return after the max wait time", func(t *testing.T) {
+		t.Parallel()
+
+		holder := &subscriptionsHolder{}
+		subscriber := createSubscribedInstance(t, holder, time.Second)
+		defer func() {
+			_ = subscriber.Close()
+		}()
+
+		start := time.Now()
+		subscriber.WaitForBatch(context.Background(), 5)
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	})
+	t.Run("dropped subscription should release the waiters and resubscribe", func(t *testing.T) {
+		t.Parallel()
+
+		holder := &subscriptionsHolder{}
+		subscriber := createSubscribedInstance(t, holder, time.Hour)
+		defer func() {
+			_ = subscriber.Close()
+		}()
+
+		done := waitForBatchAsync(subscriber, 5)
+		_, subscription := holder.last()
+		subscription.ErrChan <- errors.New("connection lost")
+
+		assert.Eventually(t, func() bool {
+			return isDone(done)
+		}, time.Second, time.Millisecond*10)
+		assert.Eventually(t, func() bool {
+			return holder.getNumCalls() == 2 && subscriber.IsSubscribed()
+		}, time.Second*5, time.Millisecond*10)
+	})
+}
+
+func TestEventsSubscriber_Close(t *testing.T) {
+	t.Parallel()
+
+	holder := &subscriptionsHolder{}
+	subscriber := createSubscribedInstance(t, holder, time.Hour)
+
+	unsubscribed := make(chan struct{})
+	_, subscription := holder.last()
+	subscription.UnsubscribeCalled = func() {
+		close(unsubscribed)
+	}
+
+	err := subscriber.Close()
+	assert.Nil(t, err)
+	assert.Eventually(t, func() bool {
+		return isDone(unsubscribed)
+	}, time.Second, time.Millisecond*10)
+	assert.Eventually(t, func() bool {
+		return !subscriber.IsSubscribed()
+	}, time.Second, time.Millisecond*10)
+}
@@ -0,0 +1,13 @@
+package eventsSubscriber
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// LogsSubscriber defines the Ethereum client operation able to stream the new logs (requires a websocket connection)
+type LogsSubscriber interface {
+	SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error)
+}
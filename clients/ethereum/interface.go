@@ -48,6 +48,7 @@ type Erc20ContractsHolder interface {
 // Broadcaster defines the operations for a component used for communication with other peers
 type Broadcaster interface {
 	BroadcastSignature(signature []byte, messageHash []byte)
+	RequestSignatures(messageHash []byte)
 	IsInterfaceNil() bool
 }
 
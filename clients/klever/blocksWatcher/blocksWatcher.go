@@ -0,0 +1,232 @@
+package blocksWatcher
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/clients"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/proxy/models"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	minMaxWaitTime      = time.Second
+	minMaxBlocksPerPoll = 1
+)
+
+// ArgsBlocksWatcher is the DTO used in the NewBlocksWatcher constructor function
+type ArgsBlocksWatcher struct {
+	Log                 logger.Logger
+	Proxy               BlocksProxy
+	SafeContractAddress address.Address
+	ActivityWindow      time.Duration
+	MaxWaitTime         time.Duration
+	MaxBlocksPerPoll    uint64
+}
+
+// blocksWatcher follows the new Klever Blockchain blocks and detects the transactions touching the safe contract,
+// so the Klever Blockchain to Ethereum flow only runs its VM queries when a new batch can exist
+type blocksWatcher struct {
+	log                 logger.Logger
+	proxy               BlocksProxy
+	safeContractAddress string
+	activityWindow      time.Duration
+	maxWaitTime         time.Duration
+	maxBlocksPerPoll    uint64
+	getTimeFunc         func() time.Time
+
+	mut              sync.RWMutex
+	isSynced         bool
+	lastCheckedNonce uint64
+	lastActivityTime time.Time
+	notifyChan       chan struct{}
+}
+
+// NewBlocksWatcher creates a new Klever Blockchain blocks watcher
+func NewBlocksWatcher(args ArgsBlocksWatcher) (*blocksWatcher, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blocksWatcher{
+		log:                 args.Log,
+		proxy:               args.Proxy,
+		safeContractAddress: args.SafeContractAddress.Bech32(),
+		activityWindow:      args.ActivityWindow,
+		maxWaitTime:         args.MaxWaitTime,
+		maxBlocksPerPoll:    args.MaxBlocksPerPoll,
+		getTimeFunc:         time.Now,
+		notifyChan:          make(chan struct{}),
+	}, nil
+}
+
+func checkArgs(args ArgsBlocksWatcher) error {
+	if check.IfNil(args.Log) {
+		return clients.ErrNilLogger
+	}
+	if check.IfNil(args.Proxy) {
+		return errNilProxy
+	}
+	if check.IfNil(args.SafeContractAddress) {
+		return errNilSafeContractAddress
+	}
+	if args.ActivityWindow < 0 {
+		return fmt.Errorf("%w for ActivityWindow, got: %v", clients.ErrInvalidValue, args.ActivityWindow)
+	}
+	if args.MaxWaitTime < minMaxWaitTime {
+		return fmt.Errorf("%w for MaxWaitTime, got: %v, minimum: %v",
+			clients.ErrInvalidValue, args.MaxWaitTime, minMaxWaitTime)
+	}
+	if args.MaxBlocksPerPoll < minMaxBlocksPerPoll {
+		return fmt.Errorf("%w for MaxBlocksPerPoll, got: %d, minimum: %d",
+			clients.ErrInvalidValue, args.MaxBlocksPerPoll, minMaxBlocksPerPoll)
+	}
+
+	return nil
+}
+
+// Execute will check all the blocks produced since the last call for transactions touching the safe contract
+func (watcher *blocksWatcher) Execute(ctx context.Context) error {
+	status, err := watcher.proxy.GetNetworkStatus(ctx)
+	if err != nil {
+		watcher.setNotSynced()
+		return err
+	}
+
+	lastCheckedNonce := watcher.getLastCheckedNonce()
+	if lastCheckedNonce == 0 {
+		// first run, the relayer will anyway query the safe on its first step
+		watcher.log.Debug("blocks watcher started", "nonce", status.Nonce)
+		watcher.setLastCheckedNonce(status.Nonce)
+		watcher.recordActivity()
+		return nil
+	}
+
+	if status.Nonce > lastCheckedNonce+watcher.maxBlocksPerPoll {
+		watcher.log.Debug("blocks watcher is behind, skipping blocks",
+			"last checked nonce", lastCheckedNonce, "current nonce", status.Nonce)
+		lastCheckedNonce = status.Nonce - watcher.maxBlocksPerPoll
+		watcher.setLastCheckedNonce(lastCheckedNonce)
+		// the skipped blocks might contain deposits
+		watcher.recordActivity()
+	}
+
+	for nonce := lastCheckedNonce + 1; nonce <= status.Nonce; nonce++ {
+		block, errGet := watcher.proxy.GetBlockByNonce(ctx, nonce)
+		if errGet != nil {
+			watcher.setNotSynced()
+			return fmt.Errorf("%w while fetching block %d", errGet, nonce)
+		}
+
+		if watcher.hasSafeActivity(block) {
+			watcher.log.Debug("safe contract activity detected", "block nonce", nonce, "block hash", block.Hash)
+			watcher.recordActivity()
+		}
+		watcher.setLastCheckedNonce(nonce)
+	}
+
+	watcher.setSynced()
+
+	return nil
+}
+
+func (watcher *blocksWatcher) hasSafeActivity(block *models.Block) bool {
+	for _, tx := range block.Transactions {
+		if tx == nil || tx.Logs == nil {
+			continue
+		}
+		if tx.Logs.Address == watcher.safeContractAddress {
+			return true
+		}
+		for _, event := range tx.Logs.Events {
+			if event != nil && event.Address == watcher.safeContractAddress {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (watcher *blocksWatcher) getLastCheckedNonce() uint64 {
+	watcher.mut.RLock()
+	defer watcher.mut.RUnlock()
+
+	return watcher.lastCheckedNonce
+}
+
+func (watcher *blocksWatcher) setLastCheckedNonce(nonce uint64) {
+	watcher.mut.Lock()
+	defer watcher.mut.Unlock()
+
+	watcher.lastCheckedNonce = nonce
+}
+
+func (watcher *blocksWatcher) recordActivity() {
+	watcher.mut.Lock()
+	defer watcher.mut.Unlock()
+
+	watcher.lastActivityTime = watcher.getTimeFunc()
+	watcher.notifyWaiters()
+}
+
+func (watcher *blocksWatcher) setSynced() {
+	watcher.mut.Lock()
+	defer watcher.mut.Unlock()
+
+	watcher.isSynced = true
+}
+
+func (watcher *blocksWatcher) setNotSynced() {
+	watcher.mut.Lock()
+	defer watcher.mut.Unlock()
+
+	if !watcher.isSynced {
+		return
+	}
+
+	watcher.log.Debug("blocks watcher is not synced, falling back to polling")
+	watcher.isSynced = false
+	watcher.notifyWaiters()
+}
+
+// notifyWaiters should be called under mutex protection
+func (watcher *blocksWatcher) notifyWaiters() {
+	close(watcher.notifyChan)
+	watcher.notifyChan = make(chan struct{})
+}
+
+// WaitForSafeActivity blocks until a transaction touching the safe contract is detected, the maximum wait time
+// elapses or the context is done. It returns immediately if the watcher is not synced or if the safe was
+// touched in the last activity window, as the created batches become final only after some time
+func (watcher *blocksWatcher) WaitForSafeActivity(ctx context.Context) {
+	timer := time.NewTimer(watcher.maxWaitTime)
+	defer timer.Stop()
+
+	watcher.mut.RLock()
+	isSynced := watcher.isSynced
+	isActivityRecent := watcher.getTimeFunc().Sub(watcher.lastActivityTime) < watcher.activityWindow
+	notifyChan := watcher.notifyChan
+	watcher.mut.RUnlock()
+
+	if !isSynced || isActivityRecent {
+		return
+	}
+
+	select {
+	case <-notifyChan:
+	case <-timer.C:
+		watcher.log.Debug("no safe contract activity, polling the safe contract")
+	case <-ctx.Done():
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (watcher *blocksWatcher) IsInterfaceNil() bool {
+	return watcher == nil
+}
@@ -0,0 +1,357 @@
+package blocksWatcher
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/clients"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/proxy/models"
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon/interactors"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const safeBech32Address = "klv1qqqqqqqqqqqqqpgqh46r9zh78lry2py8tq723fpjdr4pp0zgsg8syf6mq0"
+
+func createMockArgsBlocksWatcher() ArgsBlocksWatcher {
+	safeAddress, _ := address.NewAddress(safeBech32Address)
+
+	return ArgsBlocksWatcher{
+		Log:                 logger.GetOrCreate("test"),
+		Proxy:               &interactors.ProxyStub{},
+		SafeContractAddress: safeAddress,
+		ActivityWindow:      time.Minute,
+		MaxWaitTime:         time.Second,
+		MaxBlocksPerPoll:    10,
+	}
+}
+
+func createBlock(addresses ...string) *models.Block {
+	block := &models.Block{
+		Hash: "hash",
+	}
+	for _, addr := range addresses {
+		block.Transactions = append(block.Transactions, &models.BlockTransaction{
+			Logs: &models.TransactionLogs{
+				Events: []*models.TransactionEvent{
+					{
+						Address: addr,
+					},
+				},
+			},
+		})
+	}
+
+	return block
+}
+
+func TestNewBlocksWatcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksWatcher()
+		args.Log = nil
+
+		watcher, err := NewBlocksWatcher(args)
+		assert.True(t, check.IfNil(watcher))
+		assert.Equal(t, clients.ErrNilLogger, err)
+	})
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksWatcher()
+		args.Proxy = nil
+
+		watcher, err := NewBlocksWatcher(args)
+		assert.True(t, check.IfNil(watcher))
+		assert.Equal(t, errNilProxy, err)
+	})
+	t.Run("nil safe contract address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksWatcher()
+		args.SafeContractAddress = nil
+
+		watcher, err := NewBlocksWatcher(args)
+		assert.True(t, check.IfNil(watcher))
+		assert.Equal(t, errNilSafeContractAddress, err)
+	})
+	t.Run("invalid activity window should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksWatcher()
+		args.ActivityWindow = -time.Second
+
+		watcher, err := NewBlocksWatcher(args)
+		assert.True(t, check.IfNil(watcher))
+		assert.ErrorIs(t, err, clients.ErrInvalidValue)
+		assert.True(t, strings.Contains(err.Error(), "ActivityWindow"))
+	})
+	t.Run("invalid max wait time should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksWatcher()
+		args.MaxWaitTime = time.Millisecond
+
+		watcher, err := NewBlocksWatcher(args)
+		assert.True(t, check.IfNil(watcher))
+		assert.ErrorIs(t, err, clients.ErrInvalidValue)
+		assert.True(t, strings.Contains(err.Error(), "MaxWaitTime"))
+	})
+	t.Run("invalid max blocks per poll should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksWatcher()
+		args.MaxBlocksPerPoll = 0
+
+		watcher, err := NewBlocksWatcher(args)
+		assert.True(t, check.IfNil(watcher))
+		assert.ErrorIs(t, err, clients.ErrInvalidValue)
+		assert.True(t, strings.Contains(err.Error(), "MaxBlocksPerPoll"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		watcher, err := NewBlocksWatcher(createMockArgsBlocksWatcher())
+		assert.False(t, check.IfNil(watcher))
+		assert.Nil(t, err)
+	})
+}
+
+func TestBlocksWatcher_Execute(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	t.Run("get network status errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksWatcher()
+		args.Proxy = &interactors.ProxyStub{
+			GetNetworkStatusCalled: func(ctx context.Context) (*models.NodeOverview, error) {
+				return nil, expectedErr
+			},
+		}
+		watcher, _ := NewBlocksWatcher(args)
+
+		err := watcher.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.False(t, watcher.isSynced)
+	})
+	t.Run("first run should only record the current nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksWatcher()
+		args.Proxy = &interactors.ProxyStub{
+			GetNetworkStatusCalled: func(ctx context.Context) (*models.NodeOverview, error) {
+				return &models.NodeOverview{Nonce: 100}, nil
+			},
+			GetBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*models.Block, error) {
+				assert.Fail(t, "should have not called GetBlockByNonce")
+				return nil, nil
+			},
+		}
+		watcher, _ := NewBlocksWatcher(args)
+
+		err := watcher.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(100), watcher.lastCheckedNonce)
+		assert.False(t, watcher.lastActivityTime.IsZero())
+	})
+	t.Run("should check all new blocks and detect the safe activity", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksWatcher()
+		var fetchedNonces []uint64
+		args.Proxy = &interactors.ProxyStub{
+			GetNetworkStatusCalled: func(ctx context.Context) (*models.NodeOverview, error) {
+				return &models.NodeOverview{Nonce: 103}, nil
+			},
+			GetBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*models.Block, error) {
+				fetchedNonces = append(fetchedNonces, nonce)
+				if nonce == 102 {
+					return createBlock("klv1other", safeBech32Address), nil
+				}
+
+				return createBlock("klv1other"), nil
+			},
+		}
+		watcher, _ := NewBlocksWatcher(args)
+		watcher.lastCheckedNonce = 100
+
+		err := watcher.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []uint64{101, 102, 103}, fetchedNonces)
+		assert.Equal(t, uint64(103), watcher.lastCheckedNonce)
+		assert.True(t, watcher.isSynced)
+		assert.False(t, watcher.lastActivityTime.IsZero())
+	})
+	t.Run("no safe activity should not record activity", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksWatcher()
+		args.Proxy = &interactors.ProxyStub{
+			GetNetworkStatusCalled: func(ctx context.Context) (*models.NodeOverview, error) {
+				return &models.NodeOverview{Nonce: 101}, nil
+			},
+			GetBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*models.Block, error) {
+				block := createBlock("klv1other")
+				block.Transactions = append(block.Transactions, &models.BlockTransaction{})
+
+				return block, nil
+			},
+		}
+		watcher, _ := NewBlocksWatcher(args)
+		watcher.lastCheckedNonce = 100
+
+		err := watcher.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, watcher.isSynced)
+		assert.True(t, watcher.lastActivityTime.IsZero())
+	})
+	t.Run("get block errors should resume from the failed block", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksWatcher()
+		args.Proxy = &interactors.ProxyStub{
+			GetNetworkStatusCalled: func(ctx context.Context) (*models.NodeOverview, error) {
+				return &models.NodeOverview{Nonce: 103}, nil
+			},
+			GetBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*models.Block, error) {
+				if nonce == 102 {
+					return nil, expectedErr
+				}
+
+				return createBlock(), nil
+			},
+		}
+		watcher, _ := NewBlocksWatcher(args)
+		watcher.lastCheckedNonce = 100
+		watcher.isSynced = true
+
+		err := watcher.Execute(context.Background())
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Equal(t, uint64(101), watcher.lastCheckedNonce)
+		assert.False(t, watcher.isSynced)
+	})
+	t.Run("too many new blocks should skip blocks and record activity", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksWatcher()
+		args.MaxBlocksPerPoll = 2
+		var fetchedNonces []uint64
+		args.Proxy = &interactors.ProxyStub{
+			GetNetworkStatusCalled: func(ctx context.Context) (*models.NodeOverview, error) {
+				return &models.NodeOverview{Nonce: 110}, nil
+			},
+			GetBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*models.Block, error) {
+				fetchedNonces = append(fetchedNonces, nonce)
+				return createBlock(), nil
+			},
+		}
+		watcher, _ := NewBlocksWatcher(args)
+		watcher.lastCheckedNonce = 100
+
+		err := watcher.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []uint64{109, 110}, fetchedNonces)
+		assert.False(t, watcher.lastActivityTime.IsZero())
+	})
+}
+
+func TestBlocksWatcher_WaitForSafeActivity(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not synced should return immediately", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksWatcher()
+		args.MaxWaitTime = time.Minute
+		watcher, _ := NewBlocksWatcher(args)
+
+		start := time.Now()
+		watcher.WaitForSafeActivity(context.Background())
+		assert.Less(t, time.Since(start), time.Second)
+	})
+	t.Run("recent activity should return immediately", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksWatcher()
+		args.MaxWaitTime = time.Minute
+		watcher, _ := NewBlocksWatcher(args)
+		watcher.isSynced = true
+		watcher.recordActivity()
+
+		start := time.Now()
+		watcher.WaitForSafeActivity(context.Background())
+		assert.Less(t, time.Since(start), time.Second)
+	})
+	t.Run("old activity should wait the maximum wait time", func(t *testing.T) {
+		t.Parallel()
+
+		watcher, _ := NewBlocksWatcher(createMockArgsBlocksWatcher())
+		watcher.isSynced = true
+		watcher.lastActivityTime = time.Now().Add(-time.Hour)
+
+		start := time.Now()
+		watcher.WaitForSafeActivity(context.Background())
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	})
+	t.Run("new activity should release the waiters", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksWatcher()
+		args.MaxWaitTime = time.Minute
+		watcher, _ := NewBlocksWatcher(args)
+		watcher.isSynced = true
+
+		go func() {
+			time.Sleep(time.Millisecond * 100)
+			watcher.recordActivity()
+		}()
+
+		start := time.Now()
+		watcher.WaitForSafeActivity(context.Background())
+		assert.Less(t, time.Since(start), time.Second*10)
+	})
+	t.Run("losing the sync should release the waiters", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksWatcher()
+		args.MaxWaitTime = time.Minute
+		watcher, _ := NewBlocksWatcher(args)
+		watcher.isSynced = true
+
+		go func() {
+			time.Sleep(time.Millisecond * 100)
+			watcher.setNotSynced()
+		}()
+
+		start := time.Now()
+		watcher.WaitForSafeActivity(context.Background())
+		assert.Less(t, time.Since(start), time.Second*10)
+	})
+	t.Run("context done should return", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksWatcher()
+		args.MaxWaitTime = time.Minute
+		watcher, _ := NewBlocksWatcher(args)
+		watcher.isSynced = true
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+		defer cancel()
+
+		start := time.Now()
+		watcher.WaitForSafeActivity(ctx)
+		assert.Less(t, time.Since(start), time.Second*10)
+		require.NotNil(t, ctx.Err())
+	})
+}
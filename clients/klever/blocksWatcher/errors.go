@@ -0,0 +1,8 @@
+package blocksWatcher
+
+import "errors"
+
+var (
+	errNilProxy               = errors.New("nil proxy")
+	errNilSafeContractAddress = errors.New("nil safe contract address")
+)
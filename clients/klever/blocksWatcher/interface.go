@@ -0,0 +1,14 @@
+package blocksWatcher
+
+import (
+	"context"
+
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/proxy/models"
+)
+
+// BlocksProxy defines the Klever Blockchain proxy operations needed to follow the new blocks
+type BlocksProxy interface {
+	GetNetworkStatus(ctx context.Context) (*models.NodeOverview, error)
+	GetBlockByNonce(ctx context.Context, nonce uint64) (*models.Block, error)
+	IsInterfaceNil() bool
+}
@@ -35,6 +35,7 @@ const (
 	getLastExecutedEthTxId                                    = "getLastExecutedEthTxId"
 	signedFuncName                                            = "signed"
 	getAllStakedRelayersFuncName                              = "getAllStakedRelayers"
+	getAmountStakedFuncName                                   = "getAmountStaked"
 	isPausedFuncName                                          = "isPaused"
 	isMintBurnTokenFuncName                                   = "isMintBurnToken"
 	isNativeTokenFuncName                                     = "isNativeToken"
@@ -43,6 +44,7 @@ const (
 	getBurnBalances                                           = "getBurnBalances"
 	getAllKnownTokens                                         = "getAllKnownTokens"
 	getLastBatchId                                            = "getLastBatchId"
+	getKdaSafeAddressFuncName                                 = "getKdaSafeAddress"
 	convertEthToKdaAmountFuncName                             = "convertEthToKdaAmount"
 )
 
@@ -438,6 +440,14 @@ func (dataGetter *klvClientDataGetter) GetAllStakedRelayers(ctx context.Context)
 	return dataGetter.executeQueryFromBuilder(ctx, builder)
 }
 
+// GetAmountStaked returns the amount staked by the provided relayer in the Klever Blockchain SC
+func (dataGetter *klvClientDataGetter) GetAmountStaked(ctx context.Context, relayer []byte) (*big.Int, error) {
+	builder := dataGetter.createMultisigDefaultVmQueryBuilder()
+	builder.Function(getAmountStakedFuncName).ArgBytes(relayer)
+
+	return dataGetter.executeQueryBigIntFromBuilder(ctx, builder)
+}
+
 // IsPaused returns true if the multisig contract is paused
 func (dataGetter *klvClientDataGetter) IsPaused(ctx context.Context) (bool, error) {
 	builder := dataGetter.createMultisigDefaultVmQueryBuilder()
@@ -518,6 +528,14 @@ func (dataGetter *klvClientDataGetter) GetLastKCBatchID(ctx context.Context) (ui
 	return dataGetter.executeQueryUint64FromBuilder(ctx, builder)
 }
 
+// GetKdaSafeAddress returns the safe contract address the multisig contract is wired to
+func (dataGetter *klvClientDataGetter) GetKdaSafeAddress(ctx context.Context) ([][]byte, error) {
+	builder := dataGetter.createMultisigDefaultVmQueryBuilder()
+	builder.Function(getKdaSafeAddressFuncName)
+
+	return dataGetter.executeQueryFromBuilder(ctx, builder)
+}
+
 // ConvertEthToKdaAmount converts an amount from Ethereum decimals to KDA decimals
 func (dataGetter *klvClientDataGetter) ConvertEthToKdaAmount(ctx context.Context, token []byte, amount *big.Int) (*big.Int, error) {
 	builder := dataGetter.createSafeDefaultVmQueryBuilder()
@@ -1,6 +1,7 @@
 package klever
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/hex"
@@ -1262,6 +1263,36 @@ func TestKlvClientDataGetter_GetAllStakedRelayers(t *testing.T) {
 	assert.Equal(t, providedRelayers, result)
 }
 
+func TestKlvClientDataGetter_GetAmountStaked(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsKLVClientDataGetter()
+	providedRelayer := bytes.Repeat([]byte("1"), 32)
+	providedStake := big.NewInt(5000)
+	args.Proxy = &interactors.ProxyStub{
+		ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *models.VmValueRequest) (*models.VmValuesResponseData, error) {
+			assert.Equal(t, getBech32Address(args.RelayerAddress), vmRequest.CallerAddr)
+			assert.Equal(t, getBech32Address(args.MultisigContractAddress), vmRequest.Address)
+			assert.Equal(t, 0, len(vmRequest.CallValue))
+			assert.Equal(t, getAmountStakedFuncName, vmRequest.FuncName)
+			assert.Equal(t, []string{hex.EncodeToString(providedRelayer)}, vmRequest.Args)
+
+			return &models.VmValuesResponseData{
+				Data: &vm.VMOutputApi{
+					ReturnCode: okCodeAfterExecution,
+					ReturnData: [][]byte{providedStake.Bytes()},
+				},
+			}, nil
+		},
+	}
+
+	dg, _ := NewKLVClientDataGetter(args)
+
+	result, err := dg.GetAmountStaked(context.Background(), providedRelayer)
+	assert.Nil(t, err)
+	assert.Equal(t, providedStake, result)
+}
+
 func TestKlvClientDataGetter_GetAllKnownTokens(t *testing.T) {
 	t.Parallel()
 
@@ -1549,6 +1580,37 @@ func TestKCClientDataGetter_getBurnBalances(t *testing.T) {
 	assert.True(t, proxyCalled)
 }
 
+func TestKCClientDataGetter_GetKdaSafeAddress(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsKLVClientDataGetter()
+	proxyCalled := false
+	args.Proxy = &interactors.ProxyStub{
+		ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *models.VmValueRequest) (*models.VmValuesResponseData, error) {
+			proxyCalled = true
+			assert.Equal(t, getBech32Address(args.MultisigContractAddress), vmRequest.Address)
+			assert.Equal(t, getBech32Address(args.RelayerAddress), vmRequest.CallerAddr)
+			assert.Equal(t, 0, len(vmRequest.CallValue))
+			assert.Equal(t, getKdaSafeAddressFuncName, vmRequest.FuncName)
+			assert.Empty(t, vmRequest.Args)
+
+			return &models.VmValuesResponseData{
+				Data: &vm.VMOutputApi{
+					ReturnCode: okCodeAfterExecution,
+					ReturnData: [][]byte{args.SafeContractAddress.Bytes()},
+				},
+			}, nil
+		},
+	}
+
+	dg, _ := NewKLVClientDataGetter(args)
+
+	result, err := dg.GetKdaSafeAddress(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{args.SafeContractAddress.Bytes()}, result)
+	assert.True(t, proxyCalled)
+}
+
 func TestKCClientDataGetter_GetLastKCBatchID(t *testing.T) {
 	t.Parallel()
 
@@ -12,6 +12,7 @@ const (
 	transactionStatus        = "transaction/%s/status"
 	transactionInfo          = "transaction/%s"
 	kda                      = "address/%s/kda/%s"
+	blockByNonce             = "block/by-nonce/%d?withTxs=true"
 )
 
 type baseEndpointProvider struct{}
@@ -60,3 +61,8 @@ func (base *baseEndpointProvider) GetTransactionStatus(hexHash string) string {
 func (base *baseEndpointProvider) GetTransactionInfo(hexHash string) string {
 	return fmt.Sprintf(transactionInfo, hexHash)
 }
+
+// GetBlockByNonce returns the block by nonce endpoint, including the block transactions
+func (base *baseEndpointProvider) GetBlockByNonce(nonce uint64) string {
+	return fmt.Sprintf(blockByNonce, nonce)
+}
@@ -18,4 +18,5 @@ func TestBaseEndpointProvider(t *testing.T) {
 	assert.Equal(t, "transaction/hex/status", base.GetTransactionStatus("hex"))
 	assert.Equal(t, "transaction/hex", base.GetTransactionInfo("hex"))
 	assert.Equal(t, "address/klv1address/kda/TKN-001122", base.GetKDATokenData("klv1address", "TKN-001122"))
+	assert.Equal(t, "block/by-nonce/37?withTxs=true", base.GetBlockByNonce(37))
 }
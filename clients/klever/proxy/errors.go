@@ -37,6 +37,9 @@ var ErrInvalidEndpointProvider = errors.New("invalid endpoint provider")
 // ErrNilNetworkStatus signals that nil network status was received
 var ErrNilNetworkStatus = errors.New("nil network status")
 
+// ErrNilBlock signals that a nil block was received
+var ErrNilBlock = errors.New("nil block")
+
 // ErrNilRequest signals that a nil request was provided
 var ErrNilRequest = errors.New("nil request")
 
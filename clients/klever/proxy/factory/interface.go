@@ -15,5 +15,6 @@ type EndpointProvider interface {
 	GetNodeStatus() string
 	GetRestAPIEntityType() models.RestAPIEntityType
 	GetKDATokenData(addressAsBech32 string, tokenIdentifier string) string
+	GetBlockByNonce(nonce uint64) string
 	IsInterfaceNil() bool
 }
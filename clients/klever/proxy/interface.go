@@ -17,6 +17,7 @@ type Proxy interface {
 	GetAccount(ctx context.Context, address address.Address) (*models.Account, error)
 	GetNetworkStatus(ctx context.Context) (*models.NodeOverview, error)
 	GetKDATokenData(ctx context.Context, address address.Address, tokenIdentifier string) (*models.KDAFungibleTokenData, error)
+	GetBlockByNonce(ctx context.Context, nonce uint64) (*models.Block, error)
 	GetTransactionInfoWithResults(ctx context.Context, hash string) (*models.TransactionData, error)
 	EstimateTransactionFees(ctx context.Context, txs *transaction.Transaction) (*transaction.FeesResponse, error)
 	IsInterfaceNil() bool
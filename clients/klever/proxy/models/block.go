@@ -0,0 +1,40 @@
+package models
+
+// Block holds the block fields used by the relayer, as returned by the block endpoints when the transactions are requested
+type Block struct {
+	Hash         string              `json:"hash"`
+	Status       string              `json:"status"`
+	Transactions []*BlockTransaction `json:"transactions"`
+}
+
+// BlockTransaction holds the block transaction fields used by the relayer
+type BlockTransaction struct {
+	Hash   string           `json:"hash"`
+	Status string           `json:"status"`
+	Logs   *TransactionLogs `json:"logs,omitempty"`
+}
+
+// TransactionLogs holds the logs generated by a transaction
+type TransactionLogs struct {
+	Address string              `json:"address"`
+	Events  []*TransactionEvent `json:"events"`
+}
+
+// TransactionEvent holds an event generated by a transaction
+type TransactionEvent struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     []string `json:"topics"`
+}
+
+// BlockResponseData follows the format of the data field of a get block response
+type BlockResponseData struct {
+	Block *Block `json:"block"`
+}
+
+// BlockResponse defines a get block response
+type BlockResponse struct {
+	Data  BlockResponseData `json:"data"`
+	Error string            `json:"error"`
+	Code  string            `json:"code"`
+}
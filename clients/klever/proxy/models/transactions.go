@@ -51,6 +51,7 @@ type KDAFungibleTokenData struct {
 	TokenIdentifier string `json:"tokenIdentifier"`
 	Balance         string `json:"balance"`
 	Properties      string `json:"properties"`
+	Precision       uint32 `json:"precision"`
 }
 
 // TransactionData represents the structure that maps and validates user input for publishing a new transaction
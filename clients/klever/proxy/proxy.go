@@ -397,6 +397,29 @@ func (ep *proxy) GetKDATokenData(
 	return response.Data.TokenData, nil
 }
 
+// GetBlockByNonce returns the block with the provided nonce, including its transactions
+func (ep *proxy) GetBlockByNonce(ctx context.Context, nonce uint64) (*models.Block, error) {
+	endpoint := ep.endpointProvider.GetBlockByNonce(nonce)
+	buff, code, err := ep.GetHTTP(ctx, endpoint)
+	if err != nil || code != http.StatusOK {
+		return nil, createHTTPStatusErrorWithBody(code, err, buff)
+	}
+
+	response := &models.BlockResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+	if response.Data.Block == nil {
+		return nil, ErrNilBlock
+	}
+
+	return response.Data.Block, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ep *proxy) IsInterfaceNil() bool {
 	return ep == nil
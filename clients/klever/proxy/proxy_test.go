@@ -303,6 +303,61 @@ func TestProxy_GetTransactionInfoWithResults(t *testing.T) {
 	require.Len(t, tx.Signature, 1)
 }
 
+func TestProxy_GetBlockByNonce(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		responseBytes := []byte(`{"data":{"block":{"Header":{"Nonce":37},"hash":"aabb","status":"on-chain","transactions":[{"hash":"ccdd","status":"success","logs":{"address":"` + contractAddress + `","events":[{"address":"` + contractAddress + `","identifier":"createTransaction","topics":["dG9waWM="]}]}},{"hash":"eeff","status":"success"}]}},"error":"","code":"successful"}`)
+		var requestedURL string
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				requestedURL = req.URL.String()
+				return &http.Response{
+					Body:       io.NopCloser(bytes.NewReader(responseBytes)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		args := createMockArgsProxy(httpClient, models.ObserverNode)
+		ep, _ := NewProxy(args)
+
+		block, err := ep.GetBlockByNonce(context.Background(), 37)
+		require.Nil(t, err)
+		assert.Equal(t, testHttpURL+"/block/by-nonce/37?withTxs=true", requestedURL)
+		assert.Equal(t, "aabb", block.Hash)
+		require.Len(t, block.Transactions, 2)
+		assert.Equal(t, "ccdd", block.Transactions[0].Hash)
+		require.Len(t, block.Transactions[0].Logs.Events, 1)
+		assert.Equal(t, contractAddress, block.Transactions[0].Logs.Events[0].Address)
+		assert.Equal(t, "createTransaction", block.Transactions[0].Logs.Events[0].Identifier)
+		assert.Nil(t, block.Transactions[1].Logs)
+	})
+	t.Run("response error should error", func(t *testing.T) {
+		t.Parallel()
+
+		httpClient := createMockClientRespondingBytes([]byte(`{"data":null,"error":"block not found","code":"internal_issue"}`))
+		args := createMockArgsProxy(httpClient, models.ObserverNode)
+		ep, _ := NewProxy(args)
+
+		block, err := ep.GetBlockByNonce(context.Background(), 37)
+		assert.Nil(t, block)
+		assert.Equal(t, "block not found", err.Error())
+	})
+	t.Run("missing block should error", func(t *testing.T) {
+		t.Parallel()
+
+		httpClient := createMockClientRespondingBytes([]byte(`{"data":{},"error":"","code":"successful"}`))
+		args := createMockArgsProxy(httpClient, models.ObserverNode)
+		ep, _ := NewProxy(args)
+
+		block, err := ep.GetBlockByNonce(context.Background(), 37)
+		assert.Nil(t, block)
+		assert.Equal(t, ErrNilBlock, err)
+	})
+}
+
 func TestSendTransaction_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,10 @@
+package relayersHealth
+
+import "errors"
+
+var (
+	errNilPublicKeysProvider = errors.New("nil public keys provider")
+	errNilLeaderProvider     = errors.New("nil leader provider")
+	errNilQuorumProvider     = errors.New("nil quorum provider")
+	errEmptyOwnAddress       = errors.New("empty own address")
+)
@@ -0,0 +1,23 @@
+package relayersHealth
+
+import (
+	"context"
+	"math/big"
+)
+
+// PublicKeysProvider defines the operations of a component able to provide the whitelisted relayers
+type PublicKeysProvider interface {
+	SortedPublicKeys() [][]byte
+	IsInterfaceNil() bool
+}
+
+// LeaderProvider defines the operations of a component able to provide the current leader
+type LeaderProvider interface {
+	CurrentLeader() []byte
+	IsInterfaceNil() bool
+}
+
+// QuorumProvider defines the operations of a component able to provide the quorum size
+type QuorumProvider interface {
+	Quorum(ctx context.Context) (*big.Int, error)
+}
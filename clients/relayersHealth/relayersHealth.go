@@ -0,0 +1,347 @@
+package relayersHealth
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/clients"
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const maxTrackedMessageHashes = 10
+
+// ArgsRelayersHealth is the DTO used in the NewRelayersHealth constructor function
+type ArgsRelayersHealth struct {
+	Log                logger.Logger
+	PublicKeysProvider PublicKeysProvider
+	LeaderProvider     LeaderProvider
+	QuorumProvider     QuorumProvider
+	StatusHandler      core.StatusHandler
+	AddressConverter   core.AddressConverter
+	OwnAddress         []byte
+}
+
+type relayerActivity struct {
+	lastJoin            time.Time
+	lastSignature       time.Time
+	numMissedSignatures uint64
+	lastMissedBatchID   uint64
+	numBatchesAsLeader  uint64
+	lastBatchAsLeader   uint64
+}
+
+type messageHashRecord struct {
+	messageHash []byte
+	batchID     uint64
+	signers     map[string]time.Time
+}
+
+// relayersHealth tracks the activity of the whitelisted relayers: the join messages, the signatures for each message
+// hash, the missed signatures for each batch and the batches signed while being the leader. A batch is closed when
+// the next one is recorded, and the whitelisted relayers that did not sign it are considered lagging
+type relayersHealth struct {
+	log                logger.Logger
+	publicKeysProvider PublicKeysProvider
+	leaderProvider     LeaderProvider
+	quorumProvider     QuorumProvider
+	statusHandler      core.StatusHandler
+	addressConverter   core.AddressConverter
+	ownAddress         []byte
+	getTimeFunc        func() time.Time
+
+	mut             sync.RWMutex
+	quorum          uint64
+	activities      map[string]*relayerActivity
+	messageHashes   []*messageHashRecord
+	currentBatch    *messageHashRecord
+	lastClosedBatch *messageHashRecord
+}
+
+// NewRelayersHealth creates a new relayers health tracker
+func NewRelayersHealth(args ArgsRelayersHealth) (*relayersHealth, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &relayersHealth{
+		log:                args.Log,
+		publicKeysProvider: args.PublicKeysProvider,
+		leaderProvider:     args.LeaderProvider,
+		quorumProvider:     args.QuorumProvider,
+		statusHandler:      args.StatusHandler,
+		addressConverter:   args.AddressConverter,
+		ownAddress:         args.OwnAddress,
+		getTimeFunc:        time.Now,
+		activities:         make(map[string]*relayerActivity),
+		messageHashes:      make([]*messageHashRecord, 0, maxTrackedMessageHashes),
+	}, nil
+}
+
+func checkArgs(args ArgsRelayersHealth) error {
+	if check.IfNil(args.Log) {
+		return clients.ErrNilLogger
+	}
+	if check.IfNil(args.PublicKeysProvider) {
+		return errNilPublicKeysProvider
+	}
+	if check.IfNil(args.LeaderProvider) {
+		return errNilLeaderProvider
+	}
+	if args.QuorumProvider == nil {
+		return errNilQuorumProvider
+	}
+	if check.IfNil(args.StatusHandler) {
+		return clients.ErrNilStatusHandler
+	}
+	if check.IfNil(args.AddressConverter) {
+		return clients.ErrNilAddressConverter
+	}
+	if len(args.OwnAddress) == 0 {
+		return errEmptyOwnAddress
+	}
+
+	return nil
+}
+
+// RecordJoin records a join message sent by the relayer with the provided public key
+func (rh *relayersHealth) RecordJoin(publicKey []byte) {
+	rh.mut.Lock()
+	defer rh.mut.Unlock()
+
+	rh.getActivity(publicKey).lastJoin = rh.getTimeFunc()
+}
+
+// RecordSignature records the signature of the relayer with the provided public key on the message hash
+func (rh *relayersHealth) RecordSignature(publicKey []byte, messageHash []byte) {
+	now := rh.getTimeFunc()
+
+	rh.mut.Lock()
+	defer rh.mut.Unlock()
+
+	rh.getActivity(publicKey).lastSignature = now
+	record := rh.getMessageHashRecord(messageHash)
+	_, found := record.signers[string(publicKey)]
+	if !found {
+		record.signers[string(publicKey)] = now
+	}
+}
+
+// LastActivity returns the moment of the last join message or signature received from the relayer with the provided
+// public key. Returns the zero time if the relayer was not seen
+func (rh *relayersHealth) LastActivity(publicKey []byte) time.Time {
+	rh.mut.RLock()
+	defer rh.mut.RUnlock()
+
+	activity, found := rh.activities[string(publicKey)]
+	if !found {
+		return time.Time{}
+	}
+	if activity.lastSignature.After(activity.lastJoin) {
+		return activity.lastSignature
+	}
+
+	return activity.lastJoin
+}
+
+// RecordBatch records the message hash of a new batch. The previous batch is closed and the whitelisted relayers that
+// did not sign it are accounted as missing the signature. The current leader is accounted as the batch leader
+func (rh *relayersHealth) RecordBatch(batchID uint64, messageHash []byte) {
+	whitelisted := rh.publicKeysProvider.SortedPublicKeys()
+	leader := rh.leaderProvider.CurrentLeader()
+
+	rh.mut.Lock()
+	defer rh.mut.Unlock()
+
+	if rh.currentBatch != nil && rh.currentBatch.batchID == batchID && string(rh.currentBatch.messageHash) == string(messageHash) {
+		return
+	}
+
+	if rh.currentBatch != nil {
+		rh.closeBatch(rh.currentBatch, whitelisted)
+	}
+
+	record := rh.getMessageHashRecord(messageHash)
+	record.batchID = batchID
+	rh.currentBatch = record
+
+	if len(leader) > 0 {
+		activity := rh.getActivity(leader)
+		activity.numBatchesAsLeader++
+		activity.lastBatchAsLeader = batchID
+	}
+}
+
+// closeBatch should be called under mutex protection
+func (rh *relayersHealth) closeBatch(record *messageHashRecord, whitelisted [][]byte) {
+	for _, publicKey := range whitelisted {
+		_, signed := record.signers[string(publicKey)]
+		if signed {
+			continue
+		}
+
+		activity := rh.getActivity(publicKey)
+		activity.numMissedSignatures++
+		activity.lastMissedBatchID = record.batchID
+
+		rh.log.Debug("relayer did not sign the batch", "relayer", rh.addressConverter.ToBech32StringSilent(publicKey),
+			"batch ID", record.batchID, "message hash", hex.EncodeToString(record.messageHash))
+	}
+
+	rh.lastClosedBatch = record
+}
+
+// getActivity should be called under mutex protection
+func (rh *relayersHealth) getActivity(publicKey []byte) *relayerActivity {
+	activity, found := rh.activities[string(publicKey)]
+	if !found {
+		activity = &relayerActivity{}
+		rh.activities[string(publicKey)] = activity
+	}
+
+	return activity
+}
+
+// getMessageHashRecord should be called under mutex protection
+func (rh *relayersHealth) getMessageHashRecord(messageHash []byte) *messageHashRecord {
+	for _, record := range rh.messageHashes {
+		if string(record.messageHash) == string(messageHash) {
+			return record
+		}
+	}
+
+	record := &messageHashRecord{
+		messageHash: append([]byte{}, messageHash...),
+		signers:     make(map[string]time.Time),
+	}
+	rh.messageHashes = append(rh.messageHashes, record)
+	if len(rh.messageHashes) > maxTrackedMessageHashes {
+		rh.messageHashes = rh.messageHashes[len(rh.messageHashes)-maxTrackedMessageHashes:]
+	}
+
+	return record
+}
+
+// Execute will fetch the quorum size and will update the metrics
+func (rh *relayersHealth) Execute(ctx context.Context) error {
+	quorum, err := rh.quorumProvider.Quorum(ctx)
+	if err != nil {
+		return err
+	}
+
+	rh.mut.Lock()
+	rh.quorum = quorum.Uint64()
+	rh.mut.Unlock()
+
+	snapshot := rh.GetRelayersHealth()
+	rh.statusHandler.SetIntMetric(core.MetricRelayersQuorum, int(snapshot.Quorum))
+	rh.statusHandler.SetIntMetric(core.MetricNumWhitelistedRelayers, snapshot.NumWhitelisted)
+	rh.statusHandler.SetIntMetric(core.MetricNumJoinedRelayers, snapshot.NumJoined)
+	rh.statusHandler.SetIntMetric(core.MetricNumLaggingRelayers, snapshot.NumLagging)
+	rh.statusHandler.SetStringMetric(core.MetricIsQuorumAtRisk, strconv.FormatBool(snapshot.IsQuorumAtRisk))
+
+	if snapshot.IsQuorumAtRisk {
+		rh.log.Warn("the relayers that are not lagging can not reach the quorum",
+			"quorum", snapshot.Quorum, "whitelisted", snapshot.NumWhitelisted, "lagging", snapshot.NumLagging)
+	}
+
+	return nil
+}
+
+// GetRelayersHealth returns the health of the whitelisted relayers
+func (rh *relayersHealth) GetRelayersHealth() *core.RelayersHealthSnapshot {
+	whitelisted := rh.publicKeysProvider.SortedPublicKeys()
+	leader := rh.leaderProvider.CurrentLeader()
+
+	rh.mut.RLock()
+	defer rh.mut.RUnlock()
+
+	snapshot := &core.RelayersHealthSnapshot{
+		Quorum:         rh.quorum,
+		NumWhitelisted: len(whitelisted),
+		Relayers:       make([]*core.RelayerHealth, 0, len(whitelisted)),
+	}
+	if rh.currentBatch != nil {
+		snapshot.LastBatchID = rh.currentBatch.batchID
+	}
+
+	for _, publicKey := range whitelisted {
+		relayer := rh.createRelayerHealth(publicKey, leader)
+		if relayer.LastJoinTimestamp > 0 || relayer.LastSignatureTimestamp > 0 {
+			snapshot.NumJoined++
+		}
+		if relayer.IsLagging {
+			snapshot.NumLagging++
+		}
+
+		snapshot.Relayers = append(snapshot.Relayers, relayer)
+	}
+
+	numActive := uint64(snapshot.NumWhitelisted - snapshot.NumLagging)
+	snapshot.IsQuorumAtRisk = snapshot.Quorum > 0 && numActive < snapshot.Quorum
+
+	return snapshot
+}
+
+// createRelayerHealth should be called under mutex protection
+func (rh *relayersHealth) createRelayerHealth(publicKey []byte, leader []byte) *core.RelayerHealth {
+	relayer := &core.RelayerHealth{
+		Address:         rh.addressConverter.ToBech32StringSilent(publicKey),
+		IsSelf:          string(publicKey) == string(rh.ownAddress),
+		IsCurrentLeader: string(publicKey) == string(leader),
+		Signatures:      make([]core.RelayerSignature, 0),
+	}
+
+	activity, found := rh.activities[string(publicKey)]
+	if found {
+		relayer.LastJoinTimestamp = toUnix(activity.lastJoin)
+		relayer.LastSignatureTimestamp = toUnix(activity.lastSignature)
+		relayer.NumMissedSignatures = activity.numMissedSignatures
+		relayer.LastMissedBatchID = activity.lastMissedBatchID
+		relayer.NumBatchesAsLeader = activity.numBatchesAsLeader
+		relayer.LastBatchAsLeader = activity.lastBatchAsLeader
+	}
+
+	// newest message hashes first
+	for i := len(rh.messageHashes) - 1; i >= 0; i-- {
+		record := rh.messageHashes[i]
+		signTime, signed := record.signers[string(publicKey)]
+		if !signed {
+			continue
+		}
+
+		relayer.Signatures = append(relayer.Signatures, core.RelayerSignature{
+			MessageHash: hex.EncodeToString(record.messageHash),
+			BatchID:     record.batchID,
+			Timestamp:   signTime.Unix(),
+		})
+	}
+
+	isSeen := relayer.LastJoinTimestamp > 0 || relayer.LastSignatureTimestamp > 0
+	missedLastBatch := false
+	if rh.lastClosedBatch != nil {
+		_, signed := rh.lastClosedBatch.signers[string(publicKey)]
+		missedLastBatch = !signed
+	}
+	relayer.IsLagging = !isSeen || missedLastBatch
+
+	return relayer
+}
+
+func toUnix(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.Unix()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rh *relayersHealth) IsInterfaceNil() bool {
+	return rh == nil
+}
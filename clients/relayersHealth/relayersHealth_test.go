@@ -0,0 +1,307 @@
+package relayersHealth
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/clients"
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/core/converters"
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
+	bridgeTests "github.com/klever-io/klv-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	relayer1 = []byte("relayer1-public-key-of-32-bytes-")
+	relayer2 = []byte("relayer2-public-key-of-32-bytes-")
+	relayer3 = []byte("relayer3-public-key-of-32-bytes-")
+	hash1    = []byte("message hash 1")
+	hash2    = []byte("message hash 2")
+)
+
+func createMockArgsRelayersHealth() ArgsRelayersHealth {
+	addressConverter, _ := converters.NewAddressConverter()
+
+	return ArgsRelayersHealth{
+		Log: &testsCommon.LoggerStub{},
+		PublicKeysProvider: &testsCommon.BroadcasterStub{
+			SortedPublicKeysCalled: func() [][]byte {
+				return [][]byte{relayer1, relayer2, relayer3}
+			},
+		},
+		LeaderProvider: &testsCommon.LeaderProviderStub{
+			CurrentLeaderCalled: func() []byte {
+				return relayer2
+			},
+		},
+		QuorumProvider: &bridgeTests.EthereumClientWrapperStub{
+			QuorumCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(2), nil
+			},
+		},
+		StatusHandler:    testsCommon.NewStatusHandlerMock("test"),
+		AddressConverter: addressConverter,
+		OwnAddress:       relayer1,
+	}
+}
+
+func createRelayersHealthWithTime(t *testing.T, args ArgsRelayersHealth, currentTime *time.Time) *relayersHealth {
+	instance, err := NewRelayersHealth(args)
+	require.Nil(t, err)
+	instance.getTimeFunc = func() time.Time {
+		return *currentTime
+	}
+
+	return instance
+}
+
+func TestNewRelayersHealth(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayersHealth()
+		args.Log = nil
+
+		instance, err := NewRelayersHealth(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, clients.ErrNilLogger, err)
+	})
+	t.Run("nil public keys provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayersHealth()
+		args.PublicKeysProvider = nil
+
+		instance, err := NewRelayersHealth(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, errNilPublicKeysProvider, err)
+	})
+	t.Run("nil leader provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayersHealth()
+		args.LeaderProvider = nil
+
+		instance, err := NewRelayersHealth(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, errNilLeaderProvider, err)
+	})
+	t.Run("nil quorum provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayersHealth()
+		args.QuorumProvider = nil
+
+		instance, err := NewRelayersHealth(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, errNilQuorumProvider, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayersHealth()
+		args.StatusHandler = nil
+
+		instance, err := NewRelayersHealth(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, clients.ErrNilStatusHandler, err)
+	})
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayersHealth()
+		args.AddressConverter = nil
+
+		instance, err := NewRelayersHealth(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, clients.ErrNilAddressConverter, err)
+	})
+	t.Run("empty own address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayersHealth()
+		args.OwnAddress = nil
+
+		instance, err := NewRelayersHealth(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, errEmptyOwnAddress, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewRelayersHealth(createMockArgsRelayersHealth())
+		assert.False(t, check.IfNil(instance))
+		assert.Nil(t, err)
+	})
+}
+
+func TestRelayersHealth_GetRelayersHealth(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no activity should mark all relayers as lagging", func(t *testing.T) {
+		t.Parallel()
+
+		instance, _ := NewRelayersHealth(createMockArgsRelayersHealth())
+
+		snapshot := instance.GetRelayersHealth()
+		assert.Equal(t, 3, snapshot.NumWhitelisted)
+		assert.Equal(t, 0, snapshot.NumJoined)
+		assert.Equal(t, 3, snapshot.NumLagging)
+		require.Len(t, snapshot.Relayers, 3)
+		assert.True(t, snapshot.Relayers[0].IsSelf)
+		assert.False(t, snapshot.Relayers[0].IsCurrentLeader)
+		assert.False(t, snapshot.Relayers[1].IsSelf)
+		assert.True(t, snapshot.Relayers[1].IsCurrentLeader)
+		assert.Empty(t, snapshot.Relayers[2].Signatures)
+	})
+	t.Run("should track the joins, the signatures and the missed signatures", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1000, 0)
+		instance := createRelayersHealthWithTime(t, createMockArgsRelayersHealth(), &currentTime)
+
+		instance.RecordJoin(relayer1)
+		instance.RecordJoin(relayer2)
+		instance.RecordJoin(relayer3)
+		// a signature might be received before the batch is recorded
+		instance.RecordSignature(relayer2, hash1)
+		currentTime = time.Unix(1010, 0)
+		instance.RecordBatch(1, hash1)
+		instance.RecordSignature(relayer1, hash1)
+		instance.RecordSignature(relayer1, hash1)
+
+		snapshot := instance.GetRelayersHealth()
+		assert.Equal(t, 3, snapshot.NumJoined)
+		assert.Equal(t, 0, snapshot.NumLagging)
+		assert.Equal(t, uint64(1), snapshot.LastBatchID)
+		expectedSignature := core.RelayerSignature{
+			MessageHash: hex.EncodeToString(hash1),
+			BatchID:     1,
+			Timestamp:   1010,
+		}
+		assert.Equal(t, []core.RelayerSignature{expectedSignature}, snapshot.Relayers[0].Signatures)
+		assert.Equal(t, int64(1000), snapshot.Relayers[1].Signatures[0].Timestamp)
+
+		// the second batch closes the first one, relayer3 did not sign it
+		currentTime = time.Unix(1020, 0)
+		instance.RecordBatch(2, hash2)
+		instance.RecordSignature(relayer3, hash2)
+
+		snapshot = instance.GetRelayersHealth()
+		assert.Equal(t, 1, snapshot.NumLagging)
+		relayer := snapshot.Relayers[2]
+		assert.True(t, relayer.IsLagging)
+		assert.Equal(t, uint64(1), relayer.NumMissedSignatures)
+		assert.Equal(t, uint64(1), relayer.LastMissedBatchID)
+		assert.Equal(t, int64(1000), relayer.LastJoinTimestamp)
+		assert.Equal(t, int64(1020), relayer.LastSignatureTimestamp)
+		require.Len(t, relayer.Signatures, 1)
+		assert.Equal(t, uint64(2), relayer.Signatures[0].BatchID)
+
+		relayer = snapshot.Relayers[1]
+		assert.False(t, relayer.IsLagging)
+		assert.Equal(t, uint64(2), relayer.NumBatchesAsLeader)
+		assert.Equal(t, uint64(2), relayer.LastBatchAsLeader)
+	})
+	t.Run("recording the same batch twice should not close it", func(t *testing.T) {
+		t.Parallel()
+
+		instance, _ := NewRelayersHealth(createMockArgsRelayersHealth())
+		instance.RecordBatch(1, hash1)
+		instance.RecordBatch(1, hash1)
+
+		snapshot := instance.GetRelayersHealth()
+		for _, relayer := range snapshot.Relayers {
+			assert.Zero(t, relayer.NumMissedSignatures)
+		}
+		assert.Equal(t, uint64(1), snapshot.Relayers[1].NumBatchesAsLeader)
+	})
+	t.Run("should keep only the latest message hashes", func(t *testing.T) {
+		t.Parallel()
+
+		instance, _ := NewRelayersHealth(createMockArgsRelayersHealth())
+		for i := 0; i < maxTrackedMessageHashes+5; i++ {
+			instance.RecordSignature(relayer1, []byte{byte(i)})
+		}
+
+		snapshot := instance.GetRelayersHealth()
+		require.Len(t, snapshot.Relayers[0].Signatures, maxTrackedMessageHashes)
+		assert.Equal(t, "0e", snapshot.Relayers[0].Signatures[0].MessageHash)
+	})
+}
+
+func TestRelayersHealth_LastActivity(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	instance := createRelayersHealthWithTime(t, createMockArgsRelayersHealth(), &currentTime)
+
+	assert.True(t, instance.LastActivity(relayer1).IsZero())
+
+	instance.RecordJoin(relayer1)
+	assert.Equal(t, time.Unix(1000, 0), instance.LastActivity(relayer1))
+
+	currentTime = time.Unix(1010, 0)
+	instance.RecordSignature(relayer1, hash1)
+	assert.Equal(t, time.Unix(1010, 0), instance.LastActivity(relayer1))
+
+	currentTime = time.Unix(1020, 0)
+	instance.RecordJoin(relayer1)
+	assert.Equal(t, time.Unix(1020, 0), instance.LastActivity(relayer1))
+	assert.True(t, instance.LastActivity(relayer2).IsZero())
+}
+
+func TestRelayersHealth_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("quorum error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsRelayersHealth()
+		args.QuorumProvider = &bridgeTests.EthereumClientWrapperStub{
+			QuorumCalled: func(ctx context.Context) (*big.Int, error) {
+				return nil, expectedErr
+			},
+		}
+		instance, _ := NewRelayersHealth(args)
+
+		err := instance.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should update the metrics", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayersHealth()
+		instance, _ := NewRelayersHealth(args)
+		instance.RecordJoin(relayer1)
+		instance.RecordJoin(relayer2)
+
+		err := instance.Execute(context.Background())
+		assert.Nil(t, err)
+
+		statusHandler := args.StatusHandler.(*testsCommon.StatusHandlerMock)
+		assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricRelayersQuorum))
+		assert.Equal(t, 3, statusHandler.GetIntMetric(core.MetricNumWhitelistedRelayers))
+		assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricNumJoinedRelayers))
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumLaggingRelayers))
+		assert.Equal(t, "false", statusHandler.GetStringMetric(core.MetricIsQuorumAtRisk))
+
+		instance.RecordBatch(1, hash1)
+		instance.RecordBatch(2, hash2)
+		err = instance.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 3, statusHandler.GetIntMetric(core.MetricNumLaggingRelayers))
+		assert.Equal(t, "true", statusHandler.GetStringMetric(core.MetricIsQuorumAtRisk))
+		assert.True(t, instance.GetRelayersHealth().IsQuorumAtRisk)
+	})
+}
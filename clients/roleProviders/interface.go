@@ -2,6 +2,7 @@ package roleproviders
 
 import (
 	"context"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -9,6 +10,7 @@ import (
 // DataGetter defines the interface able to handle get requests for Klever blockchain
 type DataGetter interface {
 	GetAllStakedRelayers(ctx context.Context) ([][]byte, error)
+	GetAmountStaked(ctx context.Context, relayer []byte) (*big.Int, error)
 	IsInterfaceNil() bool
 }
 
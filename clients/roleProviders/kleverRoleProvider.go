@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"sort"
 	"strings"
 	"sync"
@@ -17,14 +18,17 @@ import (
 
 // ArgsKleverRoleProvider is the argument for the Klever Blockchain role provider constructor
 type ArgsKleverRoleProvider struct {
-	DataGetter DataGetter
-	Log        logger.Logger
+	DataGetter  DataGetter
+	Log         logger.Logger
+	FetchStakes bool
 }
 
 type kleverRoleProvider struct {
 	dataGetter           DataGetter
 	log                  logger.Logger
+	fetchStakes          bool
 	whitelistedAddresses map[string]struct{}
+	stakes               map[string]*big.Int
 	mut                  sync.RWMutex
 }
 
@@ -38,7 +42,9 @@ func NewKleverRoleProvider(args ArgsKleverRoleProvider) (*kleverRoleProvider, er
 	krp := &kleverRoleProvider{
 		dataGetter:           args.DataGetter,
 		log:                  args.Log,
+		fetchStakes:          args.FetchStakes,
 		whitelistedAddresses: make(map[string]struct{}),
+		stakes:               make(map[string]*big.Int),
 	}
 
 	return krp, nil
@@ -55,14 +61,42 @@ func checkKleverRoleProviderSpecificArgs(args ArgsKleverRoleProvider) error {
 	return nil
 }
 
-// Execute will fetch the available relayers and store them in the inner map
+// Execute will fetch the available relayers and store them in the inner map. If enabled, the stake of each relayer
+// is also fetched
 func (krp *kleverRoleProvider) Execute(ctx context.Context) error {
 	results, err := krp.dataGetter.GetAllStakedRelayers(ctx)
 	if err != nil {
 		return err
 	}
 
-	return krp.processResults(results)
+	err = krp.processResults(results)
+	if err != nil {
+		return err
+	}
+
+	if !krp.fetchStakes {
+		return nil
+	}
+
+	return krp.fetchStakesOfRelayers(ctx, results)
+}
+
+func (krp *kleverRoleProvider) fetchStakesOfRelayers(ctx context.Context, relayers [][]byte) error {
+	temporaryMap := make(map[string]*big.Int, len(relayers))
+	for _, relayer := range relayers {
+		stake, err := krp.dataGetter.GetAmountStaked(ctx, relayer)
+		if err != nil {
+			return fmt.Errorf("%w while fetching the stake of %s", err, hex.EncodeToString(relayer))
+		}
+
+		temporaryMap[string(relayer)] = stake
+	}
+
+	krp.mut.Lock()
+	krp.stakes = temporaryMap
+	krp.mut.Unlock()
+
+	return nil
 }
 
 func (krp *kleverRoleProvider) processResults(results [][]byte) error {
@@ -124,6 +158,19 @@ func (krp *kleverRoleProvider) SortedPublicKeys() [][]byte {
 	return sortedPublicKeys
 }
 
+// StakeOf returns the last fetched stake of the provided public key. Returns 0 if the stake is not known
+func (krp *kleverRoleProvider) StakeOf(publicKey []byte) *big.Int {
+	krp.mut.RLock()
+	defer krp.mut.RUnlock()
+
+	stake, found := krp.stakes[string(publicKey)]
+	if !found || stake == nil {
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).Set(stake)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (krp *kleverRoleProvider) IsInterfaceNil() bool {
 	return krp == nil
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"math/big"
 	"strings"
 	"testing"
 
@@ -148,3 +149,85 @@ func TestKleverRoleProvider_MisconfiguredAddressesShouldError(t *testing.T) {
 	assert.True(t, strings.Contains(err.Error(), hex.EncodeToString(misconfiguredAddresses[2])))
 	assert.Zero(t, len(krp.whitelistedAddresses))
 }
+
+func TestKleverRoleProvider_ExecuteWithStakes(t *testing.T) {
+	t.Parallel()
+
+	relayer1 := bytes.Repeat([]byte("1"), 32)
+	relayer2 := bytes.Repeat([]byte("2"), 32)
+	stakes := map[string]*big.Int{
+		string(relayer1): big.NewInt(1000),
+		string(relayer2): big.NewInt(3000),
+	}
+
+	t.Run("stakes not fetched should return 0", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			GetAllStakedRelayersCalled: func(ctx context.Context) ([][]byte, error) {
+				return [][]byte{relayer1, relayer2}, nil
+			},
+			GetAmountStakedCalled: func(ctx context.Context, relayer []byte) (*big.Int, error) {
+				assert.Fail(t, "should have not called GetAmountStaked")
+				return nil, nil
+			},
+		}
+
+		krp, _ := NewKleverRoleProvider(args)
+		err := krp.Execute(context.TODO())
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(0), krp.StakeOf(relayer1))
+		assert.Equal(t, big.NewInt(0), krp.StakeOf(relayer2))
+	})
+	t.Run("fetching a stake errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgs()
+		args.FetchStakes = true
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			GetAllStakedRelayersCalled: func(ctx context.Context) ([][]byte, error) {
+				return [][]byte{relayer1, relayer2}, nil
+			},
+			GetAmountStakedCalled: func(ctx context.Context, relayer []byte) (*big.Int, error) {
+				if bytes.Equal(relayer, relayer2) {
+					return nil, expectedErr
+				}
+
+				return stakes[string(relayer)], nil
+			},
+		}
+
+		krp, _ := NewKleverRoleProvider(args)
+		err := krp.Execute(context.TODO())
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Equal(t, big.NewInt(0), krp.StakeOf(relayer1))
+		assert.Equal(t, 2, len(krp.SortedPublicKeys()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.FetchStakes = true
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			GetAllStakedRelayersCalled: func(ctx context.Context) ([][]byte, error) {
+				return [][]byte{relayer1, relayer2}, nil
+			},
+			GetAmountStakedCalled: func(ctx context.Context, relayer []byte) (*big.Int, error) {
+				return stakes[string(relayer)], nil
+			},
+		}
+
+		krp, _ := NewKleverRoleProvider(args)
+		err := krp.Execute(context.TODO())
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(1000), krp.StakeOf(relayer1))
+		assert.Equal(t, big.NewInt(3000), krp.StakeOf(relayer2))
+		assert.Equal(t, big.NewInt(0), krp.StakeOf([]byte("unknown")))
+
+		// the returned value should be a copy
+		krp.StakeOf(relayer1).SetInt64(1)
+		assert.Equal(t, big.NewInt(1000), krp.StakeOf(relayer1))
+	})
+}
@@ -0,0 +1,69 @@
+package tokensCache
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/klever-io/klv-bridge-eth-go/clients/ethereum"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+const (
+	kindEthMintBurn    = "ethMintBurn"
+	kindEthNative      = "ethNative"
+	kindEthWhitelisted = "ethWhitelisted"
+)
+
+// ArgsCachedClientWrapper is the DTO used in the NewCachedClientWrapper constructor function
+type ArgsCachedClientWrapper struct {
+	ClientWrapper ethereum.ClientWrapper
+	TokensCache   Cache
+}
+
+// cachedClientWrapper caches the Ethereum safe token flags, all the other operations (including the
+// balance queries) are forwarded to the wrapped client
+type cachedClientWrapper struct {
+	ethereum.ClientWrapper
+	cache Cache
+}
+
+// NewCachedClientWrapper creates an Ethereum client wrapper able to cache the token flags
+func NewCachedClientWrapper(args ArgsCachedClientWrapper) (*cachedClientWrapper, error) {
+	if check.IfNil(args.ClientWrapper) {
+		return nil, errNilClientWrapper
+	}
+	if check.IfNil(args.TokensCache) {
+		return nil, errNilTokensCache
+	}
+
+	return &cachedClientWrapper{
+		ClientWrapper: args.ClientWrapper,
+		cache:         args.TokensCache,
+	}, nil
+}
+
+// MintBurnTokens returns true if the token is mint/burn on the Ethereum safe
+func (wrapper *cachedClientWrapper) MintBurnTokens(ctx context.Context, token common.Address) (bool, error) {
+	return getBool(wrapper.cache, createKey(token.Bytes(), kindEthMintBurn), func() (bool, error) {
+		return wrapper.ClientWrapper.MintBurnTokens(ctx, token)
+	})
+}
+
+// NativeTokens returns true if the token is native on the Ethereum safe
+func (wrapper *cachedClientWrapper) NativeTokens(ctx context.Context, token common.Address) (bool, error) {
+	return getBool(wrapper.cache, createKey(token.Bytes(), kindEthNative), func() (bool, error) {
+		return wrapper.ClientWrapper.NativeTokens(ctx, token)
+	})
+}
+
+// WhitelistedTokens returns true if the token is whitelisted on the Ethereum safe
+func (wrapper *cachedClientWrapper) WhitelistedTokens(ctx context.Context, token common.Address) (bool, error) {
+	return getBool(wrapper.cache, createKey(token.Bytes(), kindEthWhitelisted), func() (bool, error) {
+		return wrapper.ClientWrapper.WhitelistedTokens(ctx, token)
+	})
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wrapper *cachedClientWrapper) IsInterfaceNil() bool {
+	return wrapper == nil
+}
@@ -0,0 +1,87 @@
+package tokensCache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	bridgeTests "github.com/klever-io/klv-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCachedClientWrapper(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil client wrapper should error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper, err := NewCachedClientWrapper(ArgsCachedClientWrapper{TokensCache: createTestCache()})
+		assert.True(t, check.IfNil(wrapper))
+		assert.Equal(t, errNilClientWrapper, err)
+	})
+	t.Run("nil tokens cache should error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper, err := NewCachedClientWrapper(ArgsCachedClientWrapper{ClientWrapper: &bridgeTests.EthereumClientWrapperStub{}})
+		assert.True(t, check.IfNil(wrapper))
+		assert.Equal(t, errNilTokensCache, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper, err := NewCachedClientWrapper(ArgsCachedClientWrapper{
+			ClientWrapper: &bridgeTests.EthereumClientWrapperStub{},
+			TokensCache:   createTestCache(),
+		})
+		assert.False(t, check.IfNil(wrapper))
+		assert.Nil(t, err)
+	})
+}
+
+func TestCachedClientWrapper_TokenFlags(t *testing.T) {
+	t.Parallel()
+
+	erc20Address := common.HexToAddress("0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c")
+	numCalls := make(map[string]int)
+	stub := &bridgeTests.EthereumClientWrapperStub{
+		MintBurnTokensCalled: func(ctx context.Context, account common.Address) (bool, error) {
+			numCalls[kindEthMintBurn]++
+			return true, nil
+		},
+		NativeTokensCalled: func(ctx context.Context, account common.Address) (bool, error) {
+			numCalls[kindEthNative]++
+			return false, nil
+		},
+		WhitelistedTokensCalled: func(ctx context.Context, account common.Address) (bool, error) {
+			numCalls[kindEthWhitelisted]++
+			return true, nil
+		},
+	}
+	cache := createTestCache()
+	wrapper, _ := NewCachedClientWrapper(ArgsCachedClientWrapper{ClientWrapper: stub, TokensCache: cache})
+
+	for i := 0; i < 3; i++ {
+		isMintBurn, err := wrapper.MintBurnTokens(context.Background(), erc20Address)
+		assert.Nil(t, err)
+		assert.True(t, isMintBurn)
+
+		isNative, err := wrapper.NativeTokens(context.Background(), erc20Address)
+		assert.Nil(t, err)
+		assert.False(t, isNative)
+
+		isWhitelisted, err := wrapper.WhitelistedTokens(context.Background(), erc20Address)
+		assert.Nil(t, err)
+		assert.True(t, isWhitelisted)
+	}
+	expectedNumCalls := map[string]int{
+		kindEthMintBurn:    1,
+		kindEthNative:      1,
+		kindEthWhitelisted: 1,
+	}
+	assert.Equal(t, expectedNumCalls, numCalls)
+
+	cache.InvalidateTokens(erc20Address.Bytes())
+	_, _ = wrapper.WhitelistedTokens(context.Background(), erc20Address)
+	assert.Equal(t, 2, numCalls[kindEthWhitelisted])
+}
@@ -0,0 +1,81 @@
+package tokensCache
+
+import (
+	"context"
+
+	"github.com/klever-io/klv-bridge-eth-go/clients"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+const (
+	kindTokenId      = "tokenId"
+	kindErc20Address = "erc20Address"
+)
+
+// ArgsCachedDataGetter is the DTO used in the NewCachedDataGetter constructor function
+type ArgsCachedDataGetter struct {
+	DataGetter  DataGetter
+	TokensCache Cache
+}
+
+type cachedDataGetter struct {
+	dataGetter DataGetter
+	cache      Cache
+}
+
+// NewCachedDataGetter creates a data getter that caches the token mappings. Empty responses are not cached
+// so a token whitelisted later will be picked up on the next lookup
+func NewCachedDataGetter(args ArgsCachedDataGetter) (*cachedDataGetter, error) {
+	if check.IfNil(args.DataGetter) {
+		return nil, clients.ErrNilDataGetter
+	}
+	if check.IfNil(args.TokensCache) {
+		return nil, errNilTokensCache
+	}
+
+	return &cachedDataGetter{
+		dataGetter: args.DataGetter,
+		cache:      args.TokensCache,
+	}, nil
+}
+
+// GetTokenIdForErc20Address returns the token ID for the provided ERC20 address
+func (getter *cachedDataGetter) GetTokenIdForErc20Address(ctx context.Context, erc20Address []byte) ([][]byte, error) {
+	key := createKey(erc20Address, kindTokenId)
+	value, found := getter.cache.Get(key)
+	if found {
+		return value.([][]byte), nil
+	}
+
+	response, err := getter.dataGetter.GetTokenIdForErc20Address(ctx, erc20Address)
+	if err != nil || len(response) == 0 {
+		return response, err
+	}
+
+	getter.cache.Put(key, response)
+
+	return response, nil
+}
+
+// GetERC20AddressForTokenId returns the ERC20 address for the provided token ID
+func (getter *cachedDataGetter) GetERC20AddressForTokenId(ctx context.Context, tokenId []byte) ([][]byte, error) {
+	key := createKey(tokenId, kindErc20Address)
+	value, found := getter.cache.Get(key)
+	if found {
+		return value.([][]byte), nil
+	}
+
+	response, err := getter.dataGetter.GetERC20AddressForTokenId(ctx, tokenId)
+	if err != nil || len(response) == 0 {
+		return response, err
+	}
+
+	getter.cache.Put(key, response)
+
+	return response, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (getter *cachedDataGetter) IsInterfaceNil() bool {
+	return getter == nil
+}
@@ -0,0 +1,119 @@
+package tokensCache
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/klever-io/klv-bridge-eth-go/clients"
+	bridgeTests "github.com/klever-io/klv-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestCache() *tokensCache {
+	cache, _ := NewTokensCache(createMockArgsTokensCache())
+
+	return cache
+}
+
+func TestNewCachedDataGetter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil data getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		getter, err := NewCachedDataGetter(ArgsCachedDataGetter{TokensCache: createTestCache()})
+		assert.True(t, check.IfNil(getter))
+		assert.Equal(t, clients.ErrNilDataGetter, err)
+	})
+	t.Run("nil tokens cache should error", func(t *testing.T) {
+		t.Parallel()
+
+		getter, err := NewCachedDataGetter(ArgsCachedDataGetter{DataGetter: &bridgeTests.DataGetterStub{}})
+		assert.True(t, check.IfNil(getter))
+		assert.Equal(t, errNilTokensCache, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		getter, err := NewCachedDataGetter(ArgsCachedDataGetter{
+			DataGetter:  &bridgeTests.DataGetterStub{},
+			TokensCache: createTestCache(),
+		})
+		assert.False(t, check.IfNil(getter))
+		assert.Nil(t, err)
+	})
+}
+
+func TestCachedDataGetter_GetTokenIdForErc20Address(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should cache non empty responses", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		dataGetter := &bridgeTests.DataGetterStub{
+			GetTokenIdForErc20AddressCalled: func(ctx context.Context, erc20Address []byte) ([][]byte, error) {
+				numCalls++
+				return [][]byte{[]byte("tck")}, nil
+			},
+		}
+		getter, _ := NewCachedDataGetter(ArgsCachedDataGetter{DataGetter: dataGetter, TokensCache: createTestCache()})
+
+		for i := 0; i < 3; i++ {
+			response, err := getter.GetTokenIdForErc20Address(context.Background(), []byte("erc20"))
+			assert.Nil(t, err)
+			assert.Equal(t, [][]byte{[]byte("tck")}, response)
+		}
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("should not cache empty responses or errors", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		numCalls := 0
+		dataGetter := &bridgeTests.DataGetterStub{
+			GetTokenIdForErc20AddressCalled: func(ctx context.Context, erc20Address []byte) ([][]byte, error) {
+				numCalls++
+				if numCalls == 1 {
+					return nil, expectedErr
+				}
+				return make([][]byte, 0), nil
+			},
+		}
+		getter, _ := NewCachedDataGetter(ArgsCachedDataGetter{DataGetter: dataGetter, TokensCache: createTestCache()})
+
+		_, err := getter.GetTokenIdForErc20Address(context.Background(), []byte("erc20"))
+		assert.Equal(t, expectedErr, err)
+		response, err := getter.GetTokenIdForErc20Address(context.Background(), []byte("erc20"))
+		assert.Nil(t, err)
+		assert.Empty(t, response)
+		_, _ = getter.GetTokenIdForErc20Address(context.Background(), []byte("erc20"))
+		assert.Equal(t, 3, numCalls)
+	})
+}
+
+func TestCachedDataGetter_GetERC20AddressForTokenId(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	dataGetter := &bridgeTests.DataGetterStub{
+		GetERC20AddressForTokenIdCalled: func(ctx context.Context, tokenId []byte) ([][]byte, error) {
+			numCalls++
+			return [][]byte{[]byte("erc20")}, nil
+		},
+	}
+	cache := createTestCache()
+	getter, _ := NewCachedDataGetter(ArgsCachedDataGetter{DataGetter: dataGetter, TokensCache: cache})
+
+	response, err := getter.GetERC20AddressForTokenId(context.Background(), []byte("tck"))
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("erc20")}, response)
+	_, _ = getter.GetERC20AddressForTokenId(context.Background(), []byte("tck"))
+	assert.Equal(t, 1, numCalls)
+
+	cache.InvalidateTokens([]byte("tck"))
+	_, _ = getter.GetERC20AddressForTokenId(context.Background(), []byte("tck"))
+	assert.Equal(t, 2, numCalls)
+}
@@ -0,0 +1,84 @@
+package tokensCache
+
+import (
+	"context"
+	"math/big"
+
+	ethklever "github.com/klever-io/klv-bridge-eth-go/bridges/ethKC"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+const (
+	kindKCMintBurn        = "kcMintBurn"
+	kindKCNative          = "kcNative"
+	kindConvertedKdaValue = "convertedKdaAmount"
+)
+
+// ArgsCachedKCClient is the DTO used in the NewCachedKCClient constructor function
+type ArgsCachedKCClient struct {
+	KCClient    ethklever.KCClient
+	TokensCache Cache
+}
+
+// cachedKCClient caches the Klever Blockchain safe token flags and amount conversions, all the other
+// operations (including the balance queries) are forwarded to the wrapped client
+type cachedKCClient struct {
+	ethklever.KCClient
+	cache Cache
+}
+
+// NewCachedKCClient creates a Klever Blockchain client wrapper able to cache the token flags
+func NewCachedKCClient(args ArgsCachedKCClient) (*cachedKCClient, error) {
+	if check.IfNil(args.KCClient) {
+		return nil, errNilKCClient
+	}
+	if check.IfNil(args.TokensCache) {
+		return nil, errNilTokensCache
+	}
+
+	return &cachedKCClient{
+		KCClient: args.KCClient,
+		cache:    args.TokensCache,
+	}, nil
+}
+
+// IsMintBurnToken returns true if the provided token is whitelisted for mint/burn operations
+func (c *cachedKCClient) IsMintBurnToken(ctx context.Context, token []byte) (bool, error) {
+	return getBool(c.cache, createKey(token, kindKCMintBurn), func() (bool, error) {
+		return c.KCClient.IsMintBurnToken(ctx, token)
+	})
+}
+
+// IsNativeToken returns true if the provided token is native
+func (c *cachedKCClient) IsNativeToken(ctx context.Context, token []byte) (bool, error) {
+	return getBool(c.cache, createKey(token, kindKCNative), func() (bool, error) {
+		return c.KCClient.IsNativeToken(ctx, token)
+	})
+}
+
+// ConvertEthToKdaAmount converts an amount from Ethereum decimals to KDA decimals
+func (c *cachedKCClient) ConvertEthToKdaAmount(ctx context.Context, token []byte, amount *big.Int) (*big.Int, error) {
+	if amount == nil {
+		return nil, errNilAmount
+	}
+
+	key := createKey(token, kindConvertedKdaValue, amount.String())
+	value, found := c.cache.Get(key)
+	if found {
+		return big.NewInt(0).Set(value.(*big.Int)), nil
+	}
+
+	converted, err := c.KCClient.ConvertEthToKdaAmount(ctx, token, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.Put(key, big.NewInt(0).Set(converted))
+
+	return converted, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (c *cachedKCClient) IsInterfaceNil() bool {
+	return c == nil
+}
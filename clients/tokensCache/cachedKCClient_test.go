@@ -0,0 +1,116 @@
+package tokensCache
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	bridgeTests "github.com/klever-io/klv-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCachedKCClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil client should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewCachedKCClient(ArgsCachedKCClient{TokensCache: createTestCache()})
+		assert.True(t, check.IfNil(client))
+		assert.Equal(t, errNilKCClient, err)
+	})
+	t.Run("nil tokens cache should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewCachedKCClient(ArgsCachedKCClient{KCClient: &bridgeTests.KCClientStub{}})
+		assert.True(t, check.IfNil(client))
+		assert.Equal(t, errNilTokensCache, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := NewCachedKCClient(ArgsCachedKCClient{
+			KCClient:    &bridgeTests.KCClientStub{},
+			TokensCache: createTestCache(),
+		})
+		assert.False(t, check.IfNil(client))
+		assert.Nil(t, err)
+	})
+}
+
+func TestCachedKCClient_TokenFlags(t *testing.T) {
+	t.Parallel()
+
+	numMintBurnCalls := 0
+	numNativeCalls := 0
+	stub := &bridgeTests.KCClientStub{
+		IsMintBurnTokenCalled: func(ctx context.Context, token []byte) (bool, error) {
+			numMintBurnCalls++
+			return true, nil
+		},
+		IsNativeTokenCalled: func(ctx context.Context, token []byte) (bool, error) {
+			numNativeCalls++
+			return false, nil
+		},
+	}
+	cache := createTestCache()
+	client, _ := NewCachedKCClient(ArgsCachedKCClient{KCClient: stub, TokensCache: cache})
+
+	for i := 0; i < 3; i++ {
+		isMintBurn, err := client.IsMintBurnToken(context.Background(), []byte("tck"))
+		assert.Nil(t, err)
+		assert.True(t, isMintBurn)
+
+		isNative, err := client.IsNativeToken(context.Background(), []byte("tck"))
+		assert.Nil(t, err)
+		assert.False(t, isNative)
+	}
+	assert.Equal(t, 1, numMintBurnCalls)
+	assert.Equal(t, 1, numNativeCalls)
+
+	cache.InvalidateTokens([]byte("tck"))
+	_, _ = client.IsMintBurnToken(context.Background(), []byte("tck"))
+	_, _ = client.IsNativeToken(context.Background(), []byte("tck"))
+	assert.Equal(t, 2, numMintBurnCalls)
+	assert.Equal(t, 2, numNativeCalls)
+}
+
+func TestCachedKCClient_ConvertEthToKdaAmount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		client, _ := NewCachedKCClient(ArgsCachedKCClient{KCClient: &bridgeTests.KCClientStub{}, TokensCache: createTestCache()})
+		converted, err := client.ConvertEthToKdaAmount(context.Background(), []byte("tck"), nil)
+		assert.Nil(t, converted)
+		assert.Equal(t, errNilAmount, err)
+	})
+	t.Run("should cache per amount and return copies", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		stub := &bridgeTests.KCClientStub{
+			ConvertEthToKdaAmountCalled: func(ctx context.Context, token []byte, amount *big.Int) (*big.Int, error) {
+				numCalls++
+				return big.NewInt(0).Div(amount, big.NewInt(10)), nil
+			},
+		}
+		client, _ := NewCachedKCClient(ArgsCachedKCClient{KCClient: stub, TokensCache: createTestCache()})
+
+		converted, err := client.ConvertEthToKdaAmount(context.Background(), []byte("tck"), big.NewInt(1000))
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(100), converted)
+		converted.SetInt64(5)
+
+		converted, err = client.ConvertEthToKdaAmount(context.Background(), []byte("tck"), big.NewInt(1000))
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(100), converted)
+		assert.Equal(t, 1, numCalls)
+
+		converted, _ = client.ConvertEthToKdaAmount(context.Background(), []byte("tck"), big.NewInt(2000))
+		assert.Equal(t, big.NewInt(200), converted)
+		assert.Equal(t, 2, numCalls)
+	})
+}
@@ -0,0 +1,29 @@
+package tokensCache
+
+import (
+	"encoding/hex"
+	"strings"
+)
+
+// createKey builds a key prefixed by the token so all the values of a token can be invalidated at once
+func createKey(token []byte, kind string, extra ...string) string {
+	parts := append([]string{hex.EncodeToString(token), kind}, extra...)
+
+	return strings.Join(parts, "/")
+}
+
+func getBool(cache Cache, key string, fetch func() (bool, error)) (bool, error) {
+	value, found := cache.Get(key)
+	if found {
+		return value.(bool), nil
+	}
+
+	result, err := fetch()
+	if err != nil {
+		return false, err
+	}
+
+	cache.Put(key, result)
+
+	return result, nil
+}
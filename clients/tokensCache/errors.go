@@ -0,0 +1,10 @@
+package tokensCache
+
+import "errors"
+
+var (
+	errNilTokensCache   = errors.New("nil tokens cache")
+	errNilKCClient      = errors.New("nil Klever Blockchain client")
+	errNilClientWrapper = errors.New("nil Ethereum client wrapper")
+	errNilAmount        = errors.New("nil amount")
+)
@@ -0,0 +1,17 @@
+package tokensCache
+
+import "context"
+
+// DataGetter defines the Klever Blockchain data getter operations used by the tokens mappers
+type DataGetter interface {
+	GetTokenIdForErc20Address(ctx context.Context, erc20Address []byte) ([][]byte, error)
+	GetERC20AddressForTokenId(ctx context.Context, tokenId []byte) ([][]byte, error)
+	IsInterfaceNil() bool
+}
+
+// Cache defines the operations of the cache used by the cached wrappers
+type Cache interface {
+	Get(key string) (interface{}, bool)
+	Put(key string, value interface{})
+	IsInterfaceNil() bool
+}
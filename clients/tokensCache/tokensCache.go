@@ -0,0 +1,152 @@
+package tokensCache
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/clients"
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// maxNumEntries bounds the cache size as some keys (like the converted amounts) are not limited to the number of tokens
+const maxNumEntries = 10000
+
+// ArgsTokensCache is the DTO used in the NewTokensCache constructor function
+type ArgsTokensCache struct {
+	Log           logger.Logger
+	StatusHandler core.StatusHandler
+	Expiration    time.Duration
+}
+
+type cacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+type tokensCache struct {
+	log           logger.Logger
+	statusHandler core.StatusHandler
+	expiration    time.Duration
+	getTimeFunc   func() time.Time
+
+	mut     sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+// NewTokensCache creates a cache for the rarely changing token values like mappings and contract flags.
+// A zero expiration disables the caching, all the lookups being forwarded to the chains.
+func NewTokensCache(args ArgsTokensCache) (*tokensCache, error) {
+	if check.IfNil(args.Log) {
+		return nil, clients.ErrNilLogger
+	}
+	if check.IfNil(args.StatusHandler) {
+		return nil, clients.ErrNilStatusHandler
+	}
+	if args.Expiration < 0 {
+		return nil, fmt.Errorf("%w for Expiration: %v", clients.ErrInvalidValue, args.Expiration)
+	}
+
+	return &tokensCache{
+		log:           args.Log,
+		statusHandler: args.StatusHandler,
+		expiration:    args.Expiration,
+		getTimeFunc:   time.Now,
+		entries:       make(map[string]*cacheEntry),
+	}, nil
+}
+
+// Get returns the cached value for the provided key, if it exists and did not expire
+func (cache *tokensCache) Get(key string) (interface{}, bool) {
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+
+	entry, found := cache.entries[key]
+	if found && cache.getTimeFunc().Before(entry.expiresAt) {
+		cache.statusHandler.AddIntMetric(core.MetricTokensCacheHits, 1)
+		return entry.value, true
+	}
+	if found {
+		delete(cache.entries, key)
+	}
+
+	cache.statusHandler.AddIntMetric(core.MetricTokensCacheMisses, 1)
+	cache.statusHandler.SetIntMetric(core.MetricTokensCacheNumEntries, len(cache.entries))
+
+	return nil, false
+}
+
+// Put stores the value for the provided key. It does nothing if the caching is disabled
+func (cache *tokensCache) Put(key string, value interface{}) {
+	if cache.expiration == 0 {
+		return
+	}
+
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+
+	now := cache.getTimeFunc()
+	if len(cache.entries) >= maxNumEntries {
+		cache.removeExpired(now)
+	}
+	if len(cache.entries) >= maxNumEntries {
+		cache.log.Debug("tokens cache is full, clearing it", "num entries", len(cache.entries))
+		cache.entries = make(map[string]*cacheEntry)
+	}
+
+	cache.entries[key] = &cacheEntry{
+		value:     value,
+		expiresAt: now.Add(cache.expiration),
+	}
+	cache.statusHandler.SetIntMetric(core.MetricTokensCacheNumEntries, len(cache.entries))
+}
+
+func (cache *tokensCache) removeExpired(now time.Time) {
+	for key, entry := range cache.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(cache.entries, key)
+		}
+	}
+}
+
+// InvalidateTokens removes all the cached values of the provided tokens (KDA token IDs or ERC20 addresses)
+func (cache *tokensCache) InvalidateTokens(tokens ...[]byte) {
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+
+	numRemoved := 0
+	for _, token := range tokens {
+		prefix := hex.EncodeToString(token) + "/"
+		for key := range cache.entries {
+			if strings.HasPrefix(key, prefix) {
+				delete(cache.entries, key)
+				numRemoved++
+			}
+		}
+	}
+
+	cache.log.Debug("tokens cache invalidated", "num tokens", len(tokens), "num removed entries", numRemoved)
+	cache.statusHandler.AddIntMetric(core.MetricTokensCacheInvalidations, 1)
+	cache.statusHandler.SetIntMetric(core.MetricTokensCacheNumEntries, len(cache.entries))
+}
+
+// InvalidateAll removes all the cached values
+func (cache *tokensCache) InvalidateAll() {
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+
+	cache.entries = make(map[string]*cacheEntry)
+
+	cache.log.Debug("tokens cache invalidated")
+	cache.statusHandler.AddIntMetric(core.MetricTokensCacheInvalidations, 1)
+	cache.statusHandler.SetIntMetric(core.MetricTokensCacheNumEntries, 0)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cache *tokensCache) IsInterfaceNil() bool {
+	return cache == nil
+}
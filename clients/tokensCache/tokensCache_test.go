@@ -0,0 +1,165 @@
+package tokensCache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/clients"
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsTokensCache() ArgsTokensCache {
+	return ArgsTokensCache{
+		Log:           logger.GetOrCreate("test"),
+		StatusHandler: testsCommon.NewStatusHandlerMock("test"),
+		Expiration:    time.Minute,
+	}
+}
+
+func TestNewTokensCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokensCache()
+		args.Log = nil
+
+		cache, err := NewTokensCache(args)
+		assert.True(t, check.IfNil(cache))
+		assert.Equal(t, clients.ErrNilLogger, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokensCache()
+		args.StatusHandler = nil
+
+		cache, err := NewTokensCache(args)
+		assert.True(t, check.IfNil(cache))
+		assert.Equal(t, clients.ErrNilStatusHandler, err)
+	})
+	t.Run("negative expiration should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokensCache()
+		args.Expiration = -time.Second
+
+		cache, err := NewTokensCache(args)
+		assert.True(t, check.IfNil(cache))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.Contains(t, err.Error(), "for Expiration")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cache, err := NewTokensCache(createMockArgsTokensCache())
+		assert.False(t, check.IfNil(cache))
+		assert.Nil(t, err)
+	})
+}
+
+func TestTokensCache_GetPut(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return the stored value until it expires", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokensCache()
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		cache, _ := NewTokensCache(args)
+		currentTime := time.Unix(1000, 0)
+		cache.getTimeFunc = func() time.Time {
+			return currentTime
+		}
+
+		key := createKey([]byte("tck"), "kind")
+		value, found := cache.Get(key)
+		assert.Nil(t, value)
+		assert.False(t, found)
+
+		cache.Put(key, true)
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricTokensCacheNumEntries))
+
+		currentTime = currentTime.Add(time.Minute - time.Second)
+		value, found = cache.Get(key)
+		assert.Equal(t, true, value)
+		assert.True(t, found)
+
+		currentTime = currentTime.Add(time.Second)
+		value, found = cache.Get(key)
+		assert.Nil(t, value)
+		assert.False(t, found)
+
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricTokensCacheHits))
+		assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricTokensCacheMisses))
+		assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricTokensCacheNumEntries))
+	})
+	t.Run("zero expiration should not store values", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokensCache()
+		args.Expiration = 0
+		cache, _ := NewTokensCache(args)
+
+		key := createKey([]byte("tck"), "kind")
+		cache.Put(key, true)
+		value, found := cache.Get(key)
+		assert.Nil(t, value)
+		assert.False(t, found)
+		assert.Equal(t, 0, len(cache.entries))
+	})
+	t.Run("full cache should be cleared", func(t *testing.T) {
+		t.Parallel()
+
+		cache, _ := NewTokensCache(createMockArgsTokensCache())
+		for i := 0; i < maxNumEntries; i++ {
+			cache.Put(createKey([]byte("tck"), "kind", string(rune(i))), i)
+		}
+		require.Equal(t, maxNumEntries, len(cache.entries))
+
+		cache.Put("new key", 1)
+		assert.Equal(t, 1, len(cache.entries))
+	})
+}
+
+func TestTokensCache_InvalidateTokens(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTokensCache()
+	statusHandler := testsCommon.NewStatusHandlerMock("test")
+	args.StatusHandler = statusHandler
+	cache, _ := NewTokensCache(args)
+
+	cache.Put(createKey([]byte("tck1"), "kind1"), true)
+	cache.Put(createKey([]byte("tck1"), "kind2", "extra"), false)
+	cache.Put(createKey([]byte("tck10"), "kind1"), true)
+	cache.Put(createKey([]byte("tck2"), "kind1"), true)
+	cache.Put(createKey([]byte("tck3"), "kind1"), true)
+
+	cache.InvalidateTokens([]byte("tck1"), []byte("tck2"))
+
+	_, found := cache.Get(createKey([]byte("tck1"), "kind1"))
+	assert.False(t, found)
+	_, found = cache.Get(createKey([]byte("tck1"), "kind2", "extra"))
+	assert.False(t, found)
+	_, found = cache.Get(createKey([]byte("tck2"), "kind1"))
+	assert.False(t, found)
+	_, found = cache.Get(createKey([]byte("tck10"), "kind1"))
+	assert.True(t, found)
+	_, found = cache.Get(createKey([]byte("tck3"), "kind1"))
+	assert.True(t, found)
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricTokensCacheInvalidations))
+
+	cache.InvalidateAll()
+	assert.Equal(t, 0, len(cache.entries))
+	assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricTokensCacheInvalidations))
+	assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricTokensCacheNumEntries))
+}
@@ -0,0 +1,12 @@
+package tokensRegistry
+
+import "errors"
+
+var (
+	errNilKCClient             = errors.New("nil Klever Blockchain client")
+	errNilKleverProxy          = errors.New("nil Klever Blockchain proxy")
+	errNilEthereumClient       = errors.New("nil Ethereum client")
+	errNilErc20ContractsHolder = errors.New("nil ERC20 contracts holder")
+	errNilSafeContractAddress  = errors.New("nil Klever Blockchain safe contract address")
+	errNilCacheInvalidator     = errors.New("nil tokens cache invalidator")
+)
@@ -0,0 +1,50 @@
+package tokensRegistry
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/proxy/models"
+)
+
+// KCDataGetter defines the Klever Blockchain data getter operations needed to load the known tokens and their mappings
+type KCDataGetter interface {
+	GetAllKnownTokens(ctx context.Context) ([][]byte, error)
+	GetERC20AddressForTokenId(ctx context.Context, tokenId []byte) ([][]byte, error)
+	GetTokenIdForErc20Address(ctx context.Context, erc20Address []byte) ([][]byte, error)
+	IsInterfaceNil() bool
+}
+
+// KCClient defines the Klever Blockchain client operations needed to fetch the Safe token flags
+type KCClient interface {
+	IsMintBurnToken(ctx context.Context, token []byte) (bool, error)
+	IsNativeToken(ctx context.Context, token []byte) (bool, error)
+	IsInterfaceNil() bool
+}
+
+// KleverProxy defines the Klever Blockchain proxy operations needed to fetch the KDA token properties
+type KleverProxy interface {
+	GetKDATokenData(ctx context.Context, address address.Address, tokenIdentifier string) (*models.KDAFungibleTokenData, error)
+	IsInterfaceNil() bool
+}
+
+// EthereumClient defines the Ethereum client operations needed to fetch the Safe token flags
+type EthereumClient interface {
+	WhitelistedTokens(ctx context.Context, token common.Address) (bool, error)
+	MintBurnTokens(ctx context.Context, token common.Address) (bool, error)
+	NativeTokens(ctx context.Context, token common.Address) (bool, error)
+	IsInterfaceNil() bool
+}
+
+// Erc20ContractsHolder defines the Ethereum ERC20 contract operations needed to fetch the token decimals
+type Erc20ContractsHolder interface {
+	Decimals(ctx context.Context, erc20Address common.Address) (uint8, error)
+	IsInterfaceNil() bool
+}
+
+// TokensCacheInvalidator defines a component able to drop the cached values of the provided tokens
+type TokensCacheInvalidator interface {
+	InvalidateTokens(tokens ...[]byte)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,301 @@
+package tokensRegistry
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/klever-io/klv-bridge-eth-go/clients"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// ArgsTokensRegistry is the DTO used in the NewTokensRegistry constructor function
+type ArgsTokensRegistry struct {
+	Log                       logger.Logger
+	KCDataGetter              KCDataGetter
+	KCClient                  KCClient
+	KleverProxy               KleverProxy
+	KleverSafeContractAddress address.Address
+	EthereumClient            EthereumClient
+	Erc20ContractsHolder      Erc20ContractsHolder
+	CacheInvalidator          TokensCacheInvalidator
+}
+
+type tokensRegistry struct {
+	log                       logger.Logger
+	kcDataGetter              KCDataGetter
+	kcClient                  KCClient
+	kleverProxy               KleverProxy
+	kleverSafeContractAddress address.Address
+	ethereumClient            EthereumClient
+	erc20ContractsHolder      Erc20ContractsHolder
+	cacheInvalidator          TokensCacheInvalidator
+
+	mutSnapshot sync.RWMutex
+	snapshot    *core.TokensRegistrySnapshot
+}
+
+// NewTokensRegistry creates a new tokens registry able to gather the bridged tokens information from both chains
+func NewTokensRegistry(args ArgsTokensRegistry) (*tokensRegistry, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tokensRegistry{
+		log:                       args.Log,
+		kcDataGetter:              args.KCDataGetter,
+		kcClient:                  args.KCClient,
+		kleverProxy:               args.KleverProxy,
+		kleverSafeContractAddress: args.KleverSafeContractAddress,
+		ethereumClient:            args.EthereumClient,
+		erc20ContractsHolder:      args.Erc20ContractsHolder,
+		cacheInvalidator:          args.CacheInvalidator,
+		snapshot: &core.TokensRegistrySnapshot{
+			Tokens: make([]*core.TokenRegistryEntry, 0),
+		},
+	}, nil
+}
+
+func checkArgs(args ArgsTokensRegistry) error {
+	if check.IfNil(args.Log) {
+		return clients.ErrNilLogger
+	}
+	if check.IfNil(args.KCDataGetter) {
+		return clients.ErrNilDataGetter
+	}
+	if check.IfNil(args.KCClient) {
+		return errNilKCClient
+	}
+	if check.IfNil(args.KleverProxy) {
+		return errNilKleverProxy
+	}
+	if check.IfNil(args.KleverSafeContractAddress) {
+		return errNilSafeContractAddress
+	}
+	if check.IfNil(args.EthereumClient) {
+		return errNilEthereumClient
+	}
+	if check.IfNil(args.Erc20ContractsHolder) {
+		return errNilErc20ContractsHolder
+	}
+	if check.IfNil(args.CacheInvalidator) {
+		return errNilCacheInvalidator
+	}
+
+	return nil
+}
+
+// Execute will reload all the known tokens from both chains and will replace the stored snapshot
+func (registry *tokensRegistry) Execute(ctx context.Context) error {
+	tokens, err := registry.kcDataGetter.GetAllKnownTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	snapshot := &core.TokensRegistrySnapshot{
+		LastUpdateTimestamp: time.Now().Unix(),
+		Tokens:              make([]*core.TokenRegistryEntry, 0, len(tokens)),
+	}
+	for _, token := range tokens {
+		entry := registry.loadToken(ctx, token)
+		snapshot.NumWarnings += len(entry.Warnings)
+		snapshot.Tokens = append(snapshot.Tokens, entry)
+	}
+
+	registry.mutSnapshot.Lock()
+	previousSnapshot := registry.snapshot
+	registry.snapshot = snapshot
+	registry.mutSnapshot.Unlock()
+
+	registry.invalidateChangedTokens(previousSnapshot, snapshot)
+
+	registry.log.Debug("tokens registry updated", "num tokens", len(snapshot.Tokens), "num warnings", snapshot.NumWarnings)
+	for _, entry := range snapshot.Tokens {
+		for _, warning := range entry.Warnings {
+			registry.log.Warn("tokens registry inconsistency", "token", entry.KDA.TokenID, "warning", warning)
+		}
+	}
+
+	return nil
+}
+
+func (registry *tokensRegistry) loadToken(ctx context.Context, token []byte) *core.TokenRegistryEntry {
+	entry := &core.TokenRegistryEntry{
+		KDA: core.KDATokenInfo{
+			TokenID: string(token),
+		},
+		Warnings: make([]string, 0),
+	}
+
+	registry.loadKDAInfo(ctx, token, entry)
+
+	erc20Address, found := registry.loadERC20Address(ctx, token, entry)
+	if !found {
+		return entry
+	}
+
+	registry.loadERC20Info(ctx, erc20Address, entry)
+	registry.checkFlags(entry)
+
+	return entry
+}
+
+func (registry *tokensRegistry) loadKDAInfo(ctx context.Context, token []byte, entry *core.TokenRegistryEntry) {
+	var err error
+	entry.KDA.IsNative, err = registry.kcClient.IsNativeToken(ctx, token)
+	if err != nil {
+		addWarning(entry, "could not fetch the Klever Blockchain native flag: %v", err)
+	}
+
+	entry.KDA.IsMintBurn, err = registry.kcClient.IsMintBurnToken(ctx, token)
+	if err != nil {
+		addWarning(entry, "could not fetch the Klever Blockchain mint-burn flag: %v", err)
+	}
+
+	tokenData, err := registry.kleverProxy.GetKDATokenData(ctx, registry.kleverSafeContractAddress, string(token))
+	if err != nil {
+		addWarning(entry, "could not fetch the KDA token data: %v", err)
+		return
+	}
+	entry.KDA.Precision = tokenData.Precision
+}
+
+func (registry *tokensRegistry) loadERC20Address(ctx context.Context, token []byte, entry *core.TokenRegistryEntry) (common.Address, bool) {
+	response, err := registry.kcDataGetter.GetERC20AddressForTokenId(ctx, token)
+	if err != nil {
+		addWarning(entry, "could not fetch the ERC20 address: %v", err)
+		return common.Address{}, false
+	}
+	if len(response) == 0 || len(response[0]) == 0 {
+		addWarning(entry, "missing KDA to ERC20 mapping")
+		return common.Address{}, false
+	}
+
+	erc20Address := common.BytesToAddress(response[0])
+	entry.ERC20.Address = erc20Address.String()
+
+	response, err = registry.kcDataGetter.GetTokenIdForErc20Address(ctx, erc20Address.Bytes())
+	if err != nil {
+		addWarning(entry, "could not fetch the token ID for the ERC20 address: %v", err)
+		return erc20Address, true
+	}
+	if len(response) == 0 || len(response[0]) == 0 {
+		addWarning(entry, "missing ERC20 to KDA mapping, the mapping exists in one direction only")
+		return erc20Address, true
+	}
+	if !bytes.Equal(response[0], token) {
+		addWarning(entry, "ERC20 to KDA mapping points to %s, the mapping exists in one direction only", string(response[0]))
+	}
+
+	return erc20Address, true
+}
+
+func (registry *tokensRegistry) loadERC20Info(ctx context.Context, erc20Address common.Address, entry *core.TokenRegistryEntry) {
+	var err error
+	entry.ERC20.Decimals, err = registry.erc20ContractsHolder.Decimals(ctx, erc20Address)
+	if err != nil {
+		addWarning(entry, "could not fetch the ERC20 decimals: %v", err)
+	}
+
+	entry.ERC20.IsWhitelisted, err = registry.ethereumClient.WhitelistedTokens(ctx, erc20Address)
+	if err != nil {
+		addWarning(entry, "could not fetch the Ethereum whitelist flag: %v", err)
+	}
+
+	entry.ERC20.IsNative, err = registry.ethereumClient.NativeTokens(ctx, erc20Address)
+	if err != nil {
+		addWarning(entry, "could not fetch the Ethereum native flag: %v", err)
+	}
+
+	entry.ERC20.IsMintBurn, err = registry.ethereumClient.MintBurnTokens(ctx, erc20Address)
+	if err != nil {
+		addWarning(entry, "could not fetch the Ethereum mint-burn flag: %v", err)
+	}
+}
+
+func (registry *tokensRegistry) checkFlags(entry *core.TokenRegistryEntry) {
+	if !entry.ERC20.IsWhitelisted {
+		addWarning(entry, "token is not whitelisted on the Ethereum safe")
+	}
+	if entry.KDA.IsNative && entry.ERC20.IsNative {
+		addWarning(entry, "token is native on both chains")
+	}
+	if !entry.KDA.IsNative && !entry.ERC20.IsNative {
+		addWarning(entry, "token is not native on any chain")
+	}
+	if !entry.KDA.IsNative && !entry.KDA.IsMintBurn {
+		addWarning(entry, "token is neither native nor mint-burn on the Klever Blockchain safe")
+	}
+	if !entry.ERC20.IsNative && !entry.ERC20.IsMintBurn {
+		addWarning(entry, "token is neither native nor mint-burn on the Ethereum safe")
+	}
+}
+
+// invalidateChangedTokens drops the cached values of the tokens that were added, removed or had their
+// mappings or flags changed since the previous snapshot, as the safes do not emit events on whitelist changes
+func (registry *tokensRegistry) invalidateChangedTokens(previousSnapshot *core.TokensRegistrySnapshot, snapshot *core.TokensRegistrySnapshot) {
+	if previousSnapshot.LastUpdateTimestamp == 0 {
+		return
+	}
+
+	previousEntries := make(map[string]*core.TokenRegistryEntry, len(previousSnapshot.Tokens))
+	for _, entry := range previousSnapshot.Tokens {
+		previousEntries[entry.KDA.TokenID] = entry
+	}
+
+	changedTokens := make([][]byte, 0)
+	for _, entry := range snapshot.Tokens {
+		previousEntry, found := previousEntries[entry.KDA.TokenID]
+		delete(previousEntries, entry.KDA.TokenID)
+		if found && previousEntry.KDA == entry.KDA && previousEntry.ERC20 == entry.ERC20 {
+			continue
+		}
+
+		registry.log.Info("tokens registry detected a token change", "token", entry.KDA.TokenID, "ERC20 address", entry.ERC20.Address)
+		changedTokens = append(changedTokens, getTokensToInvalidate(entry)...)
+		if found {
+			changedTokens = append(changedTokens, getTokensToInvalidate(previousEntry)...)
+		}
+	}
+	for _, removedEntry := range previousEntries {
+		registry.log.Info("tokens registry detected a removed token", "token", removedEntry.KDA.TokenID, "ERC20 address", removedEntry.ERC20.Address)
+		changedTokens = append(changedTokens, getTokensToInvalidate(removedEntry)...)
+	}
+
+	if len(changedTokens) > 0 {
+		registry.cacheInvalidator.InvalidateTokens(changedTokens...)
+	}
+}
+
+func getTokensToInvalidate(entry *core.TokenRegistryEntry) [][]byte {
+	tokens := [][]byte{[]byte(entry.KDA.TokenID)}
+	if len(entry.ERC20.Address) > 0 {
+		tokens = append(tokens, common.HexToAddress(entry.ERC20.Address).Bytes())
+	}
+
+	return tokens
+}
+
+func addWarning(entry *core.TokenRegistryEntry, format string, args ...interface{}) {
+	entry.Warnings = append(entry.Warnings, fmt.Sprintf(format, args...))
+}
+
+// GetTokens returns the last loaded tokens snapshot
+func (registry *tokensRegistry) GetTokens() *core.TokensRegistrySnapshot {
+	registry.mutSnapshot.RLock()
+	defer registry.mutSnapshot.RUnlock()
+
+	return registry.snapshot
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (registry *tokensRegistry) IsInterfaceNil() bool {
+	return registry == nil
+}
@@ -0,0 +1,344 @@
+package tokensRegistry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/klever-io/klv-bridge-eth-go/clients"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/proxy/models"
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
+	bridgeTests "github.com/klever-io/klv-bridge-eth-go/testsCommon/bridge"
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon/interactors"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKleverSafeAddress = "klv1qqqqqqqqqqqqqpgqxjgmvqe9kvvr4xvvxflue3a7cjjeyvx9sg8snh0ljc"
+
+var (
+	testToken        = []byte("ETHUSDC-0g3a")
+	testErc20Address = common.HexToAddress("0x2222222222222222222222222222222222222222")
+)
+
+func createMockArgs(t *testing.T) ArgsTokensRegistry {
+	safeAddress, err := address.NewAddress(testKleverSafeAddress)
+	require.Nil(t, err)
+
+	return ArgsTokensRegistry{
+		Log: &testsCommon.LoggerStub{},
+		KCDataGetter: &bridgeTests.DataGetterStub{
+			GetAllKnownTokensCalled: func(ctx context.Context) ([][]byte, error) {
+				return [][]byte{testToken}, nil
+			},
+			GetERC20AddressForTokenIdCalled: func(ctx context.Context, tokenId []byte) ([][]byte, error) {
+				return [][]byte{testErc20Address.Bytes()}, nil
+			},
+			GetTokenIdForErc20AddressCalled: func(ctx context.Context, erc20Address []byte) ([][]byte, error) {
+				return [][]byte{testToken}, nil
+			},
+		},
+		KCClient: &bridgeTests.KCClientStub{
+			IsMintBurnTokenCalled: func(ctx context.Context, token []byte) (bool, error) {
+				return true, nil
+			},
+			IsNativeTokenCalled: func(ctx context.Context, token []byte) (bool, error) {
+				return false, nil
+			},
+		},
+		KleverProxy: &interactors.ProxyStub{
+			GetKDATokenDataCalled: func(ctx context.Context, address address.Address, tokenIdentifier string) (*models.KDAFungibleTokenData, error) {
+				return &models.KDAFungibleTokenData{
+					TokenIdentifier: tokenIdentifier,
+					Precision:       6,
+				}, nil
+			},
+		},
+		KleverSafeContractAddress: safeAddress,
+		EthereumClient: &bridgeTests.EthereumClientWrapperStub{
+			WhitelistedTokensCalled: func(ctx context.Context, account common.Address) (bool, error) {
+				return true, nil
+			},
+			NativeTokensCalled: func(ctx context.Context, account common.Address) (bool, error) {
+				return true, nil
+			},
+		},
+		Erc20ContractsHolder: &bridgeTests.ERC20ContractsHolderStub{
+			DecimalsCalled: func(ctx context.Context, erc20Address common.Address) (uint8, error) {
+				return 18, nil
+			},
+		},
+		CacheInvalidator: &testsCommon.TokensCacheInvalidatorStub{},
+	}
+}
+
+func TestNewTokensRegistry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Log = nil
+
+		registry, err := NewTokensRegistry(args)
+		assert.True(t, check.IfNil(registry))
+		assert.Equal(t, clients.ErrNilLogger, err)
+	})
+	t.Run("nil data getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.KCDataGetter = nil
+
+		registry, err := NewTokensRegistry(args)
+		assert.True(t, check.IfNil(registry))
+		assert.Equal(t, clients.ErrNilDataGetter, err)
+	})
+	t.Run("nil Klever Blockchain client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.KCClient = nil
+
+		registry, err := NewTokensRegistry(args)
+		assert.True(t, check.IfNil(registry))
+		assert.Equal(t, errNilKCClient, err)
+	})
+	t.Run("nil Klever Blockchain proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.KleverProxy = nil
+
+		registry, err := NewTokensRegistry(args)
+		assert.True(t, check.IfNil(registry))
+		assert.Equal(t, errNilKleverProxy, err)
+	})
+	t.Run("nil safe contract address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.KleverSafeContractAddress = nil
+
+		registry, err := NewTokensRegistry(args)
+		assert.True(t, check.IfNil(registry))
+		assert.Equal(t, errNilSafeContractAddress, err)
+	})
+	t.Run("nil Ethereum client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.EthereumClient = nil
+
+		registry, err := NewTokensRegistry(args)
+		assert.True(t, check.IfNil(registry))
+		assert.Equal(t, errNilEthereumClient, err)
+	})
+	t.Run("nil ERC20 contracts holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Erc20ContractsHolder = nil
+
+		registry, err := NewTokensRegistry(args)
+		assert.True(t, check.IfNil(registry))
+		assert.Equal(t, errNilErc20ContractsHolder, err)
+	})
+	t.Run("nil cache invalidator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.CacheInvalidator = nil
+
+		registry, err := NewTokensRegistry(args)
+		assert.True(t, check.IfNil(registry))
+		assert.Equal(t, errNilCacheInvalidator, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		registry, err := NewTokensRegistry(createMockArgs(t))
+		assert.False(t, check.IfNil(registry))
+		assert.Nil(t, err)
+
+		snapshot := registry.GetTokens()
+		assert.Empty(t, snapshot.Tokens)
+		assert.Zero(t, snapshot.LastUpdateTimestamp)
+	})
+}
+
+func TestTokensRegistry_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("get all known tokens errors should not replace the snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgs(t)
+		args.KCDataGetter.(*bridgeTests.DataGetterStub).GetAllKnownTokensCalled = func(ctx context.Context) ([][]byte, error) {
+			return nil, expectedErr
+		}
+
+		registry, _ := NewTokensRegistry(args)
+		err := registry.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.Empty(t, registry.GetTokens().Tokens)
+	})
+	t.Run("consistent token should not have warnings", func(t *testing.T) {
+		t.Parallel()
+
+		registry, _ := NewTokensRegistry(createMockArgs(t))
+		err := registry.Execute(context.Background())
+		require.Nil(t, err)
+
+		snapshot := registry.GetTokens()
+		assert.NotZero(t, snapshot.LastUpdateTimestamp)
+		assert.Zero(t, snapshot.NumWarnings)
+		require.Equal(t, 1, len(snapshot.Tokens))
+
+		expectedEntry := &core.TokenRegistryEntry{
+			KDA: core.KDATokenInfo{
+				TokenID:    string(testToken),
+				Precision:  6,
+				IsNative:   false,
+				IsMintBurn: true,
+			},
+			ERC20: core.ERC20TokenInfo{
+				Address:       testErc20Address.String(),
+				Decimals:      18,
+				IsWhitelisted: true,
+				IsNative:      true,
+				IsMintBurn:    false,
+			},
+			Warnings: make([]string, 0),
+		}
+		assert.Equal(t, expectedEntry, snapshot.Tokens[0])
+	})
+	t.Run("missing ERC20 mapping should warn", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.KCDataGetter.(*bridgeTests.DataGetterStub).GetERC20AddressForTokenIdCalled = func(ctx context.Context, tokenId []byte) ([][]byte, error) {
+			return make([][]byte, 0), nil
+		}
+
+		registry, _ := NewTokensRegistry(args)
+		_ = registry.Execute(context.Background())
+
+		snapshot := registry.GetTokens()
+		assert.Equal(t, 1, snapshot.NumWarnings)
+		assert.Equal(t, []string{"missing KDA to ERC20 mapping"}, snapshot.Tokens[0].Warnings)
+		assert.Empty(t, snapshot.Tokens[0].ERC20.Address)
+	})
+	t.Run("one direction mapping should warn", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.KCDataGetter.(*bridgeTests.DataGetterStub).GetTokenIdForErc20AddressCalled = func(ctx context.Context, erc20Address []byte) ([][]byte, error) {
+			return [][]byte{[]byte("OTHER-0001")}, nil
+		}
+
+		registry, _ := NewTokensRegistry(args)
+		_ = registry.Execute(context.Background())
+
+		expectedWarnings := []string{"ERC20 to KDA mapping points to OTHER-0001, the mapping exists in one direction only"}
+		assert.Equal(t, expectedWarnings, registry.GetTokens().Tokens[0].Warnings)
+	})
+	t.Run("inconsistent flags should warn", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.KCClient.(*bridgeTests.KCClientStub).IsNativeTokenCalled = func(ctx context.Context, token []byte) (bool, error) {
+			return true, nil
+		}
+		args.EthereumClient.(*bridgeTests.EthereumClientWrapperStub).WhitelistedTokensCalled = func(ctx context.Context, account common.Address) (bool, error) {
+			return false, nil
+		}
+
+		registry, _ := NewTokensRegistry(args)
+		_ = registry.Execute(context.Background())
+
+		expectedWarnings := []string{
+			"token is not whitelisted on the Ethereum safe",
+			"token is native on both chains",
+		}
+		snapshot := registry.GetTokens()
+		assert.Equal(t, expectedWarnings, snapshot.Tokens[0].Warnings)
+		assert.Equal(t, 2, snapshot.NumWarnings)
+	})
+	t.Run("query errors should be reported as warnings", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgs(t)
+		args.KleverProxy = &interactors.ProxyStub{
+			GetKDATokenDataCalled: func(ctx context.Context, address address.Address, tokenIdentifier string) (*models.KDAFungibleTokenData, error) {
+				return nil, expectedErr
+			},
+		}
+		args.Erc20ContractsHolder = &bridgeTests.ERC20ContractsHolderStub{
+			DecimalsCalled: func(ctx context.Context, erc20Address common.Address) (uint8, error) {
+				return 0, expectedErr
+			},
+		}
+
+		registry, _ := NewTokensRegistry(args)
+		err := registry.Execute(context.Background())
+		assert.Nil(t, err)
+
+		expectedWarnings := []string{
+			"could not fetch the KDA token data: expected error",
+			"could not fetch the ERC20 decimals: expected error",
+		}
+		assert.Equal(t, expectedWarnings, registry.GetTokens().Tokens[0].Warnings)
+	})
+}
+
+func TestTokensRegistry_ExecuteShouldInvalidateChangedTokens(t *testing.T) {
+	t.Parallel()
+
+	isWhitelisted := true
+	knownTokens := [][]byte{testToken}
+	invalidatedTokens := make([][]byte, 0)
+	args := createMockArgs(t)
+	args.KCDataGetter.(*bridgeTests.DataGetterStub).GetAllKnownTokensCalled = func(ctx context.Context) ([][]byte, error) {
+		return knownTokens, nil
+	}
+	args.EthereumClient.(*bridgeTests.EthereumClientWrapperStub).WhitelistedTokensCalled = func(ctx context.Context, account common.Address) (bool, error) {
+		return isWhitelisted, nil
+	}
+	args.CacheInvalidator = &testsCommon.TokensCacheInvalidatorStub{
+		InvalidateTokensCalled: func(tokens ...[]byte) {
+			invalidatedTokens = append(invalidatedTokens, tokens...)
+		},
+	}
+	registry, _ := NewTokensRegistry(args)
+
+	t.Run("first load should not invalidate", func(t *testing.T) {
+		_ = registry.Execute(context.Background())
+		assert.Empty(t, invalidatedTokens)
+	})
+	t.Run("unchanged tokens should not invalidate", func(t *testing.T) {
+		_ = registry.Execute(context.Background())
+		assert.Empty(t, invalidatedTokens)
+	})
+	t.Run("whitelist change should invalidate both sides", func(t *testing.T) {
+		isWhitelisted = false
+		_ = registry.Execute(context.Background())
+
+		expectedTokens := [][]byte{testToken, testErc20Address.Bytes(), testToken, testErc20Address.Bytes()}
+		assert.Equal(t, expectedTokens, invalidatedTokens)
+	})
+	t.Run("removed token should invalidate", func(t *testing.T) {
+		invalidatedTokens = make([][]byte, 0)
+		knownTokens = make([][]byte, 0)
+		_ = registry.Execute(context.Background())
+
+		assert.Equal(t, [][]byte{testToken, testErc20Address.Bytes()}, invalidatedTokens)
+	})
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/klever-io/klever-go/tools"
+	"github.com/klever-io/klv-bridge-eth-go/clients/ethereum"
+	"github.com/klever-io/klv-bridge-eth-go/clients/ethereum/contract"
+	"github.com/klever-io/klv-bridge-eth-go/clients/ethereum/wrappers"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	"github.com/klever-io/klv-bridge-eth-go/cmd/bridge/disabled"
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/doctor"
+	"github.com/urfave/cli"
+)
+
+const (
+	exitCodeDoctorChecksFailed = 2
+	doctorTimeout              = time.Minute * 2
+)
+
+func runDoctor(ctx *cli.Context) error {
+	flagsConfig := getFlagsConfig(ctx)
+
+	_, err := attachFileLogger(log, flagsConfig)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig(flagsConfig.ConfigurationFile)
+	if err != nil {
+		return err
+	}
+
+	report := doctor.NewReport()
+	doctor.CheckConfig(cfg, report)
+	if report.NumFailed() > 0 {
+		return printDoctorReport(report)
+	}
+
+	args, err := createDoctorArgs(cfg)
+	if err != nil {
+		return err
+	}
+
+	doctorInstance, err := doctor.NewDoctor(args)
+	if err != nil {
+		return err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), doctorTimeout)
+	defer cancel()
+
+	return printDoctorReport(doctorInstance.Run(timeoutCtx))
+}
+
+func printDoctorReport(report *doctor.Report) error {
+	fmt.Fprint(os.Stdout, report.String())
+	if report.NumFailed() > 0 {
+		return cli.NewExitError(fmt.Sprintf("doctor: %d checks failed", report.NumFailed()), exitCodeDoctorChecksFailed)
+	}
+
+	return nil
+}
+
+func createDoctorArgs(cfg config.Config) (doctor.ArgsDoctor, error) {
+	proxy, err := createKleverProxy(cfg)
+	if err != nil {
+		return doctor.ArgsDoctor{}, err
+	}
+
+	ethClient, err := ethclient.Dial(cfg.Eth.NetworkAddress)
+	if err != nil {
+		return doctor.ArgsDoctor{}, err
+	}
+
+	multiSigInstance, err := contract.NewBridge(ethCommon.HexToAddress(cfg.Eth.MultisigContractAddress), ethClient)
+	if err != nil {
+		return doctor.ArgsDoctor{}, err
+	}
+
+	safeInstance, err := contract.NewERC20Safe(ethCommon.HexToAddress(cfg.Eth.SafeContractAddress), ethClient)
+	if err != nil {
+		return doctor.ArgsDoctor{}, err
+	}
+
+	argsClientWrapper := wrappers.ArgsEthereumChainWrapper{
+		StatusHandler:    &disabled.StatusHandler{},
+		MultiSigContract: multiSigInstance,
+		SafeContract:     safeInstance,
+		BlockchainClient: ethClient,
+	}
+	clientWrapper, err := wrappers.NewEthereumChainWrapper(argsClientWrapper)
+	if err != nil {
+		return doctor.ArgsDoctor{}, err
+	}
+
+	cryptoHandler, err := ethereum.NewCryptoHandler(cfg.Eth.PrivateKeyFile)
+	if err != nil {
+		return doctor.ArgsDoctor{}, fmt.Errorf("%w for Eth.PrivateKeyFile", err)
+	}
+
+	_, pbkString, err := tools.LoadSkPkFromPemFile(cfg.Klever.PrivateKeyFile, 0, "")
+	if err != nil {
+		return doctor.ArgsDoctor{}, fmt.Errorf("%w for Klever.PrivateKeyFile", err)
+	}
+
+	kleverRelayerAddress, err := address.NewAddress(pbkString)
+	if err != nil {
+		return doctor.ArgsDoctor{}, err
+	}
+
+	multisigAddress, err := address.NewAddress(cfg.Klever.MultisigContractAddress)
+	if err != nil {
+		return doctor.ArgsDoctor{}, err
+	}
+
+	safeAddress, err := address.NewAddress(cfg.Klever.SafeContractAddress)
+	if err != nil {
+		return doctor.ArgsDoctor{}, err
+	}
+
+	argsKLVClientDataGetter := klever.ArgsKLVClientDataGetter{
+		MultisigContractAddress: multisigAddress,
+		SafeContractAddress:     safeAddress,
+		RelayerAddress:          kleverRelayerAddress,
+		Proxy:                   proxy,
+		Log:                     log,
+	}
+	dataGetter, err := klever.NewKLVClientDataGetter(argsKLVClientDataGetter)
+	if err != nil {
+		return doctor.ArgsDoctor{}, err
+	}
+
+	log.Debug("doctor relayer addresses",
+		"Ethereum", cryptoHandler.GetAddress().String(),
+		"Klever Blockchain", kleverRelayerAddress.Bech32())
+
+	return doctor.ArgsDoctor{
+		Config:                 cfg,
+		EthereumClient:         clientWrapper,
+		SafeContract:           safeInstance,
+		KleverDataGetter:       dataGetter,
+		KleverProxy:            proxy,
+		EthereumRelayerAddress: cryptoHandler.GetAddress(),
+		KleverRelayerAddress:   kleverRelayerAddress,
+	}, nil
+}
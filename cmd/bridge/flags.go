@@ -2,6 +2,7 @@ package main
 
 import (
 	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/core/logging"
 	"github.com/multiversx/mx-chain-go/facade"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/urfave/cli"
@@ -65,6 +66,14 @@ var (
 		Usage: "This flag specifies the `directory` where the node will store databases and logs.",
 		Value: "",
 	}
+	// logFormat defines the format used for the log lines written on the standard output
+	logFormat = cli.StringFlag{
+		Name: "log-format",
+		Usage: "This flag specifies the `format` of the log lines written on the standard output. It can be " +
+			logging.PlainFormat + " or " + logging.JSONFormat + ". The " + logging.JSONFormat + " format emits one JSON " +
+			"object per line, with the batch ID, action ID, tx hash and direction as dedicated keys.",
+		Value: logging.PlainFormat,
+	}
 	// disableAnsiColor defines if the logger subsystem should prevent displaying ANSI colors
 	disableAnsiColor = cli.BoolFlag{
 		Name:  "disable-ansi-color",
@@ -81,6 +90,7 @@ func getFlags() []cli.Flag {
 	return []cli.Flag{
 		workingDirectory,
 		logLevel,
+		logFormat,
 		disableAnsiColor,
 		configurationFile,
 		configurationApiFile,
@@ -95,6 +105,7 @@ func getFlagsConfig(ctx *cli.Context) config.ContextFlagsConfig {
 
 	flagsConfig.WorkingDir = ctx.GlobalString(workingDirectory.Name)
 	flagsConfig.LogLevel = ctx.GlobalString(logLevel.Name)
+	flagsConfig.LogFormat = ctx.GlobalString(logFormat.Name)
 	flagsConfig.DisableAnsiColor = ctx.GlobalBool(disableAnsiColor.Name)
 	flagsConfig.ConfigurationFile = ctx.GlobalString(configurationFile.Name)
 	flagsConfig.ConfigurationApiFile = ctx.GlobalString(configurationApiFile.Name)
@@ -18,6 +18,7 @@ import (
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/proxy/models"
 	"github.com/klever-io/klv-bridge-eth-go/config"
 	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/core/logging"
 	"github.com/klever-io/klv-bridge-eth-go/factory"
 	"github.com/klever-io/klv-bridge-eth-go/p2p"
 	"github.com/klever-io/klv-bridge-eth-go/status"
@@ -86,6 +87,17 @@ func main() {
 	app.Action = func(c *cli.Context) error {
 		return startRelay(c, app.Version)
 	}
+	app.Commands = []cli.Command{
+		{
+			Name: "doctor",
+			Usage: "Checks the relayer setup (config, connectivity to both chains, relayer whitelisting, contracts wiring " +
+				"and token mappings) and prints a pass/fail report. Exits with code " + fmt.Sprint(exitCodeDoctorChecksFailed) +
+				" if any check fails",
+			Action: func(c *cli.Context) error {
+				return runDoctor(c)
+			},
+		},
+	}
 
 	err := app.Run(os.Args)
 	if err != nil {
@@ -154,29 +166,23 @@ func startRelay(ctx *cli.Context, version string) error {
 		return err
 	}
 
-	if len(cfg.Klever.NetworkAddress) == 0 {
-		return fmt.Errorf("empty Klever.NetworkAddress in config file")
-	}
-
-	argsProxy := proxy.ArgsProxy{
-		ProxyURL:            cfg.Klever.NetworkAddress,
-		SameScState:         false,
-		ShouldBeSynced:      false,
-		FinalityCheck:       cfg.Klever.Proxy.FinalityCheck,
-		AllowedDeltaToFinal: cfg.Klever.Proxy.MaxNoncesDelta,
-		CacheExpirationTime: time.Second * time.Duration(cfg.Klever.Proxy.CacherExpirationSeconds),
-		EntityType:          models.RestAPIEntityType(cfg.Klever.Proxy.RestAPIEntityType),
+	proxy, err := createKleverProxy(cfg)
+	if err != nil {
+		return err
 	}
 
-	proxy, err := proxy.NewProxy(argsProxy)
+	ethClient, err := ethclient.Dial(cfg.Eth.NetworkAddress)
 	if err != nil {
 		return err
 	}
 
-	ethClient, err := ethclient.Dial(cfg.Eth.NetworkAddress)
+	ethLogsSubscriber, err := createEthereumLogsSubscriber(cfg)
 	if err != nil {
 		return err
 	}
+	if ethLogsSubscriber != nil {
+		defer ethLogsSubscriber.Close()
+	}
 
 	bridgeEthAddress := ethCommon.HexToAddress(cfg.Eth.MultisigContractAddress)
 	multiSigInstance, err := contract.NewBridge(bridgeEthAddress, ethClient)
@@ -254,13 +260,16 @@ func startRelay(ctx *cli.Context, version string) error {
 		AppStatusHandler:          appStatusHandler,
 		KleverClientStatusHandler: kleverClientStatusHandler,
 	}
+	if ethLogsSubscriber != nil {
+		args.EthereumLogsSubscriber = ethLogsSubscriber
+	}
 
 	ethToKCComponents, err := factory.NewEthKleverBridgeComponents(args)
 	if err != nil {
 		return err
 	}
 
-	webServer, err := factory.StartWebServer(configs, metricsHolder)
+	webServer, err := factory.StartWebServer(configs, metricsHolder, ethToKCComponents.TokensRegistry(), ethToKCComponents.RelayersHealth())
 	if err != nil {
 		return err
 	}
@@ -293,6 +302,36 @@ func startRelay(ctx *cli.Context, version string) error {
 	return lastErr
 }
 
+// createEthereumLogsSubscriber returns a websocket Ethereum client, or nil if the events subscription is disabled
+func createEthereumLogsSubscriber(cfg config.Config) (*ethclient.Client, error) {
+	if !cfg.Eth.EventsSubscription.Enabled {
+		return nil, nil
+	}
+	if len(cfg.Eth.EventsSubscription.WebSocketAddress) == 0 {
+		return nil, fmt.Errorf("empty Eth.EventsSubscription.WebSocketAddress in config file")
+	}
+
+	return ethclient.Dial(cfg.Eth.EventsSubscription.WebSocketAddress)
+}
+
+func createKleverProxy(cfg config.Config) (proxy.Proxy, error) {
+	if len(cfg.Klever.NetworkAddress) == 0 {
+		return nil, fmt.Errorf("empty Klever.NetworkAddress in config file")
+	}
+
+	argsProxy := proxy.ArgsProxy{
+		ProxyURL:            cfg.Klever.NetworkAddress,
+		SameScState:         false,
+		ShouldBeSynced:      false,
+		FinalityCheck:       cfg.Klever.Proxy.FinalityCheck,
+		AllowedDeltaToFinal: cfg.Klever.Proxy.MaxNoncesDelta,
+		CacheExpirationTime: time.Second * time.Duration(cfg.Klever.Proxy.CacherExpirationSeconds),
+		EntityType:          models.RestAPIEntityType(cfg.Klever.Proxy.RestAPIEntityType),
+	}
+
+	return proxy.NewProxy(argsProxy)
+}
+
 func loadConfig(filepath string) (config.Config, error) {
 	cfg := config.Config{}
 	err := chainCore.LoadTomlFile(&cfg, filepath)
@@ -349,7 +388,13 @@ func attachFileLogger(log logger.Logger, flagsConfig config.ContextFlagsConfig)
 			return nil, err
 		}
 	}
-	log.Trace("logger updated", "level", logLevelFlagValue, "disable ANSI color", flagsConfig.DisableAnsiColor)
+
+	err = logging.ApplyFormat(flagsConfig.LogFormat)
+	if err != nil {
+		return nil, err
+	}
+	log.Trace("logger updated", "level", logLevelFlagValue, "format", flagsConfig.LogFormat,
+		"disable ANSI color", flagsConfig.DisableAnsiColor)
 
 	return fileLogging, nil
 }
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"github.com/urfave/cli"
+)
+
+var (
+	data = cli.StringFlag{
+		Name:  "data",
+		Usage: "The hex encoded `[data]` to be decoded. The 0x prefix is optional",
+	}
+	source = cli.StringFlag{
+		Name: "source",
+		Usage: "This flag specifies where the data comes from. Usage: " + rawSource + " for the call data as found " +
+			"in the deposit transaction or in the rawCallData field of the pending operations REST API, " +
+			ethLogSource + " for the data field of an Ethereum ERC20SCDeposit log or " + pendingOperationSource +
+			" for a pending operation as returned by the Klever Blockchain SC proxy contract",
+		Value: rawSource,
+	}
+)
+
+func getFlags() []cli.Flag {
+	return []cli.Flag{
+		data,
+		source,
+	}
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/klever-io/klv-bridge-eth-go/clients/ethereum/contract"
+	bridgeCore "github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/parsers"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const (
+	rawSource              = "raw"
+	ethLogSource           = "eth-log"
+	pendingOperationSource = "pending-operation"
+	scDepositEventName     = "ERC20SCDeposit"
+)
+
+var log = logger.GetOrCreate("main")
+
+type pendingOperationInfo struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Token  string `json:"token"`
+	Amount string `json:"amount"`
+	Nonce  uint64 `json:"nonce"`
+}
+
+type decodedData struct {
+	Source           string                    `json:"source"`
+	DepositNonce     string                    `json:"depositNonce,omitempty"`
+	PendingOperation *pendingOperationInfo     `json:"pendingOperation,omitempty"`
+	RawCallData      string                    `json:"rawCallData"`
+	CallData         *parsers.ReadableCallData `json:"callData,omitempty"`
+	CallDataError    string                    `json:"callDataError,omitempty"`
+}
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "Call data decoder CLI tool"
+	app.Usage = "This tool decodes the call data used by the bridge SC calls into a human-readable JSON"
+	app.Flags = getFlags()
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Klever Blockchain Team",
+			Email: "contact@klever.io",
+		},
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return execute(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func execute(ctx *cli.Context) error {
+	if !ctx.IsSet(data.Name) {
+		return fmt.Errorf("missing the %s flag", data.Name)
+	}
+
+	buff, err := hex.DecodeString(strings.TrimPrefix(ctx.String(data.Name), "0x"))
+	if err != nil {
+		return fmt.Errorf("%w while decoding the %s flag", err, data.Name)
+	}
+
+	result, err := decode(ctx.String(source.Name), buff)
+	if err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stdout, string(output))
+
+	return nil
+}
+
+func decode(sourceType string, buff []byte) (*decodedData, error) {
+	result := &decodedData{
+		Source: sourceType,
+	}
+
+	switch sourceType {
+	case rawSource:
+	case ethLogSource:
+		event, err := decodeEthLog(buff)
+		if err != nil {
+			return nil, err
+		}
+
+		result.DepositNonce = event.DepositNonce.String()
+		// the Ethereum call data does not contain the protocol marker and length, they are added by the relayers
+		buff = bridgeCore.ConvertEthereumCallData(event.CallData)
+	case pendingOperationSource:
+		codec := &parsers.KCCodec{}
+		completeCallData, err := codec.DecodeProxySCCompleteCallData(buff)
+		if err != nil {
+			return nil, err
+		}
+
+		result.PendingOperation = toPendingOperationInfo(completeCallData)
+		buff = completeCallData.RawCallData
+	default:
+		return nil, fmt.Errorf("unknown source %s, should be one of: %s, %s, %s",
+			sourceType, rawSource, ethLogSource, pendingOperationSource)
+	}
+
+	result.RawCallData = hex.EncodeToString(buff)
+
+	codec := &parsers.KCCodec{}
+	callData, err := codec.DecodeCallData(buff)
+	if err != nil {
+		result.CallDataError = err.Error()
+		return result, nil
+	}
+
+	readableCallData := callData.ToReadable()
+	result.CallData = &readableCallData
+
+	return result, nil
+}
+
+func decodeEthLog(buff []byte) (*contract.ERC20SafeERC20SCDeposit, error) {
+	safeAbi, err := contract.ERC20SafeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	event := &contract.ERC20SafeERC20SCDeposit{}
+	err = safeAbi.UnpackIntoInterface(event, scDepositEventName, buff)
+	if err != nil {
+		return nil, fmt.Errorf("%w while unpacking the %s log data", err, scDepositEventName)
+	}
+
+	return event, nil
+}
+
+func toPendingOperationInfo(callData parsers.ProxySCCompleteCallData) *pendingOperationInfo {
+	info := &pendingOperationInfo{
+		From:  callData.From.Hex(),
+		Token: callData.Token,
+		Nonce: callData.Nonce,
+	}
+	if !check.IfNil(callData.To) {
+		info.To = callData.To.Bech32()
+	}
+	if callData.Amount != nil {
+		info.Amount = callData.Amount.String()
+	}
+
+	return info
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/big"
+	"testing"
+
+	"github.com/klever-io/klv-bridge-eth-go/clients/ethereum/contract"
+	bridgeCore "github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/parsers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestCallData() parsers.CallData {
+	return parsers.CallData{
+		Type:      bridgeCore.DataPresentProtocolMarker,
+		Function:  "deposit",
+		GasLimit:  5000000,
+		Arguments: []string{"arg1", "arg2"},
+	}
+}
+
+func encodeTestCallData(t *testing.T) []byte {
+	codec := &parsers.KCCodec{}
+	buff, err := codec.EncodeCallData(createTestCallData())
+	require.Nil(t, err)
+
+	return buff
+}
+
+func packTestEthLog(t *testing.T, depositNonce *big.Int, callData []byte) []byte {
+	safeAbi, err := contract.ERC20SafeMetaData.GetAbi()
+	require.Nil(t, err)
+
+	buff, err := safeAbi.Events[scDepositEventName].Inputs.NonIndexed().Pack(depositNonce, callData)
+	require.Nil(t, err)
+
+	return buff
+}
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	expectedCallData := createTestCallData().ToReadable()
+
+	t.Run("unknown source should error", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := decode("unknown", encodeTestCallData(t))
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unknown source unknown")
+		assert.Nil(t, result)
+	})
+	t.Run("raw source should work", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := decode(rawSource, encodeTestCallData(t))
+		require.Nil(t, err)
+		assert.Equal(t, rawSource, result.Source)
+		assert.Empty(t, result.CallDataError)
+		assert.Equal(t, &expectedCallData, result.CallData)
+	})
+	t.Run("raw source with invalid call data should return the decoding error", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := decode(rawSource, []byte{0x03})
+		require.Nil(t, err)
+		assert.Equal(t, "03", result.RawCallData)
+		assert.NotEmpty(t, result.CallDataError)
+		assert.Nil(t, result.CallData)
+	})
+	t.Run("eth-log source with invalid log data should error", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := decode(ethLogSource, []byte{0x01, 0x02})
+		assert.NotNil(t, err)
+		assert.Nil(t, result)
+	})
+	t.Run("eth-log source should add the protocol marker and length before decoding", func(t *testing.T) {
+		t.Parallel()
+
+		// the Ethereum deposit holds the call data without the marker and the length
+		ethCallData := encodeTestCallData(t)[1+bridgeCore.Uint32ArgBytes:]
+		buff := packTestEthLog(t, big.NewInt(37), ethCallData)
+
+		result, err := decode(ethLogSource, buff)
+		require.Nil(t, err)
+		assert.Equal(t, ethLogSource, result.Source)
+		assert.Equal(t, "37", result.DepositNonce)
+		assert.Empty(t, result.CallDataError)
+		assert.Equal(t, &expectedCallData, result.CallData)
+	})
+	t.Run("eth-log source with empty call data should decode as missing data", func(t *testing.T) {
+		t.Parallel()
+
+		buff := packTestEthLog(t, big.NewInt(38), nil)
+
+		result, err := decode(ethLogSource, buff)
+		require.Nil(t, err)
+		assert.Equal(t, "38", result.DepositNonce)
+		assert.Equal(t, "00", result.RawCallData)
+		assert.Empty(t, result.CallDataError)
+		assert.Equal(t, "missing data", result.CallData.Type)
+	})
+	t.Run("pending-operation source with invalid data should error", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := decode(pendingOperationSource, []byte{0x01})
+		assert.NotNil(t, err)
+		assert.Nil(t, result)
+	})
+	t.Run("pending-operation source should work", func(t *testing.T) {
+		t.Parallel()
+
+		token := "ETHUSDC-0ae8ee"
+		buff := bytes.Repeat([]byte{0x01}, 20)                 // Eth address
+		buff = append(buff, bytes.Repeat([]byte{0x02}, 32)...) // Klv address
+		buff = binary.BigEndian.AppendUint32(buff, uint32(len(token)))
+		buff = append(buff, token...)
+		buff = binary.BigEndian.AppendUint32(buff, 2)
+		buff = append(buff, 0x4e, 0x20) // amount = 20000
+		buff = binary.BigEndian.AppendUint64(buff, 7)
+		buff = append(buff, encodeTestCallData(t)...)
+
+		result, err := decode(pendingOperationSource, buff)
+		require.Nil(t, err)
+		assert.Equal(t, pendingOperationSource, result.Source)
+		require.NotNil(t, result.PendingOperation)
+		assert.Equal(t, "0x0101010101010101010101010101010101010101", result.PendingOperation.From)
+		assert.NotEmpty(t, result.PendingOperation.To)
+		assert.Equal(t, token, result.PendingOperation.Token)
+		assert.Equal(t, "20000", result.PendingOperation.Amount)
+		assert.Equal(t, uint64(7), result.PendingOperation.Nonce)
+		assert.Empty(t, result.CallDataError)
+		assert.Equal(t, &expectedCallData, result.CallData)
+	})
+}
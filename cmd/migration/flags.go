@@ -4,6 +4,7 @@ import (
 	"path"
 
 	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/core/logging"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/urfave/cli"
 )
@@ -17,6 +18,13 @@ var (
 			" log level.",
 		Value: "*:" + logger.LogInfo.String(),
 	}
+	logFormat = cli.StringFlag{
+		Name: "log-format",
+		Usage: "This flag specifies the `format` of the log lines written on the standard output. It can be " +
+			logging.PlainFormat + " or " + logging.JSONFormat + ". The " + logging.JSONFormat + " format emits one JSON " +
+			"object per line, with the batch ID, action ID, tx hash and direction as dedicated keys.",
+		Value: logging.PlainFormat,
+	}
 	configurationFile = cli.StringFlag{
 		Name: "config",
 		Usage: "The `" + filePathPlaceholder + "` for the main configuration file. This TOML file contain the main " +
@@ -55,6 +63,7 @@ var (
 func getFlags() []cli.Flag {
 	return []cli.Flag{
 		logLevel,
+		logFormat,
 		configurationFile,
 		mode,
 		migrationJsonFile,
@@ -67,6 +76,7 @@ func getFlagsConfig(ctx *cli.Context) config.ContextFlagsConfig {
 	flagsConfig := config.ContextFlagsConfig{}
 
 	flagsConfig.LogLevel = ctx.GlobalString(logLevel.Name)
+	flagsConfig.LogFormat = ctx.GlobalString(logFormat.Name)
 	flagsConfig.ConfigurationFile = ctx.GlobalString(configurationFile.Name)
 
 	return flagsConfig
@@ -22,6 +22,7 @@ import (
 	"github.com/klever-io/klv-bridge-eth-go/cmd/migration/disabled"
 	"github.com/klever-io/klv-bridge-eth-go/config"
 	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/core/logging"
 	"github.com/klever-io/klv-bridge-eth-go/executors/ethereum"
 	"github.com/klever-io/klv-bridge-eth-go/executors/ethereum/bridgeV2Wrappers"
 	"github.com/klever-io/klv-bridge-eth-go/executors/ethereum/bridgeV2Wrappers/contract"
@@ -83,6 +84,11 @@ func execute(ctx *cli.Context) error {
 		return err
 	}
 
+	err = logging.ApplyFormat(flagsConfig.LogFormat)
+	if err != nil {
+		return err
+	}
+
 	cfg, err := loadConfig(flagsConfig.ConfigurationFile)
 	if err != nil {
 		return err
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/executors/kleverBlockchain/attempts"
+	"github.com/klever-io/klv-bridge-eth-go/factory"
+	"github.com/urfave/cli"
+)
+
+// the dead-letter commands open the attempts storage, so they should be used while the executor is stopped
+
+func listDeadLetters(ctx *cli.Context) error {
+	return runOnAttemptsTracker(ctx, func(tracker attemptsTracker) error {
+		buff, err := json.MarshalIndent(tracker.DeadLetters(), "", "  ")
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(os.Stdout, string(buff))
+
+		return nil
+	})
+}
+
+func resolveDeadLetter(ctx *cli.Context) error {
+	if !ctx.IsSet(deadLetterID.Name) {
+		return fmt.Errorf("missing the %s flag", deadLetterID.Name)
+	}
+
+	id := ctx.Uint64(deadLetterID.Name)
+
+	return runOnAttemptsTracker(ctx, func(tracker attemptsTracker) error {
+		err := tracker.ResolveDeadLetter(id)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(os.Stdout, "SC call with ID %d removed from the dead-letter list, it will be executed again\n", id)
+
+		return nil
+	})
+}
+
+type attemptsTracker interface {
+	DeadLetters() []core.ScCallExecutionAttempts
+	ResolveDeadLetter(id uint64) error
+}
+
+func runOnAttemptsTracker(ctx *cli.Context, handler func(tracker attemptsTracker) error) error {
+	flagsConfig := getFlagsConfig(ctx)
+
+	_, err := attachFileLogger(log, flagsConfig)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig(flagsConfig.ConfigurationFile)
+	if err != nil {
+		return err
+	}
+
+	proxyName := ctx.String(deadLetterProxy.Name)
+	if !isProxyDefined(cfg, proxyName) {
+		return fmt.Errorf("SC proxy %s is not defined in the Proxies list", proxyName)
+	}
+
+	storer, err := factory.CreateUnitStorer(cfg.RetryPolicy.AttemptsStorage, path.Join(flagsConfig.WorkingDir, dbPath))
+	if err != nil {
+		return err
+	}
+
+	argsAttemptsTracker := attempts.ArgsAttemptsTracker{
+		Name:        proxyName,
+		RetryPolicy: cfg.RetryPolicy,
+		Storer:      storer,
+		Log:         log,
+	}
+	tracker, err := attempts.NewAttemptsTracker(argsAttemptsTracker)
+	if err != nil {
+		_ = storer.Close()
+		return err
+	}
+
+	err = handler(tracker)
+	errClose := storer.Close()
+	if err != nil {
+		return err
+	}
+
+	return errClose
+}
+
+func isProxyDefined(cfg config.ScCallsModuleConfig, proxyName string) bool {
+	if len(cfg.Proxies) == 0 {
+		return len(proxyName) == 0
+	}
+
+	for _, proxyConfig := range cfg.Proxies {
+		if proxyConfig.Name == proxyName {
+			return true
+		}
+	}
+
+	return false
+}
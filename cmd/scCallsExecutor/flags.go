@@ -2,6 +2,7 @@ package main
 
 import (
 	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/core/logging"
 	"github.com/multiversx/mx-chain-go/facade"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/urfave/cli"
@@ -23,6 +24,13 @@ var (
 			"configurations such as monitored SC, gateway URL, timings and so on",
 		Value: "config/config.toml",
 	}
+	// configurationApiFile defines a flag for the path to the api routes toml configuration file
+	configurationApiFile = cli.StringFlag{
+		Name: "config-api",
+		Usage: "The `" + filePathPlaceholder + "` for the api configuration file. This TOML file contains " +
+			"all available routes for Rest API and options to enable or disable them.",
+		Value: "config/api.toml",
+	}
 	// logFile is used when the log output needs to be logged in a file
 	logSaveFile = cli.BoolFlag{
 		Name:  "log-save",
@@ -58,6 +66,14 @@ var (
 		Usage: "This flag specifies the `directory` where the node will store databases and logs.",
 		Value: "",
 	}
+	// logFormat defines the format used for the log lines written on the standard output
+	logFormat = cli.StringFlag{
+		Name: "log-format",
+		Usage: "This flag specifies the `format` of the log lines written on the standard output. It can be " +
+			logging.PlainFormat + " or " + logging.JSONFormat + ". The " + logging.JSONFormat + " format emits one JSON " +
+			"object per line, with the batch ID, action ID, tx hash and direction as dedicated keys.",
+		Value: logging.PlainFormat,
+	}
 	// disableAnsiColor defines if the logger subsystem should prevent displaying ANSI colors
 	disableAnsiColor = cli.BoolFlag{
 		Name:  "disable-ansi-color",
@@ -83,14 +99,27 @@ var (
 		Name:  "private-key-file",
 		Usage: "The Klever Blockchain private key file used to issue transaction for the SC calls",
 	}
+	// deadLetterID is the ID of the SC call to be removed from the dead-letter list
+	deadLetterID = cli.Uint64Flag{
+		Name:  "id",
+		Usage: "The `ID` of the SC call to be removed from the dead-letter list",
+	}
+	// deadLetterProxy is the name of the SC proxy, as defined in the Proxies list, owning the dead-letter list
+	deadLetterProxy = cli.StringFlag{
+		Name: "proxy",
+		Usage: "The `name` of the SC proxy, as defined in the Proxies list, owning the dead-letter list. Should be " +
+			"omitted if the Proxies list is not defined",
+	}
 )
 
 func getFlags() []cli.Flag {
 	return []cli.Flag{
 		workingDirectory,
 		logLevel,
+		logFormat,
 		disableAnsiColor,
 		configurationFile,
+		configurationApiFile,
 		logSaveFile,
 		logWithLoggerName,
 		profileMode,
@@ -105,8 +134,10 @@ func getFlagsConfig(ctx *cli.Context) config.ContextFlagsConfig {
 
 	flagsConfig.WorkingDir = ctx.GlobalString(workingDirectory.Name)
 	flagsConfig.LogLevel = ctx.GlobalString(logLevel.Name)
+	flagsConfig.LogFormat = ctx.GlobalString(logFormat.Name)
 	flagsConfig.DisableAnsiColor = ctx.GlobalBool(disableAnsiColor.Name)
 	flagsConfig.ConfigurationFile = ctx.GlobalString(configurationFile.Name)
+	flagsConfig.ConfigurationApiFile = ctx.GlobalString(configurationApiFile.Name)
 	flagsConfig.SaveLogFile = ctx.GlobalBool(logSaveFile.Name)
 	flagsConfig.EnableLogName = ctx.GlobalBool(logWithLoggerName.Name)
 	flagsConfig.EnablePprof = ctx.GlobalBool(profileMode.Name)
@@ -4,12 +4,16 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path"
 	"runtime"
 	"syscall"
 	"time"
 
 	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/core/logging"
 	"github.com/klever-io/klv-bridge-eth-go/executors/kleverBlockchain/module"
+	"github.com/klever-io/klv-bridge-eth-go/factory"
+	"github.com/klever-io/klv-bridge-eth-go/status"
 	chainCore "github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	chainFactory "github.com/multiversx/mx-chain-go/cmd/node/factory"
@@ -23,6 +27,7 @@ const (
 	filePathPlaceholder = "[path]"
 	defaultLogsPath     = "logs"
 	logFilePrefix       = "sc-calls-executor"
+	dbPath              = "db"
 )
 
 var log = logger.GetOrCreate("main")
@@ -56,6 +61,26 @@ func main() {
 	app.Action = func(c *cli.Context) error {
 		return startExecutor(c, app.Version)
 	}
+	app.Commands = []cli.Command{
+		{
+			Name: "dead-letters",
+			Usage: "Prints the SC calls that permanently failed and are skipped by the executor. Should be used while " +
+				"the executor is stopped",
+			Flags: []cli.Flag{deadLetterProxy},
+			Action: func(c *cli.Context) error {
+				return listDeadLetters(c)
+			},
+		},
+		{
+			Name: "resolve-dead-letter",
+			Usage: "Removes the SC call from the dead-letter list so it will be executed again. Should be used while " +
+				"the executor is stopped",
+			Flags: []cli.Flag{deadLetterID, deadLetterProxy},
+			Action: func(c *cli.Context) error {
+				return resolveDeadLetter(c)
+			},
+		},
+	}
 
 	err := app.Run(os.Args)
 	if err != nil {
@@ -93,9 +118,18 @@ func startExecutor(ctx *cli.Context, version string) error {
 		}
 	}
 
+	apiRoutesConfig, err := loadApiConfig(flagsConfig.ConfigurationApiFile)
+	if err != nil {
+		return err
+	}
+	log.Debug("config", "file", flagsConfig.ConfigurationApiFile)
+
 	if ctx.IsSet(scProxyBech32Address.Name) {
 		cfg.ScProxyBech32Address = ctx.GlobalString(scProxyBech32Address.Name)
 		log.Info("using flag-defined SC proxy address", "address", cfg.ScProxyBech32Address)
+		if len(cfg.Proxies) > 0 {
+			log.Warn("the flag-defined SC proxy address is not used as the Proxies list is defined in the config file")
+		}
 	}
 	if ctx.IsSet(networkAddress.Name) {
 		cfg.NetworkAddress = ctx.GlobalString(networkAddress.Name)
@@ -110,27 +144,32 @@ func startExecutor(ctx *cli.Context, version string) error {
 		return fmt.Errorf("empty NetworkAddress in config file")
 	}
 
-	args := config.ScCallsModuleConfig{
-		ScProxyBech32Address:            cfg.ScProxyBech32Address,
-		ExtraGasToExecute:               cfg.ExtraGasToExecute,
-		MaxGasLimitToUse:                cfg.MaxGasLimitToUse,
-		GasLimitForOutOfGasTransactions: cfg.GasLimitForOutOfGasTransactions,
-		NetworkAddress:                  cfg.NetworkAddress,
-		ProxyMaxNoncesDelta:             cfg.ProxyMaxNoncesDelta,
-		ProxyFinalityCheck:              cfg.ProxyFinalityCheck,
-		ProxyCacherExpirationSeconds:    cfg.ProxyCacherExpirationSeconds,
-		ProxyRestAPIEntityType:          cfg.ProxyRestAPIEntityType,
-		IntervalToResendTxsInSeconds:    cfg.IntervalToResendTxsInSeconds,
-		PrivateKeyFile:                  cfg.PrivateKeyFile,
-		PollingIntervalInMillis:         cfg.PollingIntervalInMillis,
-		Filter:                          cfg.Filter,
-		Logs:                            cfg.Logs,
-		TransactionChecks:               cfg.TransactionChecks,
+	args := createScCallsModuleConfig(cfg)
+
+	attemptsStorer, err := factory.CreateUnitStorer(cfg.RetryPolicy.AttemptsStorage, path.Join(flagsConfig.WorkingDir, dbPath))
+	if err != nil {
+		return err
 	}
 
+	metricsHolder := status.NewMetricsHolder()
 	chCloseApp := make(chan struct{}, 1)
-	scCallsExecutor, err := module.NewScCallsModule(args, log, chCloseApp)
+	argsScCallsModule := module.ArgsScCallsModule{
+		Config:        args,
+		Storer:        attemptsStorer,
+		MetricsHolder: metricsHolder,
+		Log:           log,
+		CloseAppChan:  chCloseApp,
+	}
+	scCallsExecutor, err := module.NewScCallsModule(argsScCallsModule)
+	if err != nil {
+		_ = attemptsStorer.Close()
+		return err
+	}
+
+	webServer, err := factory.StartScCallsExecutorWebServer(flagsConfig, apiRoutesConfig, cfg.WebAntiflood, metricsHolder, scCallsExecutor)
 	if err != nil {
+		_ = scCallsExecutor.Close()
+		_ = attemptsStorer.Close()
 		return err
 	}
 
@@ -144,7 +183,44 @@ func startExecutor(ctx *cli.Context, version string) error {
 		log.Info("application closing, requested internally, calling Close on all subcomponents...")
 	}
 
-	return scCallsExecutor.Close()
+	err = webServer.Close()
+	log.LogIfError(err)
+
+	err = scCallsExecutor.Close()
+	errStorer := attemptsStorer.Close()
+	if err != nil {
+		return err
+	}
+
+	return errStorer
+}
+
+// createScCallsModuleConfig returns the SC calls module config built from the loaded config file and the flags
+func createScCallsModuleConfig(cfg config.ScCallsModuleConfig) config.ScCallsModuleConfig {
+	return config.ScCallsModuleConfig{
+		ScProxyBech32Address:            cfg.ScProxyBech32Address,
+		ExtraGasToExecute:               cfg.ExtraGasToExecute,
+		MaxGasLimitToUse:                cfg.MaxGasLimitToUse,
+		GasLimitForOutOfGasTransactions: cfg.GasLimitForOutOfGasTransactions,
+		NetworkAddress:                  cfg.NetworkAddress,
+		ProxyMaxNoncesDelta:             cfg.ProxyMaxNoncesDelta,
+		ProxyFinalityCheck:              cfg.ProxyFinalityCheck,
+		ProxyCacherExpirationSeconds:    cfg.ProxyCacherExpirationSeconds,
+		ProxyRestAPIEntityType:          cfg.ProxyRestAPIEntityType,
+		IntervalToResendTxsInSeconds:    cfg.IntervalToResendTxsInSeconds,
+		PrivateKeyFile:                  cfg.PrivateKeyFile,
+		PollingIntervalInMillis:         cfg.PollingIntervalInMillis,
+		Filter:                          cfg.Filter,
+		Priority:                        cfg.Priority,
+		Logs:                            cfg.Logs,
+		TransactionChecks:               cfg.TransactionChecks,
+		Concurrency:                     cfg.Concurrency,
+		RetryPolicy:                     cfg.RetryPolicy,
+		FeeBudget:                       cfg.FeeBudget,
+		WebAntiflood:                    cfg.WebAntiflood,
+		Coordination:                    cfg.Coordination,
+		Proxies:                         cfg.Proxies,
+	}
 }
 
 func loadConfig(filepath string) (config.ScCallsModuleConfig, error) {
@@ -157,6 +233,17 @@ func loadConfig(filepath string) (config.ScCallsModuleConfig, error) {
 	return cfg, nil
 }
 
+// loadApiConfig returns a ApiRoutesConfig by reading the config file provided
+func loadApiConfig(filepath string) (config.ApiRoutesConfig, error) {
+	cfg := config.ApiRoutesConfig{}
+	err := chainCore.LoadTomlFile(&cfg, filepath)
+	if err != nil {
+		return config.ApiRoutesConfig{}, err
+	}
+
+	return cfg, nil
+}
+
 func attachFileLogger(log logger.Logger, flagsConfig config.ContextFlagsConfig) (chainFactory.FileLoggingHandler, error) {
 	var fileLogging chainFactory.FileLoggingHandler
 	var err error
@@ -192,7 +279,13 @@ func attachFileLogger(log logger.Logger, flagsConfig config.ContextFlagsConfig)
 			return nil, err
 		}
 	}
-	log.Trace("logger updated", "level", logLevelFlagValue, "disable ANSI color", flagsConfig.DisableAnsiColor)
+
+	err = logging.ApplyFormat(flagsConfig.LogFormat)
+	if err != nil {
+		return nil, err
+	}
+	log.Trace("logger updated", "level", logLevelFlagValue, "format", flagsConfig.LogFormat,
+		"disable ANSI color", flagsConfig.DisableAnsiColor)
 
 	return fileLogging, nil
 }
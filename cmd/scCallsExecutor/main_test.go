@@ -0,0 +1,38 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateScCallsModuleConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := loadConfig("./config/config.toml")
+	require.Nil(t, err)
+	// the sections that are empty or disabled in the shipped config file
+	cfg.FeeBudget.DailyBudget = 1000000
+	cfg.Proxies = []config.ScCallsProxyConfig{
+		{
+			Name:                 "production",
+			ScProxyBech32Address: "klv1qqqqqqqqqqqqqpgqu2jcktadaq8mmytwglc704yfv7rezv5usg8sgzuah3",
+		},
+	}
+
+	// all the sections should be set, otherwise a section that is not copied would go unnoticed
+	cfgValue := reflect.ValueOf(cfg)
+	for i := 0; i < cfgValue.NumField(); i++ {
+		field := cfgValue.Field(i)
+		if field.Kind() != reflect.Struct && field.Kind() != reflect.Slice {
+			continue
+		}
+
+		assert.False(t, field.IsZero(), "section %s is not defined", cfgValue.Type().Field(i).Name)
+	}
+
+	assert.Equal(t, cfg, createScCallsModuleConfig(cfg))
+}
@@ -23,6 +23,7 @@ type Config struct {
 	Logs              LogsConfig
 	WebAntiflood      WebAntifloodConfig
 	PeersRatingConfig PeersRatingConfig
+	Tracing           TracingConfig
 }
 
 // EthereumConfig represents the Ethereum Config parameters
@@ -36,11 +37,30 @@ type EthereumConfig struct {
 	GasLimitBase                       uint64
 	GasLimitForEach                    uint64
 	GasStation                         GasStationConfig
+	EventsSubscription                 EventsSubscriptionConfig
+	CallDataValidation                 CallDataValidationConfig
 	MaxRetriesOnQuorumReached          uint64
 	IntervalToWaitForTransferInSeconds uint64
 	ClientAvailabilityAllowDelta       uint64
 	EventsBlockRangeFrom               int64
 	EventsBlockRangeTo                 int64
+	ChainID                            uint64
+}
+
+// EventsSubscriptionConfig represents the configuration for the Ethereum safe events subscription
+type EventsSubscriptionConfig struct {
+	Enabled                      bool
+	WebSocketAddress             string
+	ResubscribeIntervalInSeconds uint64
+	MaxWaitTimeInSeconds         uint64
+}
+
+// CallDataValidationConfig represents the configuration for the SC call data validation done on the Ethereum deposits
+type CallDataValidationConfig struct {
+	MaxCallDataSizeInBytes uint64
+	MinGasLimit            uint64
+	MaxGasLimit            uint64
+	MaxNumArguments        uint64
 }
 
 // GasStationConfig represents the configuration for the gas station handler
@@ -64,25 +84,51 @@ type ConfigP2P struct {
 	Transports      p2pConfig.P2PTransportConfig
 	AntifloodConfig config.AntifloodConfig
 	ResourceLimiter p2pConfig.P2PResourceLimiterConfig
+	MessagesVersion uint32
 }
 
 // ConfigRelayer configuration for general relayer configuration
 type ConfigRelayer struct {
 	Marshalizer          config.MarshalizerConfig
 	RoleProvider         RoleProviderConfig
+	TokensRegistry       TokensRegistryConfig
+	RelayersHealth       RelayersHealthConfig
+	P2PStatePersistence  P2PStatePersistenceConfig
+	LeaderSelection      LeaderSelectionConfig
+	TokensCache          TokensCacheConfig
 	StatusMetricsStorage config.StorageConfig
 }
 
+// P2PStatePersistenceConfig will hold the configuration for the persistence of the p2p signatures and nonces
+type P2PStatePersistenceConfig struct {
+	Enabled bool
+}
+
+// LeaderSelectionConfig will hold the configuration for the leader selection policy
+type LeaderSelectionConfig struct {
+	Policy                 string
+	MaxInactivityInSeconds uint64
+}
+
 // ConfigStateMachine the configuration for the state machine
 type ConfigStateMachine struct {
 	StepDurationInMillis       uint64
 	IntervalForLeaderInSeconds uint64
+	LeaderLease                LeaderLeaseConfig
+}
+
+// LeaderLeaseConfig will hold the configuration for the progress-aware leadership of a state machine
+type LeaderLeaseConfig struct {
+	Enabled                   bool
+	GracePeriodInSeconds      uint64
+	MaxLeaseDurationInSeconds uint64
 }
 
 // ContextFlagsConfig the configuration for flags
 type ContextFlagsConfig struct {
 	WorkingDir           string
 	LogLevel             string
+	LogFormat            string
 	DisableAnsiColor     bool
 	ConfigurationFile    string
 	ConfigurationApiFile string
@@ -107,8 +153,11 @@ type WebAntifloodConfig struct {
 
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
-	Logging     ApiLoggingConfig
-	APIPackages map[string]APIPackageConfig
+	Logging      ApiLoggingConfig
+	LogStreaming ApiLogStreamingConfig
+	TLS          ApiTLSConfig
+	Auth         ApiAuthConfig
+	APIPackages  map[string]APIPackageConfig
 }
 
 // ApiLoggingConfig holds the configuration related to API requests logging
@@ -117,6 +166,33 @@ type ApiLoggingConfig struct {
 	ThresholdInMicroSeconds int
 }
 
+// ApiLogStreamingConfig holds the configuration related to the logs streamed on the /log websocket route
+type ApiLogStreamingConfig struct {
+	AuthToken string
+}
+
+// ApiTLSConfig holds the configuration related to serving the Rest API over TLS
+type ApiTLSConfig struct {
+	Enabled                 bool
+	CertificateFile         string
+	KeyFile                 string
+	ReloadIntervalInSeconds uint64
+}
+
+// ApiAuthConfig holds the configuration related to the Rest API authentication
+type ApiAuthConfig struct {
+	Enabled               bool
+	MaxClockSkewInSeconds uint64
+	Tokens                []ApiTokenConfig
+}
+
+// ApiTokenConfig holds the configuration of a Rest API token and the route scopes it can access
+type ApiTokenConfig struct {
+	Name   string
+	Secret string
+	Scopes []string
+}
+
 // APIPackageConfig holds the configuration for the routes of each package
 type APIPackageConfig struct {
 	Routes []RouteConfig
@@ -124,8 +200,20 @@ type APIPackageConfig struct {
 
 // RouteConfig holds the configuration for a single route
 type RouteConfig struct {
-	Name string
-	Open bool
+	Name  string
+	Open  bool
+	Scope string
+}
+
+// TracingConfig will hold the OpenTelemetry tracing settings
+type TracingConfig struct {
+	Enabled       bool
+	ServiceName   string
+	Exporter      string
+	OTLPEndpoint  string
+	OTLPInsecure  bool
+	FilePath      string
+	SamplingRatio float64
 }
 
 // LogsConfig will hold settings related to the logging sub-system
@@ -139,6 +227,21 @@ type RoleProviderConfig struct {
 	PollingIntervalInMillis uint64
 }
 
+// TokensRegistryConfig is the configuration for the tokens registry component
+type TokensRegistryConfig struct {
+	PollingIntervalInMillis uint64
+}
+
+// RelayersHealthConfig is the configuration for the relayers health component
+type RelayersHealthConfig struct {
+	PollingIntervalInMillis uint64
+}
+
+// TokensCacheConfig is the configuration for the cache of token mappings and contract flags
+type TokensCacheConfig struct {
+	CacheExpirationInSeconds uint64
+}
+
 // KleverConfig represents the Klever Config parameters
 type KleverConfig struct {
 	NetworkAddress                  string
@@ -151,6 +254,17 @@ type KleverConfig struct {
 	MaxRetriesOnWasTransferProposed uint64
 	ClientAvailabilityAllowDelta    uint64
 	Proxy                           ProxyConfig
+	BlocksWatcher                   BlocksWatcherConfig
+	ChainID                         string
+}
+
+// BlocksWatcherConfig represents the configuration for the Klever Blockchain blocks watcher
+type BlocksWatcherConfig struct {
+	Enabled                 bool
+	PollingIntervalInMillis uint64
+	ActivityWindowInSeconds uint64
+	MaxWaitTimeInSeconds    uint64
+	MaxBlocksPerPoll        uint64
 }
 
 // ProxyConfig represents the configuration for the Klever Blockchain proxy
@@ -188,6 +302,38 @@ type PendingOperationsFilterConfig struct {
 	AllowedKlvAddresses []string
 	DeniedTokens        []string
 	AllowedTokens       []string
+	Rules               []PendingOperationsFilterRuleConfig
+}
+
+// PendingOperationsFilterRuleConfig defines a filter rule. All the non-empty conditions should match for the rule
+// to apply. The rules are evaluated in the provided order, the first matching rule deciding the outcome
+type PendingOperationsFilterRuleConfig struct {
+	Name         string
+	Action       string
+	EthAddresses []string
+	KlvAddresses []string
+	Tokens       []string
+	Functions    []string
+	MinAmount    string
+	MaxAmount    string
+	MinGasLimit  uint64
+	MaxGasLimit  uint64
+}
+
+// PendingOperationsPriorityConfig defines the priority policy applied on the pending SC calls. The operations
+// with the same priority are executed in ascending ID order
+type PendingOperationsPriorityConfig struct {
+	TokenWeights          []PriorityWeightConfig
+	DestinationWeights    []PriorityWeightConfig
+	PriorityCallers       []string
+	PriorityCallersWeight int64
+	AgingWeightPerMinute  int64
+}
+
+// PriorityWeightConfig defines the weight added to the priority of the pending SC calls matching the item
+type PriorityWeightConfig struct {
+	Item   string
+	Weight int64
 }
 
 // ScCallsModuleConfig will hold the settings for the SC calls module
@@ -205,17 +351,65 @@ type ScCallsModuleConfig struct {
 	PrivateKeyFile                  string
 	PollingIntervalInMillis         uint64
 	Filter                          PendingOperationsFilterConfig
+	Priority                        PendingOperationsPriorityConfig
 	Logs                            LogsConfig
 	TransactionChecks               TransactionChecksConfig
+	Concurrency                     ConcurrencyConfig
+	RetryPolicy                     ScCallsRetryPolicyConfig
+	FeeBudget                       ScCallsFeeBudgetConfig
+	WebAntiflood                    WebAntifloodConfig
+	Coordination                    ScCallsCoordinationConfig
+	Proxies                         []ScCallsProxyConfig
+}
+
+// ScCallsProxyConfig will hold the settings for one of the SC proxy contracts handled by the SC calls module. When no
+// proxy is defined, the module handles only the proxy defined by the ScCallsModuleConfig fields
+type ScCallsProxyConfig struct {
+	Name                            string
+	ScProxyBech32Address            string
+	ExtraGasToExecute               uint64
+	MaxGasLimitToUse                int64
+	GasLimitForOutOfGasTransactions int64
+	PollingIntervalInMillis         uint64
+	Filter                          PendingOperationsFilterConfig
+}
+
+// ScCallsCoordinationConfig will hold the settings for running several SC calls executor instances, each one with its
+// own key. Each pending SC call is assigned to one of the executors and the others take it over, one after another,
+// if it is still pending after the takeover timeout and the executors ranked before stopped sending transactions
+type ScCallsCoordinationConfig struct {
+	Enabled                  bool
+	Executors                []string
+	TakeoverTimeoutInSeconds uint64
 }
 
-// TransactionChecksConfig will hold the setting for how to handle the transaction execution
+// ScCallsFeeBudgetConfig will hold the settings for the fees spent by the SC calls executor
+type ScCallsFeeBudgetConfig struct {
+	DailyBudget uint64
+}
+
+// ConcurrencyConfig will hold the settings for the concurrent execution of the SC calls
+type ConcurrencyConfig struct {
+	NumWorkers                  uint32
+	PreserveOrderPerDestination bool
+}
+
+// TransactionChecksConfig will hold the setting for how to handle the transaction execution. The former
+// ExtraDelayInSecondsOnError setting was replaced by the per SC call backoff from ScCallsRetryPolicyConfig
 type TransactionChecksConfig struct {
 	CheckTransactionResults    bool
 	TimeInSecondsBetweenChecks uint64
 	ExecutionTimeoutInSeconds  uint64
 	CloseAppOnError            bool
-	ExtraDelayInSecondsOnError uint64
+}
+
+// ScCallsRetryPolicyConfig will hold the settings for retrying the failed SC calls. Each failed SC call is retried
+// with an exponential backoff and, after the maximum number of attempts, it is moved in the dead-letter list
+type ScCallsRetryPolicyConfig struct {
+	MaxAttempts             uint32
+	InitialBackoffInSeconds uint64
+	MaxBackoffInSeconds     uint64
+	AttemptsStorage         config.StorageConfig
 }
 
 // MigrationToolConfig is the migration tool config struct
@@ -34,6 +34,18 @@ func TestConfigs(t *testing.T) {
 				GasPriceSelector:           "SafeGasPrice",
 				GasPriceMultiplier:         1000000000,
 			},
+			EventsSubscription: EventsSubscriptionConfig{
+				Enabled:                      false,
+				WebSocketAddress:             "ws://127.0.0.1:8546",
+				ResubscribeIntervalInSeconds: 10,
+				MaxWaitTimeInSeconds:         60,
+			},
+			CallDataValidation: CallDataValidationConfig{
+				MaxCallDataSizeInBytes: 65536,
+				MinGasLimit:            0,
+				MaxGasLimit:            243999999,
+				MaxNumArguments:        64,
+			},
 			MaxRetriesOnQuorumReached:    3,
 			ClientAvailabilityAllowDelta: 10,
 			EventsBlockRangeFrom:         -100,
@@ -63,11 +75,19 @@ func TestConfigs(t *testing.T) {
 				MaxNoncesDelta:          7,
 				FinalityCheck:           true,
 			},
+			BlocksWatcher: BlocksWatcherConfig{
+				Enabled:                 false,
+				PollingIntervalInMillis: 2000,
+				ActivityWindowInSeconds: 300,
+				MaxWaitTimeInSeconds:    60,
+				MaxBlocksPerPoll:        100,
+			},
 		},
 		P2P: ConfigP2P{
 			Port:            "10010",
 			InitialPeerList: make([]string, 0),
 			ProtocolID:      "/klv/relay/1.0.0",
+			MessagesVersion: 1,
 			Transports: p2pConfig.P2PTransportConfig{
 				TCP: config.TCPProtocolConfig{
 					ListenAddress:    "/ip4/0.0.0.0/tcp/%d",
@@ -160,6 +180,14 @@ func TestConfigs(t *testing.T) {
 							Topic:             "EthereumToKC_sign",
 							NumMessagesPerSec: 100,
 						},
+						{
+							Topic:             "EthereumToKC_sigreq",
+							NumMessagesPerSec: 10,
+						},
+						{
+							Topic:             "EthereumToKC_lease",
+							NumMessagesPerSec: 10,
+						},
 					},
 				},
 				TxAccumulator: chainConfig.TxAccumulatorConfig{},
@@ -169,10 +197,20 @@ func TestConfigs(t *testing.T) {
 			"EthereumToKC": {
 				StepDurationInMillis:       12000,
 				IntervalForLeaderInSeconds: 120,
+				LeaderLease: LeaderLeaseConfig{
+					Enabled:                   true,
+					GracePeriodInSeconds:      60,
+					MaxLeaseDurationInSeconds: 600,
+				},
 			},
 			"KCToEthereum": {
 				StepDurationInMillis:       12000,
 				IntervalForLeaderInSeconds: 720,
+				LeaderLease: LeaderLeaseConfig{
+					Enabled:                   true,
+					GracePeriodInSeconds:      180,
+					MaxLeaseDurationInSeconds: 1800,
+				},
 			},
 		},
 		Relayer: ConfigRelayer{
@@ -183,6 +221,22 @@ func TestConfigs(t *testing.T) {
 			RoleProvider: RoleProviderConfig{
 				PollingIntervalInMillis: 60000,
 			},
+			TokensRegistry: TokensRegistryConfig{
+				PollingIntervalInMillis: 300000,
+			},
+			RelayersHealth: RelayersHealthConfig{
+				PollingIntervalInMillis: 60000,
+			},
+			P2PStatePersistence: P2PStatePersistenceConfig{
+				Enabled: true,
+			},
+			LeaderSelection: LeaderSelectionConfig{
+				Policy:                 "uniform",
+				MaxInactivityInSeconds: 900,
+			},
+			TokensCache: TokensCacheConfig{
+				CacheExpirationInSeconds: 600,
+			},
 			StatusMetricsStorage: chainConfig.StorageConfig{
 				Cache: chainConfig.CacheConfig{
 					Name:     "StatusMetricsStorage",
@@ -214,6 +268,15 @@ func TestConfigs(t *testing.T) {
 			TopRatedCacheCapacity: 5000,
 			BadRatedCacheCapacity: 5000,
 		},
+		Tracing: TracingConfig{
+			Enabled:       false,
+			ServiceName:   "klv-bridge-relayer",
+			Exporter:      "otlp",
+			OTLPEndpoint:  "localhost:4318",
+			OTLPInsecure:  true,
+			FilePath:      "traces.json",
+			SamplingRatio: 1.0,
+		},
 	}
 
 	testString := `
@@ -241,6 +304,18 @@ func TestConfigs(t *testing.T) {
         MaximumAllowedGasPrice = 300 # maximum value allowed for the fetched gas price value
         # GasPriceSelector available options: "SafeGasPrice", "ProposeGasPrice", "FastGasPrice"
         GasPriceSelector = "SafeGasPrice" # selector used to provide the gas price
+    [Eth.EventsSubscription]
+        # when enabled, the relayer subscribes to the safe deposit events through the websocket address and waits
+        # for them instead of polling the safe for new batches. Polling is used as a fallback while the subscription is down
+        Enabled = false
+        WebSocketAddress = "ws://127.0.0.1:8546"
+        ResubscribeIntervalInSeconds = 10 # time to wait before subscribing again after the subscription dropped
+        MaxWaitTimeInSeconds = 60 # the safe is polled at least this often, even if no events are received
+    [Eth.CallDataValidation]
+        MaxCallDataSizeInBytes = 65536
+        MinGasLimit = 0
+        MaxGasLimit = 243999999
+        MaxNumArguments = 64
 
 [Klever]
     NetworkAddress = "https://api.devnet.klever.finance" # the network address
@@ -259,6 +334,14 @@ func TestConfigs(t *testing.T) {
         RestAPIEntityType = "observer"
         FinalityCheck = true
         MaxNoncesDelta = 7 # the number of maximum blocks allowed to be "in front" of what the metachain has notarized
+    [Klever.BlocksWatcher]
+        # when enabled, the relayer follows the new blocks and queries the safe for new batches only after a transaction
+        # touched the safe contract. Polling is used as a fallback while the watcher is not synced
+        Enabled = false
+        PollingIntervalInMillis = 2000 # time between the network status checks
+        ActivityWindowInSeconds = 300 # the safe is polled continuously this long after each activity, should cover the batch settle time
+        MaxWaitTimeInSeconds = 60 # the safe is polled at least this often, even if no activity is detected
+        MaxBlocksPerPoll = 100 # maximum number of blocks fetched on each check, older blocks are skipped and treated as activity
     [Klever.GasMap]
         Sign = 8000000
         ProposeTransferBase = 11000000
@@ -272,6 +355,10 @@ func TestConfigs(t *testing.T) {
     Port = "10010"
     InitialPeerList = []
     ProtocolID = "/klv/relay/1.0.0"
+    # MessagesVersion defines how the relayer messages are sent to the other relayers. Messages of both versions are accepted.
+    # 1 - legacy JSON messages, understood by all relayers. Use it while the relayers set is upgraded
+    # 2 - messages marshalled with the Relayer.Marshalizer and wrapped in a versioned envelope
+    MessagesVersion = 1
     [P2P.Transports]
         QUICAddress = "" # optional QUIC address. If this transport should be activated, should be in this format: /ip4/0.0.0.0/udp/%d/quic-v1
         WebSocketAddress = "" # optional WebSocket address. If this transport should be activated, should be in this format: /ip4/0.0.0.0/tcp/%d/ws
@@ -342,7 +429,9 @@ func TestConfigs(t *testing.T) {
         [P2P.AntifloodConfig.Topic]
             DefaultMaxMessagesPerSec = 300 # default number of messages per interval for a topic
             MaxMessages = [{ Topic = "EthereumToKC_join", NumMessagesPerSec = 100 },
-                           { Topic = "EthereumToKC_sign", NumMessagesPerSec = 100 }]
+                           { Topic = "EthereumToKC_sign", NumMessagesPerSec = 100 },
+                           { Topic = "EthereumToKC_sigreq", NumMessagesPerSec = 10 },
+                           { Topic = "EthereumToKC_lease", NumMessagesPerSec = 10 }]
 
 [Relayer]
     [Relayer.Marshalizer]
@@ -350,6 +439,29 @@ func TestConfigs(t *testing.T) {
         SizeCheckDelta = 10
     [Relayer.RoleProvider]
         PollingIntervalInMillis = 60000 # 1 minute
+    [Relayer.TokensRegistry]
+        PollingIntervalInMillis = 300000 # 5 minutes
+    [Relayer.RelayersHealth]
+        # PollingIntervalInMillis is the interval used to refresh the quorum and the relayers set health metrics
+        PollingIntervalInMillis = 60000 # 1 minute
+    [Relayer.P2PStatePersistence]
+        # Enabled will save in the StatusMetricsStorage the received Ethereum signatures of the batch in flight and
+        # the last nonce seen for each relayer, so they are not lost when the relayer restarts
+        Enabled = true
+    [Relayer.LeaderSelection]
+        # Policy is the leader selection policy, the same on all relayers. Can be one of:
+        # "uniform" - each whitelisted relayer has the same chance of being the leader
+        # "liveness" - only the relayers that gossiped in the last MaxInactivityInSeconds can be leaders
+        # "stake-weighted" - the chance of being the leader is proportional to the relayer's stake
+        Policy = "uniform"
+        # MaxInactivityInSeconds is used by the "liveness" policy and should be greater than the join messages interval
+        # A relayer that became inactive can be the leader again only after being active for another MaxInactivityInSeconds
+        MaxInactivityInSeconds = 900 # 15 minutes
+    [Relayer.TokensCache]
+        # CacheExpirationInSeconds is the time the token mappings and the safes token flags are kept in memory
+        # before being queried again. The entries are dropped earlier when the tokens registry detects a change.
+        # 0 disables the cache
+        CacheExpirationInSeconds = 600 # 10 minutes
     [Relayer.StatusMetricsStorage]
         [Relayer.StatusMetricsStorage.Cache]
             Name = "StatusMetricsStorage"
@@ -366,10 +478,22 @@ func TestConfigs(t *testing.T) {
     [StateMachine.EthereumToKC]
         StepDurationInMillis = 12000 #12 seconds
         IntervalForLeaderInSeconds = 120 #2 minutes
+        [StateMachine.EthereumToKC.LeaderLease]
+            # Enabled lets the leader that sent a transaction keep the leadership, announcing a lease over p2p, while
+            # the transaction is in flight. The other relayers will take over only if the lease was not renewed in the
+            # last GracePeriodInSeconds or it was held for more than MaxLeaseDurationInSeconds
+            Enabled = true
+            GracePeriodInSeconds = 60 #1 minute
+            MaxLeaseDurationInSeconds = 600 #10 minutes
 
     [StateMachine.KCToEthereum]
         StepDurationInMillis = 12000 #12 seconds
         IntervalForLeaderInSeconds = 720 #12 minutes
+        [StateMachine.KCToEthereum.LeaderLease]
+            # same as the [StateMachine.EthereumToKC.LeaderLease] section
+            Enabled = true
+            GracePeriodInSeconds = 180 #3 minutes
+            MaxLeaseDurationInSeconds = 1800 #30 minutes
 
 [Logs]
     LogFileLifeSpanInSec = 86400 # 24h
@@ -391,6 +515,21 @@ func TestConfigs(t *testing.T) {
     TopRatedCacheCapacity = 5000
     BadRatedCacheCapacity = 5000
 
+[Tracing]
+    # Enabled will record OpenTelemetry spans for each state machine step, each Ethereum and Klever Blockchain client
+    # call and each p2p signature message, tagged with the direction, the batch ID and the action ID
+    Enabled = false
+    ServiceName = "klv-bridge-relayer"
+    # Exporter can be one of:
+    # "otlp" - the spans are sent over HTTP to the OpenTelemetry collector found at OTLPEndpoint
+    # "file" - the spans are appended to the FilePath file as JSON objects, one per line
+    Exporter = "otlp"
+    OTLPEndpoint = "localhost:4318"
+    OTLPInsecure = true
+    FilePath = "traces.json"
+    # SamplingRatio is the fraction of the traces that are recorded, in the (0, 1] interval
+    SamplingRatio = 1.0
+
 `
 
 	cfg := Config{}
@@ -421,6 +560,31 @@ func TestScCallsExecutorConfigs(t *testing.T) {
 			AllowedEthAddresses: []string{"*"},
 			AllowedKlvAddresses: []string{"*"},
 			AllowedTokens:       []string{"MEME-a43fa1"},
+			Rules: []PendingOperationsFilterRuleConfig{
+				{
+					Name:         "stake calls",
+					Action:       "allow",
+					KlvAddresses: []string{"klv1qqqqqqqqqqqqqpgqu2jcktadaq8mmytwglc704yfv7rezv5usg8sgzuah3"},
+					Functions:    []string{"stake"},
+					MinAmount:    "1000",
+					MaxGasLimit:  50000000,
+				},
+				{
+					Name:   "everything else",
+					Action: "deny",
+				},
+			},
+		},
+		Priority: PendingOperationsPriorityConfig{
+			TokenWeights: []PriorityWeightConfig{
+				{Item: "MEME-a43fa1", Weight: 10},
+			},
+			DestinationWeights: []PriorityWeightConfig{
+				{Item: "klv1qqqqqqqqqqqqqpgqu2jcktadaq8mmytwglc704yfv7rezv5usg8sgzuah3", Weight: 5},
+			},
+			PriorityCallers:       []string{"0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c"},
+			PriorityCallersWeight: 20,
+			AgingWeightPerMinute:  1,
 		},
 		Logs: LogsConfig{
 			LogFileLifeSpanInSec: 86400,
@@ -431,7 +595,63 @@ func TestScCallsExecutorConfigs(t *testing.T) {
 			TimeInSecondsBetweenChecks: 6,
 			ExecutionTimeoutInSeconds:  120,
 			CloseAppOnError:            false,
-			ExtraDelayInSecondsOnError: 120,
+		},
+		Concurrency: ConcurrencyConfig{
+			NumWorkers:                  4,
+			PreserveOrderPerDestination: true,
+		},
+		RetryPolicy: ScCallsRetryPolicyConfig{
+			MaxAttempts:             5,
+			InitialBackoffInSeconds: 60,
+			MaxBackoffInSeconds:     3600,
+			AttemptsStorage: chainConfig.StorageConfig{
+				Cache: chainConfig.CacheConfig{
+					Name:     "ScCallsAttemptsStorage",
+					Capacity: 1000,
+					Type:     "LRU",
+				},
+				DB: chainConfig.DBConfig{
+					FilePath:          "ScCallsAttemptsStorageDB",
+					Type:              "LvlDBSerial",
+					BatchDelaySeconds: 2,
+					MaxBatchSize:      100,
+					MaxOpenFiles:      10,
+				},
+			},
+		},
+		FeeBudget: ScCallsFeeBudgetConfig{
+			DailyBudget: 5000000,
+		},
+		WebAntiflood: WebAntifloodConfig{
+			Enabled: true,
+			WebServer: WebServerAntifloodConfig{
+				SimultaneousRequests:         100,
+				SameSourceRequests:           10000,
+				SameSourceResetIntervalInSec: 1,
+			},
+		},
+		Coordination: ScCallsCoordinationConfig{
+			Enabled: true,
+			Executors: []string{
+				"klv1qqqqqqqqqqqqqpgqswlgqde4tfwp4ucwkh42m6d8a0d49w92sg8shyj6q3",
+				"klv1qqqqqqqqqqqqqpgqh46r9zh78lry2py8tq723fpjdr4pp0zgsg8syf6mq0",
+			},
+			TakeoverTimeoutInSeconds: 120,
+		},
+		Proxies: []ScCallsProxyConfig{
+			{
+				Name:                            "test-dapps",
+				ScProxyBech32Address:            "klv1qqqqqqqqqqqqqpgqsudu3a3n9yu62k5qkgcpy4j9ywl2x2gl5smsl7s8wj",
+				ExtraGasToExecute:               10000000,
+				MaxGasLimitToUse:                100000000,
+				GasLimitForOutOfGasTransactions: 30000000,
+				PollingIntervalInMillis:         12000,
+				Filter: PendingOperationsFilterConfig{
+					AllowedEthAddresses: []string{"*"},
+					AllowedKlvAddresses: []string{"*"},
+					AllowedTokens:       []string{"*"},
+				},
+			},
 		},
 	}
 
@@ -453,6 +673,27 @@ PollingIntervalInMillis = 6000
 	AllowedEthAddresses = ["*"]		# execute SC calls from all ETH addresses
 	AllowedKlvAddresses = ["*"]     # execute SC calls to all Klv contracts
 	AllowedTokens = ["MEME-a43fa1"] # execute SC calls for this token only
+	[[Filter.Rules]]
+		Name = "stake calls"
+		Action = "allow"
+		KlvAddresses = ["klv1qqqqqqqqqqqqqpgqu2jcktadaq8mmytwglc704yfv7rezv5usg8sgzuah3"]
+		Functions = ["stake"]
+		MinAmount = "1000"
+		MaxGasLimit = 50000000
+	[[Filter.Rules]]
+		Name = "everything else"
+		Action = "deny"
+
+[Priority]
+	TokenWeights = [
+		{ Item = "MEME-a43fa1", Weight = 10 },
+	]
+	DestinationWeights = [
+		{ Item = "klv1qqqqqqqqqqqqqpgqu2jcktadaq8mmytwglc704yfv7rezv5usg8sgzuah3", Weight = 5 },
+	]
+	PriorityCallers = ["0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c"] # Ethereum addresses of the callers whose SC calls are executed first
+	PriorityCallersWeight = 20
+	AgingWeightPerMinute = 1 # weight added for each minute a SC call waits
 
 [Logs]
 	LogFileLifeSpanInSec = 86400 # 24h
@@ -463,7 +704,53 @@ PollingIntervalInMillis = 6000
 	TimeInSecondsBetweenChecks = 6     # the number of seconds to recheck the status of the transaction
 	ExecutionTimeoutInSeconds  = 120   # the number of seconds after the transaction is considered failed if it was not seen by the blockchain 
 	CloseAppOnError            = false # enable or disable if the executor should automatically close on a transaction execution error  
-	ExtraDelayInSecondsOnError = 120   # extra delay in seconds if the transaction execution errored 
+
+[Concurrency]
+	NumWorkers = 4                     # the maximum number of SC calls executed in parallel, each one with its own nonce
+	PreserveOrderPerDestination = true # SC calls towards the same contract are executed one after another, in the pending operations order
+
+[RetryPolicy]
+	MaxAttempts = 5                # the number of failed executions after which a SC call is moved in the dead-letter list
+	InitialBackoffInSeconds = 60   # the delay before retrying a failed SC call, doubled on each failed execution
+	MaxBackoffInSeconds = 3600     # the maximum delay before retrying a failed SC call
+	[RetryPolicy.AttemptsStorage]
+		[RetryPolicy.AttemptsStorage.Cache]
+			Name = "ScCallsAttemptsStorage"
+			Capacity = 1000
+			Type = "LRU"
+		[RetryPolicy.AttemptsStorage.DB]
+			FilePath = "ScCallsAttemptsStorageDB"
+			Type = "LvlDBSerial"
+			BatchDelaySeconds = 2
+			MaxBatchSize = 100
+			MaxOpenFiles = 10
+
+[FeeBudget]
+	DailyBudget = 5000000 # the maximum fees spent on executions in a day, in the smallest KLV units, 0 for no limit
+
+[WebAntiflood]
+	Enabled = true
+	[WebAntiflood.WebServer]
+		SimultaneousRequests = 100
+		SameSourceRequests = 10000
+		SameSourceResetIntervalInSec = 1
+
+[Coordination]
+	Enabled = true
+	Executors = ["klv1qqqqqqqqqqqqqpgqswlgqde4tfwp4ucwkh42m6d8a0d49w92sg8shyj6q3", "klv1qqqqqqqqqqqqqpgqh46r9zh78lry2py8tq723fpjdr4pp0zgsg8syf6mq0"]
+	TakeoverTimeoutInSeconds = 120
+
+[[Proxies]]
+	Name = "test-dapps"
+	ScProxyBech32Address = "klv1qqqqqqqqqqqqqpgqsudu3a3n9yu62k5qkgcpy4j9ywl2x2gl5smsl7s8wj"
+	ExtraGasToExecute = 10000000
+	MaxGasLimitToUse = 100000000
+	GasLimitForOutOfGasTransactions = 30000000
+	PollingIntervalInMillis = 12000
+	[Proxies.Filter]
+		AllowedEthAddresses = ["*"]
+		AllowedKlvAddresses = ["*"]
+		AllowedTokens = ["*"]
 `
 
 	cfg := ScCallsModuleConfig{}
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -66,41 +67,55 @@ func (tb *TransferBatch) ResolveNewDeposits(newNumDeposits int) {
 
 // DepositTransfer is the deposit transfer structure agnostic of any chain implementation
 type DepositTransfer struct {
-	Nonce                 uint64   `json:"nonce"`
-	ToBytes               []byte   `json:"-"`
-	DisplayableTo         string   `json:"to"`
-	FromBytes             []byte   `json:"-"`
-	DisplayableFrom       string   `json:"from"`
-	SourceTokenBytes      []byte   `json:"-"`
-	DestinationTokenBytes []byte   `json:"-"`
-	DisplayableToken      string   `json:"token"`
-	Amount                *big.Int `json:"amount"`
-	ConvertedAmount       *big.Int `json:"convertedAmount"`
-	Data                  []byte   `json:"-"`
-	DisplayableData       string   `json:"data"`
+	Nonce                    uint64   `json:"nonce"`
+	ToBytes                  []byte   `json:"-"`
+	DisplayableTo            string   `json:"to"`
+	FromBytes                []byte   `json:"-"`
+	DisplayableFrom          string   `json:"from"`
+	SourceTokenBytes         []byte   `json:"-"`
+	DestinationTokenBytes    []byte   `json:"-"`
+	DisplayableToken         string   `json:"token"`
+	Amount                   *big.Int `json:"amount"`
+	ConvertedAmount          *big.Int `json:"convertedAmount"`
+	Data                     []byte   `json:"-"`
+	DisplayableData          string   `json:"data"`
+	CallDataValidation       string   `json:"callDataValidation,omitempty"`
+	CallDataValidationReason string   `json:"callDataValidationReason,omitempty"`
 }
 
 // String will convert the deposit transfer to a string
 func (dt *DepositTransfer) String() string {
-	return fmt.Sprintf("to: %s, from: %s, token address: %s, amount: %v, deposit nonce: %d, data: %s",
+	str := fmt.Sprintf("to: %s, from: %s, token address: %s, amount: %v, deposit nonce: %d, data: %s",
 		dt.DisplayableTo, dt.DisplayableFrom, dt.DisplayableToken, dt.Amount, dt.Nonce, dt.DisplayableData)
+	if len(dt.CallDataValidation) == 0 {
+		return str
+	}
+
+	str += ", call data: " + dt.CallDataValidation
+	if len(dt.CallDataValidationReason) > 0 {
+		str += " (" + dt.CallDataValidationReason + ")"
+	}
+
+	return str
 }
 
 // Clone will deeply clone the current DepositTransfer instance
 func (dt *DepositTransfer) Clone() *DepositTransfer {
 	cloned := &DepositTransfer{
-		Nonce:                 dt.Nonce,
-		ToBytes:               make([]byte, len(dt.ToBytes)),
-		DisplayableTo:         dt.DisplayableTo,
-		FromBytes:             make([]byte, len(dt.FromBytes)),
-		DisplayableFrom:       dt.DisplayableFrom,
-		SourceTokenBytes:      make([]byte, len(dt.SourceTokenBytes)),
-		DestinationTokenBytes: make([]byte, len(dt.DestinationTokenBytes)),
-		DisplayableToken:      dt.DisplayableToken,
-		Amount:                big.NewInt(0),
-		ConvertedAmount:       big.NewInt(0),
-		Data:                  make([]byte, len(dt.Data)),
-		DisplayableData:       dt.DisplayableData,
+		Nonce:                    dt.Nonce,
+		ToBytes:                  make([]byte, len(dt.ToBytes)),
+		DisplayableTo:            dt.DisplayableTo,
+		FromBytes:                make([]byte, len(dt.FromBytes)),
+		DisplayableFrom:          dt.DisplayableFrom,
+		SourceTokenBytes:         make([]byte, len(dt.SourceTokenBytes)),
+		DestinationTokenBytes:    make([]byte, len(dt.DestinationTokenBytes)),
+		DisplayableToken:         dt.DisplayableToken,
+		Amount:                   big.NewInt(0),
+		ConvertedAmount:          big.NewInt(0),
+		Data:                     make([]byte, len(dt.Data)),
+		DisplayableData:          dt.DisplayableData,
+		CallDataValidation:       dt.CallDataValidation,
+		CallDataValidationReason: dt.CallDataValidationReason,
 	}
 
 	copy(cloned.ToBytes, dt.ToBytes)
@@ -117,3 +132,21 @@ func (dt *DepositTransfer) Clone() *DepositTransfer {
 
 	return cloned
 }
+
+// ConvertEthereumCallData converts the call data of an Ethereum SC deposit in the protocol format: the missing data
+// marker for an empty call data or the data present marker followed by the call data length and the call data
+func ConvertEthereumCallData(callData []byte) []byte {
+	dataLen := len(callData)
+	if dataLen == 0 {
+		return []byte{MissingDataProtocolMarker}
+	}
+	if dataLen == 1 && callData[0] == MissingDataProtocolMarker {
+		return callData
+	}
+
+	result := make([]byte, 0, 1+Uint32ArgBytes+dataLen)
+	result = append(result, DataPresentProtocolMarker)
+	result = binary.BigEndian.AppendUint32(result, uint32(dataLen))
+
+	return append(result, callData...)
+}
@@ -11,17 +11,19 @@ func TestDepositTransfer_Clone(t *testing.T) {
 	t.Parallel()
 
 	dt := &DepositTransfer{
-		Nonce:                 112334,
-		ToBytes:               []byte("to"),
-		DisplayableTo:         "to",
-		FromBytes:             []byte("from"),
-		DisplayableFrom:       "from",
-		SourceTokenBytes:      []byte("source token"),
-		DisplayableToken:      "token",
-		Amount:                big.NewInt(7463),
-		ConvertedAmount:       big.NewInt(746300),
-		DestinationTokenBytes: []byte("destination token"),
-		Data:                  []byte("tx data"),
+		Nonce:                    112334,
+		ToBytes:                  []byte("to"),
+		DisplayableTo:            "to",
+		FromBytes:                []byte("from"),
+		DisplayableFrom:          "from",
+		SourceTokenBytes:         []byte("source token"),
+		DisplayableToken:         "token",
+		Amount:                   big.NewInt(7463),
+		ConvertedAmount:          big.NewInt(746300),
+		DestinationTokenBytes:    []byte("destination token"),
+		Data:                     []byte("tx data"),
+		CallDataValidation:       CallDataInvalid,
+		CallDataValidationReason: "reason",
 	}
 
 	cloned := dt.Clone()
@@ -46,6 +48,13 @@ func TestDepositTransfer_String(t *testing.T) {
 
 	expectedString := "to: to, from: from, token address: token, amount: 7463, deposit nonce: 112334, data: "
 	assert.Equal(t, expectedString, dt.String())
+
+	dt.CallDataValidation = CallDataValid
+	assert.Equal(t, expectedString+", call data: valid", dt.String())
+
+	dt.CallDataValidation = CallDataDowngraded
+	dt.CallDataValidationReason = "reason"
+	assert.Equal(t, expectedString+", call data: downgraded (reason)", dt.String())
 }
 
 func TestTransferBatch_Clone(t *testing.T) {
@@ -168,3 +177,13 @@ func TestTransferBatch_ResolveNewDeposits(t *testing.T) {
 		assert.Equal(t, []byte{0, 0, Rejected}, workingBatch.Statuses)
 	})
 }
+
+func TestConvertEthereumCallData(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []byte{MissingDataProtocolMarker}, ConvertEthereumCallData(nil))
+	assert.Equal(t, []byte{MissingDataProtocolMarker}, ConvertEthereumCallData([]byte{}))
+	assert.Equal(t, []byte{MissingDataProtocolMarker}, ConvertEthereumCallData([]byte{MissingDataProtocolMarker}))
+	assert.Equal(t, []byte{DataPresentProtocolMarker, 0, 0, 0, 3, 0, 0, 0}, ConvertEthereumCallData([]byte{0, 0, 0}))
+	assert.Equal(t, []byte{DataPresentProtocolMarker, 0, 0, 0, 1, 7}, ConvertEthereumCallData([]byte{7}))
+}
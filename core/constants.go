@@ -33,6 +33,17 @@ const (
 	WebServerOffString = "off"
 )
 
+const (
+	// CallDataValid marks a deposit with a call data that passed the validation
+	CallDataValid = "valid"
+
+	// CallDataInvalid marks a deposit with a call data that failed the validation and is forwarded as it is
+	CallDataInvalid = "invalid"
+
+	// CallDataDowngraded marks a deposit with a call data that failed the validation and is forwarded as a plain transfer
+	CallDataDowngraded = "downgraded"
+)
+
 const (
 	// MetricNumBatches represents the metric used for counting the number of executed batches
 	MetricNumBatches = "num batches"
@@ -75,8 +86,85 @@ const (
 	// MetricConnectedP2PAddresses represents the metric used to store all the P2P addresses the messenger has connected to
 	MetricConnectedP2PAddresses = "connected P2P addresses"
 
+	// MetricNumInvalidCallData represents the metric used to count the Ethereum deposits with an invalid SC call data
+	MetricNumInvalidCallData = "num invalid call data"
+
 	// MetricLastBlockNonce represents the last block nonce queried
 	MetricLastBlockNonce = "last block nonce"
+
+	// MetricTokensCacheHits represents the metric used to count the token lookups served from the cache
+	MetricTokensCacheHits = "tokens cache hits"
+
+	// MetricTokensCacheMisses represents the metric used to count the token lookups that required a chain query
+	MetricTokensCacheMisses = "tokens cache misses"
+
+	// MetricTokensCacheInvalidations represents the metric used to count the tokens cache invalidations
+	MetricTokensCacheInvalidations = "tokens cache invalidations"
+
+	// MetricTokensCacheNumEntries represents the metric used to store the number of entries held by the tokens cache
+	MetricTokensCacheNumEntries = "tokens cache num entries"
+
+	// MetricScCallsNumPendingOperations represents the metric used to store the number of pending SC calls
+	MetricScCallsNumPendingOperations = "sc calls num pending operations"
+
+	// MetricScCallsNumFilteredOut represents the metric used to store the number of pending SC calls rejected by the filter
+	MetricScCallsNumFilteredOut = "sc calls num filtered out"
+
+	// MetricScCallsNumFilteredOutByReasonPrefix represents the prefix of the metrics used to store the number of pending
+	// SC calls rejected by the filter, one for each reason (matching rule or denied list)
+	MetricScCallsNumFilteredOutByReasonPrefix = "sc calls num filtered out by "
+
+	// MetricScCallsNumSkippedByRetryPolicy represents the metric used to store the number of pending SC calls that
+	// wait for a retry or are dead letters
+	MetricScCallsNumSkippedByRetryPolicy = "sc calls num skipped by retry policy"
+
+	// MetricScCallsNumSkippedByGasLimit represents the metric used to count the SC calls skipped because their gas
+	// limit exceeds the maximum allowed
+	MetricScCallsNumSkippedByGasLimit = "sc calls num skipped by gas limit"
+
+	// MetricScCallsNumSuccessfulExecutions represents the metric used to count the successful SC calls executions
+	MetricScCallsNumSuccessfulExecutions = "sc calls num successful executions"
+
+	// MetricScCallsNumFailedExecutions represents the metric used to count the failed SC calls executions
+	MetricScCallsNumFailedExecutions = "sc calls num failed executions"
+
+	// MetricScCallsNumOutOfGasExecutions represents the metric used to count the SC calls executed with the out of
+	// gas limit, as they will be refunded
+	MetricScCallsNumOutOfGasExecutions = "sc calls num out of gas executions"
+
+	// MetricScCallsLastExecutedID represents the metric used to store the ID of the last executed SC call
+	MetricScCallsLastExecutedID = "sc calls last executed ID"
+
+	// MetricScCallsNumSkippedByFeeBudget represents the metric used to store the number of pending SC calls that
+	// were not executed in the last round because the daily fee budget was exceeded
+	MetricScCallsNumSkippedByFeeBudget = "sc calls num skipped by fee budget"
+
+	// MetricScCallsTotalFees represents the metric used to store the total fees spent on SC calls executions
+	MetricScCallsTotalFees = "sc calls total fees"
+
+	// MetricScCallsCurrentDayFees represents the metric used to store the fees spent on SC calls executions in the current day
+	MetricScCallsCurrentDayFees = "sc calls current day fees"
+
+	// MetricScCallsNumAssignedToOtherExecutors represents the metric used to store the number of pending SC calls
+	// left to the other coordinated executor instances
+	MetricScCallsNumAssignedToOtherExecutors = "sc calls num assigned to other executors"
+
+	// MetricRelayersQuorum represents the metric used to store the quorum size set on the Ethereum safe contract
+	MetricRelayersQuorum = "relayers quorum"
+
+	// MetricNumWhitelistedRelayers represents the metric used to store the number of whitelisted relayers
+	MetricNumWhitelistedRelayers = "num whitelisted relayers"
+
+	// MetricNumJoinedRelayers represents the metric used to store the number of whitelisted relayers seen on the p2p network
+	MetricNumJoinedRelayers = "num joined relayers"
+
+	// MetricNumLaggingRelayers represents the metric used to store the number of whitelisted relayers that were not
+	// seen on the p2p network or that did not sign the last batch
+	MetricNumLaggingRelayers = "num lagging relayers"
+
+	// MetricIsQuorumAtRisk represents the metric used to signal that the relayers that are not lagging can not
+	// reach the quorum
+	MetricIsQuorumAtRisk = "is quorum at risk"
 )
 
 // PersistedMetrics represents the array of metrics that should be persisted
@@ -90,4 +178,13 @@ const (
 
 	// KleverClientStatusHandlerName is the Klever client status handler name
 	KleverClientStatusHandlerName = "klever-client"
+
+	// TokensCacheStatusHandlerName is the tokens cache status handler name
+	TokensCacheStatusHandlerName = "tokens-cache"
+
+	// ScCallsExecutorStatusHandlerName is the SC calls executor status handler name
+	ScCallsExecutorStatusHandlerName = "sc-calls-executor"
+
+	// RelayersHealthStatusHandlerName is the relayers health status handler name
+	RelayersHealthStatusHandlerName = "relayers-health"
 )
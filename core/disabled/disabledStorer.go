@@ -0,0 +1,33 @@
+package disabled
+
+import "errors"
+
+var errDisabledStorer = errors.New("disabled storer")
+
+type disabledStorer struct {
+}
+
+// NewDisabledStorer will return a disabled storer instance
+func NewDisabledStorer() *disabledStorer {
+	return &disabledStorer{}
+}
+
+// Put does nothing
+func (storer *disabledStorer) Put(_, _ []byte) error {
+	return nil
+}
+
+// Get returns an error as nothing is stored
+func (storer *disabledStorer) Get(_ []byte) ([]byte, error) {
+	return nil, errDisabledStorer
+}
+
+// Close does nothing
+func (storer *disabledStorer) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (storer *disabledStorer) IsInterfaceNil() bool {
+	return storer == nil
+}
@@ -0,0 +1,29 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledStorer_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	storer := NewDisabledStorer()
+	assert.False(t, check.IfNil(storer))
+
+	assert.Nil(t, storer.Put([]byte("key"), []byte("data")))
+	data, err := storer.Get([]byte("key"))
+	assert.Nil(t, data)
+	assert.Equal(t, errDisabledStorer, err)
+	assert.Nil(t, storer.Close())
+}
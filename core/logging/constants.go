@@ -0,0 +1,21 @@
+package logging
+
+const (
+	// PlainFormat is the default, human-readable log format
+	PlainFormat = "plain"
+	// JSONFormat outputs one JSON object per log line
+	JSONFormat = "json"
+)
+
+const (
+	directionKey = "direction"
+	batchIDKey   = "batch_id"
+	actionIDKey  = "action_id"
+	txHashKey    = "tx_hash"
+)
+
+// the half-bridge logger names are built as <chain>ToKleverBlockchain and KleverBlockchainTo<chain>
+const (
+	toKleverBlockchainSuffix   = "ToKleverBlockchain"
+	fromKleverBlockchainPrefix = "KleverBlockchainTo"
+)
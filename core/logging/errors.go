@@ -0,0 +1,6 @@
+package logging
+
+import "errors"
+
+// ErrUnknownLogFormat signals that an unknown log format was provided
+var ErrUnknownLogFormat = errors.New("unknown log format")
@@ -0,0 +1,26 @@
+package logging
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// ApplyFormat changes the formatter used for the standard output. An empty format keeps the current formatter
+func ApplyFormat(format string) error {
+	switch strings.ToLower(format) {
+	case "", PlainFormat:
+		return nil
+	case JSONFormat:
+		err := logger.RemoveLogObserver(os.Stdout)
+		if err != nil {
+			return err
+		}
+
+		return logger.AddLogObserver(os.Stdout, &JSONFormatter{})
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownLogFormat, format)
+	}
+}
@@ -0,0 +1,67 @@
+package logging
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyFormat(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown format should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := ApplyFormat("xml")
+		assert.True(t, errors.Is(err, ErrUnknownLogFormat))
+		assert.Contains(t, err.Error(), "xml")
+	})
+	t.Run("plain or empty format should keep the current formatter", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, ApplyFormat(""))
+		assert.Nil(t, ApplyFormat(PlainFormat))
+	})
+}
+
+func TestApplyFormat_JSONFormatShouldReplaceTheStdoutObserver(t *testing.T) {
+	// not parallel, the test changes the stdout and the log observers
+	reader, writer, err := os.Pipe()
+	require.Nil(t, err)
+
+	originalStdout := os.Stdout
+	defer func() {
+		_ = logger.RemoveLogObserver(os.Stdout)
+		os.Stdout = originalStdout
+		_ = logger.AddLogObserver(os.Stdout, &logger.ConsoleFormatter{})
+	}()
+
+	// replace the default stdout observer with one writing in the pipe
+	require.Nil(t, logger.RemoveLogObserver(os.Stdout))
+	os.Stdout = writer
+	require.Nil(t, logger.AddLogObserver(os.Stdout, &logger.ConsoleFormatter{}))
+
+	err = ApplyFormat(JSONFormat)
+	require.Nil(t, err)
+
+	logger.GetOrCreate("logging/test").Info("json line", "batch ID", 3)
+	_ = writer.Close()
+
+	output, err := io.ReadAll(reader)
+	require.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	require.Equal(t, 1, len(lines))
+
+	result := make(map[string]interface{})
+	err = json.Unmarshal([]byte(lines[0]), &result)
+	require.Nil(t, err)
+	assert.Equal(t, "json line", result["message"])
+	assert.Equal(t, float64(3), result["batch_id"])
+}
@@ -0,0 +1,138 @@
+package logging
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// normalizedKeys maps the argument names used across the relayer (lowercased, without separators)
+// to the typed keys emitted at the top level of each JSON log line
+var normalizedKeys = map[string]string{
+	"direction":       directionKey,
+	"batchid":         batchIDKey,
+	"actionid":        actionIDKey,
+	"txhash":          txHashKey,
+	"transactionhash": txHashKey,
+}
+
+type jsonLogLine struct {
+	Timestamp string            `json:"timestamp"`
+	Level     string            `json:"level"`
+	Logger    string            `json:"logger"`
+	Message   string            `json:"message"`
+	Direction string            `json:"direction,omitempty"`
+	BatchID   *uint64           `json:"batch_id,omitempty"`
+	ActionID  *uint64           `json:"action_id,omitempty"`
+	TxHash    string            `json:"tx_hash,omitempty"`
+	Fields    map[string]string `json:"fields,omitempty"`
+}
+
+// JSONFormatter implements the logger's formatter interface and outputs each log line as a single JSON object
+type JSONFormatter struct {
+}
+
+// Output converts the provided LogLineHandler into a JSON object, terminated by a new line
+func (jf *JSONFormatter) Output(line logger.LogLineHandler) []byte {
+	if line == nil || line.IsInterfaceNil() {
+		return nil
+	}
+
+	loggerName := line.GetLoggerName()
+	output := &jsonLogLine{
+		Timestamp: time.Unix(0, line.GetTimestamp()).UTC().Format(time.RFC3339Nano),
+		Level:     strings.TrimSpace(logger.LogLevel(line.GetLogLevel()).String()),
+		Logger:    loggerName,
+		Message:   strings.TrimPrefix(line.GetMessage(), loggerName+" "),
+	}
+	addArgs(output, line.GetArgs())
+
+	if len(output.Direction) == 0 && isDirectionName(loggerName) {
+		output.Direction = loggerName
+	}
+
+	buff, err := json.Marshal(output)
+	if err != nil {
+		return nil
+	}
+
+	return append(buff, '\n')
+}
+
+// addArgs iterates through the provided arguments, provided as "name1", "val1", "name2", "val2" ...
+// The well-known arguments are moved to their typed keys, the rest are kept under fields. The batch and action IDs
+// that are not numbers are kept under fields too, so each typed key has a single JSON type. It ignores odd number of arguments
+func addArgs(output *jsonLogLine, args []string) {
+	for index := 1; index < len(args); index += 2 {
+		name, value := args[index-1], args[index]
+		if !setTypedField(output, normalizedKeys[normalizeName(name)], value) {
+			addField(output, name, value)
+		}
+	}
+}
+
+func setTypedField(output *jsonLogLine, key string, value string) bool {
+	switch key {
+	case directionKey:
+		if len(output.Direction) > 0 {
+			return false
+		}
+		output.Direction = value
+	case batchIDKey:
+		if output.BatchID != nil {
+			return false
+		}
+		output.BatchID = toNumber(value)
+		return output.BatchID != nil
+	case actionIDKey:
+		if output.ActionID != nil {
+			return false
+		}
+		output.ActionID = toNumber(value)
+		return output.ActionID != nil
+	case txHashKey:
+		if len(output.TxHash) > 0 {
+			return false
+		}
+		output.TxHash = value
+	default:
+		return false
+	}
+
+	return true
+}
+
+func addField(output *jsonLogLine, name string, value string) {
+	if output.Fields == nil {
+		output.Fields = make(map[string]string)
+	}
+
+	output.Fields[name] = value
+}
+
+func normalizeName(name string) string {
+	return strings.NewReplacer(" ", "", "_", "", "-", "").Replace(strings.ToLower(name))
+}
+
+// toNumber returns nil if the value is not a number, so the typed keys always hold numbers and the value is kept
+// under fields instead
+func toNumber(value string) *uint64 {
+	number, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	return &number
+}
+
+func isDirectionName(name string) bool {
+	return strings.HasSuffix(name, toKleverBlockchainSuffix) || strings.HasPrefix(name, fromKleverBlockchainPrefix)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jf *JSONFormatter) IsInterfaceNil() bool {
+	return jf == nil
+}
@@ -0,0 +1,160 @@
+package logging
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-logger-go/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLogLine(loggerName string, message string, args ...string) *logger.LogLineWrapper {
+	return &logger.LogLineWrapper{
+		LogLineMessage: proto.LogLineMessage{
+			Message:    message,
+			LogLevel:   int32(logger.LogInfo),
+			Args:       args,
+			Timestamp:  time.Date(2024, 5, 6, 7, 8, 9, 123000000, time.UTC).UnixNano(),
+			LoggerName: loggerName,
+		},
+	}
+}
+
+func outputAsMap(t *testing.T, buff []byte) map[string]interface{} {
+	require.Equal(t, byte('\n'), buff[len(buff)-1])
+
+	result := make(map[string]interface{})
+	err := json.Unmarshal(buff, &result)
+	require.Nil(t, err)
+
+	return result
+}
+
+func TestJSONFormatter_Output(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil line should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		formatter := &JSONFormatter{}
+		assert.Nil(t, formatter.Output(nil))
+	})
+	t.Run("should output the base fields", func(t *testing.T) {
+		t.Parallel()
+
+		formatter := &JSONFormatter{}
+		result := outputAsMap(t, formatter.Output(createLogLine("p2p", "message sent")))
+
+		expected := map[string]interface{}{
+			"timestamp": "2024-05-06T07:08:09.123Z",
+			"level":     "INFO",
+			"logger":    "p2p",
+			"message":   "message sent",
+		}
+		assert.Equal(t, expected, result)
+	})
+	t.Run("should normalize the well-known arguments", func(t *testing.T) {
+		t.Parallel()
+
+		formatter := &JSONFormatter{}
+		line := createLogLine("executor", "sent perform action transaction",
+			"tx hash", "0xabcd",
+			"action ID", "37",
+			"batch ID", "112",
+			"direction", "EthereumToKleverBlockchain",
+			"nonce", "4",
+			"hash", "0x1234",
+		)
+		result := outputAsMap(t, formatter.Output(line))
+
+		assert.Equal(t, "0xabcd", result["tx_hash"])
+		assert.Equal(t, float64(37), result["action_id"])
+		assert.Equal(t, float64(112), result["batch_id"])
+		assert.Equal(t, "EthereumToKleverBlockchain", result["direction"])
+		// the generic hash argument is not always a transaction hash
+		assert.Equal(t, map[string]interface{}{"nonce": "4", "hash": "0x1234"}, result["fields"])
+	})
+	t.Run("should accept the argument name variants", func(t *testing.T) {
+		t.Parallel()
+
+		formatter := &JSONFormatter{}
+		line := createLogLine("client", "performed action",
+			"actionID", "3",
+			"batchID", "not a number",
+			"transaction hash", "0x01",
+		)
+		result := outputAsMap(t, formatter.Output(line))
+
+		assert.Equal(t, float64(3), result["action_id"])
+		assert.Nil(t, result["batch_id"])
+		assert.Equal(t, "0x01", result["tx_hash"])
+		assert.Equal(t, map[string]interface{}{"batchID": "not a number"}, result["fields"])
+	})
+	t.Run("the IDs should always be numbers", func(t *testing.T) {
+		t.Parallel()
+
+		formatter := &JSONFormatter{}
+		line := createLogLine("client", "message", "batch ID", "0", "action ID", "-1", "actionID", "5")
+		result := outputAsMap(t, formatter.Output(line))
+
+		assert.Equal(t, float64(0), result["batch_id"])
+		assert.Equal(t, float64(5), result["action_id"])
+		assert.Equal(t, map[string]interface{}{"action ID": "-1"}, result["fields"])
+	})
+	t.Run("the sent transactions log lines should populate the tx hash", func(t *testing.T) {
+		t.Parallel()
+
+		// the arguments used by the bridge executor and the SC calls executor when sending transactions
+		formatter := &JSONFormatter{}
+		line := createLogLine("EthereumToKleverBlockchain", "sent perform action transaction",
+			"tx hash", "0xabcd", "batch ID", "112", "action ID", "37")
+		result := outputAsMap(t, formatter.Output(line))
+		assert.Equal(t, "0xabcd", result["tx_hash"])
+		assert.Equal(t, float64(112), result["batch_id"])
+		assert.Equal(t, float64(37), result["action_id"])
+		assert.Equal(t, "EthereumToKleverBlockchain", result["direction"])
+		assert.Nil(t, result["fields"])
+
+		line = createLogLine("scCallExecutor", "scCallExecutor.executeOperation: sent transaction from executor",
+			"tx hash", "0x1234", "tx ID", "4")
+		result = outputAsMap(t, formatter.Output(line))
+		assert.Equal(t, "0x1234", result["tx_hash"])
+	})
+	t.Run("duplicated well-known arguments should be kept as fields", func(t *testing.T) {
+		t.Parallel()
+
+		formatter := &JSONFormatter{}
+		line := createLogLine("client", "message", "batch ID", "1", "batchID", "2", "dangling")
+		result := outputAsMap(t, formatter.Output(line))
+
+		assert.Equal(t, float64(1), result["batch_id"])
+		assert.Equal(t, map[string]interface{}{"batchID": "2"}, result["fields"])
+	})
+	t.Run("should extract the direction from the logger name", func(t *testing.T) {
+		t.Parallel()
+
+		formatter := &JSONFormatter{}
+		result := outputAsMap(t, formatter.Output(createLogLine("KleverBlockchainToEthereum", "KleverBlockchainToEthereum step executed")))
+		assert.Equal(t, "KleverBlockchainToEthereum", result["direction"])
+		assert.Equal(t, "step executed", result["message"])
+
+		result = outputAsMap(t, formatter.Output(createLogLine("EthereumToKleverBlockchain", "message")))
+		assert.Equal(t, "EthereumToKleverBlockchain", result["direction"])
+
+		result = outputAsMap(t, formatter.Output(createLogLine("EthereumKleverBlockchain-Base", "message")))
+		assert.Nil(t, result["direction"])
+	})
+}
+
+func TestJSONFormatter_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var formatter *JSONFormatter
+	assert.True(t, formatter.IsInterfaceNil())
+
+	formatter = &JSONFormatter{}
+	assert.False(t, formatter.IsInterfaceNil())
+}
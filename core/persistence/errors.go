@@ -0,0 +1,15 @@
+package persistence
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer was provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrEmptyKey signals that an empty key was provided
+var ErrEmptyKey = errors.New("empty key")
+
+// ErrNilDataProvider signals that a nil data provider function was provided
+var ErrNilDataProvider = errors.New("nil data provider")
+
+// ErrNilLogger signals that a nil logger was provided
+var ErrNilLogger = errors.New("nil logger")
@@ -0,0 +1,124 @@
+package persistence
+
+import (
+	"sync"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// ArgsThrottledWriter is the DTO used in the throttled writer constructor
+type ArgsThrottledWriter struct {
+	Storer               core.Storer
+	Key                  []byte
+	MinTimeBetweenWrites time.Duration
+	DataProvider         func() ([]byte, error)
+	Log                  logger.Logger
+}
+
+// throttledWriter saves the data returned by the data provider under the configured key at most once every
+// MinTimeBetweenWrites. The changes notified in between are gathered in a single write
+type throttledWriter struct {
+	storer               core.Storer
+	key                  []byte
+	minTimeBetweenWrites time.Duration
+	dataProvider         func() ([]byte, error)
+	log                  logger.Logger
+
+	mut       sync.Mutex
+	mutWrite  sync.Mutex
+	dirty     bool
+	closed    bool
+	lastWrite time.Time
+	timer     *time.Timer
+}
+
+// NewThrottledWriter creates a new throttled writer instance
+func NewThrottledWriter(args ArgsThrottledWriter) (*throttledWriter, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if len(args.Key) == 0 {
+		return nil, ErrEmptyKey
+	}
+	if args.DataProvider == nil {
+		return nil, ErrNilDataProvider
+	}
+	if check.IfNil(args.Log) {
+		return nil, ErrNilLogger
+	}
+
+	return &throttledWriter{
+		storer:               args.Storer,
+		key:                  args.Key,
+		minTimeBetweenWrites: args.MinTimeBetweenWrites,
+		dataProvider:         args.DataProvider,
+		log:                  args.Log,
+	}, nil
+}
+
+// MarkDirty schedules a write of the data. The data provider is called later, on another go routine, so this
+// function can be called while holding the locks required by the data provider
+func (writer *throttledWriter) MarkDirty() {
+	writer.mut.Lock()
+	defer writer.mut.Unlock()
+
+	writer.dirty = true
+	if writer.closed || writer.timer != nil {
+		return
+	}
+
+	delay := writer.minTimeBetweenWrites - time.Since(writer.lastWrite)
+	if delay < 0 {
+		delay = 0
+	}
+	writer.timer = time.AfterFunc(delay, writer.Flush)
+}
+
+// Flush writes the data right away if there are changes not yet written
+func (writer *throttledWriter) Flush() {
+	writer.mut.Lock()
+	if writer.timer != nil {
+		writer.timer.Stop()
+		writer.timer = nil
+	}
+	if !writer.dirty {
+		writer.mut.Unlock()
+		return
+	}
+	writer.dirty = false
+	writer.lastWrite = time.Now()
+	writer.mut.Unlock()
+
+	writer.mutWrite.Lock()
+	defer writer.mutWrite.Unlock()
+
+	buff, err := writer.dataProvider()
+	if err != nil {
+		writer.log.Debug("throttledWriter.Flush save to buffer", "key", string(writer.key), "error", err)
+		return
+	}
+
+	err = writer.storer.Put(writer.key, buff)
+	if err != nil {
+		writer.log.Debug("throttledWriter.Flush writing to storer", "key", string(writer.key), "error", err)
+	}
+}
+
+// Close writes the pending changes and stops the scheduled writes
+func (writer *throttledWriter) Close() error {
+	writer.mut.Lock()
+	writer.closed = true
+	writer.mut.Unlock()
+
+	writer.Flush()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (writer *throttledWriter) IsInterfaceNil() bool {
+	return writer == nil
+}
@@ -0,0 +1,209 @@
+package persistence
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsThrottledWriter() ArgsThrottledWriter {
+	return ArgsThrottledWriter{
+		Storer:               testsCommon.NewStorerMock(),
+		Key:                  []byte("key"),
+		MinTimeBetweenWrites: time.Millisecond * 100,
+		DataProvider: func() ([]byte, error) {
+			return []byte("data"), nil
+		},
+		Log: logger.GetOrCreate("test"),
+	}
+}
+
+func TestNewThrottledWriter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsThrottledWriter()
+		args.Storer = nil
+
+		writer, err := NewThrottledWriter(args)
+		assert.True(t, check.IfNil(writer))
+		assert.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("empty key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsThrottledWriter()
+		args.Key = nil
+
+		writer, err := NewThrottledWriter(args)
+		assert.True(t, check.IfNil(writer))
+		assert.Equal(t, ErrEmptyKey, err)
+	})
+	t.Run("nil data provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsThrottledWriter()
+		args.DataProvider = nil
+
+		writer, err := NewThrottledWriter(args)
+		assert.True(t, check.IfNil(writer))
+		assert.Equal(t, ErrNilDataProvider, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsThrottledWriter()
+		args.Log = nil
+
+		writer, err := NewThrottledWriter(args)
+		assert.True(t, check.IfNil(writer))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		writer, err := NewThrottledWriter(createMockArgsThrottledWriter())
+		assert.False(t, check.IfNil(writer))
+		assert.Nil(t, err)
+	})
+}
+
+func TestThrottledWriter_MarkDirtyShouldGatherTheWrites(t *testing.T) {
+	t.Parallel()
+
+	numPuts := uint32(0)
+	args := createMockArgsThrottledWriter()
+	args.MinTimeBetweenWrites = time.Second
+	args.Storer = &testsCommon.StorerStub{
+		PutCalled: func(key, data []byte) error {
+			assert.Equal(t, []byte("key"), key)
+			assert.Equal(t, []byte("data"), data)
+			atomic.AddUint32(&numPuts, 1)
+			return nil
+		},
+	}
+	writer, _ := NewThrottledWriter(args)
+
+	// the first write is done right away
+	writer.MarkDirty()
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numPuts))
+
+	for i := 0; i < 100; i++ {
+		writer.MarkDirty()
+	}
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numPuts))
+
+	time.Sleep(time.Second)
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numPuts))
+}
+
+func TestThrottledWriter_FlushShouldWriteOnlyWhenDirty(t *testing.T) {
+	t.Parallel()
+
+	numPuts := uint32(0)
+	args := createMockArgsThrottledWriter()
+	args.MinTimeBetweenWrites = time.Hour
+	args.Storer = &testsCommon.StorerStub{
+		PutCalled: func(key, data []byte) error {
+			atomic.AddUint32(&numPuts, 1)
+			return errors.New("expected error")
+		},
+	}
+	writer, _ := NewThrottledWriter(args)
+
+	writer.Flush()
+	assert.Equal(t, uint32(0), atomic.LoadUint32(&numPuts))
+
+	writer.MarkDirty()
+	writer.Flush()
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numPuts))
+
+	writer.Flush()
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numPuts))
+}
+
+func TestThrottledWriter_CloseShouldWriteThePendingChanges(t *testing.T) {
+	t.Parallel()
+
+	numPuts := uint32(0)
+	args := createMockArgsThrottledWriter()
+	args.MinTimeBetweenWrites = time.Hour
+	args.Storer = &testsCommon.StorerStub{
+		PutCalled: func(key, data []byte) error {
+			atomic.AddUint32(&numPuts, 1)
+			return nil
+		},
+	}
+	args.DataProvider = func() ([]byte, error) {
+		return nil, nil
+	}
+	writer, _ := NewThrottledWriter(args)
+
+	writer.MarkDirty()
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numPuts))
+
+	writer.MarkDirty()
+	err := writer.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numPuts))
+
+	writer.MarkDirty()
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numPuts))
+}
+
+func TestThrottledWriter_DataProviderErrorShouldNotWrite(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsThrottledWriter()
+	args.Storer = &testsCommon.StorerStub{
+		PutCalled: func(key, data []byte) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		},
+	}
+	args.DataProvider = func() ([]byte, error) {
+		return nil, errors.New("expected error")
+	}
+	writer, _ := NewThrottledWriter(args)
+
+	writer.MarkDirty()
+	writer.Flush()
+}
+
+func TestThrottledWriter_ConcurrentOperationsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsThrottledWriter()
+	args.MinTimeBetweenWrites = time.Millisecond
+	writer, _ := NewThrottledWriter(args)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+
+			if idx%10 == 0 {
+				writer.Flush()
+				return
+			}
+			writer.MarkDirty()
+		}(i)
+	}
+	wg.Wait()
+
+	_ = writer.Close()
+}
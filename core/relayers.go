@@ -0,0 +1,40 @@
+package core
+
+// RelayerSignature holds the moment a relayer's signature was received for a message hash
+type RelayerSignature struct {
+	MessageHash string `json:"messageHash"`
+	BatchID     uint64 `json:"batchId,omitempty"`
+	Timestamp   int64  `json:"timestamp"`
+}
+
+// RelayerHealth holds the activity of a whitelisted relayer as seen by the current relayer
+type RelayerHealth struct {
+	Address                string             `json:"address"`
+	IsSelf                 bool               `json:"isSelf"`
+	IsCurrentLeader        bool               `json:"isCurrentLeader"`
+	IsLagging              bool               `json:"isLagging"`
+	LastJoinTimestamp      int64              `json:"lastJoinTimestamp"`
+	LastSignatureTimestamp int64              `json:"lastSignatureTimestamp"`
+	Signatures             []RelayerSignature `json:"signatures"`
+	NumMissedSignatures    uint64             `json:"numMissedSignatures"`
+	LastMissedBatchID      uint64             `json:"lastMissedBatchId"`
+	NumBatchesAsLeader     uint64             `json:"numBatchesAsLeader"`
+	LastBatchAsLeader      uint64             `json:"lastBatchAsLeader"`
+}
+
+// RelayersHealthSnapshot holds the health of the whole relayers set as seen at a certain moment
+type RelayersHealthSnapshot struct {
+	Quorum         uint64           `json:"quorum"`
+	NumWhitelisted int              `json:"numWhitelisted"`
+	NumJoined      int              `json:"numJoined"`
+	NumLagging     int              `json:"numLagging"`
+	IsQuorumAtRisk bool             `json:"isQuorumAtRisk"`
+	LastBatchID    uint64           `json:"lastBatchId"`
+	Relayers       []*RelayerHealth `json:"relayers"`
+}
+
+// RelayersHealthProvider defines a component able to provide the relayers health snapshot
+type RelayersHealthProvider interface {
+	GetRelayersHealth() *RelayersHealthSnapshot
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,58 @@
+package core
+
+import "context"
+
+// ScCallPendingOperation holds the decoded information of a SC call waiting to be executed
+type ScCallPendingOperation struct {
+	ID          uint64 `json:"id"`
+	From        string `json:"from"`
+	To          string `json:"to"`
+	Token       string `json:"token"`
+	Amount      string `json:"amount"`
+	Nonce       uint64 `json:"nonce"`
+	GasLimit    uint64 `json:"gasLimit"`
+	RawCallData string `json:"rawCallData"`
+	Proxy       string `json:"proxy,omitempty"`
+}
+
+// ScCallOperationResult holds the outcome of a SC call execution
+type ScCallOperationResult struct {
+	ID        uint64 `json:"id"`
+	TxHash    string `json:"txHash"`
+	Status    string `json:"status"`
+	Error     string `json:"error"`
+	Fee       int64  `json:"fee"`
+	Timestamp int64  `json:"timestamp"`
+	Proxy     string `json:"proxy,omitempty"`
+}
+
+// ScCallExecutionAttempts holds the failed execution attempts of a pending SC call
+type ScCallExecutionAttempts struct {
+	ID                   uint64 `json:"id"`
+	NumAttempts          uint32 `json:"numAttempts"`
+	LastError            string `json:"lastError"`
+	LastAttemptTimestamp int64  `json:"lastAttemptTimestamp"`
+	NextAttemptTimestamp int64  `json:"nextAttemptTimestamp"`
+	IsDeadLetter         bool   `json:"isDeadLetter"`
+	Proxy                string `json:"proxy,omitempty"`
+}
+
+// ScCallsFeesLedger holds the fees spent by the SC calls executor, in the smallest KLV units
+type ScCallsFeesLedger struct {
+	TotalFees          int64            `json:"totalFees"`
+	FeesPerToken       map[string]int64 `json:"feesPerToken"`
+	FeesPerDestination map[string]int64 `json:"feesPerDestination"`
+	CurrentDay         string           `json:"currentDay"`
+	CurrentDayFees     int64            `json:"currentDayFees"`
+	DailyBudget        uint64           `json:"dailyBudget"`
+	IsBudgetExceeded   bool             `json:"isBudgetExceeded"`
+}
+
+// ScCallsInfoProvider defines a component able to provide information about the SC calls execution
+type ScCallsInfoProvider interface {
+	GetPendingOperations(ctx context.Context) ([]*ScCallPendingOperation, error)
+	GetOperationsResults() []*ScCallOperationResult
+	GetDeadLetters() []ScCallExecutionAttempts
+	GetFeesLedger() ScCallsFeesLedger
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,38 @@
+package core
+
+// KDATokenInfo holds the Klever Blockchain side information of a bridged token
+type KDATokenInfo struct {
+	TokenID    string `json:"tokenId"`
+	Precision  uint32 `json:"precision"`
+	IsNative   bool   `json:"isNative"`
+	IsMintBurn bool   `json:"isMintBurn"`
+}
+
+// ERC20TokenInfo holds the Ethereum side information of a bridged token
+type ERC20TokenInfo struct {
+	Address       string `json:"address"`
+	Decimals      uint8  `json:"decimals"`
+	IsWhitelisted bool   `json:"isWhitelisted"`
+	IsNative      bool   `json:"isNative"`
+	IsMintBurn    bool   `json:"isMintBurn"`
+}
+
+// TokenRegistryEntry holds the cross-chain information of a bridged token together with the detected inconsistencies
+type TokenRegistryEntry struct {
+	KDA      KDATokenInfo   `json:"kda"`
+	ERC20    ERC20TokenInfo `json:"erc20"`
+	Warnings []string       `json:"warnings"`
+}
+
+// TokensRegistrySnapshot holds all the bridged tokens as seen at a certain moment
+type TokensRegistrySnapshot struct {
+	LastUpdateTimestamp int64                 `json:"lastUpdateTimestamp"`
+	NumWarnings         int                   `json:"numWarnings"`
+	Tokens              []*TokenRegistryEntry `json:"tokens"`
+}
+
+// TokensRegistry defines a component able to provide the bridged tokens snapshot
+type TokensRegistry interface {
+	GetTokens() *TokensRegistrySnapshot
+	IsInterfaceNil() bool
+}
@@ -47,6 +47,12 @@ type BroadcastClient interface {
 	IsInterfaceNil() bool
 }
 
+// LeaseClient defines a component able to process the leader leases announced by the other relayers
+type LeaseClient interface {
+	ProcessLease(publicKey []byte, lease *LeaderLease)
+	IsInterfaceNil() bool
+}
+
 // StatusHandler is able to keep metrics
 type StatusHandler interface {
 	SetIntMetric(metric string, value int)
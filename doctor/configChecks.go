@@ -0,0 +1,156 @@
+package doctor
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	"github.com/klever-io/klv-bridge-eth-go/config"
+)
+
+const (
+	checkConfigNetworkAddresses  = "config: network addresses"
+	checkConfigEthereumContracts = "config: Ethereum contract addresses"
+	checkConfigKleverContracts   = "config: Klever Blockchain contract addresses"
+	checkConfigEthereumGasLimits = "config: Ethereum gas limits"
+	checkConfigKleverGasMap      = "config: Klever Blockchain gas map"
+	checkConfigStateMachines     = "config: state machines"
+)
+
+type namedValue struct {
+	name  string
+	value uint64
+}
+
+// CheckConfig runs all the static checks on the provided configuration, without any network access
+func CheckConfig(cfg config.Config, report *Report) {
+	checkNetworkAddresses(cfg, report)
+	checkEthereumContractAddresses(cfg.Eth, report)
+	checkKleverContractAddresses(cfg.Klever, report)
+	checkEthereumGasLimits(cfg.Eth, report)
+	checkKleverGasMap(cfg.Klever.GasMap, report)
+	checkStateMachines(cfg, report)
+}
+
+func checkNetworkAddresses(cfg config.Config, report *Report) {
+	missing := make([]string, 0)
+	if len(cfg.Eth.NetworkAddress) == 0 {
+		missing = append(missing, "Eth.NetworkAddress")
+	}
+	if len(cfg.Klever.NetworkAddress) == 0 {
+		missing = append(missing, "Klever.NetworkAddress")
+	}
+	if len(missing) > 0 {
+		report.AddFail(checkConfigNetworkAddresses, "empty values for %s", strings.Join(missing, ", "))
+		return
+	}
+
+	report.AddPass(checkConfigNetworkAddresses, "Ethereum: %s, Klever Blockchain: %s", cfg.Eth.NetworkAddress, cfg.Klever.NetworkAddress)
+}
+
+func checkEthereumContractAddresses(cfg config.EthereumConfig, report *Report) {
+	if !common.IsHexAddress(cfg.MultisigContractAddress) {
+		report.AddFail(checkConfigEthereumContracts, "invalid Eth.MultisigContractAddress %q", cfg.MultisigContractAddress)
+		return
+	}
+	if !common.IsHexAddress(cfg.SafeContractAddress) {
+		report.AddFail(checkConfigEthereumContracts, "invalid Eth.SafeContractAddress %q", cfg.SafeContractAddress)
+		return
+	}
+
+	multisigAddress := common.HexToAddress(cfg.MultisigContractAddress)
+	safeAddress := common.HexToAddress(cfg.SafeContractAddress)
+	if multisigAddress == safeAddress {
+		report.AddFail(checkConfigEthereumContracts, "Eth.MultisigContractAddress and Eth.SafeContractAddress are the same: %s", multisigAddress.String())
+		return
+	}
+
+	report.AddPass(checkConfigEthereumContracts, "multisig: %s, safe: %s", multisigAddress.String(), safeAddress.String())
+}
+
+func checkKleverContractAddresses(cfg config.KleverConfig, report *Report) {
+	multisigAddress, err := address.NewAddress(cfg.MultisigContractAddress)
+	if err != nil {
+		report.AddFail(checkConfigKleverContracts, "invalid Klever.MultisigContractAddress %q: %s", cfg.MultisigContractAddress, err.Error())
+		return
+	}
+	safeAddress, err := address.NewAddress(cfg.SafeContractAddress)
+	if err != nil {
+		report.AddFail(checkConfigKleverContracts, "invalid Klever.SafeContractAddress %q: %s", cfg.SafeContractAddress, err.Error())
+		return
+	}
+	if multisigAddress.Bech32() == safeAddress.Bech32() {
+		report.AddFail(checkConfigKleverContracts, "Klever.MultisigContractAddress and Klever.SafeContractAddress are the same: %s", multisigAddress.Bech32())
+		return
+	}
+
+	report.AddPass(checkConfigKleverContracts, "multisig: %s, safe: %s", multisigAddress.Bech32(), safeAddress.Bech32())
+}
+
+func checkEthereumGasLimits(cfg config.EthereumConfig, report *Report) {
+	values := []namedValue{
+		{name: "Eth.GasLimitBase", value: cfg.GasLimitBase},
+		{name: "Eth.GasLimitForEach", value: cfg.GasLimitForEach},
+	}
+
+	checkNonZeroValues(checkConfigEthereumGasLimits, values, report)
+}
+
+func checkKleverGasMap(cfg config.KleverGasMapConfig, report *Report) {
+	values := []namedValue{
+		{name: "Sign", value: cfg.Sign},
+		{name: "ProposeTransferBase", value: cfg.ProposeTransferBase},
+		{name: "ProposeTransferForEach", value: cfg.ProposeTransferForEach},
+		{name: "ProposeStatusBase", value: cfg.ProposeStatusBase},
+		{name: "ProposeStatusForEach", value: cfg.ProposeStatusForEach},
+		{name: "PerformActionBase", value: cfg.PerformActionBase},
+		{name: "PerformActionForEach", value: cfg.PerformActionForEach},
+		{name: "ScCallPerByte", value: cfg.ScCallPerByte},
+		{name: "ScCallPerformForEach", value: cfg.ScCallPerformForEach},
+	}
+
+	checkNonZeroValues(checkConfigKleverGasMap, values, report)
+}
+
+func checkNonZeroValues(checkName string, values []namedValue, report *Report) {
+	zeroValues := make([]string, 0)
+	for _, nv := range values {
+		if nv.value == 0 {
+			zeroValues = append(zeroValues, nv.name)
+		}
+	}
+	if len(zeroValues) > 0 {
+		report.AddFail(checkName, "zero values for %s", strings.Join(zeroValues, ", "))
+		return
+	}
+
+	report.AddPass(checkName, "%d values set", len(values))
+}
+
+func checkStateMachines(cfg config.Config, report *Report) {
+	names := []string{
+		cfg.Eth.Chain.EvmCompatibleChainToKleverBlockchainName(),
+		cfg.Eth.Chain.KleverBlockchainToEvmCompatibleChainName(),
+	}
+
+	problems := make([]string, 0)
+	for _, name := range names {
+		stateMachineConfig, found := cfg.StateMachine[name]
+		if !found {
+			problems = append(problems, "missing StateMachine."+name)
+			continue
+		}
+		if stateMachineConfig.StepDurationInMillis == 0 {
+			problems = append(problems, "zero StateMachine."+name+".StepDurationInMillis")
+		}
+		if stateMachineConfig.IntervalForLeaderInSeconds == 0 {
+			problems = append(problems, "zero StateMachine."+name+".IntervalForLeaderInSeconds")
+		}
+	}
+	if len(problems) > 0 {
+		report.AddFail(checkConfigStateMachines, "%s", strings.Join(problems, ", "))
+		return
+	}
+
+	report.AddPass(checkConfigStateMachines, "%s", strings.Join(names, ", "))
+}
@@ -0,0 +1,105 @@
+package doctor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid config should pass", func(t *testing.T) {
+		t.Parallel()
+
+		report := NewReport()
+		CheckConfig(createTestConfig(), report)
+
+		assert.Equal(t, 0, report.NumFailed(), report.String())
+		assert.Equal(t, 6, len(report.Checks()))
+	})
+	t.Run("invalid Ethereum addresses should fail", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.Eth.MultisigContractAddress = "not an address"
+
+		report := NewReport()
+		CheckConfig(cfg, report)
+
+		assert.Equal(t, StatusFailed, findCheck(report, checkConfigEthereumContracts).Status)
+
+		cfg = createTestConfig()
+		cfg.Eth.SafeContractAddress = cfg.Eth.MultisigContractAddress
+
+		report = NewReport()
+		CheckConfig(cfg, report)
+
+		assert.Equal(t, StatusFailed, findCheck(report, checkConfigEthereumContracts).Status)
+	})
+	t.Run("invalid Klever addresses should fail", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.Klever.SafeContractAddress = "klv1invalid"
+
+		report := NewReport()
+		CheckConfig(cfg, report)
+
+		assert.Equal(t, StatusFailed, findCheck(report, checkConfigKleverContracts).Status)
+	})
+	t.Run("zero gas values should fail", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.Eth.GasLimitForEach = 0
+		cfg.Klever.GasMap.Sign = 0
+		cfg.Klever.GasMap.ScCallPerByte = 0
+
+		report := NewReport()
+		CheckConfig(cfg, report)
+
+		assert.Equal(t, 2, report.NumFailed(), report.String())
+		assert.Equal(t, "zero values for Eth.GasLimitForEach", findCheck(report, checkConfigEthereumGasLimits).Message)
+		assert.Equal(t, "zero values for Sign, ScCallPerByte", findCheck(report, checkConfigKleverGasMap).Message)
+	})
+	t.Run("missing state machine should fail", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		delete(cfg.StateMachine, "KleverBlockchainToEthereum")
+
+		report := NewReport()
+		CheckConfig(cfg, report)
+
+		result := findCheck(report, checkConfigStateMachines)
+		assert.Equal(t, StatusFailed, result.Status)
+		assert.True(t, strings.Contains(result.Message, "missing StateMachine.KleverBlockchainToEthereum"))
+	})
+	t.Run("empty network addresses should fail", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.Eth.NetworkAddress = ""
+
+		report := NewReport()
+		CheckConfig(cfg, report)
+
+		assert.Equal(t, "empty values for Eth.NetworkAddress", findCheck(report, checkConfigNetworkAddresses).Message)
+	})
+}
+
+func TestReport_String(t *testing.T) {
+	t.Parallel()
+
+	report := NewReport()
+	report.AddPass("first", "ok %d", 1)
+	report.AddFail("second check", "not ok")
+
+	expected := "[PASS] first        ok 1\n" +
+		"[FAIL] second check not ok\n" +
+		"2 checks, 1 passed, 1 failed\n"
+	assert.Equal(t, expected, report.String())
+	assert.Equal(t, 1, report.NumFailed())
+}
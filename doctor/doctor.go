@@ -0,0 +1,359 @@
+package doctor
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+const (
+	checkEthereumConnectivity = "Ethereum connectivity"
+	checkKleverConnectivity   = "Klever Blockchain connectivity"
+	checkEthereumRelayer      = "Ethereum relayer whitelisted"
+	checkKleverRelayer        = "Klever Blockchain relayer staked"
+	checkEthereumQuorum       = "Ethereum quorum"
+	checkEthereumWiring       = "Ethereum multisig <-> safe wiring"
+	checkEthereumPaused       = "Ethereum multisig not paused"
+	checkKleverWiring         = "Klever Blockchain multisig <-> safe wiring"
+	checkKleverPaused         = "Klever Blockchain multisig not paused"
+	checkTokenMappings        = "token mappings"
+)
+
+// ArgsDoctor is the DTO used in the NewDoctor constructor
+type ArgsDoctor struct {
+	Config                 config.Config
+	EthereumClient         EthereumChainClient
+	SafeContract           SafeContract
+	KleverDataGetter       KleverDataGetter
+	KleverProxy            KleverProxy
+	EthereumRelayerAddress common.Address
+	KleverRelayerAddress   address.Address
+}
+
+type doctor struct {
+	config                 config.Config
+	ethereumClient         EthereumChainClient
+	safeContract           SafeContract
+	kleverDataGetter       KleverDataGetter
+	kleverProxy            KleverProxy
+	ethereumRelayerAddress common.Address
+	kleverRelayerAddress   address.Address
+}
+
+// NewDoctor creates a new doctor instance able to check a relayer setup
+func NewDoctor(args ArgsDoctor) (*doctor, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &doctor{
+		config:                 args.Config,
+		ethereumClient:         args.EthereumClient,
+		safeContract:           args.SafeContract,
+		kleverDataGetter:       args.KleverDataGetter,
+		kleverProxy:            args.KleverProxy,
+		ethereumRelayerAddress: args.EthereumRelayerAddress,
+		kleverRelayerAddress:   args.KleverRelayerAddress,
+	}, nil
+}
+
+func checkArgs(args ArgsDoctor) error {
+	if check.IfNil(args.EthereumClient) {
+		return errNilEthereumClient
+	}
+	if check.IfNilReflect(args.SafeContract) {
+		return errNilSafeContract
+	}
+	if check.IfNil(args.KleverDataGetter) {
+		return errNilKleverDataGetter
+	}
+	if check.IfNil(args.KleverProxy) {
+		return errNilKleverProxy
+	}
+	if check.IfNil(args.KleverRelayerAddress) {
+		return fmt.Errorf("%w for the KleverRelayerAddress argument", errNilAddress)
+	}
+
+	return nil
+}
+
+// Run executes all the checks and returns the resulting report
+func (d *doctor) Run(ctx context.Context) *Report {
+	report := NewReport()
+
+	CheckConfig(d.config, report)
+
+	ethereumAvailable := d.checkEthereumConnectivity(ctx, report)
+	if ethereumAvailable {
+		d.checkEthereumRelayer(ctx, report)
+		d.checkEthereumQuorum(ctx, report)
+		d.checkEthereumWiring(ctx, report)
+		d.checkEthereumPaused(ctx, report)
+	}
+
+	kleverAvailable := d.checkKleverConnectivity(ctx, report)
+	if kleverAvailable {
+		d.checkKleverRelayer(ctx, report)
+		d.checkKleverWiring(ctx, report)
+		d.checkKleverPaused(ctx, report)
+	}
+
+	if ethereumAvailable && kleverAvailable {
+		d.checkTokenMappings(ctx, report)
+	}
+
+	return report
+}
+
+func (d *doctor) checkEthereumConnectivity(ctx context.Context, report *Report) bool {
+	chainID, err := d.ethereumClient.ChainID(ctx)
+	if err != nil {
+		report.AddFail(checkEthereumConnectivity, "%s: ChainID call failed: %s", d.config.Eth.NetworkAddress, err.Error())
+		return false
+	}
+	blockNumber, err := d.ethereumClient.BlockNumber(ctx)
+	if err != nil {
+		report.AddFail(checkEthereumConnectivity, "%s: BlockNumber call failed: %s", d.config.Eth.NetworkAddress, err.Error())
+		return false
+	}
+
+	expectedChainID := d.config.Eth.ChainID
+	if expectedChainID != 0 && chainID.Cmp(big.NewInt(0).SetUint64(expectedChainID)) != 0 {
+		report.AddFail(checkEthereumConnectivity, "chain ID mismatch, configured: %d, node reported: %s", expectedChainID, chainID.String())
+		return false
+	}
+
+	report.AddPass(checkEthereumConnectivity, "chain ID %s, block %d", chainID.String(), blockNumber)
+
+	return true
+}
+
+func (d *doctor) checkKleverConnectivity(ctx context.Context, report *Report) bool {
+	networkConfig, err := d.kleverProxy.GetNetworkConfig(ctx)
+	if err != nil {
+		report.AddFail(checkKleverConnectivity, "%s: GetNetworkConfig call failed: %s", d.config.Klever.NetworkAddress, err.Error())
+		return false
+	}
+	nonce, err := d.kleverDataGetter.GetCurrentNonce(ctx)
+	if err != nil {
+		report.AddFail(checkKleverConnectivity, "%s: GetNetworkStatus call failed: %s", d.config.Klever.NetworkAddress, err.Error())
+		return false
+	}
+
+	expectedChainID := d.config.Klever.ChainID
+	if len(expectedChainID) > 0 && networkConfig.ChainID != expectedChainID {
+		report.AddFail(checkKleverConnectivity, "chain ID mismatch, configured: %s, node reported: %s", expectedChainID, networkConfig.ChainID)
+		return false
+	}
+
+	report.AddPass(checkKleverConnectivity, "chain ID %s, nonce %d", networkConfig.ChainID, nonce)
+
+	return true
+}
+
+func (d *doctor) checkEthereumRelayer(ctx context.Context, report *Report) {
+	relayers, err := d.ethereumClient.GetRelayers(ctx)
+	if err != nil {
+		report.AddFail(checkEthereumRelayer, "GetRelayers call failed: %s", err.Error())
+		return
+	}
+
+	for _, relayer := range relayers {
+		if relayer == d.ethereumRelayerAddress {
+			report.AddPass(checkEthereumRelayer, "%s found in %d relayers", d.ethereumRelayerAddress.String(), len(relayers))
+			return
+		}
+	}
+
+	report.AddFail(checkEthereumRelayer, "%s not found in %d relayers", d.ethereumRelayerAddress.String(), len(relayers))
+}
+
+func (d *doctor) checkKleverRelayer(ctx context.Context, report *Report) {
+	relayers, err := d.kleverDataGetter.GetAllStakedRelayers(ctx)
+	if err != nil {
+		report.AddFail(checkKleverRelayer, "getAllStakedRelayers query failed: %s", err.Error())
+		return
+	}
+
+	for _, relayer := range relayers {
+		if bytes.Equal(relayer, d.kleverRelayerAddress.Bytes()) {
+			report.AddPass(checkKleverRelayer, "%s found in %d relayers", d.kleverRelayerAddress.Bech32(), len(relayers))
+			return
+		}
+	}
+
+	report.AddFail(checkKleverRelayer, "%s not found in %d relayers", d.kleverRelayerAddress.Bech32(), len(relayers))
+}
+
+func (d *doctor) checkEthereumQuorum(ctx context.Context, report *Report) {
+	quorum, err := d.ethereumClient.Quorum(ctx)
+	if err != nil {
+		report.AddFail(checkEthereumQuorum, "Quorum call failed: %s", err.Error())
+		return
+	}
+	relayers, err := d.ethereumClient.GetRelayers(ctx)
+	if err != nil {
+		report.AddFail(checkEthereumQuorum, "GetRelayers call failed: %s", err.Error())
+		return
+	}
+
+	if quorum.Sign() <= 0 {
+		report.AddFail(checkEthereumQuorum, "invalid quorum %s", quorum.String())
+		return
+	}
+	if quorum.Cmp(big.NewInt(int64(len(relayers)))) > 0 {
+		report.AddFail(checkEthereumQuorum, "quorum %s is greater than the number of relayers %d", quorum.String(), len(relayers))
+		return
+	}
+
+	report.AddPass(checkEthereumQuorum, "quorum %s out of %d relayers", quorum.String(), len(relayers))
+}
+
+func (d *doctor) checkEthereumWiring(ctx context.Context, report *Report) {
+	bridgeAddress, err := d.safeContract.Bridge(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		report.AddFail(checkEthereumWiring, "Bridge call on safe %s failed: %s", d.config.Eth.SafeContractAddress, err.Error())
+		return
+	}
+
+	multisigAddress := common.HexToAddress(d.config.Eth.MultisigContractAddress)
+	if bridgeAddress != multisigAddress {
+		report.AddFail(checkEthereumWiring, "safe is wired to bridge %s, configured multisig is %s", bridgeAddress.String(), multisigAddress.String())
+		return
+	}
+
+	report.AddPass(checkEthereumWiring, "safe is wired to multisig %s", multisigAddress.String())
+}
+
+func (d *doctor) checkEthereumPaused(ctx context.Context, report *Report) {
+	isPaused, err := d.ethereumClient.IsPaused(ctx)
+	if err != nil {
+		report.AddFail(checkEthereumPaused, "Paused call failed: %s", err.Error())
+		return
+	}
+	if isPaused {
+		report.AddFail(checkEthereumPaused, "multisig %s is paused", d.config.Eth.MultisigContractAddress)
+		return
+	}
+
+	report.AddPass(checkEthereumPaused, "multisig %s is active", d.config.Eth.MultisigContractAddress)
+}
+
+func (d *doctor) checkKleverWiring(ctx context.Context, report *Report) {
+	lastBatchID, err := d.kleverDataGetter.GetLastKCBatchID(ctx)
+	if err != nil {
+		report.AddFail(checkKleverWiring, "getLastBatchId query on safe %s failed: %s", d.config.Klever.SafeContractAddress, err.Error())
+		return
+	}
+
+	response, err := d.kleverDataGetter.GetKdaSafeAddress(ctx)
+	if err != nil {
+		report.AddFail(checkKleverWiring, "getKdaSafeAddress query on multisig %s failed: %s", d.config.Klever.MultisigContractAddress, err.Error())
+		return
+	}
+
+	safeAddress, err := address.NewAddress(d.config.Klever.SafeContractAddress)
+	if err != nil {
+		report.AddFail(checkKleverWiring, "invalid safe address %s: %s", d.config.Klever.SafeContractAddress, err.Error())
+		return
+	}
+	if len(response) == 0 || !bytes.Equal(response[0], safeAddress.Bytes()) {
+		report.AddFail(checkKleverWiring, "multisig is wired to safe %s, configured safe is %s", displayKleverAddress(response), d.config.Klever.SafeContractAddress)
+		return
+	}
+
+	report.AddPass(checkKleverWiring, "multisig is wired to safe %s, last batch ID %d", d.config.Klever.SafeContractAddress, lastBatchID)
+}
+
+func displayKleverAddress(response [][]byte) string {
+	if len(response) == 0 || len(response[0]) == 0 {
+		return "<none>"
+	}
+
+	wiredAddress, err := address.NewAddressFromBytes(response[0])
+	if err != nil {
+		return hex.EncodeToString(response[0])
+	}
+
+	return wiredAddress.Bech32()
+}
+
+func (d *doctor) checkKleverPaused(ctx context.Context, report *Report) {
+	isPaused, err := d.kleverDataGetter.IsPaused(ctx)
+	if err != nil {
+		report.AddFail(checkKleverPaused, "isPaused query failed: %s", err.Error())
+		return
+	}
+	if isPaused {
+		report.AddFail(checkKleverPaused, "multisig %s is paused", d.config.Klever.MultisigContractAddress)
+		return
+	}
+
+	report.AddPass(checkKleverPaused, "multisig %s is active", d.config.Klever.MultisigContractAddress)
+}
+
+func (d *doctor) checkTokenMappings(ctx context.Context, report *Report) {
+	tokens, err := d.kleverDataGetter.GetAllKnownTokens(ctx)
+	if err != nil {
+		report.AddFail(checkTokenMappings, "getAllKnownTokens query failed: %s", err.Error())
+		return
+	}
+
+	problems := make([]string, 0)
+	for _, token := range tokens {
+		problem := d.checkTokenMapping(ctx, token)
+		if len(problem) > 0 {
+			problems = append(problems, problem)
+		}
+	}
+	if len(problems) > 0 {
+		report.AddFail(checkTokenMappings, "%d/%d tokens with problems: %s", len(problems), len(tokens), strings.Join(problems, "; "))
+		return
+	}
+
+	report.AddPass(checkTokenMappings, "%d tokens mapped in both directions and whitelisted on Ethereum", len(tokens))
+}
+
+func (d *doctor) checkTokenMapping(ctx context.Context, token []byte) string {
+	response, err := d.kleverDataGetter.GetERC20AddressForTokenId(ctx, token)
+	if err != nil {
+		return fmt.Sprintf("%s: getErc20AddressForTokenId query failed: %s", token, err.Error())
+	}
+	if len(response) == 0 || len(response[0]) == 0 {
+		return fmt.Sprintf("%s: no ERC20 address", token)
+	}
+	erc20Address := common.BytesToAddress(response[0])
+
+	response, err = d.kleverDataGetter.GetTokenIdForErc20Address(ctx, erc20Address.Bytes())
+	if err != nil {
+		return fmt.Sprintf("%s: getTokenIdForErc20Address query for %s failed: %s", token, erc20Address.String(), err.Error())
+	}
+	if len(response) == 0 || !bytes.Equal(response[0], token) {
+		return fmt.Sprintf("%s: ERC20 address %s does not map back to the token", token, erc20Address.String())
+	}
+
+	isWhitelisted, err := d.ethereumClient.WhitelistedTokens(ctx, erc20Address)
+	if err != nil {
+		return fmt.Sprintf("%s: WhitelistedTokens call for %s failed: %s", token, erc20Address.String(), err.Error())
+	}
+	if !isWhitelisted {
+		return fmt.Sprintf("%s: ERC20 address %s is not whitelisted on the Ethereum safe", token, erc20Address.String())
+	}
+
+	return ""
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *doctor) IsInterfaceNil() bool {
+	return d == nil
+}
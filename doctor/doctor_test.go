@@ -0,0 +1,329 @@
+package doctor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/klever-io/klv-bridge-eth-go/clients/chain"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/integrationTests/mock"
+	bridgeTests "github.com/klever-io/klv-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEthMultisigAddress    = "0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c"
+	testEthSafeAddress        = "0x755765f943Bc82faA6135e01fE08B116947D38e8"
+	testKleverMultisigAddress = "klv1qqqqqqqqqqqqqpgqh46r9zh78lry2py8tq723fpjdr4pp0zgsg8syf6mq0"
+	testKleverSafeAddress     = "klv1qqqqqqqqqqqqqpgqxjgmvqe9kvvr4xvvxflue3a7cjjeyvx9sg8snh0ljc"
+)
+
+var (
+	testEthRelayerAddress = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	testErc20Address      = common.HexToAddress("0x2222222222222222222222222222222222222222")
+)
+
+func createTestConfig() config.Config {
+	stateMachineConfig := config.ConfigStateMachine{
+		StepDurationInMillis:       1000,
+		IntervalForLeaderInSeconds: 60,
+	}
+
+	return config.Config{
+		Eth: config.EthereumConfig{
+			Chain:                   chain.Ethereum,
+			NetworkAddress:          "mock",
+			MultisigContractAddress: testEthMultisigAddress,
+			SafeContractAddress:     testEthSafeAddress,
+			GasLimitBase:            350000,
+			GasLimitForEach:         30000,
+		},
+		Klever: config.KleverConfig{
+			NetworkAddress:          "mock",
+			MultisigContractAddress: testKleverMultisigAddress,
+			SafeContractAddress:     testKleverSafeAddress,
+			GasMap: config.KleverGasMapConfig{
+				Sign:                   8000000,
+				ProposeTransferBase:    11000000,
+				ProposeTransferForEach: 5500000,
+				ProposeStatusBase:      10000000,
+				ProposeStatusForEach:   7000000,
+				PerformActionBase:      40000000,
+				PerformActionForEach:   5500000,
+				ScCallPerByte:          100000,
+				ScCallPerformForEach:   10000000,
+			},
+		},
+		StateMachine: map[string]config.ConfigStateMachine{
+			"EthereumToKleverBlockchain": stateMachineConfig,
+			"KleverBlockchainToEthereum": stateMachineConfig,
+		},
+	}
+}
+
+type testComponents struct {
+	args           ArgsDoctor
+	ethChainMock   *mock.EthereumChainMock
+	kcChainMock    *mock.KleverBlockchainMock
+	kleverRelayer  address.Address
+	safeBridgeAddr common.Address
+}
+
+func createTestComponents(t *testing.T) *testComponents {
+	cfg := createTestConfig()
+
+	kleverRelayer, err := address.NewAddressFromBytes(bytes.Repeat([]byte{1}, 32))
+	require.Nil(t, err)
+	multisigAddress, err := address.NewAddress(testKleverMultisigAddress)
+	require.Nil(t, err)
+	safeAddress, err := address.NewAddress(testKleverSafeAddress)
+	require.Nil(t, err)
+
+	ethChainMock := mock.NewEthereumChainMock()
+	ethChainMock.AddRelayer(testEthRelayerAddress)
+	ethChainMock.SetQuorum(1)
+	ethChainMock.UpdateWhitelistedTokens(testErc20Address, true)
+
+	kcChainMock := mock.NewKleverBlockchainMock()
+	kcChainMock.AddRelayer(kleverRelayer)
+	kcChainMock.SetKdaSafeAddress(safeAddress)
+	kcChainMock.AddTokensPair(testErc20Address, "ETHUSDC-0g3a", false, true, big.NewInt(0), big.NewInt(0), big.NewInt(0))
+
+	dataGetter, err := klever.NewKLVClientDataGetter(klever.ArgsKLVClientDataGetter{
+		MultisigContractAddress: multisigAddress,
+		SafeContractAddress:     safeAddress,
+		RelayerAddress:          kleverRelayer,
+		Proxy:                   kcChainMock,
+		Log:                     logger.GetOrCreate("test"),
+	})
+	require.Nil(t, err)
+
+	components := &testComponents{
+		ethChainMock:   ethChainMock,
+		kcChainMock:    kcChainMock,
+		kleverRelayer:  kleverRelayer,
+		safeBridgeAddr: common.HexToAddress(testEthMultisigAddress),
+	}
+	components.args = ArgsDoctor{
+		Config:         cfg,
+		EthereumClient: ethChainMock,
+		SafeContract: &bridgeTests.SafeContractStub{
+			BridgeCalled: func(opts *bind.CallOpts) (common.Address, error) {
+				return components.safeBridgeAddr, nil
+			},
+		},
+		KleverDataGetter:       dataGetter,
+		KleverProxy:            kcChainMock,
+		EthereumRelayerAddress: testEthRelayerAddress,
+		KleverRelayerAddress:   kleverRelayer,
+	}
+
+	return components
+}
+
+func findCheck(report *Report, name string) CheckResult {
+	for _, result := range report.Checks() {
+		if result.Name == name {
+			return result
+		}
+	}
+
+	return CheckResult{}
+}
+
+func TestNewDoctor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil Ethereum client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestComponents(t).args
+		args.EthereumClient = nil
+
+		d, err := NewDoctor(args)
+		assert.True(t, check.IfNil(d))
+		assert.Equal(t, errNilEthereumClient, err)
+	})
+	t.Run("nil safe contract should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestComponents(t).args
+		args.SafeContract = nil
+
+		d, err := NewDoctor(args)
+		assert.True(t, check.IfNil(d))
+		assert.Equal(t, errNilSafeContract, err)
+	})
+	t.Run("nil Klever data getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestComponents(t).args
+		args.KleverDataGetter = nil
+
+		d, err := NewDoctor(args)
+		assert.True(t, check.IfNil(d))
+		assert.Equal(t, errNilKleverDataGetter, err)
+	})
+	t.Run("nil Klever proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestComponents(t).args
+		args.KleverProxy = nil
+
+		d, err := NewDoctor(args)
+		assert.True(t, check.IfNil(d))
+		assert.Equal(t, errNilKleverProxy, err)
+	})
+	t.Run("nil Klever relayer address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestComponents(t).args
+		args.KleverRelayerAddress = nil
+
+		d, err := NewDoctor(args)
+		assert.True(t, check.IfNil(d))
+		assert.True(t, errors.Is(err, errNilAddress))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		d, err := NewDoctor(createTestComponents(t).args)
+		assert.False(t, check.IfNil(d))
+		assert.Nil(t, err)
+	})
+}
+
+func TestDoctor_Run(t *testing.T) {
+	t.Parallel()
+
+	t.Run("healthy setup should pass all checks", func(t *testing.T) {
+		t.Parallel()
+
+		d, _ := NewDoctor(createTestComponents(t).args)
+		report := d.Run(context.Background())
+
+		assert.Equal(t, 0, report.NumFailed(), report.String())
+		assert.Equal(t, StatusPassed, findCheck(report, checkTokenMappings).Status)
+		assert.Equal(t, StatusPassed, findCheck(report, checkEthereumWiring).Status)
+		assert.Equal(t, StatusPassed, findCheck(report, checkKleverWiring).Status)
+	})
+	t.Run("relayers not whitelisted should fail", func(t *testing.T) {
+		t.Parallel()
+
+		components := createTestComponents(t)
+		components.args.EthereumRelayerAddress = common.HexToAddress("0x3333333333333333333333333333333333333333")
+		components.args.KleverRelayerAddress, _ = address.NewAddressFromBytes(bytes.Repeat([]byte{2}, 32))
+
+		d, _ := NewDoctor(components.args)
+		report := d.Run(context.Background())
+
+		assert.Equal(t, 2, report.NumFailed(), report.String())
+		assert.Equal(t, StatusFailed, findCheck(report, checkEthereumRelayer).Status)
+		assert.Equal(t, StatusFailed, findCheck(report, checkKleverRelayer).Status)
+	})
+	t.Run("safe wired to another bridge should fail", func(t *testing.T) {
+		t.Parallel()
+
+		components := createTestComponents(t)
+		components.safeBridgeAddr = common.HexToAddress("0x4444444444444444444444444444444444444444")
+
+		d, _ := NewDoctor(components.args)
+		report := d.Run(context.Background())
+
+		assert.Equal(t, 1, report.NumFailed(), report.String())
+		assert.Equal(t, StatusFailed, findCheck(report, checkEthereumWiring).Status)
+	})
+	t.Run("multisig wired to another safe should fail", func(t *testing.T) {
+		t.Parallel()
+
+		components := createTestComponents(t)
+		otherSafe, _ := address.NewAddressFromBytes(bytes.Repeat([]byte{3}, 32))
+		components.kcChainMock.SetKdaSafeAddress(otherSafe)
+
+		d, _ := NewDoctor(components.args)
+		report := d.Run(context.Background())
+
+		assert.Equal(t, 1, report.NumFailed(), report.String())
+		result := findCheck(report, checkKleverWiring)
+		assert.Equal(t, StatusFailed, result.Status)
+		assert.True(t, strings.Contains(result.Message, otherSafe.Bech32()))
+	})
+	t.Run("chain ID mismatch should fail and skip dependent checks", func(t *testing.T) {
+		t.Parallel()
+
+		components := createTestComponents(t)
+		components.args.Config.Eth.ChainID = 5
+		components.args.Config.Klever.ChainID = "100"
+
+		d, _ := NewDoctor(components.args)
+		report := d.Run(context.Background())
+
+		assert.Equal(t, 2, report.NumFailed(), report.String())
+		assert.Equal(t, StatusFailed, findCheck(report, checkEthereumConnectivity).Status)
+		assert.Equal(t, StatusFailed, findCheck(report, checkKleverConnectivity).Status)
+		assert.Empty(t, findCheck(report, checkTokenMappings).Status)
+	})
+	t.Run("token not whitelisted on Ethereum should fail", func(t *testing.T) {
+		t.Parallel()
+
+		components := createTestComponents(t)
+		components.ethChainMock.UpdateWhitelistedTokens(testErc20Address, false)
+
+		d, _ := NewDoctor(components.args)
+		report := d.Run(context.Background())
+
+		result := findCheck(report, checkTokenMappings)
+		assert.Equal(t, StatusFailed, result.Status)
+		assert.True(t, strings.Contains(result.Message, "not whitelisted"))
+	})
+	t.Run("quorum above relayers count should fail", func(t *testing.T) {
+		t.Parallel()
+
+		components := createTestComponents(t)
+		components.ethChainMock.SetQuorum(2)
+
+		d, _ := NewDoctor(components.args)
+		report := d.Run(context.Background())
+
+		assert.Equal(t, 1, report.NumFailed(), report.String())
+		assert.Equal(t, StatusFailed, findCheck(report, checkEthereumQuorum).Status)
+	})
+	t.Run("one way token mapping should fail", func(t *testing.T) {
+		t.Parallel()
+
+		components := createTestComponents(t)
+		components.args.KleverDataGetter = &kleverDataGetterWrapper{
+			KleverDataGetter: components.args.KleverDataGetter,
+			tokenIdForErc20Address: func(ctx context.Context, erc20Address []byte) ([][]byte, error) {
+				return [][]byte{[]byte("OTHER-0001")}, nil
+			},
+		}
+
+		d, _ := NewDoctor(components.args)
+		report := d.Run(context.Background())
+
+		result := findCheck(report, checkTokenMappings)
+		assert.Equal(t, StatusFailed, result.Status)
+		assert.True(t, strings.Contains(result.Message, "does not map back"))
+	})
+}
+
+type kleverDataGetterWrapper struct {
+	KleverDataGetter
+	tokenIdForErc20Address func(ctx context.Context, erc20Address []byte) ([][]byte, error)
+}
+
+func (wrapper *kleverDataGetterWrapper) GetTokenIdForErc20Address(ctx context.Context, erc20Address []byte) ([][]byte, error) {
+	return wrapper.tokenIdForErc20Address(ctx, erc20Address)
+}
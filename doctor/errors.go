@@ -0,0 +1,11 @@
+package doctor
+
+import "errors"
+
+var (
+	errNilEthereumClient   = errors.New("nil Ethereum client")
+	errNilSafeContract     = errors.New("nil safe contract")
+	errNilKleverDataGetter = errors.New("nil Klever Blockchain data getter")
+	errNilKleverProxy      = errors.New("nil Klever Blockchain proxy")
+	errNilAddress          = errors.New("nil address")
+)
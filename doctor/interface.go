@@ -0,0 +1,45 @@
+package doctor
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/proxy/models"
+)
+
+// EthereumChainClient defines the Ethereum chain operations required by the doctor
+type EthereumChainClient interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	BlockNumber(ctx context.Context) (uint64, error)
+	GetRelayers(ctx context.Context) ([]common.Address, error)
+	Quorum(ctx context.Context) (*big.Int, error)
+	IsPaused(ctx context.Context) (bool, error)
+	WhitelistedTokens(ctx context.Context, arg0 common.Address) (bool, error)
+	IsInterfaceNil() bool
+}
+
+// SafeContract defines the Ethereum safe contract operations required by the doctor
+type SafeContract interface {
+	Bridge(opts *bind.CallOpts) (common.Address, error)
+}
+
+// KleverDataGetter defines the Klever Blockchain contract queries required by the doctor
+type KleverDataGetter interface {
+	GetCurrentNonce(ctx context.Context) (uint64, error)
+	GetAllStakedRelayers(ctx context.Context) ([][]byte, error)
+	GetAllKnownTokens(ctx context.Context) ([][]byte, error)
+	GetTokenIdForErc20Address(ctx context.Context, erc20Address []byte) ([][]byte, error)
+	GetERC20AddressForTokenId(ctx context.Context, tokenId []byte) ([][]byte, error)
+	GetLastKCBatchID(ctx context.Context) (uint64, error)
+	GetKdaSafeAddress(ctx context.Context) ([][]byte, error)
+	IsPaused(ctx context.Context) (bool, error)
+	IsInterfaceNil() bool
+}
+
+// KleverProxy defines the Klever Blockchain proxy operations required by the doctor
+type KleverProxy interface {
+	GetNetworkConfig(ctx context.Context) (*models.NetworkConfig, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,105 @@
+package doctor
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// CheckStatus is the outcome of a single doctor check
+type CheckStatus string
+
+const (
+	// StatusPassed signals that the check was successful
+	StatusPassed CheckStatus = "PASS"
+	// StatusFailed signals that the check found a problem
+	StatusFailed CheckStatus = "FAIL"
+)
+
+// CheckResult holds the outcome of a single doctor check
+type CheckResult struct {
+	Name    string      `json:"name"`
+	Status  CheckStatus `json:"status"`
+	Message string      `json:"message"`
+}
+
+// Report holds all the check results produced during a doctor run
+type Report struct {
+	mut    sync.RWMutex
+	checks []CheckResult
+}
+
+// NewReport creates a new empty report
+func NewReport() *Report {
+	return &Report{
+		checks: make([]CheckResult, 0),
+	}
+}
+
+// AddPass records a successful check
+func (report *Report) AddPass(name string, format string, args ...interface{}) {
+	report.add(name, StatusPassed, fmt.Sprintf(format, args...))
+}
+
+// AddFail records a failed check
+func (report *Report) AddFail(name string, format string, args ...interface{}) {
+	report.add(name, StatusFailed, fmt.Sprintf(format, args...))
+}
+
+func (report *Report) add(name string, status CheckStatus, message string) {
+	report.mut.Lock()
+	report.checks = append(report.checks, CheckResult{
+		Name:    name,
+		Status:  status,
+		Message: message,
+	})
+	report.mut.Unlock()
+}
+
+// Checks returns a copy of all the recorded check results
+func (report *Report) Checks() []CheckResult {
+	report.mut.RLock()
+	defer report.mut.RUnlock()
+
+	checks := make([]CheckResult, len(report.checks))
+	copy(checks, report.checks)
+
+	return checks
+}
+
+// NumFailed returns the number of failed checks
+func (report *Report) NumFailed() int {
+	report.mut.RLock()
+	defer report.mut.RUnlock()
+
+	numFailed := 0
+	for _, check := range report.checks {
+		if check.Status == StatusFailed {
+			numFailed++
+		}
+	}
+
+	return numFailed
+}
+
+// String returns the human-readable form of the report
+func (report *Report) String() string {
+	checks := report.Checks()
+
+	maxNameLen := 0
+	for _, check := range checks {
+		if len(check.Name) > maxNameLen {
+			maxNameLen = len(check.Name)
+		}
+	}
+
+	builder := strings.Builder{}
+	for _, check := range checks {
+		_, _ = fmt.Fprintf(&builder, "[%s] %-*s %s\n", check.Status, maxNameLen, check.Name, check.Message)
+	}
+
+	numFailed := report.NumFailed()
+	_, _ = fmt.Fprintf(&builder, "%d checks, %d passed, %d failed\n", len(checks), len(checks)-numFailed, numFailed)
+
+	return builder.String()
+}
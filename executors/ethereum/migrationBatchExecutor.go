@@ -124,7 +124,7 @@ func (executor *migrationBatchExecutor) ExecuteTransfer(ctx context.Context) err
 	}
 
 	txHash := tx.Hash().String()
-	executor.logger.Info("Executed transfer transaction", "batchID", executor.batch.BatchID, "hash", txHash)
+	executor.logger.Info("Executed transfer transaction", "batchID", executor.batch.BatchID, "tx hash", txHash)
 
 	return nil
 }
@@ -0,0 +1,299 @@
+package attempts
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	attemptsStorageKey = "scCallsExecutionAttempts"
+	minMaxAttempts     = 1
+	minBackoffInSec    = 1
+)
+
+// ArgsAttemptsTracker is the DTO used in the NewAttemptsTracker constructor function. The optional name separates
+// the persisted data of the trackers sharing the same storer
+type ArgsAttemptsTracker struct {
+	Name        string
+	RetryPolicy config.ScCallsRetryPolicyConfig
+	Storer      core.Storer
+	Log         logger.Logger
+}
+
+// attemptsTracker keeps the persisted history of the failed executions. Each failed operation is retried with an
+// exponential backoff, and after the maximum number of attempts it is moved in the dead-letter list and skipped
+// until it is manually resolved
+type attemptsTracker struct {
+	storageKey     []byte
+	storer         core.Storer
+	log            logger.Logger
+	maxAttempts    uint32
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	getTimeFunc    func() time.Time
+
+	mut      sync.RWMutex
+	attempts map[uint64]*core.ScCallExecutionAttempts
+}
+
+// NewAttemptsTracker creates a new attempts tracker instance and loads the persisted history
+func NewAttemptsTracker(args ArgsAttemptsTracker) (*attemptsTracker, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	tracker := &attemptsTracker{
+		storageKey:     createStorageKey(args.Name),
+		storer:         args.Storer,
+		log:            args.Log,
+		maxAttempts:    args.RetryPolicy.MaxAttempts,
+		initialBackoff: time.Second * time.Duration(args.RetryPolicy.InitialBackoffInSeconds),
+		maxBackoff:     time.Second * time.Duration(args.RetryPolicy.MaxBackoffInSeconds),
+		getTimeFunc:    time.Now,
+		attempts:       make(map[uint64]*core.ScCallExecutionAttempts),
+	}
+	tracker.tryLoadPersistedData()
+
+	return tracker, nil
+}
+
+func createStorageKey(name string) []byte {
+	if len(name) == 0 {
+		return []byte(attemptsStorageKey)
+	}
+
+	return []byte(attemptsStorageKey + "_" + name)
+}
+
+func checkArgs(args ArgsAttemptsTracker) error {
+	if check.IfNil(args.Storer) {
+		return errNilStorer
+	}
+	if check.IfNil(args.Log) {
+		return errNilLogger
+	}
+	if args.RetryPolicy.MaxAttempts < minMaxAttempts {
+		return fmt.Errorf("%w for RetryPolicy.MaxAttempts, minimum: %d, got: %d",
+			errInvalidValue, minMaxAttempts, args.RetryPolicy.MaxAttempts)
+	}
+	if args.RetryPolicy.InitialBackoffInSeconds < minBackoffInSec {
+		return fmt.Errorf("%w for RetryPolicy.InitialBackoffInSeconds, minimum: %d, got: %d",
+			errInvalidValue, minBackoffInSec, args.RetryPolicy.InitialBackoffInSeconds)
+	}
+	if args.RetryPolicy.MaxBackoffInSeconds < args.RetryPolicy.InitialBackoffInSeconds {
+		return fmt.Errorf("%w for RetryPolicy.MaxBackoffInSeconds, minimum: %d, got: %d",
+			errInvalidValue, args.RetryPolicy.InitialBackoffInSeconds, args.RetryPolicy.MaxBackoffInSeconds)
+	}
+
+	return nil
+}
+
+func (tracker *attemptsTracker) tryLoadPersistedData() {
+	data, err := tracker.storer.Get(tracker.storageKey)
+	if err != nil {
+		tracker.log.Debug("attemptsTracker.tryLoadPersistedData reading from storer", "error", err)
+		return
+	}
+
+	persisted := make([]*core.ScCallExecutionAttempts, 0)
+	err = json.Unmarshal(data, &persisted)
+	if err != nil {
+		tracker.log.Warn("attemptsTracker.tryLoadPersistedData unmarshalling the history", "error", err)
+		return
+	}
+
+	for _, operationAttempts := range persisted {
+		tracker.attempts[operationAttempts.ID] = operationAttempts
+	}
+
+	tracker.log.Debug("attemptsTracker.tryLoadPersistedData loaded data", "num operations", len(tracker.attempts))
+}
+
+// persistChanges should be called under mutex protection
+func (tracker *attemptsTracker) persistChanges() {
+	buff, err := json.Marshal(tracker.sortedAttempts(false))
+	if err != nil {
+		tracker.log.Error("attemptsTracker.persistChanges marshalling the history", "error", err)
+		return
+	}
+
+	err = tracker.storer.Put(tracker.storageKey, buff)
+	if err != nil {
+		tracker.log.Error("attemptsTracker.persistChanges writing in storer", "error", err)
+	}
+}
+
+// sortedAttempts should be called under mutex protection
+func (tracker *attemptsTracker) sortedAttempts(onlyDeadLetters bool) []*core.ScCallExecutionAttempts {
+	result := make([]*core.ScCallExecutionAttempts, 0, len(tracker.attempts))
+	for _, operationAttempts := range tracker.attempts {
+		if onlyDeadLetters && !operationAttempts.IsDeadLetter {
+			continue
+		}
+
+		result = append(result, operationAttempts)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+// CanExecute returns false if the operation is a dead letter or its backoff period did not elapse yet
+func (tracker *attemptsTracker) CanExecute(id uint64) bool {
+	tracker.mut.RLock()
+	defer tracker.mut.RUnlock()
+
+	operationAttempts, found := tracker.attempts[id]
+	if !found {
+		return true
+	}
+	if operationAttempts.IsDeadLetter {
+		return false
+	}
+
+	return tracker.getTimeFunc().Unix() >= operationAttempts.NextAttemptTimestamp
+}
+
+// IsDeadLetter returns true if the operation is in the dead-letter list
+func (tracker *attemptsTracker) IsDeadLetter(id uint64) bool {
+	tracker.mut.RLock()
+	defer tracker.mut.RUnlock()
+
+	operationAttempts, found := tracker.attempts[id]
+
+	return found && operationAttempts.IsDeadLetter
+}
+
+// RecordSuccess removes the history of the provided operation
+func (tracker *attemptsTracker) RecordSuccess(id uint64) {
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	_, found := tracker.attempts[id]
+	if !found {
+		return
+	}
+
+	delete(tracker.attempts, id)
+	tracker.persistChanges()
+}
+
+// RecordFailure adds a failed attempt for the provided operation and computes its next attempt time
+func (tracker *attemptsTracker) RecordFailure(id uint64, err error) {
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	operationAttempts, found := tracker.attempts[id]
+	if !found {
+		operationAttempts = &core.ScCallExecutionAttempts{
+			ID: id,
+		}
+		tracker.attempts[id] = operationAttempts
+	}
+
+	now := tracker.getTimeFunc()
+	operationAttempts.NumAttempts++
+	operationAttempts.LastAttemptTimestamp = now.Unix()
+	operationAttempts.NextAttemptTimestamp = now.Add(tracker.computeBackoff(operationAttempts.NumAttempts)).Unix()
+	if err != nil {
+		operationAttempts.LastError = err.Error()
+	}
+
+	if operationAttempts.NumAttempts >= tracker.maxAttempts {
+		operationAttempts.IsDeadLetter = true
+		tracker.log.Error("SC call moved in the dead-letter list, it requires manual resolution",
+			"ID", id, "num attempts", operationAttempts.NumAttempts, "last error", operationAttempts.LastError)
+	} else {
+		tracker.log.Warn("SC call execution failed, will retry",
+			"ID", id, "num attempts", operationAttempts.NumAttempts,
+			"next attempt", time.Unix(operationAttempts.NextAttemptTimestamp, 0).String())
+	}
+
+	tracker.persistChanges()
+}
+
+func (tracker *attemptsTracker) computeBackoff(numAttempts uint32) time.Duration {
+	backoff := tracker.initialBackoff
+	for i := uint32(1); i < numAttempts; i++ {
+		backoff *= 2
+		if backoff >= tracker.maxBackoff {
+			return tracker.maxBackoff
+		}
+	}
+
+	return backoff
+}
+
+// Prune removes the history of the operations that are no longer pending
+func (tracker *attemptsTracker) Prune(pendingIDs []uint64) {
+	pending := make(map[uint64]struct{}, len(pendingIDs))
+	for _, id := range pendingIDs {
+		pending[id] = struct{}{}
+	}
+
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	numPruned := 0
+	for id := range tracker.attempts {
+		_, found := pending[id]
+		if !found {
+			delete(tracker.attempts, id)
+			numPruned++
+		}
+	}
+	if numPruned == 0 {
+		return
+	}
+
+	tracker.log.Debug("attemptsTracker.Prune removed the operations that are no longer pending", "num operations", numPruned)
+	tracker.persistChanges()
+}
+
+// DeadLetters returns the operations that permanently failed, in ascending ID order
+func (tracker *attemptsTracker) DeadLetters() []core.ScCallExecutionAttempts {
+	tracker.mut.RLock()
+	defer tracker.mut.RUnlock()
+
+	deadLetters := tracker.sortedAttempts(true)
+	result := make([]core.ScCallExecutionAttempts, 0, len(deadLetters))
+	for _, deadLetter := range deadLetters {
+		result = append(result, *deadLetter)
+	}
+
+	return result
+}
+
+// ResolveDeadLetter removes the operation from the dead-letter list so it will be executed again
+func (tracker *attemptsTracker) ResolveDeadLetter(id uint64) error {
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	operationAttempts, found := tracker.attempts[id]
+	if !found || !operationAttempts.IsDeadLetter {
+		return fmt.Errorf("%w, ID: %d", errNotADeadLetter, id)
+	}
+
+	delete(tracker.attempts, id)
+	tracker.persistChanges()
+	tracker.log.Info("SC call removed from the dead-letter list", "ID", id)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tracker *attemptsTracker) IsInterfaceNil() bool {
+	return tracker == nil
+}
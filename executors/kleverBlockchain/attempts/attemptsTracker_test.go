@@ -0,0 +1,236 @@
+package attempts
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errExecution = errors.New("execution error")
+
+func createMockArgsAttemptsTracker() ArgsAttemptsTracker {
+	return ArgsAttemptsTracker{
+		RetryPolicy: config.ScCallsRetryPolicyConfig{
+			MaxAttempts:             3,
+			InitialBackoffInSeconds: 10,
+			MaxBackoffInSeconds:     15,
+		},
+		Storer: testsCommon.NewStorerMock(),
+		Log:    &testsCommon.LoggerStub{},
+	}
+}
+
+func createTrackerWithTime(args ArgsAttemptsTracker, currentTime *time.Time) *attemptsTracker {
+	tracker, _ := NewAttemptsTracker(args)
+	tracker.getTimeFunc = func() time.Time {
+		return *currentTime
+	}
+
+	return tracker
+}
+
+func TestNewAttemptsTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAttemptsTracker()
+		args.Storer = nil
+
+		tracker, err := NewAttemptsTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.Equal(t, errNilStorer, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAttemptsTracker()
+		args.Log = nil
+
+		tracker, err := NewAttemptsTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.Equal(t, errNilLogger, err)
+	})
+	t.Run("invalid max attempts should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAttemptsTracker()
+		args.RetryPolicy.MaxAttempts = 0
+
+		tracker, err := NewAttemptsTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.ErrorIs(t, err, errInvalidValue)
+		assert.Contains(t, err.Error(), "RetryPolicy.MaxAttempts")
+	})
+	t.Run("invalid initial backoff should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAttemptsTracker()
+		args.RetryPolicy.InitialBackoffInSeconds = 0
+
+		tracker, err := NewAttemptsTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.ErrorIs(t, err, errInvalidValue)
+		assert.Contains(t, err.Error(), "RetryPolicy.InitialBackoffInSeconds")
+	})
+	t.Run("max backoff lower than the initial backoff should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAttemptsTracker()
+		args.RetryPolicy.MaxBackoffInSeconds = args.RetryPolicy.InitialBackoffInSeconds - 1
+
+		tracker, err := NewAttemptsTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.ErrorIs(t, err, errInvalidValue)
+		assert.Contains(t, err.Error(), "RetryPolicy.MaxBackoffInSeconds")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tracker, err := NewAttemptsTracker(createMockArgsAttemptsTracker())
+		assert.False(t, check.IfNil(tracker))
+		assert.Nil(t, err)
+	})
+}
+
+func TestAttemptsTracker_RetryWithBackoff(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	tracker := createTrackerWithTime(createMockArgsAttemptsTracker(), &currentTime)
+
+	assert.True(t, tracker.CanExecute(1))
+
+	tracker.RecordFailure(1, errExecution)
+	assert.False(t, tracker.CanExecute(1))
+	assert.True(t, tracker.CanExecute(2))
+
+	currentTime = currentTime.Add(time.Second * 10)
+	assert.True(t, tracker.CanExecute(1))
+
+	// the second backoff is capped by the maximum backoff
+	tracker.RecordFailure(1, errExecution)
+	currentTime = currentTime.Add(time.Second * 14)
+	assert.False(t, tracker.CanExecute(1))
+	currentTime = currentTime.Add(time.Second)
+	assert.True(t, tracker.CanExecute(1))
+
+	tracker.RecordSuccess(1)
+	assert.True(t, tracker.CanExecute(1))
+	assert.Empty(t, tracker.attempts)
+}
+
+func TestAttemptsTracker_DeadLetters(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	tracker := createTrackerWithTime(createMockArgsAttemptsTracker(), &currentTime)
+
+	for i := 0; i < 3; i++ {
+		tracker.RecordFailure(5, errExecution)
+	}
+	tracker.RecordFailure(4, errExecution)
+
+	currentTime = currentTime.Add(time.Hour)
+	assert.False(t, tracker.CanExecute(5))
+	assert.True(t, tracker.CanExecute(4))
+	assert.True(t, tracker.IsDeadLetter(5))
+	assert.False(t, tracker.IsDeadLetter(4))
+	assert.False(t, tracker.IsDeadLetter(6))
+
+	deadLetters := tracker.DeadLetters()
+	require.Equal(t, 1, len(deadLetters))
+	assert.Equal(t, uint64(5), deadLetters[0].ID)
+	assert.Equal(t, uint32(3), deadLetters[0].NumAttempts)
+	assert.Equal(t, errExecution.Error(), deadLetters[0].LastError)
+	assert.True(t, deadLetters[0].IsDeadLetter)
+
+	err := tracker.ResolveDeadLetter(4)
+	assert.ErrorIs(t, err, errNotADeadLetter)
+	err = tracker.ResolveDeadLetter(6)
+	assert.ErrorIs(t, err, errNotADeadLetter)
+
+	err = tracker.ResolveDeadLetter(5)
+	assert.Nil(t, err)
+	assert.True(t, tracker.CanExecute(5))
+	assert.False(t, tracker.IsDeadLetter(5))
+	assert.Empty(t, tracker.DeadLetters())
+}
+
+func TestAttemptsTracker_Prune(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	tracker := createTrackerWithTime(createMockArgsAttemptsTracker(), &currentTime)
+
+	tracker.RecordFailure(1, errExecution)
+	tracker.RecordFailure(2, errExecution)
+	tracker.RecordFailure(3, errExecution)
+
+	tracker.Prune([]uint64{2, 7})
+	assert.True(t, tracker.CanExecute(1))
+	assert.False(t, tracker.CanExecute(2))
+	assert.True(t, tracker.CanExecute(3))
+	assert.Equal(t, 1, len(tracker.attempts))
+}
+
+func TestAttemptsTracker_PersistsHistory(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAttemptsTracker()
+	args.RetryPolicy.MaxAttempts = 1
+	currentTime := time.Unix(1000, 0)
+	tracker := createTrackerWithTime(args, &currentTime)
+
+	tracker.RecordFailure(1, errExecution)
+	require.Equal(t, 1, len(tracker.DeadLetters()))
+
+	reloadedTracker, err := NewAttemptsTracker(args)
+	require.Nil(t, err)
+	assert.Equal(t, tracker.DeadLetters(), reloadedTracker.DeadLetters())
+	assert.False(t, reloadedTracker.CanExecute(1))
+}
+
+func TestAttemptsTracker_NamedTrackersShouldNotShareHistory(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAttemptsTracker()
+	args.RetryPolicy.MaxAttempts = 1
+	currentTime := time.Unix(1000, 0)
+	tracker := createTrackerWithTime(args, &currentTime)
+	tracker.RecordFailure(1, errExecution)
+
+	args.Name = "proxy"
+	namedTracker := createTrackerWithTime(args, &currentTime)
+	assert.Empty(t, namedTracker.DeadLetters())
+	namedTracker.RecordFailure(2, errExecution)
+
+	args.Name = ""
+	reloadedTracker, err := NewAttemptsTracker(args)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(reloadedTracker.DeadLetters()))
+	assert.Equal(t, uint64(1), reloadedTracker.DeadLetters()[0].ID)
+
+	args.Name = "proxy"
+	reloadedNamedTracker, err := NewAttemptsTracker(args)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(reloadedNamedTracker.DeadLetters()))
+	assert.Equal(t, uint64(2), reloadedNamedTracker.DeadLetters()[0].ID)
+}
+
+func TestAttemptsTracker_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *attemptsTracker
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &attemptsTracker{}
+	assert.False(t, instance.IsInterfaceNil())
+}
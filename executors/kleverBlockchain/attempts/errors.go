@@ -0,0 +1,10 @@
+package attempts
+
+import "errors"
+
+var (
+	errNilStorer      = errors.New("nil storer")
+	errNilLogger      = errors.New("nil logger")
+	errInvalidValue   = errors.New("invalid value")
+	errNotADeadLetter = errors.New("operation is not a dead letter")
+)
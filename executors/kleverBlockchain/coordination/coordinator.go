@@ -0,0 +1,298 @@
+package coordination
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing/sha256"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	uint64Size              = 8
+	minTakeoverTimeoutInSec = 1
+)
+
+var hasher = sha256.NewSha256()
+
+// ArgsCoordinator is the DTO used in the NewCoordinator constructor function
+type ArgsCoordinator struct {
+	Config           config.ScCallsCoordinationConfig
+	ExecutionTimeout time.Duration
+	OwnAddress       string
+	Proxy            AccountsProvider
+	Log              logger.Logger
+}
+
+type executorActivity struct {
+	nonce       uint64
+	lastChanged time.Time
+}
+
+// coordinator partitions the pending operations between several SC calls executor instances. Each executor computes
+// the same deterministic ranking of the executors for every pending operation ID, so the operation is executed by the
+// first ranked executor. The executor ranked N takes the operation over only if it is still pending after N takeover
+// timeouts and the account nonces of all the executors ranked before it did not change in the last takeover timeout,
+// meaning they stopped sending transactions. An executor busy with a backlog keeps its operations.
+// No communication between the executors is required and, while all executors are running, each operation is sent by
+// only one of them
+type coordinator struct {
+	log             logger.Logger
+	proxy           AccountsProvider
+	executors       []address.Address
+	ownIndex        int
+	takeoverTimeout time.Duration
+	getTimeFunc     func() time.Time
+
+	mut        sync.RWMutex
+	firstSeen  map[uint64]time.Time
+	activities map[int]*executorActivity
+}
+
+// NewCoordinator creates a new coordinator instance
+func NewCoordinator(args ArgsCoordinator) (*coordinator, error) {
+	if check.IfNil(args.Log) {
+		return nil, errNilLogger
+	}
+	if check.IfNil(args.Proxy) {
+		return nil, errNilProxy
+	}
+	if args.Config.TakeoverTimeoutInSeconds < minTakeoverTimeoutInSec {
+		return nil, fmt.Errorf("%w, minimum: %d, got: %d",
+			errInvalidTakeoverTimeout, minTakeoverTimeoutInSec, args.Config.TakeoverTimeoutInSeconds)
+	}
+	takeoverTimeout := time.Second * time.Duration(args.Config.TakeoverTimeoutInSeconds)
+	if takeoverTimeout <= args.ExecutionTimeout {
+		// an executor waiting for its transactions to complete would look inactive to the others
+		return nil, fmt.Errorf("%w, it should be greater than the execution timeout %v, got: %v",
+			errInvalidTakeoverTimeout, args.ExecutionTimeout, takeoverTimeout)
+	}
+
+	executors, err := parseExecutors(args.Config.Executors)
+	if err != nil {
+		return nil, err
+	}
+
+	ownAddress, err := address.NewAddress(args.OwnAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w for own address", err)
+	}
+
+	ownIndex := -1
+	for i, executor := range executors {
+		if bytes.Equal(executor.Bytes(), ownAddress.Bytes()) {
+			ownIndex = i
+			break
+		}
+	}
+	if ownIndex < 0 {
+		return nil, fmt.Errorf("%w: %s", errOwnAddressNotFound, args.OwnAddress)
+	}
+
+	args.Log.Info("SC calls coordination enabled", "executors", len(executors), "own address", args.OwnAddress,
+		"takeover timeout in seconds", args.Config.TakeoverTimeoutInSeconds)
+
+	return &coordinator{
+		log:             args.Log,
+		proxy:           args.Proxy,
+		executors:       executors,
+		ownIndex:        ownIndex,
+		takeoverTimeout: takeoverTimeout,
+		getTimeFunc:     time.Now,
+		firstSeen:       make(map[uint64]time.Time),
+		activities:      make(map[int]*executorActivity),
+	}, nil
+}
+
+func parseExecutors(bech32Addresses []string) ([]address.Address, error) {
+	if len(bech32Addresses) == 0 {
+		return nil, errNoExecutors
+	}
+
+	executors := make([]address.Address, 0, len(bech32Addresses))
+	uniqueExecutors := make(map[string]struct{})
+	for _, bech32Address := range bech32Addresses {
+		executorAddress, err := address.NewAddress(bech32Address)
+		if err != nil {
+			return nil, fmt.Errorf("%w for executor %s", err, bech32Address)
+		}
+
+		_, found := uniqueExecutors[string(executorAddress.Bytes())]
+		if found {
+			return nil, fmt.Errorf("%w: %s", errDuplicatedExecutor, bech32Address)
+		}
+
+		uniqueExecutors[string(executorAddress.Bytes())] = struct{}{}
+		executors = append(executors, executorAddress)
+	}
+
+	// the configured order should not matter, all executors should compute the same ranking
+	sort.Slice(executors, func(i, j int) bool {
+		return bytes.Compare(executors[i].Bytes(), executors[j].Bytes()) < 0
+	})
+
+	return executors, nil
+}
+
+// UpdatePendingOperations records the moment each pending operation was first seen, removes the operations that
+// are no longer pending and, if there are pending operations, refreshes the account nonces of the other executors
+func (c *coordinator) UpdatePendingOperations(ctx context.Context, pendingIDs []uint64) {
+	if len(pendingIDs) > 0 {
+		c.updateActivities(ctx)
+	}
+
+	now := c.getTimeFunc()
+	pending := make(map[uint64]struct{}, len(pendingIDs))
+
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	for _, id := range pendingIDs {
+		pending[id] = struct{}{}
+		_, found := c.firstSeen[id]
+		if !found {
+			c.firstSeen[id] = now
+		}
+	}
+
+	for id := range c.firstSeen {
+		_, found := pending[id]
+		if !found {
+			delete(c.firstSeen, id)
+		}
+	}
+}
+
+// updateActivities records the moment the account nonce of each of the other executors last changed. If the nonce
+// can not be fetched, the executor is considered active so it will not be taken over by mistake
+func (c *coordinator) updateActivities(ctx context.Context) {
+	for i, executor := range c.executors {
+		if i == c.ownIndex {
+			continue
+		}
+
+		account, err := c.proxy.GetAccount(ctx, executor)
+		now := c.getTimeFunc()
+
+		c.mut.Lock()
+		activity, found := c.activities[i]
+		switch {
+		case err != nil:
+			c.log.Debug("coordinator.updateActivities: can not fetch the executor account, considering it active",
+				"executor", executor.Bech32(), "error", err)
+			if found {
+				activity.lastChanged = now
+			}
+		case !found:
+			c.activities[i] = &executorActivity{
+				nonce:       account.Nonce,
+				lastChanged: now,
+			}
+		case activity.nonce != account.Nonce:
+			activity.nonce = account.Nonce
+			activity.lastChanged = now
+		}
+		c.mut.Unlock()
+	}
+}
+
+// ShouldExecute returns true if the operation is assigned to this executor or if the operation is pending for more
+// than the takeover timeout multiplied by this executor's rank and all the executors ranked before this one stopped
+// sending transactions
+func (c *coordinator) ShouldExecute(id uint64) bool {
+	ranking := c.computeRanking(id)
+	if ranking[0] == c.ownIndex {
+		return true
+	}
+
+	now := c.getTimeFunc()
+
+	c.mut.RLock()
+	defer c.mut.RUnlock()
+
+	firstSeen, found := c.firstSeen[id]
+	if !found {
+		return false
+	}
+
+	for rank, executorIndex := range ranking {
+		if executorIndex != c.ownIndex {
+			if !c.isInactive(executorIndex, now) {
+				return false
+			}
+			continue
+		}
+
+		waitTime := c.takeoverTimeout * time.Duration(rank)
+		if now.Sub(firstSeen) < waitTime {
+			return false
+		}
+
+		c.log.Debug("coordinator.ShouldExecute: taking over the operation", "ID", id, "rank", rank,
+			"pending since", firstSeen)
+
+		return true
+	}
+
+	return false
+}
+
+func (c *coordinator) isInactive(executorIndex int, now time.Time) bool {
+	activity, found := c.activities[executorIndex]
+	if !found {
+		return false
+	}
+
+	return now.Sub(activity.lastChanged) >= c.takeoverTimeout
+}
+
+// computeRank returns the position of this executor in the ranking of the provided operation ID
+func (c *coordinator) computeRank(id uint64) int {
+	for rank, executorIndex := range c.computeRanking(id) {
+		if executorIndex == c.ownIndex {
+			return rank
+		}
+	}
+
+	return len(c.executors)
+}
+
+// computeRanking returns the executors indexes ranked for the provided operation ID. The ranking uses the rendezvous
+// hashing so removing an executor from the list only reassigns its own operations
+func (c *coordinator) computeRanking(id uint64) []int {
+	scores := make([]uint64, len(c.executors))
+	ranking := make([]int, len(c.executors))
+	for i, executor := range c.executors {
+		scores[i] = computeScore(id, executor.Bytes())
+		ranking[i] = i
+	}
+
+	sort.SliceStable(ranking, func(i, j int) bool {
+		return scores[ranking[i]] > scores[ranking[j]]
+	})
+
+	return ranking
+}
+
+func computeScore(id uint64, executor []byte) uint64 {
+	buff := make([]byte, uint64Size, uint64Size+len(executor))
+	binary.BigEndian.PutUint64(buff, id)
+	buff = append(buff, executor...)
+
+	hash := hasher.Compute(string(buff))
+
+	return binary.BigEndian.Uint64(hash)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (c *coordinator) IsInterfaceNil() bool {
+	return c == nil
+}
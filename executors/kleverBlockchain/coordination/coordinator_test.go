@@ -0,0 +1,391 @@
+package coordination
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/proxy/models"
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon/interactors"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testExecutors = []string{
+	"klv1qqqqqqqqqqqqqpgqswlgqde4tfwp4ucwkh42m6d8a0d49w92sg8shyj6q3",
+	"klv1qqqqqqqqqqqqqpgqh46r9zh78lry2py8tq723fpjdr4pp0zgsg8syf6mq0",
+	"klv1qqqqqqqqqqqqqpgqxjgmvqe9kvvr4xvvxflue3a7cjjeyvx9sg8snh0ljc",
+}
+
+type accountsNonces struct {
+	mut    sync.RWMutex
+	nonces map[string]uint64
+	err    error
+}
+
+func (an *accountsNonces) setNonce(bech32Address string, nonce uint64) {
+	an.mut.Lock()
+	an.nonces[bech32Address] = nonce
+	an.mut.Unlock()
+}
+
+func (an *accountsNonces) setError(err error) {
+	an.mut.Lock()
+	an.err = err
+	an.mut.Unlock()
+}
+
+func (an *accountsNonces) createProxy() *interactors.ProxyStub {
+	return &interactors.ProxyStub{
+		GetAccountCalled: func(ctx context.Context, address address.Address) (*models.Account, error) {
+			an.mut.RLock()
+			defer an.mut.RUnlock()
+
+			if an.err != nil {
+				return nil, an.err
+			}
+
+			return &models.Account{
+				Address: address.Bech32(),
+				Nonce:   an.nonces[address.Bech32()],
+			}, nil
+		},
+	}
+}
+
+func createMockArgsCoordinator(ownAddress string) ArgsCoordinator {
+	return ArgsCoordinator{
+		Config: config.ScCallsCoordinationConfig{
+			Enabled:                  true,
+			Executors:                testExecutors,
+			TakeoverTimeoutInSeconds: 60,
+		},
+		ExecutionTimeout: time.Second * 30,
+		OwnAddress:       ownAddress,
+		Proxy:            &interactors.ProxyStub{},
+		Log:              &testsCommon.LoggerStub{},
+	}
+}
+
+func createCoordinatorWithTime(t *testing.T, args ArgsCoordinator, currentTime *time.Time) *coordinator {
+	instance, err := NewCoordinator(args)
+	require.Nil(t, err)
+	instance.getTimeFunc = func() time.Time {
+		return *currentTime
+	}
+
+	return instance
+}
+
+func TestNewCoordinator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCoordinator(testExecutors[0])
+		args.Log = nil
+
+		instance, err := NewCoordinator(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, errNilLogger, err)
+	})
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCoordinator(testExecutors[0])
+		args.Proxy = nil
+
+		instance, err := NewCoordinator(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, errNilProxy, err)
+	})
+	t.Run("takeover timeout not greater than the execution timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCoordinator(testExecutors[0])
+		args.ExecutionTimeout = time.Second * 60
+
+		instance, err := NewCoordinator(args)
+		assert.True(t, check.IfNil(instance))
+		assert.ErrorIs(t, err, errInvalidTakeoverTimeout)
+		assert.Contains(t, err.Error(), "greater than the execution timeout")
+	})
+	t.Run("invalid takeover timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCoordinator(testExecutors[0])
+		args.Config.TakeoverTimeoutInSeconds = 0
+
+		instance, err := NewCoordinator(args)
+		assert.True(t, check.IfNil(instance))
+		assert.ErrorIs(t, err, errInvalidTakeoverTimeout)
+	})
+	t.Run("no executors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCoordinator(testExecutors[0])
+		args.Config.Executors = nil
+
+		instance, err := NewCoordinator(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, errNoExecutors, err)
+	})
+	t.Run("invalid executor address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCoordinator(testExecutors[0])
+		args.Config.Executors = []string{testExecutors[0], "invalid"}
+
+		instance, err := NewCoordinator(args)
+		assert.True(t, check.IfNil(instance))
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for executor invalid")
+	})
+	t.Run("duplicated executor should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCoordinator(testExecutors[0])
+		args.Config.Executors = []string{testExecutors[0], testExecutors[1], testExecutors[0]}
+
+		instance, err := NewCoordinator(args)
+		assert.True(t, check.IfNil(instance))
+		assert.ErrorIs(t, err, errDuplicatedExecutor)
+	})
+	t.Run("invalid own address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCoordinator("invalid")
+
+		instance, err := NewCoordinator(args)
+		assert.True(t, check.IfNil(instance))
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for own address")
+	})
+	t.Run("own address not in the executors list should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCoordinator(testExecutors[0])
+		args.Config.Executors = testExecutors[1:]
+
+		instance, err := NewCoordinator(args)
+		assert.True(t, check.IfNil(instance))
+		assert.ErrorIs(t, err, errOwnAddressNotFound)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewCoordinator(createMockArgsCoordinator(testExecutors[0]))
+		assert.False(t, check.IfNil(instance))
+		assert.Nil(t, err)
+	})
+}
+
+func TestCoordinator_ShouldExecute(t *testing.T) {
+	t.Parallel()
+
+	createCoordinators := func(currentTime *time.Time, nonces *accountsNonces, executors []string) []*coordinator {
+		coordinators := make([]*coordinator, 0, len(executors))
+		for _, executor := range executors {
+			args := createMockArgsCoordinator(executor)
+			args.Config.Executors = executors
+			args.Proxy = nonces.createProxy()
+			coordinators = append(coordinators, createCoordinatorWithTime(t, args, currentTime))
+		}
+
+		return coordinators
+	}
+	createNonces := func() *accountsNonces {
+		return &accountsNonces{
+			nonces: make(map[string]uint64),
+		}
+	}
+	rankCoordinators := func(coordinators []*coordinator, id uint64) []*coordinator {
+		ranked := make([]*coordinator, len(coordinators))
+		for _, instance := range coordinators {
+			ranked[instance.computeRank(id)] = instance
+		}
+
+		return ranked
+	}
+	updateAll := func(coordinators []*coordinator, pendingIDs []uint64) {
+		for _, instance := range coordinators {
+			instance.UpdatePendingOperations(context.Background(), pendingIDs)
+		}
+	}
+	ownAddress := func(instance *coordinator) string {
+		return instance.executors[instance.ownIndex].Bech32()
+	}
+
+	t.Run("each operation should be assigned to exactly one executor", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Now()
+		coordinators := createCoordinators(&currentTime, createNonces(), testExecutors)
+
+		pendingIDs := make([]uint64, 0, 100)
+		for id := uint64(1); id <= 100; id++ {
+			pendingIDs = append(pendingIDs, id)
+		}
+
+		numAssigned := make([]int, len(coordinators))
+		updateAll(coordinators, pendingIDs)
+		for _, id := range pendingIDs {
+			numExecutors := 0
+			for i, instance := range coordinators {
+				if instance.ShouldExecute(id) {
+					numExecutors++
+					numAssigned[i]++
+				}
+			}
+			assert.Equal(t, 1, numExecutors, "operation %d", id)
+		}
+
+		// the operations should be spread between all executors
+		for _, numOperations := range numAssigned {
+			assert.Greater(t, numOperations, 10)
+		}
+	})
+	t.Run("the executors order in config should not matter", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Now()
+		coordinators := createCoordinators(&currentTime, createNonces(), testExecutors)
+		reversedExecutors := []string{testExecutors[2], testExecutors[1], testExecutors[0]}
+		reversedCoordinators := createCoordinators(&currentTime, createNonces(), reversedExecutors)
+
+		for id := uint64(1); id <= 100; id++ {
+			assert.Equal(t, coordinators[0].computeRank(id), reversedCoordinators[2].computeRank(id))
+			assert.Equal(t, coordinators[1].computeRank(id), reversedCoordinators[1].computeRank(id))
+			assert.Equal(t, coordinators[2].computeRank(id), reversedCoordinators[0].computeRank(id))
+		}
+	})
+	t.Run("the other executors should take over one after another when the ones before stop", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Now()
+		nonces := createNonces()
+		id := uint64(37)
+		ranked := rankCoordinators(createCoordinators(&currentTime, nonces, testExecutors), id)
+		updateAll(ranked, []uint64{id})
+
+		assert.True(t, ranked[0].ShouldExecute(id))
+		assert.False(t, ranked[1].ShouldExecute(id))
+		assert.False(t, ranked[2].ShouldExecute(id))
+
+		currentTime = currentTime.Add(time.Second * 59)
+		updateAll(ranked, []uint64{id})
+		assert.False(t, ranked[1].ShouldExecute(id))
+
+		// the first executor did not send any transaction in the last takeover timeout
+		currentTime = currentTime.Add(time.Second)
+		updateAll(ranked, []uint64{id})
+		assert.True(t, ranked[1].ShouldExecute(id))
+		assert.False(t, ranked[2].ShouldExecute(id))
+
+		// the second executor is sending transactions
+		currentTime = currentTime.Add(time.Second * 30)
+		nonces.setNonce(ownAddress(ranked[1]), 1)
+		updateAll(ranked, []uint64{id})
+		currentTime = currentTime.Add(time.Second * 30)
+		updateAll(ranked, []uint64{id})
+		assert.False(t, ranked[2].ShouldExecute(id))
+
+		// the second executor stopped too
+		currentTime = currentTime.Add(time.Second * 29)
+		updateAll(ranked, []uint64{id})
+		assert.False(t, ranked[2].ShouldExecute(id))
+
+		currentTime = currentTime.Add(time.Second)
+		updateAll(ranked, []uint64{id})
+		assert.True(t, ranked[2].ShouldExecute(id))
+	})
+	t.Run("an active executor with a backlog should not be taken over", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Now()
+		nonces := createNonces()
+		id := uint64(37)
+		ranked := rankCoordinators(createCoordinators(&currentTime, nonces, testExecutors), id)
+		updateAll(ranked, []uint64{id})
+
+		// the first executor keeps sending transactions for other operations
+		for i := uint64(1); i <= 10; i++ {
+			currentTime = currentTime.Add(time.Second * 50)
+			nonces.setNonce(ownAddress(ranked[0]), i)
+			updateAll(ranked, []uint64{id})
+
+			assert.False(t, ranked[1].ShouldExecute(id))
+			assert.False(t, ranked[2].ShouldExecute(id))
+		}
+	})
+	t.Run("an executor with an unknown activity should not be taken over", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Now()
+		nonces := createNonces()
+		nonces.setError(errors.New("expected error"))
+		id := uint64(37)
+		ranked := rankCoordinators(createCoordinators(&currentTime, nonces, testExecutors), id)
+		updateAll(ranked, []uint64{id})
+
+		// the activity was never fetched
+		currentTime = currentTime.Add(time.Hour)
+		updateAll(ranked, []uint64{id})
+		assert.False(t, ranked[1].ShouldExecute(id))
+
+		// the activity was fetched once, then the proxy failed
+		nonces.setError(nil)
+		updateAll(ranked, []uint64{id})
+		currentTime = currentTime.Add(time.Second * 50)
+		nonces.setError(errors.New("expected error"))
+		updateAll(ranked, []uint64{id})
+		currentTime = currentTime.Add(time.Second * 50)
+		assert.False(t, ranked[1].ShouldExecute(id))
+	})
+	t.Run("not recorded operation should not be taken over", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Now()
+		coordinators := createCoordinators(&currentTime, createNonces(), testExecutors)
+		id := uint64(37)
+		for _, instance := range coordinators {
+			if instance.computeRank(id) > 0 {
+				assert.False(t, instance.ShouldExecute(id))
+			}
+		}
+	})
+	t.Run("single executor should execute everything", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Now()
+		coordinators := createCoordinators(&currentTime, createNonces(), testExecutors[:1])
+		for id := uint64(1); id <= 10; id++ {
+			assert.True(t, coordinators[0].ShouldExecute(id))
+		}
+	})
+}
+
+func TestCoordinator_UpdatePendingOperations(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Now()
+	instance := createCoordinatorWithTime(t, createMockArgsCoordinator(testExecutors[0]), &currentTime)
+	firstTime := currentTime
+
+	instance.UpdatePendingOperations(context.Background(), []uint64{1, 2})
+	currentTime = currentTime.Add(time.Minute)
+	instance.UpdatePendingOperations(context.Background(), []uint64{2, 3})
+
+	// the first seen moment should not be overwritten and the executed operations should be removed
+	assert.Equal(t, map[uint64]time.Time{
+		2: firstTime,
+		3: currentTime,
+	}, instance.firstSeen)
+}
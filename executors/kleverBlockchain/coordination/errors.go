@@ -0,0 +1,12 @@
+package coordination
+
+import "errors"
+
+var (
+	errNilLogger              = errors.New("nil logger")
+	errNoExecutors            = errors.New("no executors defined")
+	errDuplicatedExecutor     = errors.New("duplicated executor")
+	errOwnAddressNotFound     = errors.New("own address not found in the executors list")
+	errInvalidTakeoverTimeout = errors.New("invalid takeover timeout")
+	errNilProxy               = errors.New("nil proxy")
+)
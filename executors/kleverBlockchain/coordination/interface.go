@@ -0,0 +1,14 @@
+package coordination
+
+import (
+	"context"
+
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/proxy/models"
+)
+
+// AccountsProvider defines the behavior of a component able to fetch the accounts of the executors
+type AccountsProvider interface {
+	GetAccount(ctx context.Context, address address.Address) (*models.Account, error)
+	IsInterfaceNil() bool
+}
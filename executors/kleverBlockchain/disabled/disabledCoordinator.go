@@ -0,0 +1,25 @@
+package disabled
+
+import "context"
+
+type disabledCoordinator struct {
+}
+
+// NewDisabledCoordinator will return a disabled coordinator instance
+func NewDisabledCoordinator() *disabledCoordinator {
+	return &disabledCoordinator{}
+}
+
+// UpdatePendingOperations does nothing
+func (disabled *disabledCoordinator) UpdatePendingOperations(_ context.Context, _ []uint64) {
+}
+
+// ShouldExecute returns true
+func (disabled *disabledCoordinator) ShouldExecute(_ uint64) bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledCoordinator) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,26 @@
+package disabled
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledCoordinator_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledCoordinator()
+	assert.False(t, check.IfNil(disabled))
+	disabled.UpdatePendingOperations(context.Background(), []uint64{1, 2})
+	assert.True(t, disabled.ShouldExecute(1))
+}
@@ -7,6 +7,11 @@ var (
 	errNilProxy                          = errors.New("nil proxy")
 	errNilCodec                          = errors.New("nil codec")
 	errNilFilter                         = errors.New("nil filter")
+	errNilPrioritizer                    = errors.New("nil prioritizer")
+	errNilCoordinator                    = errors.New("nil coordinator")
+	errNilAttemptsTracker                = errors.New("nil attempts tracker")
+	errNilFeeLedger                      = errors.New("nil fee ledger")
+	errNilStatusHandler                  = errors.New("nil status handler")
 	errNilLogger                         = errors.New("nil logger")
 	errNilNonceTxHandler                 = errors.New("nil nonce transaction handler")
 	errNilPrivateKey                     = errors.New("nil private key")
@@ -14,5 +19,6 @@ var (
 	errInvalidValue                      = errors.New("invalid value")
 	errNilCloseAppChannel                = errors.New("nil close application channel")
 	errTransactionFailed                 = errors.New("transaction failed")
+	errFeeBudgetExceeded                 = errors.New("the daily fee budget is exceeded")
 	errGasLimitIsLessThanAbsoluteMinimum = errors.New("provided gas limit is less than absolute minimum required")
 )
@@ -0,0 +1,8 @@
+package fees
+
+import "errors"
+
+var (
+	errNilStorer = errors.New("nil storer")
+	errNilLogger = errors.New("nil logger")
+)
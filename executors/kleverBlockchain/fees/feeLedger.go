@@ -0,0 +1,229 @@
+package fees
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	feesStorageKey = "scCallsFeesLedger"
+	dayLayout      = "2006-01-02"
+)
+
+// ArgsFeeLedger is the DTO used in the NewFeeLedger constructor function
+type ArgsFeeLedger struct {
+	FeeBudget config.ScCallsFeeBudgetConfig
+	Storer    core.Storer
+	Log       logger.Logger
+}
+
+type persistedLedger struct {
+	TotalFees          int64            `json:"totalFees"`
+	FeesPerToken       map[string]int64 `json:"feesPerToken"`
+	FeesPerDestination map[string]int64 `json:"feesPerDestination"`
+	CurrentDay         string           `json:"currentDay"`
+	CurrentDayFees     int64            `json:"currentDayFees"`
+}
+
+// feeLedger keeps the persisted cumulative fees spent on the SC calls executions, per token and per destination
+// contract. The executions are paused while the fees spent in the current UTC day exceed the daily budget. The
+// estimated fees of the transactions in progress are reserved so the concurrent executions can not overshoot the budget
+type feeLedger struct {
+	storer      core.Storer
+	log         logger.Logger
+	dailyBudget uint64
+	getTimeFunc func() time.Time
+
+	mut          sync.RWMutex
+	ledger       persistedLedger
+	reservedFees int64
+}
+
+// NewFeeLedger creates a new fee ledger instance and loads the persisted data
+func NewFeeLedger(args ArgsFeeLedger) (*feeLedger, error) {
+	if check.IfNil(args.Storer) {
+		return nil, errNilStorer
+	}
+	if check.IfNil(args.Log) {
+		return nil, errNilLogger
+	}
+
+	ledger := &feeLedger{
+		storer:      args.Storer,
+		log:         args.Log,
+		dailyBudget: args.FeeBudget.DailyBudget,
+		getTimeFunc: time.Now,
+		ledger: persistedLedger{
+			FeesPerToken:       make(map[string]int64),
+			FeesPerDestination: make(map[string]int64),
+		},
+	}
+	ledger.tryLoadPersistedData()
+
+	if ledger.dailyBudget == 0 {
+		ledger.log.Warn("no daily fee budget is set for the SC calls executions")
+	}
+
+	return ledger, nil
+}
+
+func (ledger *feeLedger) tryLoadPersistedData() {
+	data, err := ledger.storer.Get([]byte(feesStorageKey))
+	if err != nil {
+		ledger.log.Debug("feeLedger.tryLoadPersistedData reading from storer", "error", err)
+		return
+	}
+
+	persisted := persistedLedger{}
+	err = json.Unmarshal(data, &persisted)
+	if err != nil {
+		ledger.log.Warn("feeLedger.tryLoadPersistedData unmarshalling the ledger", "error", err)
+		return
+	}
+	if persisted.FeesPerToken == nil {
+		persisted.FeesPerToken = make(map[string]int64)
+	}
+	if persisted.FeesPerDestination == nil {
+		persisted.FeesPerDestination = make(map[string]int64)
+	}
+
+	ledger.ledger = persisted
+	ledger.log.Debug("feeLedger.tryLoadPersistedData loaded data", "total fees", persisted.TotalFees)
+}
+
+// persistChanges should be called under mutex protection
+func (ledger *feeLedger) persistChanges() {
+	buff, err := json.Marshal(&ledger.ledger)
+	if err != nil {
+		ledger.log.Error("feeLedger.persistChanges marshalling the ledger", "error", err)
+		return
+	}
+
+	err = ledger.storer.Put([]byte(feesStorageKey), buff)
+	if err != nil {
+		ledger.log.Error("feeLedger.persistChanges writing in storer", "error", err)
+	}
+}
+
+func (ledger *feeLedger) currentDay() string {
+	return ledger.getTimeFunc().UTC().Format(dayLayout)
+}
+
+// currentDayFees should be called under mutex protection
+func (ledger *feeLedger) currentDayFees() int64 {
+	if ledger.ledger.CurrentDay != ledger.currentDay() {
+		return 0
+	}
+
+	return ledger.ledger.CurrentDayFees
+}
+
+// isBudgetExceeded should be called under mutex protection
+func (ledger *feeLedger) isBudgetExceeded(extraFees int64) bool {
+	if ledger.dailyBudget == 0 {
+		return false
+	}
+
+	return uint64(ledger.currentDayFees()+extraFees) >= ledger.dailyBudget
+}
+
+// CanSpend returns false if the fees spent in the current day, together with the reserved fees, reached the daily budget
+func (ledger *feeLedger) CanSpend() bool {
+	ledger.mut.RLock()
+	defer ledger.mut.RUnlock()
+
+	return !ledger.isBudgetExceeded(ledger.reservedFees)
+}
+
+// TryReserve reserves the estimated fee of a transaction about to be sent. It returns false, without reserving
+// anything, if the fees spent in the current day, together with the reserved fees, reached the daily budget
+func (ledger *feeLedger) TryReserve(estimatedFee int64) bool {
+	ledger.mut.Lock()
+	defer ledger.mut.Unlock()
+
+	if ledger.isBudgetExceeded(ledger.reservedFees) {
+		return false
+	}
+	if estimatedFee > 0 {
+		ledger.reservedFees += estimatedFee
+	}
+
+	return true
+}
+
+// Release frees a fee previously reserved, after the actual fee was recorded
+func (ledger *feeLedger) Release(estimatedFee int64) {
+	if estimatedFee <= 0 {
+		return
+	}
+
+	ledger.mut.Lock()
+	defer ledger.mut.Unlock()
+
+	ledger.reservedFees -= estimatedFee
+	if ledger.reservedFees < 0 {
+		ledger.reservedFees = 0
+	}
+}
+
+// RecordFee adds the fee spent on an execution towards the provided destination contract for the provided token
+func (ledger *feeLedger) RecordFee(token string, destination string, fee int64) {
+	if fee <= 0 {
+		return
+	}
+
+	ledger.mut.Lock()
+	defer ledger.mut.Unlock()
+
+	day := ledger.currentDay()
+	if ledger.ledger.CurrentDay != day {
+		ledger.ledger.CurrentDay = day
+		ledger.ledger.CurrentDayFees = 0
+	}
+
+	ledger.ledger.TotalFees += fee
+	ledger.ledger.CurrentDayFees += fee
+	ledger.ledger.FeesPerToken[token] += fee
+	ledger.ledger.FeesPerDestination[destination] += fee
+	ledger.persistChanges()
+
+	if ledger.isBudgetExceeded(0) {
+		ledger.log.Warn("the daily fee budget for the SC calls executions was exceeded, the executions are paused until the next day",
+			"current day fees", ledger.ledger.CurrentDayFees, "daily budget", ledger.dailyBudget)
+	}
+}
+
+// GetFeesLedger returns a snapshot of the fees ledger
+func (ledger *feeLedger) GetFeesLedger() core.ScCallsFeesLedger {
+	ledger.mut.RLock()
+	defer ledger.mut.RUnlock()
+
+	result := core.ScCallsFeesLedger{
+		TotalFees:          ledger.ledger.TotalFees,
+		FeesPerToken:       make(map[string]int64, len(ledger.ledger.FeesPerToken)),
+		FeesPerDestination: make(map[string]int64, len(ledger.ledger.FeesPerDestination)),
+		CurrentDay:         ledger.currentDay(),
+		CurrentDayFees:     ledger.currentDayFees(),
+		DailyBudget:        ledger.dailyBudget,
+		IsBudgetExceeded:   ledger.isBudgetExceeded(0),
+	}
+	for token, fee := range ledger.ledger.FeesPerToken {
+		result.FeesPerToken[token] = fee
+	}
+	for destination, fee := range ledger.ledger.FeesPerDestination {
+		result.FeesPerDestination[destination] = fee
+	}
+
+	return result
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ledger *feeLedger) IsInterfaceNil() bool {
+	return ledger == nil
+}
@@ -0,0 +1,209 @@
+package fees
+
+import (
+	"testing"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testToken1       = "tkn1"
+	testToken2       = "tkn2"
+	testDestination1 = "klv1qqqqqqqqqqqqqpgqh46r9zh78lry2py8tq723fpjdr4pp0zgsg8syf6mq0"
+	testDestination2 = "klv1qqqqqqqqqqqqqpgqxjgmvqe9kvvr4xvvxflue3a7cjjeyvx9sg8snh0ljc"
+)
+
+func createMockArgsFeeLedger() ArgsFeeLedger {
+	return ArgsFeeLedger{
+		FeeBudget: config.ScCallsFeeBudgetConfig{
+			DailyBudget: 100,
+		},
+		Storer: testsCommon.NewStorerMock(),
+		Log:    &testsCommon.LoggerStub{},
+	}
+}
+
+func createLedgerWithTime(args ArgsFeeLedger, currentTime *time.Time) *feeLedger {
+	ledger, _ := NewFeeLedger(args)
+	ledger.getTimeFunc = func() time.Time {
+		return *currentTime
+	}
+
+	return ledger
+}
+
+func TestNewFeeLedger(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeLedger()
+		args.Storer = nil
+
+		ledger, err := NewFeeLedger(args)
+		assert.True(t, check.IfNil(ledger))
+		assert.Equal(t, errNilStorer, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeLedger()
+		args.Log = nil
+
+		ledger, err := NewFeeLedger(args)
+		assert.True(t, check.IfNil(ledger))
+		assert.Equal(t, errNilLogger, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ledger, err := NewFeeLedger(createMockArgsFeeLedger())
+		assert.False(t, check.IfNil(ledger))
+		assert.Nil(t, err)
+	})
+}
+
+func TestFeeLedger_RecordFee(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Date(2024, 5, 1, 23, 0, 0, 0, time.UTC)
+	ledger := createLedgerWithTime(createMockArgsFeeLedger(), &currentTime)
+
+	ledger.RecordFee(testToken1, testDestination1, 0)
+	ledger.RecordFee(testToken1, testDestination1, -1)
+	assert.Zero(t, ledger.GetFeesLedger().TotalFees)
+
+	ledger.RecordFee(testToken1, testDestination1, 30)
+	ledger.RecordFee(testToken2, testDestination1, 20)
+	ledger.RecordFee(testToken1, testDestination2, 10)
+
+	expectedLedger := core.ScCallsFeesLedger{
+		TotalFees: 60,
+		FeesPerToken: map[string]int64{
+			testToken1: 40,
+			testToken2: 20,
+		},
+		FeesPerDestination: map[string]int64{
+			testDestination1: 50,
+			testDestination2: 10,
+		},
+		CurrentDay:       "2024-05-01",
+		CurrentDayFees:   60,
+		DailyBudget:      100,
+		IsBudgetExceeded: false,
+	}
+	assert.Equal(t, expectedLedger, ledger.GetFeesLedger())
+}
+
+func TestFeeLedger_DailyBudget(t *testing.T) {
+	t.Parallel()
+
+	t.Run("budget exceeded should pause until the next day", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Date(2024, 5, 1, 23, 0, 0, 0, time.UTC)
+		ledger := createLedgerWithTime(createMockArgsFeeLedger(), &currentTime)
+
+		ledger.RecordFee(testToken1, testDestination1, 99)
+		assert.True(t, ledger.CanSpend())
+
+		ledger.RecordFee(testToken1, testDestination1, 1)
+		assert.False(t, ledger.CanSpend())
+		assert.True(t, ledger.GetFeesLedger().IsBudgetExceeded)
+
+		currentTime = currentTime.Add(time.Hour)
+		assert.True(t, ledger.CanSpend())
+		snapshot := ledger.GetFeesLedger()
+		assert.Equal(t, "2024-05-02", snapshot.CurrentDay)
+		assert.Zero(t, snapshot.CurrentDayFees)
+		assert.Equal(t, int64(100), snapshot.TotalFees)
+
+		ledger.RecordFee(testToken1, testDestination1, 5)
+		snapshot = ledger.GetFeesLedger()
+		assert.Equal(t, int64(5), snapshot.CurrentDayFees)
+		assert.Equal(t, int64(105), snapshot.TotalFees)
+	})
+	t.Run("no budget should not pause", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeLedger()
+		args.FeeBudget.DailyBudget = 0
+		ledger, _ := NewFeeLedger(args)
+
+		ledger.RecordFee(testToken1, testDestination1, 1000000)
+		assert.True(t, ledger.CanSpend())
+		assert.False(t, ledger.GetFeesLedger().IsBudgetExceeded)
+	})
+}
+
+func TestFeeLedger_TryReserve(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reserved fees should count towards the budget", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+		ledger := createLedgerWithTime(createMockArgsFeeLedger(), &currentTime)
+
+		ledger.RecordFee(testToken1, testDestination1, 40)
+		assert.True(t, ledger.TryReserve(30))
+		assert.True(t, ledger.TryReserve(30))
+		assert.False(t, ledger.CanSpend())
+		assert.False(t, ledger.TryReserve(1))
+		assert.False(t, ledger.GetFeesLedger().IsBudgetExceeded)
+
+		// the actual fee is lower than the estimated one
+		ledger.RecordFee(testToken1, testDestination1, 10)
+		ledger.Release(30)
+		assert.True(t, ledger.CanSpend())
+		assert.True(t, ledger.TryReserve(1))
+
+		ledger.Release(30)
+		ledger.Release(1)
+		ledger.Release(1)
+		assert.Zero(t, ledger.reservedFees)
+		assert.Equal(t, int64(50), ledger.GetFeesLedger().CurrentDayFees)
+	})
+	t.Run("no budget should always reserve", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeLedger()
+		args.FeeBudget.DailyBudget = 0
+		ledger, _ := NewFeeLedger(args)
+
+		assert.True(t, ledger.TryReserve(1000000))
+		assert.True(t, ledger.TryReserve(1000000))
+		assert.True(t, ledger.CanSpend())
+	})
+}
+
+func TestFeeLedger_PersistsLedger(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsFeeLedger()
+	ledger, _ := NewFeeLedger(args)
+	ledger.RecordFee(testToken1, testDestination1, 100)
+	require.False(t, ledger.CanSpend())
+
+	reloadedLedger, err := NewFeeLedger(args)
+	require.Nil(t, err)
+	assert.Equal(t, ledger.GetFeesLedger(), reloadedLedger.GetFeesLedger())
+	assert.False(t, reloadedLedger.CanSpend())
+}
+
+func TestFeeLedger_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *feeLedger
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &feeLedger{}
+	assert.False(t, instance.IsInterfaceNil())
+}
@@ -7,4 +7,7 @@ var (
 	errNoItemsAllowed    = errors.New("no items allowed")
 	errUnsupportedMarker = errors.New("unsupported marker")
 	errMissingEthPrefix  = errors.New("missing Ethereum address prefix")
+	errInvalidRuleAction = errors.New("invalid rule action")
+	errInvalidAmount     = errors.New("invalid amount")
+	errInvalidInterval   = errors.New("invalid interval")
 )
@@ -0,0 +1,214 @@
+package filters
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/parsers"
+)
+
+const (
+	allowAction = "allow"
+	denyAction  = "deny"
+)
+
+type filterRule struct {
+	name         string
+	reason       string
+	allow        bool
+	ethAddresses []string
+	klvAddresses []string
+	tokens       []string
+	functions    []string
+	minAmount    *big.Int
+	maxAmount    *big.Int
+	minGasLimit  uint64
+	maxGasLimit  uint64
+}
+
+func newFilterRule(cfg config.PendingOperationsFilterRuleConfig) (*filterRule, error) {
+	rule := &filterRule{
+		name:        cfg.Name,
+		minGasLimit: cfg.MinGasLimit,
+		maxGasLimit: cfg.MaxGasLimit,
+	}
+
+	switch strings.ToLower(strings.TrimSpace(cfg.Action)) {
+	case allowAction:
+		rule.allow = true
+	case denyAction:
+		rule.allow = false
+	default:
+		return nil, fmt.Errorf("%w %s", errInvalidRuleAction, cfg.Action)
+	}
+
+	var err error
+	rule.ethAddresses, err = parseList(cfg.EthAddresses, emptyString)
+	if err != nil {
+		return nil, fmt.Errorf("%w in EthAddresses", err)
+	}
+	err = checkList(rule.ethAddresses, checkEthItemValid)
+	if err != nil {
+		return nil, fmt.Errorf("%w in EthAddresses", err)
+	}
+
+	rule.klvAddresses, err = parseList(cfg.KlvAddresses, emptyString)
+	if err != nil {
+		return nil, fmt.Errorf("%w in KlvAddresses", err)
+	}
+	err = checkList(rule.klvAddresses, checkKlvItemValid)
+	if err != nil {
+		return nil, fmt.Errorf("%w in KlvAddresses", err)
+	}
+
+	rule.tokens, err = parseList(cfg.Tokens, emptyString)
+	if err != nil {
+		return nil, fmt.Errorf("%w in Tokens", err)
+	}
+
+	// function names are case-sensitive
+	rule.functions = make([]string, 0, len(cfg.Functions))
+	for index, function := range cfg.Functions {
+		function = strings.TrimSpace(function)
+		if function == emptyString {
+			return nil, fmt.Errorf("%w %s on item at index %d in Functions", errUnsupportedMarker, emptyString, index)
+		}
+		rule.functions = append(rule.functions, function)
+	}
+
+	rule.minAmount, err = parseAmount(cfg.MinAmount)
+	if err != nil {
+		return nil, fmt.Errorf("%w for MinAmount", err)
+	}
+	rule.maxAmount, err = parseAmount(cfg.MaxAmount)
+	if err != nil {
+		return nil, fmt.Errorf("%w for MaxAmount", err)
+	}
+	if rule.minAmount != nil && rule.maxAmount != nil && rule.minAmount.Cmp(rule.maxAmount) > 0 {
+		return nil, fmt.Errorf("%w: MinAmount %s is greater than MaxAmount %s", errInvalidInterval, rule.minAmount, rule.maxAmount)
+	}
+	if rule.maxGasLimit > 0 && rule.minGasLimit > rule.maxGasLimit {
+		return nil, fmt.Errorf("%w: MinGasLimit %d is greater than MaxGasLimit %d", errInvalidInterval, rule.minGasLimit, rule.maxGasLimit)
+	}
+
+	return rule, nil
+}
+
+func parseAmount(value string) (*big.Int, error) {
+	value = strings.TrimSpace(value)
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	amount, ok := big.NewInt(0).SetString(value, 10)
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("%w %s", errInvalidAmount, value)
+	}
+
+	return amount, nil
+}
+
+// requiresCallData returns true if the rule contains conditions on the function or the gas limit
+func (rule *filterRule) requiresCallData() bool {
+	return len(rule.functions) > 0 || rule.minGasLimit > 0 || rule.maxGasLimit > 0
+}
+
+// matches returns true if all the rule conditions are met. The decoded call data is nil if it could not be decoded
+func (rule *filterRule) matches(callData parsers.ProxySCCompleteCallData, decodedCallData *parsers.CallData) bool {
+	if !listMatches(callData.From.String(), rule.ethAddresses) {
+		return false
+	}
+	if !listMatches(callData.To.Bech32(), rule.klvAddresses) {
+		return false
+	}
+	if !listMatches(callData.Token, rule.tokens) {
+		return false
+	}
+	if !rule.amountMatches(callData.Amount) {
+		return false
+	}
+	if !rule.requiresCallData() {
+		return true
+	}
+	if decodedCallData == nil {
+		return false
+	}
+
+	return rule.functionMatches(decodedCallData.Function) && rule.gasLimitMatches(decodedCallData.GasLimit)
+}
+
+// listMatches returns true if the list is empty (no condition) or it contains the item
+func listMatches(item string, list []string) bool {
+	if len(list) == 0 {
+		return true
+	}
+
+	item = strings.ToLower(item)
+	for _, listItem := range list {
+		if listItem == wildcardString || listItem == item {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (rule *filterRule) amountMatches(amount *big.Int) bool {
+	if rule.minAmount == nil && rule.maxAmount == nil {
+		return true
+	}
+	if amount == nil {
+		return false
+	}
+	if rule.minAmount != nil && amount.Cmp(rule.minAmount) < 0 {
+		return false
+	}
+
+	return rule.maxAmount == nil || amount.Cmp(rule.maxAmount) <= 0
+}
+
+func (rule *filterRule) functionMatches(function string) bool {
+	if len(rule.functions) == 0 {
+		return true
+	}
+
+	for _, ruleFunction := range rule.functions {
+		if ruleFunction == function {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (rule *filterRule) gasLimitMatches(gasLimit uint64) bool {
+	if gasLimit < rule.minGasLimit {
+		return false
+	}
+
+	return rule.maxGasLimit == 0 || gasLimit <= rule.maxGasLimit
+}
+
+// String returns the human-readable version of the rule
+func (rule *filterRule) String() string {
+	action := denyAction
+	if rule.allow {
+		action = allowAction
+	}
+
+	return fmt.Sprintf("name: %s, action: %s, eth addresses: [%s], klv addresses: [%s], tokens: [%s], functions: [%s], "+
+		"amount: [%s, %s], gas limit: [%d, %d]",
+		rule.name,
+		action,
+		strings.Join(rule.ethAddresses, ", "),
+		strings.Join(rule.klvAddresses, ", "),
+		strings.Join(rule.tokens, ", "),
+		strings.Join(rule.functions, ", "),
+		rule.minAmount,
+		rule.maxAmount,
+		rule.minGasLimit,
+		rule.maxGasLimit,
+	)
+}
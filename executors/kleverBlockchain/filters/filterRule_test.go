@@ -0,0 +1,240 @@
+package filters
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/parsers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestCallData() parsers.ProxySCCompleteCallData {
+	callData := parsers.ProxySCCompleteCallData{
+		From:   common.BytesToAddress(ethTestAddress1Bytes),
+		Token:  "tkn1",
+		Amount: big.NewInt(1000),
+	}
+	callData.To, _ = address.NewAddress(klvTestAddress1)
+
+	return callData
+}
+
+func TestNewFilterRule(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid action should error", func(t *testing.T) {
+		t.Parallel()
+
+		rule, err := newFilterRule(config.PendingOperationsFilterRuleConfig{Action: "execute"})
+		assert.Nil(t, rule)
+		assert.ErrorIs(t, err, errInvalidRuleAction)
+		assert.Contains(t, err.Error(), "execute")
+	})
+	t.Run("invalid eth address should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.PendingOperationsFilterRuleConfig{
+			Action:       allowAction,
+			EthAddresses: []string{"invalid address"},
+		}
+		rule, err := newFilterRule(cfg)
+		assert.Nil(t, rule)
+		assert.ErrorIs(t, err, errMissingEthPrefix)
+		assert.Contains(t, err.Error(), "on item at index 0 in EthAddresses")
+	})
+	t.Run("invalid klv address should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.PendingOperationsFilterRuleConfig{
+			Action:       allowAction,
+			KlvAddresses: []string{klvTestAddress1, "invalid address"},
+		}
+		rule, err := newFilterRule(cfg)
+		assert.Nil(t, rule)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "on item at index 1 in KlvAddresses")
+	})
+	t.Run("empty token should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.PendingOperationsFilterRuleConfig{
+			Action: allowAction,
+			Tokens: []string{" "},
+		}
+		rule, err := newFilterRule(cfg)
+		assert.Nil(t, rule)
+		assert.ErrorIs(t, err, errUnsupportedMarker)
+		assert.Contains(t, err.Error(), "in Tokens")
+	})
+	t.Run("empty function should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.PendingOperationsFilterRuleConfig{
+			Action:    allowAction,
+			Functions: []string{"stake", ""},
+		}
+		rule, err := newFilterRule(cfg)
+		assert.Nil(t, rule)
+		assert.ErrorIs(t, err, errUnsupportedMarker)
+		assert.Contains(t, err.Error(), "on item at index 1 in Functions")
+	})
+	t.Run("invalid amounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.PendingOperationsFilterRuleConfig{
+			Action:    allowAction,
+			MinAmount: "1.5",
+		}
+		rule, err := newFilterRule(cfg)
+		assert.Nil(t, rule)
+		assert.ErrorIs(t, err, errInvalidAmount)
+		assert.Contains(t, err.Error(), "for MinAmount")
+
+		cfg.MinAmount = ""
+		cfg.MaxAmount = "-1"
+		rule, err = newFilterRule(cfg)
+		assert.Nil(t, rule)
+		assert.ErrorIs(t, err, errInvalidAmount)
+		assert.Contains(t, err.Error(), "for MaxAmount")
+
+		cfg.MinAmount = "11"
+		cfg.MaxAmount = "10"
+		rule, err = newFilterRule(cfg)
+		assert.Nil(t, rule)
+		assert.ErrorIs(t, err, errInvalidInterval)
+	})
+	t.Run("invalid gas limit interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.PendingOperationsFilterRuleConfig{
+			Action:      allowAction,
+			MinGasLimit: 11,
+			MaxGasLimit: 10,
+		}
+		rule, err := newFilterRule(cfg)
+		assert.Nil(t, rule)
+		assert.ErrorIs(t, err, errInvalidInterval)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.PendingOperationsFilterRuleConfig{
+			Name:         "stake",
+			Action:       " Deny ",
+			EthAddresses: []string{ethTestAddress1},
+			KlvAddresses: []string{klvTestAddress1},
+			Tokens:       []string{"TKN1"},
+			Functions:    []string{"stake"},
+			MinAmount:    "10",
+			MaxAmount:    "100",
+			MinGasLimit:  10,
+		}
+		rule, err := newFilterRule(cfg)
+		require.Nil(t, err)
+		assert.False(t, rule.allow)
+		assert.Equal(t, []string{"tkn1"}, rule.tokens)
+		assert.Equal(t, big.NewInt(10), rule.minAmount)
+		assert.Equal(t, big.NewInt(100), rule.maxAmount)
+		assert.True(t, rule.requiresCallData())
+		assert.Contains(t, rule.String(), "action: deny")
+	})
+}
+
+func TestFilterRule_Matches(t *testing.T) {
+	t.Parallel()
+
+	decodedCallData := &parsers.CallData{
+		Function: "stake",
+		GasLimit: 5000000,
+	}
+
+	t.Run("empty rule should match everything", func(t *testing.T) {
+		t.Parallel()
+
+		rule, _ := newFilterRule(config.PendingOperationsFilterRuleConfig{Action: allowAction})
+		assert.True(t, rule.matches(createTestCallData(), nil))
+		assert.False(t, rule.requiresCallData())
+	})
+	t.Run("address and token conditions", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.PendingOperationsFilterRuleConfig{
+			Action:       allowAction,
+			EthAddresses: []string{ethTestAddress2, ethTestAddress1},
+			KlvAddresses: []string{klvTestAddress1},
+			Tokens:       []string{"*"},
+		}
+		rule, _ := newFilterRule(cfg)
+		assert.True(t, rule.matches(createTestCallData(), nil))
+
+		cfg.KlvAddresses = []string{klvTestAddress2}
+		rule, _ = newFilterRule(cfg)
+		assert.False(t, rule.matches(createTestCallData(), nil))
+
+		cfg.KlvAddresses = nil
+		cfg.Tokens = []string{"tkn2"}
+		rule, _ = newFilterRule(cfg)
+		assert.False(t, rule.matches(createTestCallData(), nil))
+
+		cfg.Tokens = nil
+		cfg.EthAddresses = []string{ethTestAddress2}
+		rule, _ = newFilterRule(cfg)
+		assert.False(t, rule.matches(createTestCallData(), nil))
+	})
+	t.Run("amount conditions", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.PendingOperationsFilterRuleConfig{
+			Action:    allowAction,
+			MinAmount: "1000",
+		}
+		rule, _ := newFilterRule(cfg)
+		assert.True(t, rule.matches(createTestCallData(), nil))
+
+		callData := createTestCallData()
+		callData.Amount = big.NewInt(999)
+		assert.False(t, rule.matches(callData, nil))
+		callData.Amount = nil
+		assert.False(t, rule.matches(callData, nil))
+
+		cfg.MinAmount = ""
+		cfg.MaxAmount = "999"
+		rule, _ = newFilterRule(cfg)
+		assert.False(t, rule.matches(createTestCallData(), nil))
+	})
+	t.Run("call data conditions", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.PendingOperationsFilterRuleConfig{
+			Action:    allowAction,
+			Functions: []string{"unstake", "stake"},
+		}
+		rule, _ := newFilterRule(cfg)
+		assert.True(t, rule.matches(createTestCallData(), decodedCallData))
+		assert.False(t, rule.matches(createTestCallData(), nil))
+
+		cfg.Functions = []string{"Stake"}
+		rule, _ = newFilterRule(cfg)
+		assert.False(t, rule.matches(createTestCallData(), decodedCallData))
+
+		cfg.Functions = nil
+		cfg.MinGasLimit = 5000000
+		cfg.MaxGasLimit = 5000000
+		rule, _ = newFilterRule(cfg)
+		assert.True(t, rule.matches(createTestCallData(), decodedCallData))
+
+		cfg.MinGasLimit = 5000001
+		cfg.MaxGasLimit = 0
+		rule, _ = newFilterRule(cfg)
+		assert.False(t, rule.matches(createTestCallData(), decodedCallData))
+
+		cfg.MinGasLimit = 0
+		cfg.MaxGasLimit = 4999999
+		rule, _ = newFilterRule(cfg)
+		assert.False(t, rule.matches(createTestCallData(), decodedCallData))
+	})
+}
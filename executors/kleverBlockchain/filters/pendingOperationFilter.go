@@ -18,6 +18,15 @@ const (
 	ethAddressPrefix = "0x"
 )
 
+const (
+	reasonMissingDestination = "missing destination address"
+	reasonDeniedEthAddress   = "denied eth address"
+	reasonDeniedKlvAddress   = "denied klv address"
+	reasonDeniedToken        = "denied token"
+	reasonNotAllowed         = "not allowed"
+	reasonAllowed            = "allowed"
+)
+
 var ethWildcardString = ""
 
 func init() {
@@ -26,13 +35,20 @@ func init() {
 	ethWildcardString = ethAddressWildcard.String()
 }
 
+type callDataDecoder interface {
+	PartiallyDecodeRawCallData(buff []byte) (parsers.CallData, error)
+}
+
 type pendingOperationFilter struct {
-	allowedEthAddresses []string
-	deniedEthAddresses  []string
-	allowedKlvAddresses []string
-	deniedKlvAddresses  []string
-	allowedTokens       []string
-	deniedTokens        []string
+	rules                []*filterRule
+	rulesRequireCallData bool
+	codec                callDataDecoder
+	allowedEthAddresses  []string
+	deniedEthAddresses   []string
+	allowedKlvAddresses  []string
+	deniedKlvAddresses   []string
+	allowedTokens        []string
+	deniedTokens         []string
 }
 
 // NewPendingOperationFilter creates a new instance of type pendingOperationFilter
@@ -40,11 +56,13 @@ func NewPendingOperationFilter(cfg config.PendingOperationsFilterConfig, log log
 	if check.IfNil(log) {
 		return nil, errNilLogger
 	}
-	if len(cfg.AllowedKlvAddresses)+len(cfg.AllowedEthAddresses)+len(cfg.AllowedTokens) == 0 {
+	if len(cfg.AllowedKlvAddresses)+len(cfg.AllowedEthAddresses)+len(cfg.AllowedTokens)+len(cfg.Rules) == 0 {
 		return nil, errNoItemsAllowed
 	}
 
-	filter := &pendingOperationFilter{}
+	filter := &pendingOperationFilter{
+		codec: &parsers.KCCodec{},
+	}
 	err := filter.parseConfigs(cfg)
 	if err != nil {
 		return nil, err
@@ -62,7 +80,11 @@ func NewPendingOperationFilter(cfg config.PendingOperationsFilterConfig, log log
 		"AllowedEthAddresses", strings.Join(filter.allowedEthAddresses, ", "),
 		"AllowedKlvAddresses", strings.Join(filter.allowedKlvAddresses, ", "),
 		"AllowedTokens", strings.Join(filter.allowedTokens, ", "),
+		"num rules", len(filter.rules),
 	)
+	for index, rule := range filter.rules {
+		log.Info("NewPendingOperationFilter rule", "index", index, "rule", rule.String())
+	}
 
 	return filter, nil
 }
@@ -102,6 +124,22 @@ func (filter *pendingOperationFilter) parseConfigs(cfg config.PendingOperationsF
 		return fmt.Errorf("%w in list AllowedTokens", err)
 	}
 
+	filter.rules = make([]*filterRule, 0, len(cfg.Rules))
+	for index, ruleConfig := range cfg.Rules {
+		rule, errRule := newFilterRule(ruleConfig)
+		if errRule != nil {
+			return fmt.Errorf("%w in rule at index %d", errRule, index)
+		}
+
+		rule.reason = fmt.Sprintf("rule at index %d", index)
+		if len(rule.name) > 0 {
+			rule.reason = fmt.Sprintf("rule %s", rule.name)
+		}
+
+		filter.rules = append(filter.rules, rule)
+		filter.rulesRequireCallData = filter.rulesRequireCallData || rule.requiresCallData()
+	}
+
 	return nil
 }
 
@@ -121,22 +159,22 @@ func parseList(list []string, unsupportedMarker string) ([]string, error) {
 }
 
 func (filter *pendingOperationFilter) checkLists() error {
-	err := filter.checkList(filter.allowedEthAddresses, checkEthItemValid)
+	err := checkList(filter.allowedEthAddresses, checkEthItemValid)
 	if err != nil {
 		return fmt.Errorf("%w in list AllowedEthAddresses", err)
 	}
 
-	err = filter.checkList(filter.deniedEthAddresses, checkEthItemValid)
+	err = checkList(filter.deniedEthAddresses, checkEthItemValid)
 	if err != nil {
 		return fmt.Errorf("%w in list DeniedEthAddresses", err)
 	}
 
-	err = filter.checkList(filter.allowedKlvAddresses, checkKlvItemValid)
+	err = checkList(filter.allowedKlvAddresses, checkKlvItemValid)
 	if err != nil {
 		return fmt.Errorf("%w in list AllowedKlvAddresses", err)
 	}
 
-	err = filter.checkList(filter.deniedKlvAddresses, checkKlvItemValid)
+	err = checkList(filter.deniedKlvAddresses, checkKlvItemValid)
 	if err != nil {
 		return fmt.Errorf("%w in list DeniedKlvAddresses", err)
 	}
@@ -144,7 +182,7 @@ func (filter *pendingOperationFilter) checkLists() error {
 	return nil
 }
 
-func (filter *pendingOperationFilter) checkList(list []string, checkItem func(item string) error) error {
+func checkList(list []string, checkItem func(item string) error) error {
 	for index, item := range list {
 		if item == wildcardString {
 			continue
@@ -172,25 +210,60 @@ func checkEthItemValid(item string) error {
 	return nil
 }
 
-// ShouldExecute returns true if the To, From or token are not denied and allowed
-func (filter *pendingOperationFilter) ShouldExecute(callData parsers.ProxySCCompleteCallData) bool {
+// ShouldExecute returns the action of the first matching rule. If no rule matches, it returns true if the To, From
+// or token are not denied and allowed. The second returned value is the reason of the decision: the matching rule or
+// the list that decided it
+func (filter *pendingOperationFilter) ShouldExecute(callData parsers.ProxySCCompleteCallData) (bool, string) {
 	if check.IfNil(callData.To) {
-		return false
+		return false, reasonMissingDestination
+	}
+
+	rule := filter.firstMatchingRule(callData)
+	if rule != nil {
+		return rule.allow, rule.reason
 	}
 
 	toAddress := callData.To.Bech32()
-	isSpecificallyDenied := filter.stringExistsInList(callData.From.String(), filter.deniedEthAddresses, ethWildcardString) ||
-		filter.stringExistsInList(toAddress, filter.deniedKlvAddresses, wildcardString) ||
-		filter.stringExistsInList(callData.Token, filter.deniedTokens, wildcardString)
-	if isSpecificallyDenied {
-		return false
+	if filter.stringExistsInList(callData.From.String(), filter.deniedEthAddresses, ethWildcardString) {
+		return false, reasonDeniedEthAddress
+	}
+	if filter.stringExistsInList(toAddress, filter.deniedKlvAddresses, wildcardString) {
+		return false, reasonDeniedKlvAddress
+	}
+	if filter.stringExistsInList(callData.Token, filter.deniedTokens, wildcardString) {
+		return false, reasonDeniedToken
 	}
 
 	isAllowed := filter.stringExistsInList(callData.From.String(), filter.allowedEthAddresses, ethWildcardString) ||
 		filter.stringExistsInList(toAddress, filter.allowedKlvAddresses, wildcardString) ||
 		filter.stringExistsInList(callData.Token, filter.allowedTokens, wildcardString)
+	if !isAllowed {
+		return false, reasonNotAllowed
+	}
+
+	return true, reasonAllowed
+}
+
+func (filter *pendingOperationFilter) firstMatchingRule(callData parsers.ProxySCCompleteCallData) *filterRule {
+	if len(filter.rules) == 0 {
+		return nil
+	}
 
-	return isAllowed
+	var decodedCallData *parsers.CallData
+	if filter.rulesRequireCallData {
+		decoded, err := filter.codec.PartiallyDecodeRawCallData(callData.RawCallData)
+		if err == nil {
+			decodedCallData = &decoded
+		}
+	}
+
+	for _, rule := range filter.rules {
+		if rule.matches(callData, decodedCallData) {
+			return rule
+		}
+	}
+
+	return nil
 }
 
 func (filter *pendingOperationFilter) stringExistsInList(needle string, haystack []string, wildcardMarker string) bool {
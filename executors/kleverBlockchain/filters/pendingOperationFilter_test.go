@@ -2,6 +2,7 @@ package filters
 
 import (
 	"encoding/hex"
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -10,6 +11,7 @@ import (
 	"github.com/klever-io/klv-bridge-eth-go/parsers"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const ethTestAddress1 = "0x880ec53af800b5cd051531672ef4fc4de233bd5d"
@@ -160,6 +162,33 @@ func TestNewPendingOperationFilter(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "on item at index 0 in list DeniedKlvAddresses")
 	})
+	t.Run("invalid rule should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.Rules = []config.PendingOperationsFilterRuleConfig{
+			{Action: allowAction},
+			{Action: "invalid"},
+		}
+
+		filter, err := NewPendingOperationFilter(cfg, testLog)
+		assert.Nil(t, filter)
+		assert.ErrorIs(t, err, errInvalidRuleAction)
+		assert.Contains(t, err.Error(), "in rule at index 1")
+	})
+	t.Run("only rules should work", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.PendingOperationsFilterConfig{
+			Rules: []config.PendingOperationsFilterRuleConfig{
+				{Action: allowAction},
+			},
+		}
+
+		filter, err := NewPendingOperationFilter(cfg, testLog)
+		assert.NotNil(t, filter)
+		assert.Nil(t, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -184,6 +213,18 @@ func TestPendingOperationFilter_IsInterfaceNil(t *testing.T) {
 	assert.False(t, instance.IsInterfaceNil())
 }
 
+func checkShouldExecute(
+	tb testing.TB,
+	filter *pendingOperationFilter,
+	callData parsers.ProxySCCompleteCallData,
+	expectedShouldExecute bool,
+	expectedReason string,
+) {
+	shouldExecute, reason := filter.ShouldExecute(callData)
+	assert.Equal(tb, expectedShouldExecute, shouldExecute)
+	assert.Equal(tb, expectedReason, reason)
+}
+
 func TestPendingOperationFilter_ShouldExecute(t *testing.T) {
 	t.Parallel()
 
@@ -197,7 +238,7 @@ func TestPendingOperationFilter_ShouldExecute(t *testing.T) {
 		cfg := createTestConfig()
 		filter, _ := NewPendingOperationFilter(cfg, testLog)
 
-		assert.False(t, filter.ShouldExecute(callData))
+		checkShouldExecute(t, filter, callData, false, reasonMissingDestination)
 	})
 	t.Run("callData.To is not a valid KLV address should return false", func(t *testing.T) {
 		t.Parallel()
@@ -210,7 +251,7 @@ func TestPendingOperationFilter_ShouldExecute(t *testing.T) {
 		cfg := createTestConfig()
 		filter, _ := NewPendingOperationFilter(cfg, testLog)
 
-		assert.False(t, filter.ShouldExecute(callData))
+		checkShouldExecute(t, filter, callData, false, reasonMissingDestination)
 	})
 	t.Run("eth address", func(t *testing.T) {
 		t.Parallel()
@@ -227,11 +268,11 @@ func TestPendingOperationFilter_ShouldExecute(t *testing.T) {
 			cfg.AllowedEthAddresses = []string{ethTestAddress1}
 
 			filter, _ := NewPendingOperationFilter(cfg, testLog)
-			assert.False(t, filter.ShouldExecute(callData))
+			checkShouldExecute(t, filter, callData, false, reasonDeniedEthAddress)
 
 			cfg.AllowedEthAddresses = []string{"*"}
 			filter, _ = NewPendingOperationFilter(cfg, testLog)
-			assert.False(t, filter.ShouldExecute(callData))
+			checkShouldExecute(t, filter, callData, false, reasonDeniedEthAddress)
 		})
 		t.Run("is not denied but allowed should return true", func(t *testing.T) {
 			t.Parallel()
@@ -240,11 +281,11 @@ func TestPendingOperationFilter_ShouldExecute(t *testing.T) {
 			cfg.AllowedEthAddresses = []string{ethTestAddress1}
 
 			filter, _ := NewPendingOperationFilter(cfg, testLog)
-			assert.True(t, filter.ShouldExecute(callData))
+			checkShouldExecute(t, filter, callData, true, reasonAllowed)
 
 			cfg.AllowedEthAddresses = []string{"*"}
 			filter, _ = NewPendingOperationFilter(cfg, testLog)
-			assert.True(t, filter.ShouldExecute(callData))
+			checkShouldExecute(t, filter, callData, true, reasonAllowed)
 		})
 		t.Run("is not denied but not allowed should return false", func(t *testing.T) {
 			t.Parallel()
@@ -255,7 +296,7 @@ func TestPendingOperationFilter_ShouldExecute(t *testing.T) {
 			cfg.AllowedKlvAddresses = nil
 
 			filter, _ := NewPendingOperationFilter(cfg, testLog)
-			assert.False(t, filter.ShouldExecute(callData))
+			checkShouldExecute(t, filter, callData, false, reasonNotAllowed)
 		})
 	})
 	t.Run("kda address", func(t *testing.T) {
@@ -273,11 +314,11 @@ func TestPendingOperationFilter_ShouldExecute(t *testing.T) {
 			cfg.AllowedKlvAddresses = []string{klvTestAddress1}
 
 			filter, _ := NewPendingOperationFilter(cfg, testLog)
-			assert.False(t, filter.ShouldExecute(callData))
+			checkShouldExecute(t, filter, callData, false, reasonDeniedKlvAddress)
 
 			cfg.AllowedKlvAddresses = []string{"*"}
 			filter, _ = NewPendingOperationFilter(cfg, testLog)
-			assert.False(t, filter.ShouldExecute(callData))
+			checkShouldExecute(t, filter, callData, false, reasonDeniedKlvAddress)
 		})
 		t.Run("is not denied but allowed should return true", func(t *testing.T) {
 			t.Parallel()
@@ -286,11 +327,11 @@ func TestPendingOperationFilter_ShouldExecute(t *testing.T) {
 			cfg.AllowedKlvAddresses = []string{klvTestAddress1}
 
 			filter, _ := NewPendingOperationFilter(cfg, testLog)
-			assert.True(t, filter.ShouldExecute(callData))
+			checkShouldExecute(t, filter, callData, true, reasonAllowed)
 
 			cfg.AllowedKlvAddresses = []string{"*"}
 			filter, _ = NewPendingOperationFilter(cfg, testLog)
-			assert.True(t, filter.ShouldExecute(callData))
+			checkShouldExecute(t, filter, callData, true, reasonAllowed)
 		})
 		t.Run("is not denied but not allowed should return false", func(t *testing.T) {
 			t.Parallel()
@@ -301,7 +342,7 @@ func TestPendingOperationFilter_ShouldExecute(t *testing.T) {
 			cfg.AllowedEthAddresses = nil
 
 			filter, _ := NewPendingOperationFilter(cfg, testLog)
-			assert.False(t, filter.ShouldExecute(callData))
+			checkShouldExecute(t, filter, callData, false, reasonNotAllowed)
 		})
 	})
 	t.Run("tokens", func(t *testing.T) {
@@ -323,11 +364,11 @@ func TestPendingOperationFilter_ShouldExecute(t *testing.T) {
 			cfg.AllowedTokens = []string{token1}
 
 			filter, _ := NewPendingOperationFilter(cfg, testLog)
-			assert.False(t, filter.ShouldExecute(callData))
+			checkShouldExecute(t, filter, callData, false, reasonDeniedToken)
 
 			cfg.AllowedTokens = []string{"*"}
 			filter, _ = NewPendingOperationFilter(cfg, testLog)
-			assert.False(t, filter.ShouldExecute(callData))
+			checkShouldExecute(t, filter, callData, false, reasonDeniedToken)
 		})
 		t.Run("is not denied but allowed should return true", func(t *testing.T) {
 			t.Parallel()
@@ -336,11 +377,11 @@ func TestPendingOperationFilter_ShouldExecute(t *testing.T) {
 			cfg.AllowedTokens = []string{token1}
 
 			filter, _ := NewPendingOperationFilter(cfg, testLog)
-			assert.True(t, filter.ShouldExecute(callData))
+			checkShouldExecute(t, filter, callData, true, reasonAllowed)
 
 			cfg.AllowedTokens = []string{"*"}
 			filter, _ = NewPendingOperationFilter(cfg, testLog)
-			assert.True(t, filter.ShouldExecute(callData))
+			checkShouldExecute(t, filter, callData, true, reasonAllowed)
 		})
 		t.Run("is not denied but not allowed should return false", func(t *testing.T) {
 			t.Parallel()
@@ -351,7 +392,92 @@ func TestPendingOperationFilter_ShouldExecute(t *testing.T) {
 			cfg.AllowedEthAddresses = nil
 
 			filter, _ := NewPendingOperationFilter(cfg, testLog)
-			assert.False(t, filter.ShouldExecute(callData))
+			checkShouldExecute(t, filter, callData, false, reasonNotAllowed)
 		})
 	})
 }
+
+func TestPendingOperationFilter_ShouldExecuteWithRules(t *testing.T) {
+	t.Parallel()
+
+	stakeCallData := []byte{
+		1,
+		0, 0, 0, 17,
+		0, 0, 0, 5, 's', 't', 'a', 'k', 'e',
+		0, 0, 0, 0, 0, 0x4c, 0x4b, 0x40, // gas limit is 5000000
+	}
+	createCallData := func(amount int64, rawCallData []byte) parsers.ProxySCCompleteCallData {
+		callData := parsers.ProxySCCompleteCallData{
+			From:        common.BytesToAddress(ethTestAddress1Bytes),
+			Token:       "tkn1",
+			Amount:      big.NewInt(amount),
+			RawCallData: rawCallData,
+		}
+		callData.To, _ = address.NewAddress(klvTestAddress1)
+
+		return callData
+	}
+
+	// only execute calls to contract klvTestAddress1 with function stake and amount of at least 1000
+	cfg := config.PendingOperationsFilterConfig{
+		Rules: []config.PendingOperationsFilterRuleConfig{
+			{
+				Name:         "stake on contract",
+				Action:       allowAction,
+				KlvAddresses: []string{klvTestAddress1},
+				Functions:    []string{"stake"},
+				MinAmount:    "1000",
+			},
+			{
+				Name:   "deny everything else",
+				Action: denyAction,
+			},
+		},
+	}
+	filter, err := NewPendingOperationFilter(cfg, testLog)
+	require.Nil(t, err)
+
+	checkShouldExecute(t, filter, createCallData(1000, stakeCallData), true, "rule stake on contract")
+	checkShouldExecute(t, filter, createCallData(999, stakeCallData), false, "rule deny everything else")
+	checkShouldExecute(t, filter, createCallData(1000, []byte{0}), false, "rule deny everything else")
+	checkShouldExecute(t, filter, createCallData(1000, []byte{1, 0, 0}), false, "rule deny everything else")
+
+	t.Run("first matching rule wins", func(t *testing.T) {
+		t.Parallel()
+
+		cfgDenyFirst := createTestConfig()
+		cfgDenyFirst.Rules = []config.PendingOperationsFilterRuleConfig{
+			{
+				Action: denyAction,
+				Tokens: []string{"tkn1"},
+			},
+			{
+				Action: allowAction,
+			},
+		}
+		filterDenyFirst, _ := NewPendingOperationFilter(cfgDenyFirst, testLog)
+		checkShouldExecute(t, filterDenyFirst, createCallData(1000, stakeCallData), false, "rule at index 0")
+
+		callData := createCallData(1000, stakeCallData)
+		callData.Token = "tkn2"
+		checkShouldExecute(t, filterDenyFirst, callData, true, "rule at index 1")
+	})
+	t.Run("no matching rule should use the lists", func(t *testing.T) {
+		t.Parallel()
+
+		cfgLists := createTestConfig()
+		cfgLists.DeniedTokens = []string{"tkn2"}
+		cfgLists.Rules = []config.PendingOperationsFilterRuleConfig{
+			{
+				Action:    denyAction,
+				Functions: []string{"unstake"},
+			},
+		}
+		filterLists, _ := NewPendingOperationFilter(cfgLists, testLog)
+		checkShouldExecute(t, filterLists, createCallData(1000, stakeCallData), true, reasonAllowed)
+
+		callData := createCallData(1000, stakeCallData)
+		callData.Token = "tkn2"
+		checkShouldExecute(t, filterLists, callData, false, reasonDeniedToken)
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/klever-io/klever-go/data/transaction"
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	"github.com/klever-io/klv-bridge-eth-go/core"
 	"github.com/klever-io/klv-bridge-eth-go/parsers"
 )
 
@@ -18,7 +19,41 @@ type NonceTransactionsHandler interface {
 
 // ScCallsExecuteFilter defines the operations supported by a filter that allows selective executions of batches
 type ScCallsExecuteFilter interface {
-	ShouldExecute(callData parsers.ProxySCCompleteCallData) bool
+	ShouldExecute(callData parsers.ProxySCCompleteCallData) (bool, string)
+	IsInterfaceNil() bool
+}
+
+// ScCallsPrioritizer defines the operations supported by a component able to prioritize the pending operations
+type ScCallsPrioritizer interface {
+	ComputePriorities(pendingOperations map[uint64]parsers.ProxySCCompleteCallData) map[uint64]int64
+	IsInterfaceNil() bool
+}
+
+// ScCallsCoordinator defines the operations supported by a component able to partition the pending operations
+// between several executor instances
+type ScCallsCoordinator interface {
+	UpdatePendingOperations(ctx context.Context, pendingIDs []uint64)
+	ShouldExecute(id uint64) bool
+	IsInterfaceNil() bool
+}
+
+// AttemptsTracker defines the operations supported by a component able to track the failed execution attempts
+type AttemptsTracker interface {
+	CanExecute(id uint64) bool
+	IsDeadLetter(id uint64) bool
+	RecordSuccess(id uint64)
+	RecordFailure(id uint64, err error)
+	Prune(pendingIDs []uint64)
+	IsInterfaceNil() bool
+}
+
+// FeeLedger defines the operations supported by a component able to account the fees spent on executions
+type FeeLedger interface {
+	CanSpend() bool
+	TryReserve(estimatedFee int64) bool
+	Release(estimatedFee int64)
+	RecordFee(token string, destination string, fee int64)
+	GetFeesLedger() core.ScCallsFeesLedger
 	IsInterfaceNil() bool
 }
 
@@ -0,0 +1,11 @@
+package module
+
+import "errors"
+
+var (
+	errNilMetricsHolder       = errors.New("nil metrics holder")
+	errEmptyProxyName         = errors.New("empty SC proxy name")
+	errDuplicatedProxyName    = errors.New("duplicated SC proxy name")
+	errDuplicatedProxyAddress = errors.New("duplicated SC proxy address")
+	errUnknownProxy           = errors.New("unknown SC proxy")
+)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/klever-io/klever-go/data/transaction"
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	"github.com/klever-io/klv-bridge-eth-go/core"
 )
 
 type nonceTransactionsHandler interface {
@@ -22,6 +23,28 @@ type pollingHandler interface {
 
 type executor interface {
 	Execute(ctx context.Context) error
+	GetPendingOperations(ctx context.Context) ([]*core.ScCallPendingOperation, error)
+	GetOperationsResults() []*core.ScCallOperationResult
 	GetNumSentTransaction() uint32
 	IsInterfaceNil() bool
 }
+
+type attemptsTracker interface {
+	CanExecute(id uint64) bool
+	IsDeadLetter(id uint64) bool
+	RecordSuccess(id uint64)
+	RecordFailure(id uint64, err error)
+	Prune(pendingIDs []uint64)
+	DeadLetters() []core.ScCallExecutionAttempts
+	ResolveDeadLetter(id uint64) error
+	IsInterfaceNil() bool
+}
+
+type feeLedger interface {
+	CanSpend() bool
+	TryReserve(estimatedFee int64) bool
+	Release(estimatedFee int64)
+	RecordFee(token string, destination string, fee int64)
+	GetFeesLedger() core.ScCallsFeesLedger
+	IsInterfaceNil() bool
+}
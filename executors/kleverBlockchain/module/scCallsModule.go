@@ -1,18 +1,28 @@
 package module
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"time"
 
 	"github.com/klever-io/klever-go/tools"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/interactors/nonceHandlerV2"
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/proxy"
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/proxy/models"
 	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/core"
 	kc "github.com/klever-io/klv-bridge-eth-go/executors/kleverBlockchain"
+	"github.com/klever-io/klv-bridge-eth-go/executors/kleverBlockchain/attempts"
+	"github.com/klever-io/klv-bridge-eth-go/executors/kleverBlockchain/coordination"
+	"github.com/klever-io/klv-bridge-eth-go/executors/kleverBlockchain/disabled"
+	"github.com/klever-io/klv-bridge-eth-go/executors/kleverBlockchain/fees"
 	"github.com/klever-io/klv-bridge-eth-go/executors/kleverBlockchain/filters"
+	"github.com/klever-io/klv-bridge-eth-go/executors/kleverBlockchain/priority"
 	"github.com/klever-io/klv-bridge-eth-go/parsers"
+	"github.com/klever-io/klv-bridge-eth-go/status"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
@@ -24,15 +34,52 @@ var suite = ed25519.NewEd25519()
 var keyGen = signing.NewKeyGenerator(suite)
 var singleSigner = &singlesig.Ed25519Signer{}
 
-type scCallsModule struct {
-	nonceTxsHandler  nonceTransactionsHandler
+// ArgsScCallsModule is the DTO used in the NewScCallsModule constructor function
+type ArgsScCallsModule struct {
+	Config        config.ScCallsModuleConfig
+	Storer        core.Storer
+	MetricsHolder core.MetricsHolder
+	Log           logger.Logger
+	CloseAppChan  chan struct{}
+}
+
+// proxyExecutor holds the components handling one SC proxy contract
+type proxyExecutor struct {
+	name             string
 	pollingHandler   pollingHandler
 	executorInstance executor
+	attemptsTracker  attemptsTracker
+	prioritizer      kc.ScCallsPrioritizer
+}
+
+type scCallsModule struct {
+	ownAddress      string
+	nonceTxsHandler nonceTransactionsHandler
+	feeLedger       feeLedger
+	proxyExecutors  []*proxyExecutor
 }
 
 // NewScCallsModule creates a starts a new scCallsModule instance
-func NewScCallsModule(cfg config.ScCallsModuleConfig, log logger.Logger, chCloseApp chan struct{}) (*scCallsModule, error) {
-	filter, err := filters.NewPendingOperationFilter(cfg.Filter, log)
+func NewScCallsModule(args ArgsScCallsModule) (*scCallsModule, error) {
+	cfg := args.Config
+	log := args.Log
+
+	if check.IfNil(args.MetricsHolder) {
+		return nil, errNilMetricsHolder
+	}
+
+	proxiesConfigs, err := getProxiesConfigs(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	module := &scCallsModule{}
+	argsFeeLedger := fees.ArgsFeeLedger{
+		FeeBudget: cfg.FeeBudget,
+		Storer:    args.Storer,
+		Log:       log,
+	}
+	module.feeLedger, err = fees.NewFeeLedger(argsFeeLedger)
 	if err != nil {
 		return nil, err
 	}
@@ -52,8 +99,6 @@ func NewScCallsModule(cfg config.ScCallsModuleConfig, log logger.Logger, chClose
 		return nil, err
 	}
 
-	module := &scCallsModule{}
-
 	argNonceHandler := nonceHandlerV2.ArgsNonceTransactionsHandlerV2{
 		Proxy:            proxy,
 		IntervalToResend: time.Second * time.Duration(cfg.IntervalToResendTxsInSeconds),
@@ -79,59 +124,284 @@ func NewScCallsModule(cfg config.ScCallsModuleConfig, log logger.Logger, chClose
 		return nil, err
 	}
 
-	argsExecutor := kc.ArgsScCallExecutor{
-		ScProxyBech32Address:            cfg.ScProxyBech32Address,
-		Proxy:                           proxy,
-		Codec:                           &parsers.KCCodec{},
-		Filter:                          filter,
-		Log:                             log,
-		ExtraGasToExecute:               cfg.ExtraGasToExecute,
-		MaxGasLimitToUse:                cfg.MaxGasLimitToUse,
-		GasLimitForOutOfGasTransactions: cfg.GasLimitForOutOfGasTransactions,
-		NonceTxHandler:                  module.nonceTxsHandler,
-		PrivateKey:                      privateKey,
-		SingleSigner:                    singleSigner,
-		CloseAppChan:                    chCloseApp,
-		TransactionChecks:               cfg.TransactionChecks,
+	publicKeyBytes, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
 	}
-	module.executorInstance, err = kc.NewScCallExecutor(argsExecutor)
+
+	ownAddress, err := address.NewAddressFromBytes(publicKeyBytes)
 	if err != nil {
 		return nil, err
 	}
+	module.ownAddress = ownAddress.Bech32()
+
+	for _, proxyConfig := range proxiesConfigs {
+		argsExecutor := kc.ArgsScCallExecutor{
+			ScProxyBech32Address:            proxyConfig.ScProxyBech32Address,
+			Proxy:                           proxy,
+			Codec:                           &parsers.KCCodec{},
+			FeeLedger:                       module.feeLedger,
+			Log:                             log,
+			ExtraGasToExecute:               proxyConfig.ExtraGasToExecute,
+			MaxGasLimitToUse:                proxyConfig.MaxGasLimitToUse,
+			GasLimitForOutOfGasTransactions: proxyConfig.GasLimitForOutOfGasTransactions,
+			NonceTxHandler:                  module.nonceTxsHandler,
+			PrivateKey:                      privateKey,
+			SingleSigner:                    singleSigner,
+			CloseAppChan:                    args.CloseAppChan,
+			TransactionChecks:               cfg.TransactionChecks,
+			Concurrency:                     cfg.Concurrency,
+		}
+
+		var instance *proxyExecutor
+		instance, err = module.createProxyExecutor(args, proxyConfig, argsExecutor)
+		if err != nil {
+			_ = module.Close()
+			return nil, fmt.Errorf("%w for SC proxy %s", err, proxyConfig.ScProxyBech32Address)
+		}
+
+		module.proxyExecutors = append(module.proxyExecutors, instance)
+	}
+
+	return module, nil
+}
 
+func (module *scCallsModule) createProxyExecutor(
+	args ArgsScCallsModule,
+	proxyConfig config.ScCallsProxyConfig,
+	argsExecutor kc.ArgsScCallExecutor,
+) (*proxyExecutor, error) {
+	log := args.Log
+	instance := &proxyExecutor{
+		name: proxyConfig.Name,
+	}
+
+	filter, err := filters.NewPendingOperationFilter(proxyConfig.Filter, log)
+	if err != nil {
+		return nil, err
+	}
+
+	coordinator, err := module.createCoordinator(args.Config, argsExecutor.Proxy, log)
+	if err != nil {
+		return nil, err
+	}
+
+	// the prioritizer keeps the first seen time of the pending operations by their ID, and the IDs are only unique
+	// inside a SC proxy, so each proxy needs its own instance
+	instance.prioritizer, err = priority.NewPendingOperationsPrioritizer(args.Config.Priority, log)
+	if err != nil {
+		return nil, err
+	}
+
+	argsAttemptsTracker := attempts.ArgsAttemptsTracker{
+		Name:        proxyConfig.Name,
+		RetryPolicy: args.Config.RetryPolicy,
+		Storer:      args.Storer,
+		Log:         log,
+	}
+	instance.attemptsTracker, err = attempts.NewAttemptsTracker(argsAttemptsTracker)
+	if err != nil {
+		return nil, err
+	}
+
+	statusHandler, err := status.NewStatusHandler(createStatusHandlerName(proxyConfig.Name), args.Storer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = args.MetricsHolder.AddStatusHandler(statusHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	argsExecutor.Filter = filter
+	argsExecutor.Coordinator = coordinator
+	argsExecutor.Prioritizer = instance.prioritizer
+	argsExecutor.AttemptsTracker = instance.attemptsTracker
+	argsExecutor.StatusHandler = statusHandler
+	instance.executorInstance, err = kc.NewScCallExecutor(argsExecutor)
+	if err != nil {
+		return nil, err
+	}
+
+	pollingHandlerName := "Klever Blockchain SC calls"
+	if len(proxyConfig.Name) > 0 {
+		pollingHandlerName += " " + proxyConfig.Name
+	}
 	argsPollingHandler := polling.ArgsPollingHandler{
 		Log:              log,
-		Name:             "Klever Blockchain SC calls",
-		PollingInterval:  time.Duration(cfg.PollingIntervalInMillis) * time.Millisecond,
-		PollingWhenError: time.Duration(cfg.PollingIntervalInMillis) * time.Millisecond,
-		Executor:         module.executorInstance,
+		Name:             pollingHandlerName,
+		PollingInterval:  time.Duration(proxyConfig.PollingIntervalInMillis) * time.Millisecond,
+		PollingWhenError: time.Duration(proxyConfig.PollingIntervalInMillis) * time.Millisecond,
+		Executor:         instance.executorInstance,
 	}
 
-	module.pollingHandler, err = polling.NewPollingHandler(argsPollingHandler)
+	instance.pollingHandler, err = polling.NewPollingHandler(argsPollingHandler)
 	if err != nil {
 		return nil, err
 	}
 
-	err = module.pollingHandler.StartProcessingLoop()
+	err = instance.pollingHandler.StartProcessingLoop()
 	if err != nil {
+		_ = instance.pollingHandler.Close()
 		return nil, err
 	}
 
-	return module, nil
+	return instance, nil
+}
+
+func (module *scCallsModule) createCoordinator(cfg config.ScCallsModuleConfig, proxy coordination.AccountsProvider, log logger.Logger) (kc.ScCallsCoordinator, error) {
+	if !cfg.Coordination.Enabled {
+		return disabled.NewDisabledCoordinator(), nil
+	}
+
+	argsCoordinator := coordination.ArgsCoordinator{
+		Config:           cfg.Coordination,
+		ExecutionTimeout: time.Second * time.Duration(cfg.TransactionChecks.ExecutionTimeoutInSeconds),
+		OwnAddress:       module.ownAddress,
+		Proxy:            proxy,
+		Log:              log,
+	}
+
+	return coordination.NewCoordinator(argsCoordinator)
+}
+
+// getProxiesConfigs returns the configured SC proxies. If none is configured, the proxy defined by the module
+// config fields is used, with an empty name, so the persisted data and the metrics keep their legacy keys
+func getProxiesConfigs(cfg config.ScCallsModuleConfig) ([]config.ScCallsProxyConfig, error) {
+	if len(cfg.Proxies) == 0 {
+		return []config.ScCallsProxyConfig{
+			{
+				ScProxyBech32Address:            cfg.ScProxyBech32Address,
+				ExtraGasToExecute:               cfg.ExtraGasToExecute,
+				MaxGasLimitToUse:                cfg.MaxGasLimitToUse,
+				GasLimitForOutOfGasTransactions: cfg.GasLimitForOutOfGasTransactions,
+				PollingIntervalInMillis:         cfg.PollingIntervalInMillis,
+				Filter:                          cfg.Filter,
+			},
+		}, nil
+	}
+
+	names := make(map[string]struct{})
+	addresses := make(map[string]struct{})
+	for i, proxyConfig := range cfg.Proxies {
+		if len(proxyConfig.Name) == 0 {
+			return nil, fmt.Errorf("%w for the proxy at index %d", errEmptyProxyName, i)
+		}
+		_, found := names[proxyConfig.Name]
+		if found {
+			return nil, fmt.Errorf("%w: %s", errDuplicatedProxyName, proxyConfig.Name)
+		}
+		_, found = addresses[proxyConfig.ScProxyBech32Address]
+		if found {
+			return nil, fmt.Errorf("%w: %s", errDuplicatedProxyAddress, proxyConfig.ScProxyBech32Address)
+		}
+
+		names[proxyConfig.Name] = struct{}{}
+		addresses[proxyConfig.ScProxyBech32Address] = struct{}{}
+	}
+
+	return cfg.Proxies, nil
+}
+
+func createStatusHandlerName(proxyName string) string {
+	if len(proxyName) == 0 {
+		return core.ScCallsExecutorStatusHandlerName
+	}
+
+	return core.ScCallsExecutorStatusHandlerName + "-" + proxyName
 }
 
 // GetNumSentTransaction returns the total sent transactions
 func (module *scCallsModule) GetNumSentTransaction() uint32 {
-	return module.executorInstance.GetNumSentTransaction()
+	numSentTransactions := uint32(0)
+	for _, instance := range module.proxyExecutors {
+		numSentTransactions += instance.executorInstance.GetNumSentTransaction()
+	}
+
+	return numSentTransactions
+}
+
+// GetPendingOperations returns the decoded SC calls waiting to be executed
+func (module *scCallsModule) GetPendingOperations(ctx context.Context) ([]*core.ScCallPendingOperation, error) {
+	result := make([]*core.ScCallPendingOperation, 0)
+	for _, instance := range module.proxyExecutors {
+		operations, err := instance.executorInstance.GetPendingOperations(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, operation := range operations {
+			operation.Proxy = instance.name
+		}
+		result = append(result, operations...)
+	}
+
+	return result, nil
+}
+
+// GetOperationsResults returns the results of the latest executed SC calls
+func (module *scCallsModule) GetOperationsResults() []*core.ScCallOperationResult {
+	result := make([]*core.ScCallOperationResult, 0)
+	for _, instance := range module.proxyExecutors {
+		for _, operationResult := range instance.executorInstance.GetOperationsResults() {
+			operationResultCopy := *operationResult
+			operationResultCopy.Proxy = instance.name
+			result = append(result, &operationResultCopy)
+		}
+	}
+
+	return result
+}
+
+// GetDeadLetters returns the SC calls that permanently failed and require manual resolution
+func (module *scCallsModule) GetDeadLetters() []core.ScCallExecutionAttempts {
+	result := make([]core.ScCallExecutionAttempts, 0)
+	for _, instance := range module.proxyExecutors {
+		for _, deadLetter := range instance.attemptsTracker.DeadLetters() {
+			deadLetter.Proxy = instance.name
+			result = append(result, deadLetter)
+		}
+	}
+
+	return result
+}
+
+// GetFeesLedger returns the fees spent on the SC calls executions
+func (module *scCallsModule) GetFeesLedger() core.ScCallsFeesLedger {
+	return module.feeLedger.GetFeesLedger()
+}
+
+// ResolveDeadLetter removes the SC call from the dead-letter list of the named proxy so it will be executed again.
+// The empty name selects the proxy defined by the module config fields
+func (module *scCallsModule) ResolveDeadLetter(proxyName string, id uint64) error {
+	for _, instance := range module.proxyExecutors {
+		if instance.name == proxyName {
+			return instance.attemptsTracker.ResolveDeadLetter(id)
+		}
+	}
+
+	return fmt.Errorf("%w: %s", errUnknownProxy, proxyName)
 }
 
 // Close closes any components started
 func (module *scCallsModule) Close() error {
-	errPollingHandler := module.pollingHandler.Close()
-	errNonceTxsHandler := module.nonceTxsHandler.Close()
+	var lastError error
+	for _, instance := range module.proxyExecutors {
+		err := instance.pollingHandler.Close()
+		if err != nil {
+			lastError = err
+		}
+	}
 
-	if errPollingHandler != nil {
-		return errPollingHandler
+	errNonceTxsHandler := module.nonceTxsHandler.Close()
+	if lastError != nil {
+		return lastError
 	}
 	return errNonceTxsHandler
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (module *scCallsModule) IsInterfaceNil() bool {
+	return module == nil
+}
@@ -1,12 +1,18 @@
 package module
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/proxy/models"
 	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/executors/kleverBlockchain/attempts"
+	"github.com/klever-io/klv-bridge-eth-go/status"
 	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createTestConfigs() config.ScCallsModuleConfig {
@@ -31,6 +37,55 @@ func createTestConfigs() config.ScCallsModuleConfig {
 			DeniedTokens:        nil,
 			AllowedTokens:       []string{"*"},
 		},
+		Concurrency: config.ConcurrencyConfig{
+			NumWorkers: 1,
+		},
+		RetryPolicy: config.ScCallsRetryPolicyConfig{
+			MaxAttempts:             3,
+			InitialBackoffInSeconds: 1,
+			MaxBackoffInSeconds:     10,
+		},
+	}
+}
+
+func createTestProxiesConfigs() []config.ScCallsProxyConfig {
+	return []config.ScCallsProxyConfig{
+		{
+			Name:                            "test",
+			ScProxyBech32Address:            "klv1qqqqqqqqqqqqqpgqsudu3a3n9yu62k5qkgcpy4j9ywl2x2gl5smsl7s8wj",
+			ExtraGasToExecute:               1000000,
+			MaxGasLimitToUse:                100000000,
+			GasLimitForOutOfGasTransactions: 30000000,
+			PollingIntervalInMillis:         5000,
+			Filter: config.PendingOperationsFilterConfig{
+				AllowedEthAddresses: []string{"*"},
+				AllowedKlvAddresses: []string{"*"},
+				AllowedTokens:       []string{"*"},
+			},
+		},
+		{
+			Name:                            "production",
+			ScProxyBech32Address:            "klv1qqqqqqqqqqqqqpgqu2jcktadaq8mmytwglc704yfv7rezv5usg8sgzuah3",
+			ExtraGasToExecute:               6000000,
+			MaxGasLimitToUse:                249999999,
+			GasLimitForOutOfGasTransactions: 30000000,
+			PollingIntervalInMillis:         10000,
+			Filter: config.PendingOperationsFilterConfig{
+				AllowedEthAddresses: []string{"*"},
+				AllowedKlvAddresses: []string{"*"},
+				AllowedTokens:       []string{"*"},
+			},
+		},
+	}
+}
+
+func createMockArgsScCallsModule(cfg config.ScCallsModuleConfig, chCloseApp chan struct{}) ArgsScCallsModule {
+	return ArgsScCallsModule{
+		Config:        cfg,
+		Storer:        testsCommon.NewStorerMock(),
+		MetricsHolder: status.NewMetricsHolder(),
+		Log:           &testsCommon.LoggerStub{},
+		CloseAppChan:  chCloseApp,
 	}
 }
 
@@ -43,18 +98,40 @@ func TestNewScCallsModule(t *testing.T) {
 		cfg := createTestConfigs()
 		cfg.Filter.DeniedTokens = []string{"*"}
 
-		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		module, err := NewScCallsModule(createMockArgsScCallsModule(cfg, nil))
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "unsupported marker * on item at index 0 in list DeniedTokens")
 		assert.Nil(t, module)
 	})
+	t.Run("invalid priority config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.Priority.PriorityCallers = []string{"invalid"}
+
+		module, err := NewScCallsModule(createMockArgsScCallsModule(cfg, nil))
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "missing Ethereum address prefix (missing 0x prefix) on item at index 0 in list PriorityCallers")
+		assert.Nil(t, module)
+	})
+	t.Run("invalid retry policy config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.RetryPolicy.MaxAttempts = 0
+
+		module, err := NewScCallsModule(createMockArgsScCallsModule(cfg, nil))
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "invalid value for RetryPolicy.MaxAttempts")
+		assert.Nil(t, module)
+	})
 	t.Run("invalid proxy cacher interval expiration should error", func(t *testing.T) {
 		t.Parallel()
 
 		cfg := createTestConfigs()
 		cfg.ProxyCacherExpirationSeconds = 0
 
-		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		module, err := NewScCallsModule(createMockArgsScCallsModule(cfg, nil))
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "invalid caching duration, provided: 0s, minimum: 1s")
 		assert.Nil(t, module)
@@ -65,7 +142,7 @@ func TestNewScCallsModule(t *testing.T) {
 		cfg := createTestConfigs()
 		cfg.IntervalToResendTxsInSeconds = 0
 
-		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		module, err := NewScCallsModule(createMockArgsScCallsModule(cfg, nil))
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "invalid value for intervalToResend in NewNonceTransactionHandlerV2")
 		assert.Nil(t, module)
@@ -76,7 +153,7 @@ func TestNewScCallsModule(t *testing.T) {
 		cfg := createTestConfigs()
 		cfg.PrivateKeyFile = ""
 
-		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		module, err := NewScCallsModule(createMockArgsScCallsModule(cfg, nil))
 		assert.NotNil(t, err)
 		assert.Nil(t, module)
 	})
@@ -86,20 +163,144 @@ func TestNewScCallsModule(t *testing.T) {
 		cfg := createTestConfigs()
 		cfg.PollingIntervalInMillis = 0
 
-		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		module, err := NewScCallsModule(createMockArgsScCallsModule(cfg, nil))
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "invalid value for PollingInterval")
 		assert.Nil(t, module)
 	})
+	t.Run("nil metrics holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallsModule(createTestConfigs(), nil)
+		args.MetricsHolder = nil
+
+		module, err := NewScCallsModule(args)
+		assert.Equal(t, errNilMetricsHolder, err)
+		assert.Nil(t, module)
+	})
+	t.Run("empty proxy name should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.Proxies = createTestProxiesConfigs()
+		cfg.Proxies[1].Name = ""
+
+		module, err := NewScCallsModule(createMockArgsScCallsModule(cfg, nil))
+		assert.ErrorIs(t, err, errEmptyProxyName)
+		assert.Contains(t, err.Error(), "index 1")
+		assert.Nil(t, module)
+	})
+	t.Run("duplicated proxy name should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.Proxies = createTestProxiesConfigs()
+		cfg.Proxies[1].Name = cfg.Proxies[0].Name
+
+		module, err := NewScCallsModule(createMockArgsScCallsModule(cfg, nil))
+		assert.ErrorIs(t, err, errDuplicatedProxyName)
+		assert.Nil(t, module)
+	})
+	t.Run("duplicated proxy address should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.Proxies = createTestProxiesConfigs()
+		cfg.Proxies[1].ScProxyBech32Address = cfg.Proxies[0].ScProxyBech32Address
+
+		module, err := NewScCallsModule(createMockArgsScCallsModule(cfg, nil))
+		assert.ErrorIs(t, err, errDuplicatedProxyAddress)
+		assert.Nil(t, module)
+	})
+	t.Run("invalid proxy filter should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.Proxies = createTestProxiesConfigs()
+		cfg.Proxies[1].Filter.DeniedTokens = []string{"*"}
+
+		module, err := NewScCallsModule(createMockArgsScCallsModule(cfg, nil))
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for SC proxy "+cfg.Proxies[1].ScProxyBech32Address)
+		assert.Nil(t, module)
+	})
+	t.Run("own address not in the coordinated executors should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.Coordination = config.ScCallsCoordinationConfig{
+			Enabled:                  true,
+			Executors:                []string{"klv1qqqqqqqqqqqqqpgqswlgqde4tfwp4ucwkh42m6d8a0d49w92sg8shyj6q3"},
+			TakeoverTimeoutInSeconds: 60,
+		}
+
+		module, err := NewScCallsModule(createMockArgsScCallsModule(cfg, nil))
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "own address not found in the executors list")
+		assert.Nil(t, module)
+	})
+	t.Run("should work with coordination enabled", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.Coordination = config.ScCallsCoordinationConfig{
+			Enabled: true,
+			Executors: []string{
+				"klv1qqqqqqqqqqqqqpgqswlgqde4tfwp4ucwkh42m6d8a0d49w92sg8shyj6q3",
+				"klv17la0vdplk320zvy9s7qps5j69ff2yl3dn0drmhyuw57dnhe23g5schkvag", // grace.pem
+			},
+			TakeoverTimeoutInSeconds: 60,
+		}
+
+		module, err := NewScCallsModule(createMockArgsScCallsModule(cfg, nil))
+		assert.Nil(t, err)
+		assert.NotNil(t, module)
+
+		err = module.Close()
+		assert.Nil(t, err)
+	})
+	t.Run("should work with multiple proxies", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.Proxies = createTestProxiesConfigs()
+		args := createMockArgsScCallsModule(cfg, nil)
+		module, err := NewScCallsModule(args)
+		assert.Nil(t, err)
+		assert.NotNil(t, module)
+
+		expectedStatusHandlers := []string{
+			core.ScCallsExecutorStatusHandlerName + "-test",
+			core.ScCallsExecutorStatusHandlerName + "-production",
+		}
+		assert.ElementsMatch(t, expectedStatusHandlers, args.MetricsHolder.GetAvailableStatusHandlers())
+		require.Equal(t, 2, len(module.proxyExecutors))
+		assert.False(t, module.proxyExecutors[0].prioritizer == module.proxyExecutors[1].prioritizer) // pointer testing
+		assert.Zero(t, module.GetNumSentTransaction())
+		assert.Empty(t, module.GetDeadLetters())
+		err = module.ResolveDeadLetter("test", 1)
+		assert.NotNil(t, err)
+		assert.NotErrorIs(t, err, errUnknownProxy)
+		assert.ErrorIs(t, module.ResolveDeadLetter("", 1), errUnknownProxy)
+
+		err = module.Close()
+		assert.Nil(t, err)
+	})
 	t.Run("should work with nil close app chan", func(t *testing.T) {
 		t.Parallel()
 
 		cfg := createTestConfigs()
-		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		args := createMockArgsScCallsModule(cfg, nil)
+		module, err := NewScCallsModule(args)
 		assert.Nil(t, err)
 		assert.NotNil(t, module)
 
 		assert.Zero(t, module.GetNumSentTransaction())
+		assert.Empty(t, module.GetDeadLetters())
+		assert.Empty(t, module.GetOperationsResults())
+		assert.Zero(t, module.GetFeesLedger().TotalFees)
+		assert.NotNil(t, module.ResolveDeadLetter("", 1))
+		assert.Equal(t, []string{core.ScCallsExecutorStatusHandlerName}, args.MetricsHolder.GetAvailableStatusHandlers())
 
 		err = module.Close()
 		assert.Nil(t, err)
@@ -112,7 +313,7 @@ func TestNewScCallsModule(t *testing.T) {
 		cfg.TransactionChecks.TimeInSecondsBetweenChecks = 1
 		cfg.TransactionChecks.ExecutionTimeoutInSeconds = 1
 		cfg.TransactionChecks.CloseAppOnError = true
-		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, make(chan struct{}, 1))
+		module, err := NewScCallsModule(createMockArgsScCallsModule(cfg, make(chan struct{}, 1)))
 		assert.Nil(t, err)
 		assert.NotNil(t, module)
 
@@ -122,3 +323,73 @@ func TestNewScCallsModule(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestScCallsModule_ShouldAggregateTheProxiesData(t *testing.T) {
+	t.Parallel()
+
+	createProxyExecutor := func(name string, id uint64) *proxyExecutor {
+		tracker, err := attempts.NewAttemptsTracker(attempts.ArgsAttemptsTracker{
+			Name: name,
+			RetryPolicy: config.ScCallsRetryPolicyConfig{
+				MaxAttempts:             1,
+				InitialBackoffInSeconds: 1,
+				MaxBackoffInSeconds:     1,
+			},
+			Storer: testsCommon.NewStorerMock(),
+			Log:    &testsCommon.LoggerStub{},
+		})
+		require.Nil(t, err)
+		tracker.RecordFailure(id, errors.New("execution error"))
+
+		return &proxyExecutor{
+			name: name,
+			executorInstance: &testsCommon.ScCallsExecutorStub{
+				GetPendingOperationsCalled: func(ctx context.Context) ([]*core.ScCallPendingOperation, error) {
+					return []*core.ScCallPendingOperation{{ID: id}}, nil
+				},
+				GetOperationsResultsCalled: func() []*core.ScCallOperationResult {
+					return []*core.ScCallOperationResult{{ID: id}}
+				},
+				GetNumSentTransactionCalled: func() uint32 {
+					return uint32(id)
+				},
+			},
+			attemptsTracker: tracker,
+		}
+	}
+
+	module := &scCallsModule{
+		proxyExecutors: []*proxyExecutor{
+			createProxyExecutor("test", 1),
+			createProxyExecutor("production", 2),
+		},
+	}
+
+	assert.Equal(t, uint32(3), module.GetNumSentTransaction())
+
+	operations, err := module.GetPendingOperations(context.Background())
+	assert.Nil(t, err)
+	expectedOperations := []*core.ScCallPendingOperation{
+		{ID: 1, Proxy: "test"},
+		{ID: 2, Proxy: "production"},
+	}
+	assert.Equal(t, expectedOperations, operations)
+
+	expectedResults := []*core.ScCallOperationResult{
+		{ID: 1, Proxy: "test"},
+		{ID: 2, Proxy: "production"},
+	}
+	assert.Equal(t, expectedResults, module.GetOperationsResults())
+
+	deadLetters := module.GetDeadLetters()
+	require.Equal(t, 2, len(deadLetters))
+	assert.Equal(t, uint64(1), deadLetters[0].ID)
+	assert.Equal(t, "test", deadLetters[0].Proxy)
+	assert.Equal(t, uint64(2), deadLetters[1].ID)
+	assert.Equal(t, "production", deadLetters[1].Proxy)
+
+	assert.Nil(t, module.ResolveDeadLetter("test", 1))
+	deadLetters = module.GetDeadLetters()
+	require.Equal(t, 1, len(deadLetters))
+	assert.Equal(t, "production", deadLetters[0].Proxy)
+}
@@ -0,0 +1,10 @@
+package priority
+
+import "errors"
+
+var (
+	errNilLogger        = errors.New("nil logger")
+	errEmptyItem        = errors.New("empty item")
+	errMissingEthPrefix = errors.New("missing Ethereum address prefix")
+	errNegativeWeight   = errors.New("negative weight")
+)
@@ -0,0 +1,182 @@
+package priority
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/parsers"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const ethAddressPrefix = "0x"
+
+type pendingOperationsPrioritizer struct {
+	tokenWeights          map[string]int64
+	destinationWeights    map[string]int64
+	priorityCallers       map[string]struct{}
+	priorityCallersWeight int64
+	agingWeightPerMinute  int64
+	getTimeFunc           func() time.Time
+
+	mut       sync.Mutex
+	firstSeen map[uint64]time.Time
+}
+
+// NewPendingOperationsPrioritizer creates a new instance of type pendingOperationsPrioritizer
+func NewPendingOperationsPrioritizer(cfg config.PendingOperationsPriorityConfig, log logger.Logger) (*pendingOperationsPrioritizer, error) {
+	if check.IfNil(log) {
+		return nil, errNilLogger
+	}
+
+	prioritizer := &pendingOperationsPrioritizer{
+		priorityCallersWeight: cfg.PriorityCallersWeight,
+		agingWeightPerMinute:  cfg.AgingWeightPerMinute,
+		getTimeFunc:           time.Now,
+		firstSeen:             make(map[uint64]time.Time),
+	}
+
+	err := prioritizer.parseConfigs(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("NewPendingOperationsPrioritizer config options",
+		"TokenWeights", fmt.Sprintf("%v", prioritizer.tokenWeights),
+		"DestinationWeights", fmt.Sprintf("%v", prioritizer.destinationWeights),
+		"PriorityCallers", len(prioritizer.priorityCallers),
+		"PriorityCallersWeight", prioritizer.priorityCallersWeight,
+		"AgingWeightPerMinute", prioritizer.agingWeightPerMinute,
+	)
+
+	return prioritizer, nil
+}
+
+func (prioritizer *pendingOperationsPrioritizer) parseConfigs(cfg config.PendingOperationsPriorityConfig) error {
+	if cfg.PriorityCallersWeight < 0 {
+		return fmt.Errorf("%w for PriorityCallersWeight", errNegativeWeight)
+	}
+	if cfg.AgingWeightPerMinute < 0 {
+		return fmt.Errorf("%w for AgingWeightPerMinute", errNegativeWeight)
+	}
+
+	var err error
+	prioritizer.tokenWeights, err = parseWeights(cfg.TokenWeights, checkTokenValid)
+	if err != nil {
+		return fmt.Errorf("%w in list TokenWeights", err)
+	}
+
+	prioritizer.destinationWeights, err = parseWeights(cfg.DestinationWeights, checkKlvItemValid)
+	if err != nil {
+		return fmt.Errorf("%w in list DestinationWeights", err)
+	}
+
+	prioritizer.priorityCallers = make(map[string]struct{}, len(cfg.PriorityCallers))
+	for index, item := range cfg.PriorityCallers {
+		item = normalize(item)
+		err = checkEthItemValid(item)
+		if err != nil {
+			return fmt.Errorf("%w on item at index %d in list PriorityCallers", err, index)
+		}
+
+		prioritizer.priorityCallers[item] = struct{}{}
+	}
+
+	return nil
+}
+
+func parseWeights(list []config.PriorityWeightConfig, checkItem func(item string) error) (map[string]int64, error) {
+	weights := make(map[string]int64, len(list))
+	for index, weightConfig := range list {
+		item := normalize(weightConfig.Item)
+		err := checkItem(item)
+		if err != nil {
+			return nil, fmt.Errorf("%w on item at index %d", err, index)
+		}
+		if weightConfig.Weight < 0 {
+			return nil, fmt.Errorf("%w on item at index %d", errNegativeWeight, index)
+		}
+
+		weights[item] = weightConfig.Weight
+	}
+
+	return weights, nil
+}
+
+func normalize(item string) string {
+	item = strings.ToLower(item)
+	return strings.Trim(item, "\r\n \t")
+}
+
+func checkTokenValid(item string) error {
+	if len(item) == 0 {
+		return errEmptyItem
+	}
+
+	return nil
+}
+
+func checkKlvItemValid(item string) error {
+	_, errNewAddr := address.NewAddress(item)
+	return errNewAddr
+}
+
+func checkEthItemValid(item string) error {
+	if !strings.HasPrefix(item, ethAddressPrefix) {
+		return fmt.Errorf("%w (missing %s prefix)", errMissingEthPrefix, ethAddressPrefix)
+	}
+
+	return nil
+}
+
+// ComputePriorities returns the priority of each provided pending operation. Operations with a higher priority
+// should be executed first. The pending operations that are no longer provided are forgotten
+func (prioritizer *pendingOperationsPrioritizer) ComputePriorities(pendingOperations map[uint64]parsers.ProxySCCompleteCallData) map[uint64]int64 {
+	prioritizer.mut.Lock()
+	defer prioritizer.mut.Unlock()
+
+	now := prioritizer.getTimeFunc()
+	for id := range prioritizer.firstSeen {
+		_, found := pendingOperations[id]
+		if !found {
+			delete(prioritizer.firstSeen, id)
+		}
+	}
+
+	priorities := make(map[uint64]int64, len(pendingOperations))
+	for id, callData := range pendingOperations {
+		firstSeen, found := prioritizer.firstSeen[id]
+		if !found {
+			firstSeen = now
+			prioritizer.firstSeen[id] = now
+		}
+
+		minutesWaited := int64(now.Sub(firstSeen) / time.Minute)
+		priorities[id] = prioritizer.computePriority(callData) + minutesWaited*prioritizer.agingWeightPerMinute
+	}
+
+	return priorities
+}
+
+func (prioritizer *pendingOperationsPrioritizer) computePriority(callData parsers.ProxySCCompleteCallData) int64 {
+	priority := prioritizer.tokenWeights[normalize(callData.Token)]
+	if !check.IfNil(callData.To) {
+		priority += prioritizer.destinationWeights[callData.To.Bech32()]
+	}
+
+	_, isPriorityCaller := prioritizer.priorityCallers[normalize(callData.From.String())]
+	if isPriorityCaller {
+		priority += prioritizer.priorityCallersWeight
+	}
+
+	return priority
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (prioritizer *pendingOperationsPrioritizer) IsInterfaceNil() bool {
+	return prioritizer == nil
+}
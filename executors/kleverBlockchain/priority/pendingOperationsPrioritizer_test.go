@@ -0,0 +1,199 @@
+package priority
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/parsers"
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testDestination      = "klv1qqqqqqqqqqqqqpgqswlgqde4tfwp4ucwkh42m6d8a0d49w92sg8shyj6q3"
+	testOtherDestination = "klv1qqqqqqqqqqqqqpgqh46r9zh78lry2py8tq723fpjdr4pp0zgsg8syf6mq0"
+	testCaller           = "0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c"
+)
+
+func createCallData(token string, destination string, caller string) parsers.ProxySCCompleteCallData {
+	callData := parsers.ProxySCCompleteCallData{
+		From:   common.HexToAddress(caller),
+		Token:  token,
+		Amount: big.NewInt(37),
+	}
+	callData.To, _ = address.NewAddress(destination)
+
+	return callData
+}
+
+func TestNewPendingOperationsPrioritizer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		prioritizer, err := NewPendingOperationsPrioritizer(config.PendingOperationsPriorityConfig{}, nil)
+		assert.True(t, check.IfNil(prioritizer))
+		assert.Equal(t, errNilLogger, err)
+	})
+	t.Run("negative priority callers weight should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.PendingOperationsPriorityConfig{
+			PriorityCallersWeight: -1,
+		}
+		prioritizer, err := NewPendingOperationsPrioritizer(cfg, &testsCommon.LoggerStub{})
+		assert.True(t, check.IfNil(prioritizer))
+		assert.ErrorIs(t, err, errNegativeWeight)
+		assert.Contains(t, err.Error(), "PriorityCallersWeight")
+	})
+	t.Run("negative aging weight should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.PendingOperationsPriorityConfig{
+			AgingWeightPerMinute: -1,
+		}
+		prioritizer, err := NewPendingOperationsPrioritizer(cfg, &testsCommon.LoggerStub{})
+		assert.True(t, check.IfNil(prioritizer))
+		assert.ErrorIs(t, err, errNegativeWeight)
+		assert.Contains(t, err.Error(), "AgingWeightPerMinute")
+	})
+	t.Run("empty token should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.PendingOperationsPriorityConfig{
+			TokenWeights: []config.PriorityWeightConfig{{Item: " ", Weight: 1}},
+		}
+		prioritizer, err := NewPendingOperationsPrioritizer(cfg, &testsCommon.LoggerStub{})
+		assert.True(t, check.IfNil(prioritizer))
+		assert.ErrorIs(t, err, errEmptyItem)
+		assert.Contains(t, err.Error(), "on item at index 0 in list TokenWeights")
+	})
+	t.Run("negative token weight should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.PendingOperationsPriorityConfig{
+			TokenWeights: []config.PriorityWeightConfig{{Item: "tkn", Weight: -1}},
+		}
+		prioritizer, err := NewPendingOperationsPrioritizer(cfg, &testsCommon.LoggerStub{})
+		assert.True(t, check.IfNil(prioritizer))
+		assert.ErrorIs(t, err, errNegativeWeight)
+		assert.Contains(t, err.Error(), "in list TokenWeights")
+	})
+	t.Run("invalid destination should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.PendingOperationsPriorityConfig{
+			DestinationWeights: []config.PriorityWeightConfig{{Item: "invalid", Weight: 1}},
+		}
+		prioritizer, err := NewPendingOperationsPrioritizer(cfg, &testsCommon.LoggerStub{})
+		assert.True(t, check.IfNil(prioritizer))
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "on item at index 0 in list DestinationWeights")
+	})
+	t.Run("invalid priority caller should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.PendingOperationsPriorityConfig{
+			PriorityCallers: []string{"3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c"},
+		}
+		prioritizer, err := NewPendingOperationsPrioritizer(cfg, &testsCommon.LoggerStub{})
+		assert.True(t, check.IfNil(prioritizer))
+		assert.ErrorIs(t, err, errMissingEthPrefix)
+		assert.Contains(t, err.Error(), "on item at index 0 in list PriorityCallers")
+	})
+	t.Run("empty config should work", func(t *testing.T) {
+		t.Parallel()
+
+		prioritizer, err := NewPendingOperationsPrioritizer(config.PendingOperationsPriorityConfig{}, &testsCommon.LoggerStub{})
+		assert.False(t, check.IfNil(prioritizer))
+		assert.Nil(t, err)
+	})
+}
+
+func TestPendingOperationsPrioritizer_ComputePriorities(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty config should return zero priorities", func(t *testing.T) {
+		t.Parallel()
+
+		prioritizer, _ := NewPendingOperationsPrioritizer(config.PendingOperationsPriorityConfig{}, &testsCommon.LoggerStub{})
+		priorities := prioritizer.ComputePriorities(map[uint64]parsers.ProxySCCompleteCallData{
+			1: createCallData("tkn", testDestination, testCaller),
+			2: {},
+		})
+
+		assert.Equal(t, map[uint64]int64{1: 0, 2: 0}, priorities)
+	})
+	t.Run("should sum the matching weights", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.PendingOperationsPriorityConfig{
+			TokenWeights: []config.PriorityWeightConfig{
+				{Item: "TKN", Weight: 1},
+			},
+			DestinationWeights: []config.PriorityWeightConfig{
+				{Item: testDestination, Weight: 10},
+			},
+			PriorityCallers:       []string{testCaller},
+			PriorityCallersWeight: 100,
+		}
+		prioritizer, _ := NewPendingOperationsPrioritizer(cfg, &testsCommon.LoggerStub{})
+		priorities := prioritizer.ComputePriorities(map[uint64]parsers.ProxySCCompleteCallData{
+			1: createCallData("tkn", testDestination, testCaller),
+			2: createCallData("tkn", testOtherDestination, "0x0"),
+			3: createCallData("other", testDestination, "0x0"),
+			4: createCallData("other", testOtherDestination, testCaller),
+			5: {},
+		})
+
+		expectedPriorities := map[uint64]int64{
+			1: 111,
+			2: 1,
+			3: 10,
+			4: 100,
+			5: 0,
+		}
+		assert.Equal(t, expectedPriorities, priorities)
+	})
+	t.Run("should increase the priority of the waiting operations", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.PendingOperationsPriorityConfig{
+			AgingWeightPerMinute: 2,
+		}
+		prioritizer, _ := NewPendingOperationsPrioritizer(cfg, &testsCommon.LoggerStub{})
+		currentTime := time.Now()
+		prioritizer.getTimeFunc = func() time.Time {
+			return currentTime
+		}
+
+		priorities := prioritizer.ComputePriorities(map[uint64]parsers.ProxySCCompleteCallData{
+			1: {},
+			2: {},
+		})
+		assert.Equal(t, map[uint64]int64{1: 0, 2: 0}, priorities)
+
+		currentTime = currentTime.Add(time.Minute*3 + time.Second)
+		priorities = prioritizer.ComputePriorities(map[uint64]parsers.ProxySCCompleteCallData{
+			2: {},
+			3: {},
+		})
+		assert.Equal(t, map[uint64]int64{2: 6, 3: 0}, priorities)
+		assert.Equal(t, 2, len(prioritizer.firstSeen))
+
+		// operation 1 was executed, seeing it again should reset its age
+		currentTime = currentTime.Add(time.Minute)
+		priorities = prioritizer.ComputePriorities(map[uint64]parsers.ProxySCCompleteCallData{
+			1: {},
+			2: {},
+			3: {},
+		})
+		assert.Equal(t, map[uint64]int64{1: 0, 2: 8, 3: 2}, priorities)
+	})
+}
@@ -2,9 +2,12 @@ package kc
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"sort"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -15,6 +18,7 @@ import (
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/proxy"
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/proxy/models"
 	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/core"
 	"github.com/klever-io/klv-bridge-eth-go/errors"
 	"github.com/klever-io/klv-bridge-eth-go/parsers"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
@@ -29,6 +33,12 @@ const (
 	transactionNotFoundErrString   = "transaction not found"
 	minGasToExecuteSCCalls         = 2010000 // the absolut minimum gas limit to do a SC call
 	contractMaxGasLimit            = 249999999
+	defaultNumWorkers              = 1
+	maxOperationsResults           = 100
+
+	resultStatusExecuted = "executed"
+	resultStatusFailed   = "failed"
+	resultStatusSkipped  = "skipped"
 )
 
 // ArgsScCallExecutor represents the DTO struct for creating a new instance of type scCallExecutor
@@ -37,6 +47,11 @@ type ArgsScCallExecutor struct {
 	Proxy                           proxy.Proxy
 	Codec                           Codec
 	Filter                          ScCallsExecuteFilter
+	Prioritizer                     ScCallsPrioritizer
+	Coordinator                     ScCallsCoordinator
+	AttemptsTracker                 AttemptsTracker
+	FeeLedger                       FeeLedger
+	StatusHandler                   core.StatusHandler
 	Log                             logger.Logger
 	ExtraGasToExecute               uint64
 	MaxGasLimitToUse                int64
@@ -45,14 +60,47 @@ type ArgsScCallExecutor struct {
 	PrivateKey                      crypto.PrivateKey
 	SingleSigner                    crypto.SingleSigner
 	TransactionChecks               config.TransactionChecksConfig
+	Concurrency                     config.ConcurrencyConfig
 	CloseAppChan                    chan struct{}
 }
 
+type pendingOperation struct {
+	id       uint64
+	callData parsers.ProxySCCompleteCallData
+}
+
+// executionErrorHolder keeps the first error encountered by the execution workers
+type executionErrorHolder struct {
+	mut sync.RWMutex
+	err error
+}
+
+func (holder *executionErrorHolder) set(err error) {
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	if holder.err == nil {
+		holder.err = err
+	}
+}
+
+func (holder *executionErrorHolder) get() error {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	return holder.err
+}
+
 type scCallExecutor struct {
 	scProxyBech32Address            string
 	proxy                           proxy.Proxy
 	codec                           Codec
 	filter                          ScCallsExecuteFilter
+	prioritizer                     ScCallsPrioritizer
+	coordinator                     ScCallsCoordinator
+	attemptsTracker                 AttemptsTracker
+	feeLedger                       FeeLedger
+	statusHandler                   core.StatusHandler
 	log                             logger.Logger
 	extraGasToExecute               uint64
 	maxGasLimitToUse                int64
@@ -66,8 +114,13 @@ type scCallExecutor struct {
 	timeBetweenChecks               time.Duration
 	executionTimeout                time.Duration
 	closeAppOnError                 bool
-	extraDelayOnError               time.Duration
 	closeAppChan                    chan struct{}
+	numWorkers                      int
+	preserveOrderPerDestination     bool
+	sendMutex                       sync.Mutex
+	mutResults                      sync.RWMutex
+	results                         []*core.ScCallOperationResult
+	filteredOutReasons              map[string]struct{}
 }
 
 // NewScCallExecutor creates a new instance of type scCallExecutor
@@ -93,6 +146,11 @@ func NewScCallExecutor(args ArgsScCallExecutor) (*scCallExecutor, error) {
 		proxy:                           args.Proxy,
 		codec:                           args.Codec,
 		filter:                          args.Filter,
+		prioritizer:                     args.Prioritizer,
+		coordinator:                     args.Coordinator,
+		attemptsTracker:                 args.AttemptsTracker,
+		feeLedger:                       args.FeeLedger,
+		statusHandler:                   args.StatusHandler,
 		log:                             args.Log,
 		extraGasToExecute:               args.ExtraGasToExecute,
 		maxGasLimitToUse:                args.MaxGasLimitToUse,
@@ -105,11 +163,23 @@ func NewScCallExecutor(args ArgsScCallExecutor) (*scCallExecutor, error) {
 		timeBetweenChecks:               time.Second * time.Duration(args.TransactionChecks.TimeInSecondsBetweenChecks),
 		executionTimeout:                time.Second * time.Duration(args.TransactionChecks.ExecutionTimeoutInSeconds),
 		closeAppOnError:                 args.TransactionChecks.CloseAppOnError,
-		extraDelayOnError:               time.Second * time.Duration(args.TransactionChecks.ExtraDelayInSecondsOnError),
 		closeAppChan:                    args.CloseAppChan,
+		numWorkers:                      computeNumWorkers(args.Concurrency.NumWorkers),
+		preserveOrderPerDestination:     args.Concurrency.PreserveOrderPerDestination,
+		results:                         make([]*core.ScCallOperationResult, 0, maxOperationsResults),
+		filteredOutReasons:              make(map[string]struct{}),
 	}, nil
 }
 
+// computeNumWorkers treats a missing Concurrency section (0 workers) as the sequential, single worker execution
+func computeNumWorkers(numWorkers uint32) int {
+	if numWorkers == 0 {
+		return defaultNumWorkers
+	}
+
+	return int(numWorkers)
+}
+
 func checkArgs(args ArgsScCallExecutor) error {
 	if check.IfNil(args.Proxy) {
 		return errNilProxy
@@ -120,6 +190,21 @@ func checkArgs(args ArgsScCallExecutor) error {
 	if check.IfNil(args.Filter) {
 		return errNilFilter
 	}
+	if check.IfNil(args.Prioritizer) {
+		return errNilPrioritizer
+	}
+	if check.IfNil(args.Coordinator) {
+		return errNilCoordinator
+	}
+	if check.IfNil(args.AttemptsTracker) {
+		return errNilAttemptsTracker
+	}
+	if check.IfNil(args.FeeLedger) {
+		return errNilFeeLedger
+	}
+	if check.IfNil(args.StatusHandler) {
+		return errNilStatusHandler
+	}
 	if check.IfNil(args.Log) {
 		return errNilLogger
 	}
@@ -176,9 +261,19 @@ func (executor *scCallExecutor) Execute(ctx context.Context) error {
 		return err
 	}
 
+	pendingIDs := make([]uint64, 0, len(pendingOperations))
+	for id := range pendingOperations {
+		pendingIDs = append(pendingIDs, id)
+	}
+	executor.attemptsTracker.Prune(pendingIDs)
+	executor.coordinator.UpdatePendingOperations(ctx, pendingIDs)
+	executor.statusHandler.SetIntMetric(core.MetricScCallsNumPendingOperations, len(pendingOperations))
+	executor.updateFeesMetrics()
+
 	filteredPendingOperations := executor.filterOperations(pendingOperations)
+	assignedPendingOperations := executor.selectAssignedOperations(filteredPendingOperations)
 
-	return executor.executeOperations(ctx, filteredPendingOperations)
+	return executor.executeOperations(ctx, assignedPendingOperations)
 }
 
 func (executor *scCallExecutor) getPendingOperations(ctx context.Context) (map[uint64]parsers.ProxySCCompleteCallData, error) {
@@ -229,13 +324,53 @@ func (executor *scCallExecutor) parseResponse(response *models.VmValuesResponseD
 
 func (executor *scCallExecutor) filterOperations(pendingOperations map[uint64]parsers.ProxySCCompleteCallData) map[uint64]parsers.ProxySCCompleteCallData {
 	result := make(map[uint64]parsers.ProxySCCompleteCallData)
+	numFilteredOutByReason := make(map[string]int)
 	for id, callData := range pendingOperations {
-		if executor.filter.ShouldExecute(callData) {
+		shouldExecute, reason := executor.filter.ShouldExecute(callData)
+		if shouldExecute {
 			result[id] = callData
+			continue
 		}
+
+		numFilteredOutByReason[reason]++
 	}
 
 	executor.log.Debug("scCallExecutor.filterOperations", "input pending ops", len(pendingOperations), "result pending ops", len(result))
+	executor.statusHandler.SetIntMetric(core.MetricScCallsNumFilteredOut, len(pendingOperations)-len(result))
+	executor.setFilteredOutMetrics(numFilteredOutByReason)
+
+	return result
+}
+
+// setFilteredOutMetrics stores one counter for each filter-out reason. The reasons reported in a previous round that
+// no longer filter out anything are reset to 0
+func (executor *scCallExecutor) setFilteredOutMetrics(numFilteredOutByReason map[string]int) {
+	for reason := range executor.filteredOutReasons {
+		_, found := numFilteredOutByReason[reason]
+		if !found {
+			executor.statusHandler.SetIntMetric(core.MetricScCallsNumFilteredOutByReasonPrefix+reason, 0)
+		}
+	}
+
+	for reason, numFilteredOut := range numFilteredOutByReason {
+		executor.log.Debug("scCallExecutor.filterOperations", "reason", reason, "num filtered out", numFilteredOut)
+		executor.statusHandler.SetIntMetric(core.MetricScCallsNumFilteredOutByReasonPrefix+reason, numFilteredOut)
+		executor.filteredOutReasons[reason] = struct{}{}
+	}
+}
+
+// selectAssignedOperations keeps only the operations this executor instance is responsible for, when several
+// instances are coordinated
+func (executor *scCallExecutor) selectAssignedOperations(pendingOperations map[uint64]parsers.ProxySCCompleteCallData) map[uint64]parsers.ProxySCCompleteCallData {
+	result := make(map[uint64]parsers.ProxySCCompleteCallData)
+	for id, callData := range pendingOperations {
+		if executor.coordinator.ShouldExecute(id) {
+			result[id] = callData
+		}
+	}
+
+	executor.log.Debug("scCallExecutor.selectAssignedOperations", "input pending ops", len(pendingOperations), "result pending ops", len(result))
+	executor.statusHandler.SetIntMetric(core.MetricScCallsNumAssignedToOtherExecutors, len(pendingOperations)-len(result))
 
 	return result
 }
@@ -246,20 +381,153 @@ func (executor *scCallExecutor) executeOperations(ctx context.Context, pendingOp
 		return fmt.Errorf("%w while fetching network configs", err)
 	}
 
-	for id, callData := range pendingOperations {
+	queues := executor.createExecutionQueues(pendingOperations)
+	queuesChan := make(chan []pendingOperation, len(queues))
+	for _, queue := range queues {
+		queuesChan <- queue
+	}
+	close(queuesChan)
+
+	numWorkers := executor.numWorkers
+	if numWorkers > len(queues) {
+		numWorkers = len(queues)
+	}
+
+	errHolder := &executionErrorHolder{}
+	numSkippedByRetryPolicy := int32(0)
+	numSkippedByFeeBudget := int32(0)
+	wg := sync.WaitGroup{}
+	wg.Add(numWorkers)
+	for i := 0; i < numWorkers; i++ {
+		go func() {
+			defer wg.Done()
+
+			for queue := range queuesChan {
+				executor.executeQueue(ctx, queue, networkConfig, errHolder, &numSkippedByRetryPolicy, &numSkippedByFeeBudget)
+			}
+		}()
+	}
+	wg.Wait()
+
+	executor.statusHandler.SetIntMetric(core.MetricScCallsNumSkippedByRetryPolicy, int(atomic.LoadInt32(&numSkippedByRetryPolicy)))
+	executor.statusHandler.SetIntMetric(core.MetricScCallsNumSkippedByFeeBudget, int(atomic.LoadInt32(&numSkippedByFeeBudget)))
+
+	return errHolder.get()
+}
+
+// createExecutionQueues splits the pending operations in queues that can be executed in parallel. The queues are
+// ordered by priority, the operations with the same priority being ordered by ID. The operations from the same
+// queue are executed one after another
+func (executor *scCallExecutor) createExecutionQueues(pendingOperations map[uint64]parsers.ProxySCCompleteCallData) [][]pendingOperation {
+	priorities := executor.prioritizer.ComputePriorities(pendingOperations)
+
+	ids := make([]uint64, 0, len(pendingOperations))
+	for id := range pendingOperations {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if priorities[ids[i]] != priorities[ids[j]] {
+			return priorities[ids[i]] > priorities[ids[j]]
+		}
+
+		return ids[i] < ids[j]
+	})
+
+	queues := make([][]pendingOperation, 0, len(ids))
+	queueIndexes := make(map[string]int)
+	for _, id := range ids {
+		operation := pendingOperation{
+			id:       id,
+			callData: pendingOperations[id],
+		}
+
+		if !executor.preserveOrderPerDestination || check.IfNil(operation.callData.To) {
+			queues = append(queues, []pendingOperation{operation})
+			continue
+		}
+
+		destination := operation.callData.To.Bech32()
+		index, found := queueIndexes[destination]
+		if !found {
+			queueIndexes[destination] = len(queues)
+			queues = append(queues, []pendingOperation{operation})
+			continue
+		}
+
+		queues[index] = append(queues[index], operation)
+	}
+
+	if executor.preserveOrderPerDestination {
+		// the priority of a queue is given by its most important operation, but the operations towards
+		// the same destination are always executed in ascending ID order
+		for _, queue := range queues {
+			sort.Slice(queue, func(i, j int) bool {
+				return queue[i].id < queue[j].id
+			})
+		}
+	}
+
+	executor.log.Debug("scCallExecutor.createExecutionQueues", "pending ops", len(ids), "queues", len(queues))
+
+	return queues
+}
+
+func (executor *scCallExecutor) executeQueue(
+	ctx context.Context,
+	queue []pendingOperation,
+	networkConfig *models.NetworkConfig,
+	errHolder *executionErrorHolder,
+	numSkippedByRetryPolicy *int32,
+	numSkippedByFeeBudget *int32,
+) {
+	for index, operation := range queue {
+		if !executor.feeLedger.CanSpend() {
+			executor.log.Debug("scCallExecutor.executeQueue: the daily fee budget is exceeded",
+				"ID", operation.id, "remaining operations in queue", len(queue)-index-1)
+			atomic.AddInt32(numSkippedByFeeBudget, int32(len(queue)-index))
+			return
+		}
+		if executor.attemptsTracker.IsDeadLetter(operation.id) {
+			// a dead letter waits for a manual resolution, so it should not block the operations queued after it
+			executor.log.Debug("scCallExecutor.executeQueue: operation is a dead letter", "ID", operation.id)
+			atomic.AddInt32(numSkippedByRetryPolicy, 1)
+			continue
+		}
+		if !executor.attemptsTracker.CanExecute(operation.id) {
+			// the remaining operations from the queue should wait for this one, if the order is preserved
+			executor.log.Debug("scCallExecutor.executeQueue: operation is waiting for retry",
+				"ID", operation.id, "remaining operations in queue", len(queue)-index-1)
+			atomic.AddInt32(numSkippedByRetryPolicy, int32(len(queue)-index))
+			return
+		}
+
 		workingCtx, cancel := context.WithTimeout(ctx, executor.executionTimeout)
 
-		executor.log.Debug("scCallExecutor.executeOperations", "executing ID", id, "call data", callData,
+		executor.log.Debug("scCallExecutor.executeOperations", "executing ID", operation.id, "call data", operation.callData,
 			"maximum timeout", executor.executionTimeout)
-		err = executor.executeOperation(workingCtx, id, callData, networkConfig)
+		hash, fee, err := executor.executeOperation(workingCtx, operation.id, operation.callData, networkConfig)
 		cancel()
 
+		if ctx.Err() != nil {
+			// the application is closing, the execution result is not relevant
+			return
+		}
+		if err == errFeeBudgetExceeded {
+			executor.log.Debug("scCallExecutor.executeQueue: the daily fee budget is reserved by the executions in progress",
+				"ID", operation.id, "remaining operations in queue", len(queue)-index-1)
+			atomic.AddInt32(numSkippedByFeeBudget, int32(len(queue)-index))
+			return
+		}
+
+		executor.recordResult(operation.id, hash, fee, err)
 		if err != nil {
-			return fmt.Errorf("%w for call data: %s", err, callData)
+			executor.attemptsTracker.RecordFailure(operation.id, err)
+			errHolder.set(fmt.Errorf("%w for call data: %s", err, operation.callData))
+			return
 		}
-	}
 
-	return nil
+		executor.attemptsTracker.RecordSuccess(operation.id)
+	}
 }
 
 func (executor *scCallExecutor) executeOperation(
@@ -267,24 +535,106 @@ func (executor *scCallExecutor) executeOperation(
 	id uint64,
 	callData parsers.ProxySCCompleteCallData,
 	networkConfig *models.NetworkConfig,
-) error {
+) (string, int64, error) {
+	hash, estimatedFee, err := executor.sendExecuteTransaction(ctx, id, callData, networkConfig)
+	if err != nil {
+		return "", 0, err
+	}
+	if len(hash) == 0 {
+		// execution skipped
+		return "", 0, nil
+	}
+	// the reservation is released only after the actual fee was recorded
+	defer executor.feeLedger.Release(estimatedFee)
+
+	fee, err := executor.handleResults(ctx, hash)
+	if !executor.checkTransactionResults {
+		// the execution results are not fetched, so the estimated fee is accounted instead
+		fee = estimatedFee
+	}
+	executor.recordFee(callData, fee)
+
+	return hash, fee, err
+}
+
+func (executor *scCallExecutor) recordFee(callData parsers.ProxySCCompleteCallData, fee int64) {
+	if fee <= 0 {
+		return
+	}
+
+	destination := ""
+	if !check.IfNil(callData.To) {
+		destination = callData.To.Bech32()
+	}
+
+	executor.feeLedger.RecordFee(callData.Token, destination, fee)
+	executor.updateFeesMetrics()
+}
+
+func (executor *scCallExecutor) updateFeesMetrics() {
+	feesLedger := executor.feeLedger.GetFeesLedger()
+	executor.statusHandler.SetIntMetric(core.MetricScCallsTotalFees, int(feesLedger.TotalFees))
+	executor.statusHandler.SetIntMetric(core.MetricScCallsCurrentDayFees, int(feesLedger.CurrentDayFees))
+}
+
+func (executor *scCallExecutor) recordResult(id uint64, hash string, fee int64, err error) {
+	result := &core.ScCallOperationResult{
+		ID:        id,
+		TxHash:    hash,
+		Status:    resultStatusExecuted,
+		Fee:       fee,
+		Timestamp: time.Now().Unix(),
+	}
+
+	switch {
+	case err != nil:
+		result.Status = resultStatusFailed
+		result.Error = err.Error()
+		executor.statusHandler.AddIntMetric(core.MetricScCallsNumFailedExecutions, 1)
+	case len(hash) == 0:
+		result.Status = resultStatusSkipped
+	default:
+		executor.statusHandler.AddIntMetric(core.MetricScCallsNumSuccessfulExecutions, 1)
+		executor.statusHandler.SetIntMetric(core.MetricScCallsLastExecutedID, int(id))
+	}
+
+	executor.mutResults.Lock()
+	defer executor.mutResults.Unlock()
+
+	if len(executor.results) == maxOperationsResults {
+		executor.results = executor.results[1:]
+	}
+	executor.results = append(executor.results, result)
+}
+
+// sendExecuteTransaction sends the execute transaction for the provided operation and returns its hash. The transactions
+// are sent one at a time so that the nonces applied by the nonce handler are consecutive
+func (executor *scCallExecutor) sendExecuteTransaction(
+	ctx context.Context,
+	id uint64,
+	callData parsers.ProxySCCompleteCallData,
+	networkConfig *models.NetworkConfig,
+) (string, int64, error) {
+	executor.sendMutex.Lock()
+	defer executor.sendMutex.Unlock()
+
 	txBuilder := builders.NewTxDataBuilder()
 	txBuilder.Function(scProxyCallFunction).ArgInt64(int64(id))
 
 	dataBytes, err := txBuilder.ToDataBytes()
 	if err != nil {
-		return err
+		return "", 0, err
 	}
 
 	receiverAddr, err := address.NewAddress(executor.scProxyBech32Address)
 	if err != nil {
-		return err
+		return "", 0, err
 	}
 
 	tx := transaction.NewBaseTransaction(executor.senderAddress.Bytes(), 0, [][]byte{dataBytes}, 0, 0)
 	err = tx.SetChainID([]byte(networkConfig.ChainID))
 	if err != nil {
-		return err
+		return "", 0, err
 	}
 
 	contractRequest := &transaction.SmartContract{
@@ -294,16 +644,17 @@ func (executor *scCallExecutor) executeOperation(
 
 	err = tx.PushContract(transaction.TXContract_SmartContractType, contractRequest)
 	if err != nil {
-		return err
+		return "", 0, err
 	}
 
 	err = executor.nonceTxHandler.ApplyNonceAndGasPrice(ctx, executor.senderAddress, tx)
 	if err != nil {
-		return err
+		return "", 0, err
 	}
 
 	to := callData.To.Bech32()
-	if tx.GetBandwidthFee() > contractMaxGasLimit {
+	isOutOfGasTransaction := tx.GetBandwidthFee() > contractMaxGasLimit
+	if isOutOfGasTransaction {
 		// the contract will refund this transaction, so we will use less gas to preserve funds
 		executor.log.Warn("setting a lower gas limit for this transaction because it will be refunded",
 			"computed gas limit", tx.GasLimit,
@@ -330,22 +681,31 @@ func (executor *scCallExecutor) executeOperation(
 			"amount", callData.Amount,
 			"nonce", callData.Nonce,
 		)
+		executor.statusHandler.AddIntMetric(core.MetricScCallsNumSkippedByGasLimit, 1)
 
-		return nil
+		return "", 0, nil
+	}
+
+	// the fee is reserved while the sending is serialized, so the concurrent executions can not overshoot the budget
+	estimatedFee := tx.GetBandwidthFee() + tx.GetKAppFee()
+	if !executor.feeLedger.TryReserve(estimatedFee) {
+		return "", 0, errFeeBudgetExceeded
 	}
 
 	err = executor.signTransactionWithPrivateKey(tx)
 	if err != nil {
-		return err
+		executor.feeLedger.Release(estimatedFee)
+		return "", 0, err
 	}
 
 	hash, err := executor.nonceTxHandler.SendTransaction(ctx, tx)
 	if err != nil {
-		return err
+		executor.feeLedger.Release(estimatedFee)
+		return "", 0, err
 	}
 
 	executor.log.Info("scCallExecutor.executeOperation: sent transaction from executor",
-		"hash", hash,
+		"tx hash", hash,
 		"tx ID", id,
 		"call data", callData.String(),
 		"extra gas", executor.extraGasToExecute,
@@ -353,18 +713,21 @@ func (executor *scCallExecutor) executeOperation(
 		"to", to)
 
 	atomic.AddUint32(&executor.numSentTransactions, 1)
+	if isOutOfGasTransaction {
+		executor.statusHandler.AddIntMetric(core.MetricScCallsNumOutOfGasExecutions, 1)
+	}
 
-	return executor.handleResults(ctx, hash)
+	return hash, estimatedFee, nil
 }
 
-func (executor *scCallExecutor) handleResults(ctx context.Context, hash string) error {
+// handleResults waits for the transaction to be executed and returns the fee spent. The fee is not known if the
+// transaction results are not checked
+func (executor *scCallExecutor) handleResults(ctx context.Context, hash string) (int64, error) {
 	if !executor.checkTransactionResults {
-		return nil
+		return 0, nil
 	}
 
-	err := executor.checkResultsUntilDone(ctx, hash)
-	executor.waitForExtraDelay(ctx, err)
-	return err
+	return executor.checkResultsUntilDone(ctx, hash)
 }
 
 // signTransactionWithPrivateKey signs a transaction with the client's private key
@@ -385,7 +748,7 @@ func (executor *scCallExecutor) signTransactionWithPrivateKey(tx *transaction.Tr
 	return nil
 }
 
-func (executor *scCallExecutor) checkResultsUntilDone(ctx context.Context, hash string) error {
+func (executor *scCallExecutor) checkResultsUntilDone(ctx context.Context, hash string) (int64, error) {
 	timer := time.NewTimer(executor.timeBetweenChecks)
 	defer timer.Stop()
 
@@ -394,33 +757,43 @@ func (executor *scCallExecutor) checkResultsUntilDone(ctx context.Context, hash
 
 		select {
 		case <-ctx.Done():
-			return ctx.Err()
+			return 0, ctx.Err()
 		case <-timer.C:
-			err, shouldStop := executor.checkResults(ctx, hash)
+			fee, err, shouldStop := executor.checkResults(ctx, hash)
 			if shouldStop {
 				executor.handleError(ctx, err)
-				return err
+				return fee, err
 			}
 		}
 	}
 }
 
-func (executor *scCallExecutor) checkResults(ctx context.Context, hash string) (error, bool) {
+func (executor *scCallExecutor) checkResults(ctx context.Context, hash string) (int64, error, bool) {
 	txResult, err := executor.proxy.GetTransactionInfoWithResults(ctx, hash)
 	if err != nil {
 		if err.Error() == transactionNotFoundErrString {
-			return nil, false
+			return 0, nil, false
 		}
 
-		return err, true
+		return 0, err, true
 	}
 
+	// the fee is paid even if the execution failed
+	fee := computeTransactionFee(txResult)
 	if txResult.Status == transaction.Transaction_SUCCESS.String() {
-		return nil, true
+		return fee, nil, true
 	}
 
 	executor.logFullTransaction(ctx, txResult)
-	return fmt.Errorf("%w for tx hash %s", errTransactionFailed, hash), true
+	return fee, fmt.Errorf("%w for tx hash %s", errTransactionFailed, hash), true
+}
+
+func computeTransactionFee(txResult *models.TransactionData) int64 {
+	if txResult == nil || txResult.Transaction == nil {
+		return 0
+	}
+
+	return txResult.KAppFee + txResult.BandwidthFee
 }
 
 func (executor *scCallExecutor) handleError(ctx context.Context, err error) {
@@ -448,19 +821,58 @@ func (executor *scCallExecutor) logFullTransaction(ctx context.Context, txData *
 		return
 	}
 
-	executor.log.Error("transaction failed", "hash", txData.Hash, "full transaction details", string(txDataString))
+	executor.log.Error("transaction failed", "tx hash", txData.Hash, "full transaction details", string(txDataString))
 }
 
-func (executor *scCallExecutor) waitForExtraDelay(ctx context.Context, err error) {
-	if err == nil {
-		return
+// GetPendingOperations returns the decoded pending operations, in ascending ID order
+func (executor *scCallExecutor) GetPendingOperations(ctx context.Context) ([]*core.ScCallPendingOperation, error) {
+	pendingOperations, err := executor.getPendingOperations(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	timer := time.NewTimer(executor.extraDelayOnError)
-	select {
-	case <-ctx.Done():
-	case <-timer.C:
+	result := make([]*core.ScCallPendingOperation, 0, len(pendingOperations))
+	for id, callData := range pendingOperations {
+		operation := &core.ScCallPendingOperation{
+			ID:          id,
+			From:        callData.From.Hex(),
+			Token:       callData.Token,
+			Nonce:       callData.Nonce,
+			RawCallData: hex.EncodeToString(callData.RawCallData),
+		}
+		if !check.IfNil(callData.To) {
+			operation.To = callData.To.Bech32()
+		}
+		if callData.Amount != nil {
+			operation.Amount = callData.Amount.String()
+		}
+		operation.GasLimit, err = executor.codec.ExtractGasLimitFromRawCallData(callData.RawCallData)
+		if err != nil {
+			executor.log.Debug("scCallExecutor.GetPendingOperations: can not extract the gas limit", "ID", id, "error", err)
+		}
+
+		result = append(result, operation)
 	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result, nil
+}
+
+// GetOperationsResults returns the results of the latest executed operations, the most recent being the last
+func (executor *scCallExecutor) GetOperationsResults() []*core.ScCallOperationResult {
+	executor.mutResults.RLock()
+	defer executor.mutResults.RUnlock()
+
+	result := make([]*core.ScCallOperationResult, 0, len(executor.results))
+	for _, operationResult := range executor.results {
+		resultCopy := *operationResult
+		result = append(result, &resultCopy)
+	}
+
+	return result
 }
 
 // GetNumSentTransaction returns the total sent transactions
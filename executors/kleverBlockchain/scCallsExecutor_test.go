@@ -3,8 +3,10 @@ package kc
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"errors"
 	"math/big"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -16,6 +18,8 @@ import (
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/proxy/models"
 	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/executors/kleverBlockchain/fees"
 	"github.com/klever-io/klv-bridge-eth-go/parsers"
 	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
 	testCrypto "github.com/klever-io/klv-bridge-eth-go/testsCommon/crypto"
@@ -33,6 +37,11 @@ func createMockArgsScCallExecutor() ArgsScCallExecutor {
 		Proxy:                           &interactors.ProxyStub{},
 		Codec:                           &testsCommon.KCCodecStub{},
 		Filter:                          &testsCommon.ScCallsExecuteFilterStub{},
+		Prioritizer:                     &testsCommon.ScCallsPrioritizerStub{},
+		Coordinator:                     &testsCommon.ScCallsCoordinatorStub{},
+		AttemptsTracker:                 &testsCommon.AttemptsTrackerStub{},
+		FeeLedger:                       &testsCommon.FeeLedgerStub{},
+		StatusHandler:                   testsCommon.NewStatusHandlerMock("test"),
 		Log:                             &testsCommon.LoggerStub{},
 		ExtraGasToExecute:               100,
 		MaxGasLimitToUse:                minGasToExecuteSCCalls,
@@ -41,6 +50,9 @@ func createMockArgsScCallExecutor() ArgsScCallExecutor {
 		PrivateKey:                      testCrypto.NewPrivateKeyMock(),
 		SingleSigner:                    &testCrypto.SingleSignerStub{},
 		CloseAppChan:                    make(chan struct{}),
+		Concurrency: config.ConcurrencyConfig{
+			NumWorkers: 1,
+		},
 	}
 }
 
@@ -50,7 +62,6 @@ func createMockCheckConfigs() config.TransactionChecksConfig {
 		TimeInSecondsBetweenChecks: 6,
 		ExecutionTimeoutInSeconds:  120,
 		CloseAppOnError:            true,
-		ExtraDelayInSecondsOnError: 120,
 	}
 }
 
@@ -106,6 +117,56 @@ func TestNewScCallExecutor(t *testing.T) {
 		assert.Nil(t, executor)
 		assert.Equal(t, errNilFilter, err)
 	})
+	t.Run("nil prioritizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.Prioritizer = nil
+
+		executor, err := NewScCallExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilPrioritizer, err)
+	})
+	t.Run("nil coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.Coordinator = nil
+
+		executor, err := NewScCallExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilCoordinator, err)
+	})
+	t.Run("nil attempts tracker should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.AttemptsTracker = nil
+
+		executor, err := NewScCallExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilAttemptsTracker, err)
+	})
+	t.Run("nil fee ledger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.FeeLedger = nil
+
+		executor, err := NewScCallExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilFeeLedger, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.StatusHandler = nil
+
+		executor, err := NewScCallExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilStatusHandler, err)
+	})
 	t.Run("nil logger should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -218,6 +279,17 @@ func TestNewScCallExecutor(t *testing.T) {
 		assert.Contains(t, err.Error(), "provided: 2009999, absolute minimum required: 2010000")
 		assert.Contains(t, err.Error(), "GasLimitForOutOfGasTransactions")
 	})
+	t.Run("zero Concurrency.NumWorkers should default to one worker", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.Concurrency.NumWorkers = 0
+
+		executor, err := NewScCallExecutor(args)
+		assert.NotNil(t, executor)
+		assert.Nil(t, err)
+		assert.Equal(t, defaultNumWorkers, executor.numWorkers)
+	})
 	t.Run("should work without transaction checks", func(t *testing.T) {
 		t.Parallel()
 
@@ -611,8 +683,8 @@ func TestScCallExecutor_Execute(t *testing.T) {
 			},
 		}
 		args.Filter = &testsCommon.ScCallsExecuteFilterStub{
-			ShouldExecuteCalled: func(callData parsers.ProxySCCompleteCallData) bool {
-				return callData.Token == "tkn2"
+			ShouldExecuteCalled: func(callData parsers.ProxySCCompleteCallData) (bool, string) {
+				return callData.Token == "tkn2", "denied token"
 			},
 		}
 		args.NonceTxHandler = &testsCommon.TxNonceHandlerV2Stub{
@@ -653,6 +725,9 @@ func TestScCallExecutor_Execute(t *testing.T) {
 			},
 		}
 
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+
 		executor, _ := NewScCallExecutor(args)
 
 		err := executor.Execute(context.Background())
@@ -660,6 +735,20 @@ func TestScCallExecutor_Execute(t *testing.T) {
 		assert.True(t, sendWasCalled)
 		assert.Equal(t, uint32(1), executor.GetNumSentTransaction())
 		assert.True(t, processTransactionStatusCalled)
+
+		assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricScCallsNumPendingOperations))
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricScCallsNumFilteredOut))
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricScCallsNumFilteredOutByReasonPrefix+"denied token"))
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricScCallsNumSuccessfulExecutions))
+		assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricScCallsNumFailedExecutions))
+		assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricScCallsLastExecutedID))
+
+		results := executor.GetOperationsResults()
+		require.Len(t, results, 1)
+		assert.Equal(t, uint64(2), results[0].ID)
+		assert.Equal(t, txHash, results[0].TxHash)
+		assert.Equal(t, resultStatusExecuted, results[0].Status)
+		assert.Empty(t, results[0].Error)
 	})
 	t.Run("should work if the gas limit is above the contract threshold", func(t *testing.T) {
 		t.Parallel()
@@ -708,8 +797,8 @@ func TestScCallExecutor_Execute(t *testing.T) {
 			},
 		}
 		args.Filter = &testsCommon.ScCallsExecuteFilterStub{
-			ShouldExecuteCalled: func(callData parsers.ProxySCCompleteCallData) bool {
-				return callData.Token == "tkn2"
+			ShouldExecuteCalled: func(callData parsers.ProxySCCompleteCallData) (bool, string) {
+				return callData.Token == "tkn2", "denied token"
 			},
 		}
 		args.NonceTxHandler = &testsCommon.TxNonceHandlerV2Stub{
@@ -798,8 +887,8 @@ func TestScCallExecutor_Execute(t *testing.T) {
 			},
 		}
 		args.Filter = &testsCommon.ScCallsExecuteFilterStub{
-			ShouldExecuteCalled: func(callData parsers.ProxySCCompleteCallData) bool {
-				return callData.Token == "tkn2"
+			ShouldExecuteCalled: func(callData parsers.ProxySCCompleteCallData) (bool, string) {
+				return callData.Token == "tkn2", "denied token"
 			},
 		}
 		args.NonceTxHandler = &testsCommon.TxNonceHandlerV2Stub{
@@ -827,6 +916,667 @@ func TestScCallExecutor_Execute(t *testing.T) {
 	})
 }
 
+func TestScCallExecutor_ConcurrentExecution(t *testing.T) {
+	t.Parallel()
+
+	destinations := []string{
+		"klv1qqqqqqqqqqqqqpgqswlgqde4tfwp4ucwkh42m6d8a0d49w92sg8shyj6q3",
+		"klv1qqqqqqqqqqqqqpgqh46r9zh78lry2py8tq723fpjdr4pp0zgsg8syf6mq0",
+		"klv1qqqqqqqqqqqqqpgqxjgmvqe9kvvr4xvvxflue3a7cjjeyvx9sg8snh0ljc",
+	}
+
+	// each pending operation with ID i+1 will call the destination at destinationIndexes[i]
+	createArgs := func(destinationIndexes ...int) ArgsScCallExecutor {
+		args := createMockArgsScCallExecutor()
+		args.MaxGasLimitToUse = 250000000
+		args.TransactionChecks = createMockCheckConfigs()
+		args.TransactionChecks.TimeInSecondsBetweenChecks = 1
+		args.TransactionChecks.ExecutionTimeoutInSeconds = 10
+		args.TransactionChecks.CloseAppOnError = false
+		args.Concurrency.NumWorkers = 3
+
+		returnData := make([][]byte, 0, len(destinationIndexes)*2)
+		for i, index := range destinationIndexes {
+			returnData = append(returnData, []byte{byte(i + 1)}, []byte(destinations[index]))
+		}
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *models.VmValueRequest) (*models.VmValuesResponseData, error) {
+				return &models.VmValuesResponseData{
+					Data: &vm.VMOutputApi{
+						ReturnCode: okCodeAfterExecution,
+						ReturnData: returnData,
+					},
+				}, nil
+			},
+			GetNetworkConfigCalled: func(ctx context.Context) (*models.NetworkConfig, error) {
+				return &models.NetworkConfig{
+					ChainID: "TEST",
+				}, nil
+			},
+		}
+		args.Codec = &testsCommon.KCCodecStub{
+			DecodeProxySCCompleteCallDataCalled: func(buff []byte) (parsers.ProxySCCompleteCallData, error) {
+				callData := createTestProxySCCompleteCallData("tkn")
+				callData.To, _ = address.NewAddress(string(buff))
+
+				return callData, nil
+			},
+		}
+		args.SingleSigner = &testCrypto.SingleSignerStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+				return []byte("sig"), nil
+			},
+		}
+
+		return args
+	}
+
+	createNonceHandler := func(onSend func(hash string, nonce uint64)) *testsCommon.TxNonceHandlerV2Stub {
+		nonceCounter := uint64(100)
+		return &testsCommon.TxNonceHandlerV2Stub{
+			ApplyNonceAndGasPriceCalled: func(ctx context.Context, address address.Address, tx *transaction.Transaction) error {
+				tx.RawData.Nonce = nonceCounter
+				tx.RawData.BandwidthFee = 5000000
+				nonceCounter++
+				return nil
+			},
+			SendTransactionCalled: func(ctx context.Context, tx *transaction.Transaction) (string, error) {
+				// the data field is unique for each operation, so it is used as hash
+				hash := string(tx.GetData()[0])
+				onSend(hash, tx.GetRawData().Nonce)
+
+				return hash, nil
+			},
+		}
+	}
+
+	t.Run("should execute the operations in parallel with consecutive nonces", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs(0, 1, 2)
+		mut := sync.Mutex{}
+		sentNonces := make([]uint64, 0)
+		args.NonceTxHandler = createNonceHandler(func(hash string, nonce uint64) {
+			mut.Lock()
+			sentNonces = append(sentNonces, nonce)
+			mut.Unlock()
+		})
+		proxy := args.Proxy.(*interactors.ProxyStub)
+		proxy.GetTransactionInfoWithResultsCalled = func(ctx context.Context, hexTxHash string) (*models.TransactionData, error) {
+			mut.Lock()
+			numSent := len(sentNonces)
+			mut.Unlock()
+
+			// a sequential execution would not finish until the execution timeout
+			if numSent < 3 {
+				return nil, errors.New(transactionNotFoundErrString)
+			}
+
+			return &models.TransactionData{
+				Transaction: &data.Transaction{
+					Status: transaction.Transaction_SUCCESS.String(),
+				},
+			}, nil
+		}
+
+		executor, _ := NewScCallExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(3), executor.GetNumSentTransaction())
+		assert.ElementsMatch(t, []uint64{100, 101, 102}, sentNonces)
+	})
+	t.Run("should preserve the order per destination", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs(0, 0, 1)
+		args.Concurrency.PreserveOrderPerDestination = true
+		mut := sync.Mutex{}
+		events := make([]string, 0)
+		args.NonceTxHandler = createNonceHandler(func(hash string, nonce uint64) {
+			mut.Lock()
+			events = append(events, "sent "+hash)
+			mut.Unlock()
+		})
+		proxy := args.Proxy.(*interactors.ProxyStub)
+		proxy.GetTransactionInfoWithResultsCalled = func(ctx context.Context, hexTxHash string) (*models.TransactionData, error) {
+			mut.Lock()
+			events = append(events, "done "+hexTxHash)
+			mut.Unlock()
+
+			return &models.TransactionData{
+				Transaction: &data.Transaction{
+					Status: transaction.Transaction_SUCCESS.String(),
+				},
+			}, nil
+		}
+
+		executor, _ := NewScCallExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(3), executor.GetNumSentTransaction())
+
+		indexOf := func(event string) int {
+			for i, e := range events {
+				if e == event {
+					return i
+				}
+			}
+
+			return -1
+		}
+		assert.Less(t, indexOf("done "+scProxyCallFunction+"@01"), indexOf("sent "+scProxyCallFunction+"@02"))
+		assert.NotEqual(t, -1, indexOf("done "+scProxyCallFunction+"@03"))
+	})
+	t.Run("failed execution should stop the destination queue and return the error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs(0, 0)
+		args.Concurrency.PreserveOrderPerDestination = true
+		sentHashes := make([]string, 0)
+		args.NonceTxHandler = createNonceHandler(func(hash string, nonce uint64) {
+			sentHashes = append(sentHashes, hash)
+		})
+		proxy := args.Proxy.(*interactors.ProxyStub)
+		proxy.GetTransactionInfoWithResultsCalled = func(ctx context.Context, hexTxHash string) (*models.TransactionData, error) {
+			return &models.TransactionData{
+				Transaction: &data.Transaction{
+					Status: transaction.Transaction_FAILED.String(),
+				},
+			}, nil
+		}
+
+		executor, _ := NewScCallExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.ErrorIs(t, err, errTransactionFailed)
+		assert.Equal(t, []string{scProxyCallFunction + "@01"}, sentHashes)
+	})
+	t.Run("failed execution should be recorded and should not block the other destinations", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs(0, 1)
+		args.Concurrency.PreserveOrderPerDestination = true
+		mut := sync.Mutex{}
+		failedIDs := make([]uint64, 0)
+		succeededIDs := make([]uint64, 0)
+		var prunedIDs []uint64
+		args.AttemptsTracker = &testsCommon.AttemptsTrackerStub{
+			RecordFailureCalled: func(id uint64, err error) {
+				assert.ErrorIs(t, err, errTransactionFailed)
+
+				mut.Lock()
+				failedIDs = append(failedIDs, id)
+				mut.Unlock()
+			},
+			RecordSuccessCalled: func(id uint64) {
+				mut.Lock()
+				succeededIDs = append(succeededIDs, id)
+				mut.Unlock()
+			},
+			PruneCalled: func(pendingIDs []uint64) {
+				prunedIDs = pendingIDs
+			},
+		}
+		args.NonceTxHandler = createNonceHandler(func(hash string, nonce uint64) {})
+		proxy := args.Proxy.(*interactors.ProxyStub)
+		proxy.GetTransactionInfoWithResultsCalled = func(ctx context.Context, hexTxHash string) (*models.TransactionData, error) {
+			status := transaction.Transaction_SUCCESS
+			if hexTxHash == scProxyCallFunction+"@01" {
+				status = transaction.Transaction_FAILED
+			}
+
+			return &models.TransactionData{
+				Transaction: &data.Transaction{
+					Status: status.String(),
+				},
+			}, nil
+		}
+
+		executor, _ := NewScCallExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.ErrorIs(t, err, errTransactionFailed)
+		assert.Equal(t, uint32(2), executor.GetNumSentTransaction())
+		assert.Equal(t, []uint64{1}, failedIDs)
+		assert.Equal(t, []uint64{2}, succeededIDs)
+		assert.ElementsMatch(t, []uint64{1, 2}, prunedIDs)
+
+		statusHandler := args.StatusHandler.(*testsCommon.StatusHandlerMock)
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricScCallsNumFailedExecutions))
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricScCallsNumSuccessfulExecutions))
+		results := executor.GetOperationsResults()
+		require.Len(t, results, 2)
+		for _, result := range results {
+			if result.ID == 1 {
+				assert.Equal(t, resultStatusFailed, result.Status)
+				assert.Contains(t, result.Error, errTransactionFailed.Error())
+			}
+		}
+	})
+	t.Run("operation waiting for retry should be skipped together with the rest of its queue", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs(0, 0, 1)
+		args.Concurrency.PreserveOrderPerDestination = true
+		args.AttemptsTracker = &testsCommon.AttemptsTrackerStub{
+			CanExecuteCalled: func(id uint64) bool {
+				return id != 1
+			},
+		}
+		mut := sync.Mutex{}
+		sentHashes := make([]string, 0)
+		args.NonceTxHandler = createNonceHandler(func(hash string, nonce uint64) {
+			mut.Lock()
+			sentHashes = append(sentHashes, hash)
+			mut.Unlock()
+		})
+		proxy := args.Proxy.(*interactors.ProxyStub)
+		proxy.GetTransactionInfoWithResultsCalled = func(ctx context.Context, hexTxHash string) (*models.TransactionData, error) {
+			return &models.TransactionData{
+				Transaction: &data.Transaction{
+					Status: transaction.Transaction_SUCCESS.String(),
+				},
+			}, nil
+		}
+
+		executor, _ := NewScCallExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []string{scProxyCallFunction + "@03"}, sentHashes)
+
+		statusHandler := args.StatusHandler.(*testsCommon.StatusHandlerMock)
+		assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricScCallsNumSkippedByRetryPolicy))
+	})
+	t.Run("dead letter should not block the rest of its queue", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs(0, 0, 1)
+		args.Concurrency.PreserveOrderPerDestination = true
+		args.AttemptsTracker = &testsCommon.AttemptsTrackerStub{
+			CanExecuteCalled: func(id uint64) bool {
+				return id != 1
+			},
+			IsDeadLetterCalled: func(id uint64) bool {
+				return id == 1
+			},
+		}
+		mut := sync.Mutex{}
+		sentHashes := make([]string, 0)
+		args.NonceTxHandler = createNonceHandler(func(hash string, nonce uint64) {
+			mut.Lock()
+			sentHashes = append(sentHashes, hash)
+			mut.Unlock()
+		})
+		proxy := args.Proxy.(*interactors.ProxyStub)
+		proxy.GetTransactionInfoWithResultsCalled = func(ctx context.Context, hexTxHash string) (*models.TransactionData, error) {
+			return &models.TransactionData{
+				Transaction: &data.Transaction{
+					Status: transaction.Transaction_SUCCESS.String(),
+				},
+			}, nil
+		}
+
+		executor, _ := NewScCallExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{scProxyCallFunction + "@02", scProxyCallFunction + "@03"}, sentHashes)
+
+		statusHandler := args.StatusHandler.(*testsCommon.StatusHandlerMock)
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricScCallsNumSkippedByRetryPolicy))
+	})
+	t.Run("operations assigned to other executors should be skipped", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs(0, 1, 2)
+		var updatedPendingIDs []uint64
+		args.Coordinator = &testsCommon.ScCallsCoordinatorStub{
+			UpdatePendingOperationsCalled: func(ctx context.Context, pendingIDs []uint64) {
+				updatedPendingIDs = pendingIDs
+			},
+			ShouldExecuteCalled: func(id uint64) bool {
+				return id == 2
+			},
+		}
+		mut := sync.Mutex{}
+		sentHashes := make([]string, 0)
+		args.NonceTxHandler = createNonceHandler(func(hash string, nonce uint64) {
+			mut.Lock()
+			sentHashes = append(sentHashes, hash)
+			mut.Unlock()
+		})
+		proxy := args.Proxy.(*interactors.ProxyStub)
+		proxy.GetTransactionInfoWithResultsCalled = func(ctx context.Context, hexTxHash string) (*models.TransactionData, error) {
+			return &models.TransactionData{
+				Transaction: &data.Transaction{
+					Status: transaction.Transaction_SUCCESS.String(),
+				},
+			}, nil
+		}
+
+		executor, _ := NewScCallExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{1, 2, 3}, updatedPendingIDs)
+		assert.Equal(t, []string{scProxyCallFunction + "@02"}, sentHashes)
+
+		statusHandler := args.StatusHandler.(*testsCommon.StatusHandlerMock)
+		assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricScCallsNumAssignedToOtherExecutors))
+	})
+	t.Run("should record the fees spent, including the failed executions", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs(0, 1)
+		mut := sync.Mutex{}
+		recordedFees := make(map[string]int64)
+		args.FeeLedger = &testsCommon.FeeLedgerStub{
+			RecordFeeCalled: func(token string, destination string, fee int64) {
+				assert.Equal(t, "tkn", token)
+
+				mut.Lock()
+				recordedFees[destination] += fee
+				mut.Unlock()
+			},
+			GetFeesLedgerCalled: func() core.ScCallsFeesLedger {
+				mut.Lock()
+				defer mut.Unlock()
+
+				total := int64(0)
+				for _, fee := range recordedFees {
+					total += fee
+				}
+
+				return core.ScCallsFeesLedger{
+					TotalFees:      total,
+					CurrentDayFees: total,
+				}
+			},
+		}
+		args.NonceTxHandler = createNonceHandler(func(hash string, nonce uint64) {})
+		proxy := args.Proxy.(*interactors.ProxyStub)
+		proxy.GetTransactionInfoWithResultsCalled = func(ctx context.Context, hexTxHash string) (*models.TransactionData, error) {
+			status := transaction.Transaction_SUCCESS
+			if hexTxHash == scProxyCallFunction+"@01" {
+				status = transaction.Transaction_FAILED
+			}
+
+			return &models.TransactionData{
+				Transaction: &data.Transaction{
+					Status:       status.String(),
+					KAppFee:      1000,
+					BandwidthFee: 200,
+				},
+			}, nil
+		}
+
+		executor, _ := NewScCallExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.ErrorIs(t, err, errTransactionFailed)
+
+		expectedFees := map[string]int64{
+			destinations[0]: 1200,
+			destinations[1]: 1200,
+		}
+		assert.Equal(t, expectedFees, recordedFees)
+
+		statusHandler := args.StatusHandler.(*testsCommon.StatusHandlerMock)
+		assert.Equal(t, 2400, statusHandler.GetIntMetric(core.MetricScCallsTotalFees))
+		assert.Equal(t, 2400, statusHandler.GetIntMetric(core.MetricScCallsCurrentDayFees))
+		for _, result := range executor.GetOperationsResults() {
+			assert.Equal(t, int64(1200), result.Fee)
+		}
+	})
+	t.Run("exceeded fee budget should pause the executions", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs(0, 0, 1)
+		args.Concurrency.NumWorkers = 1
+		args.Concurrency.PreserveOrderPerDestination = true
+		mut := sync.Mutex{}
+		sentHashes := make([]string, 0)
+		args.FeeLedger = &testsCommon.FeeLedgerStub{
+			CanSpendCalled: func() bool {
+				mut.Lock()
+				defer mut.Unlock()
+
+				// the budget is exceeded after the first execution
+				return len(sentHashes) == 0
+			},
+		}
+		args.NonceTxHandler = createNonceHandler(func(hash string, nonce uint64) {
+			mut.Lock()
+			sentHashes = append(sentHashes, hash)
+			mut.Unlock()
+		})
+		proxy := args.Proxy.(*interactors.ProxyStub)
+		proxy.GetTransactionInfoWithResultsCalled = func(ctx context.Context, hexTxHash string) (*models.TransactionData, error) {
+			return &models.TransactionData{
+				Transaction: &data.Transaction{
+					Status: transaction.Transaction_SUCCESS.String(),
+				},
+			}, nil
+		}
+
+		executor, _ := NewScCallExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []string{scProxyCallFunction + "@01"}, sentHashes)
+
+		statusHandler := args.StatusHandler.(*testsCommon.StatusHandlerMock)
+		assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricScCallsNumSkippedByFeeBudget))
+	})
+	t.Run("should record the estimated fees when the transaction results are not checked", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs(0, 1)
+		args.TransactionChecks.CheckTransactionResults = false
+		mut := sync.Mutex{}
+		recordedFees := make([]int64, 0)
+		releasedFees := make([]int64, 0)
+		args.FeeLedger = &testsCommon.FeeLedgerStub{
+			RecordFeeCalled: func(token string, destination string, fee int64) {
+				mut.Lock()
+				recordedFees = append(recordedFees, fee)
+				mut.Unlock()
+			},
+			ReleaseCalled: func(estimatedFee int64) {
+				mut.Lock()
+				releasedFees = append(releasedFees, estimatedFee)
+				mut.Unlock()
+			},
+		}
+		args.NonceTxHandler = createNonceHandler(func(hash string, nonce uint64) {})
+
+		executor, _ := NewScCallExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{5000000, 5000000}, recordedFees)
+		assert.Equal(t, []int64{5000000, 5000000}, releasedFees)
+	})
+	t.Run("concurrent executions should not overshoot the fee budget", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs(0, 1, 2, 0, 1)
+		args.Concurrency.NumWorkers = 5
+		args.Concurrency.PreserveOrderPerDestination = false
+		feeLedger, err := fees.NewFeeLedger(fees.ArgsFeeLedger{
+			FeeBudget: config.ScCallsFeeBudgetConfig{
+				DailyBudget: 10000000,
+			},
+			Storer: testsCommon.NewStorerMock(),
+			Log:    &testsCommon.LoggerStub{},
+		})
+		require.Nil(t, err)
+		args.FeeLedger = feeLedger
+		mut := sync.Mutex{}
+		sentHashes := make([]string, 0)
+		args.NonceTxHandler = createNonceHandler(func(hash string, nonce uint64) {
+			mut.Lock()
+			sentHashes = append(sentHashes, hash)
+			mut.Unlock()
+		})
+		proxy := args.Proxy.(*interactors.ProxyStub)
+		proxy.GetTransactionInfoWithResultsCalled = func(ctx context.Context, hexTxHash string) (*models.TransactionData, error) {
+			return &models.TransactionData{
+				Transaction: &data.Transaction{
+					Status:       transaction.Transaction_SUCCESS.String(),
+					BandwidthFee: 5000000,
+				},
+			}, nil
+		}
+
+		executor, _ := NewScCallExecutor(args)
+
+		err = executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(sentHashes))
+		assert.Equal(t, int64(10000000), feeLedger.GetFeesLedger().CurrentDayFees)
+		assert.True(t, feeLedger.GetFeesLedger().IsBudgetExceeded)
+
+		statusHandler := args.StatusHandler.(*testsCommon.StatusHandlerMock)
+		assert.Equal(t, 3, statusHandler.GetIntMetric(core.MetricScCallsNumSkippedByFeeBudget))
+	})
+}
+
+func TestScCallExecutor_filterOperations(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsScCallExecutor()
+	args.Filter = &testsCommon.ScCallsExecuteFilterStub{
+		ShouldExecuteCalled: func(callData parsers.ProxySCCompleteCallData) (bool, string) {
+			switch callData.Token {
+			case "tkn1":
+				return false, "denied token"
+			case "tkn2":
+				return false, "rule deny stake"
+			default:
+				return true, "allowed"
+			}
+		},
+	}
+	statusHandler := testsCommon.NewStatusHandlerMock("test")
+	args.StatusHandler = statusHandler
+	executor, _ := NewScCallExecutor(args)
+
+	result := executor.filterOperations(map[uint64]parsers.ProxySCCompleteCallData{
+		1: createTestProxySCCompleteCallData("tkn1"),
+		2: createTestProxySCCompleteCallData("tkn2"),
+		3: createTestProxySCCompleteCallData("tkn2"),
+		4: createTestProxySCCompleteCallData("tkn3"),
+	})
+	require.Len(t, result, 1)
+	assert.Contains(t, result, uint64(4))
+	assert.Equal(t, 3, statusHandler.GetIntMetric(core.MetricScCallsNumFilteredOut))
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricScCallsNumFilteredOutByReasonPrefix+"denied token"))
+	assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricScCallsNumFilteredOutByReasonPrefix+"rule deny stake"))
+	assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricScCallsNumFilteredOutByReasonPrefix+"allowed"))
+
+	// the reasons that no longer filter out anything are reset
+	result = executor.filterOperations(map[uint64]parsers.ProxySCCompleteCallData{
+		2: createTestProxySCCompleteCallData("tkn2"),
+		4: createTestProxySCCompleteCallData("tkn3"),
+	})
+	require.Len(t, result, 1)
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricScCallsNumFilteredOut))
+	assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricScCallsNumFilteredOutByReasonPrefix+"denied token"))
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricScCallsNumFilteredOutByReasonPrefix+"rule deny stake"))
+}
+
+func TestScCallExecutor_createExecutionQueues(t *testing.T) {
+	t.Parallel()
+
+	firstCallData := createTestProxySCCompleteCallData("tkn1")
+	secondCallData := createTestProxySCCompleteCallData("tkn2")
+	otherCallData := createTestProxySCCompleteCallData("tkn3")
+	otherCallData.To, _ = address.NewAddress("klv1qqqqqqqqqqqqqpgqh46r9zh78lry2py8tq723fpjdr4pp0zgsg8syf6mq0")
+	pendingOperations := map[uint64]parsers.ProxySCCompleteCallData{
+		7: secondCallData,
+		3: firstCallData,
+		5: otherCallData,
+	}
+
+	t.Run("without preserving the order should create a queue for each operation", func(t *testing.T) {
+		t.Parallel()
+
+		executor, _ := NewScCallExecutor(createMockArgsScCallExecutor())
+
+		queues := executor.createExecutionQueues(pendingOperations)
+		expectedQueues := [][]pendingOperation{
+			{{id: 3, callData: firstCallData}},
+			{{id: 5, callData: otherCallData}},
+			{{id: 7, callData: secondCallData}},
+		}
+		assert.Equal(t, expectedQueues, queues)
+	})
+	t.Run("preserving the order should group the operations by destination", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.Concurrency.PreserveOrderPerDestination = true
+		executor, _ := NewScCallExecutor(args)
+
+		queues := executor.createExecutionQueues(pendingOperations)
+		expectedQueues := [][]pendingOperation{
+			{{id: 3, callData: firstCallData}, {id: 7, callData: secondCallData}},
+			{{id: 5, callData: otherCallData}},
+		}
+		assert.Equal(t, expectedQueues, queues)
+	})
+	t.Run("should order the queues by priority", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.Prioritizer = &testsCommon.ScCallsPrioritizerStub{
+			ComputePrioritiesCalled: func(pendingOperations map[uint64]parsers.ProxySCCompleteCallData) map[uint64]int64 {
+				return map[uint64]int64{
+					3: 1,
+					5: 1,
+					7: 10,
+				}
+			},
+		}
+		executor, _ := NewScCallExecutor(args)
+
+		queues := executor.createExecutionQueues(pendingOperations)
+		expectedQueues := [][]pendingOperation{
+			{{id: 7, callData: secondCallData}},
+			{{id: 3, callData: firstCallData}},
+			{{id: 5, callData: otherCallData}},
+		}
+		assert.Equal(t, expectedQueues, queues)
+	})
+	t.Run("preserving the order should keep the ID order for the same destination", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.Concurrency.PreserveOrderPerDestination = true
+		args.Prioritizer = &testsCommon.ScCallsPrioritizerStub{
+			ComputePrioritiesCalled: func(pendingOperations map[uint64]parsers.ProxySCCompleteCallData) map[uint64]int64 {
+				return map[uint64]int64{
+					3: 1,
+					5: 5,
+					7: 10,
+				}
+			},
+		}
+		executor, _ := NewScCallExecutor(args)
+
+		queues := executor.createExecutionQueues(pendingOperations)
+		expectedQueues := [][]pendingOperation{
+			{{id: 3, callData: firstCallData}, {id: 7, callData: secondCallData}},
+			{{id: 5, callData: otherCallData}},
+		}
+		assert.Equal(t, expectedQueues, queues)
+	})
+}
+
 func TestScCallExecutor_handleResults(t *testing.T) {
 	t.Parallel()
 
@@ -845,7 +1595,7 @@ func TestScCallExecutor_handleResults(t *testing.T) {
 
 		executor, _ := NewScCallExecutor(args)
 
-		err := executor.handleResults(context.Background(), testHash)
+		_, err := executor.handleResults(context.Background(), testHash)
 		assert.Nil(t, err)
 	})
 	t.Run("timeout before process transaction called", func(t *testing.T) {
@@ -866,7 +1616,7 @@ func TestScCallExecutor_handleResults(t *testing.T) {
 		workingCtx, cancel := context.WithTimeout(context.Background(), time.Second)
 		defer cancel()
 
-		err := executor.handleResults(workingCtx, testHash)
+		_, err := executor.handleResults(workingCtx, testHash)
 		assert.ErrorIs(t, err, context.DeadlineExceeded)
 	})
 	t.Run("transaction not found should continuously request the status", func(t *testing.T) {
@@ -891,7 +1641,7 @@ func TestScCallExecutor_handleResults(t *testing.T) {
 		executor, _ := NewScCallExecutor(args)
 
 		go func() {
-			err := executor.handleResults(context.Background(), testHash)
+			_, err := executor.handleResults(context.Background(), testHash)
 			assert.ErrorIs(t, err, context.DeadlineExceeded) // this will be the actual error when the function finishes
 		}()
 
@@ -902,7 +1652,7 @@ func TestScCallExecutor_handleResults(t *testing.T) {
 			assert.Fail(t, "timeout")
 		}
 	})
-	t.Run("error while requesting the status should return the error and wait", func(t *testing.T) {
+	t.Run("error while requesting the status should return the error", func(t *testing.T) {
 		t.Parallel()
 
 		expectedErr := errors.New("expected error")
@@ -919,19 +1669,15 @@ func TestScCallExecutor_handleResults(t *testing.T) {
 		}
 		args.TransactionChecks = createMockCheckConfigs()
 		args.TransactionChecks.TimeInSecondsBetweenChecks = 1
-		args.TransactionChecks.ExtraDelayInSecondsOnError = 6
 
 		executor, _ := NewScCallExecutor(args)
 
-		start := time.Now()
-		err := executor.handleResults(context.Background(), testHash)
+		_, err := executor.handleResults(context.Background(), testHash)
 		assert.Equal(t, expectedErr, err)
-		end := time.Now()
 
-		assert.GreaterOrEqual(t, end.Sub(start), time.Second*6)
 		select {
 		case <-args.CloseAppChan:
-		default:
+		case <-time.After(time.Second):
 			assert.Fail(t, "failed to write on the close app chan")
 		}
 	})
@@ -948,12 +1694,11 @@ func TestScCallExecutor_handleResults(t *testing.T) {
 		}
 		args.TransactionChecks = createMockCheckConfigs()
 		args.TransactionChecks.TimeInSecondsBetweenChecks = 1
-		args.TransactionChecks.ExtraDelayInSecondsOnError = 1
 		args.TransactionChecks.CloseAppOnError = false
 
 		executor, _ := NewScCallExecutor(args)
 
-		err := executor.handleResults(context.Background(), testHash)
+		_, err := executor.handleResults(context.Background(), testHash)
 		assert.Equal(t, expectedErr, err)
 
 		select {
@@ -981,17 +1726,104 @@ func TestScCallExecutor_handleResults(t *testing.T) {
 		}
 		args.TransactionChecks = createMockCheckConfigs()
 		args.TransactionChecks.TimeInSecondsBetweenChecks = 1
-		args.TransactionChecks.ExtraDelayInSecondsOnError = 1
 
 		executor, _ := NewScCallExecutor(args)
 
-		err := executor.handleResults(context.Background(), testHash)
+		fee, err := executor.handleResults(context.Background(), testHash)
 		assert.ErrorIs(t, err, errTransactionFailed)
+		assert.Zero(t, fee)
 
 		select {
 		case <-args.CloseAppChan:
-		default:
+		case <-time.After(time.Second):
 			assert.Fail(t, "failed to write on the close app chan")
 		}
 	})
 }
+
+func TestScCallExecutor_GetPendingOperations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("get pending errors, should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsScCallExecutor()
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *models.VmValueRequest) (*models.VmValuesResponseData, error) {
+				return nil, expectedErr
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+		operations, err := executor.GetPendingOperations(context.Background())
+		assert.Nil(t, operations)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should return the decoded operations in ascending ID order", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *models.VmValueRequest) (*models.VmValuesResponseData, error) {
+				return &models.VmValuesResponseData{
+					Data: &vm.VMOutputApi{
+						ReturnCode: okCodeAfterExecution,
+						ReturnData: [][]byte{
+							{0x07},
+							[]byte("tkn7"),
+							{0x02},
+							[]byte("tkn2"),
+						},
+					},
+				}, nil
+			},
+		}
+		args.Codec = &testsCommon.KCCodecStub{
+			DecodeProxySCCompleteCallDataCalled: func(buff []byte) (parsers.ProxySCCompleteCallData, error) {
+				return createTestProxySCCompleteCallData(string(buff)), nil
+			},
+			ExtractGasLimitFromRawCallDataCalled: func(buff []byte) (uint64, error) {
+				return 5000000, nil
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+		operations, err := executor.GetPendingOperations(context.Background())
+		assert.Nil(t, err)
+		require.Len(t, operations, 2)
+
+		expectedCallData := createTestProxySCCompleteCallData("tkn2")
+		assert.Equal(t, &core.ScCallPendingOperation{
+			ID:          2,
+			From:        expectedCallData.From.Hex(),
+			To:          expectedCallData.To.Bech32(),
+			Token:       "tkn2",
+			Amount:      "37",
+			Nonce:       1,
+			GasLimit:    5000000,
+			RawCallData: hex.EncodeToString(expectedCallData.RawCallData),
+		}, operations[0])
+		assert.Equal(t, uint64(7), operations[1].ID)
+		assert.Equal(t, "tkn7", operations[1].Token)
+	})
+}
+
+func TestScCallExecutor_GetOperationsResults(t *testing.T) {
+	t.Parallel()
+
+	executor, _ := NewScCallExecutor(createMockArgsScCallExecutor())
+	for i := 0; i < maxOperationsResults+5; i++ {
+		executor.recordResult(uint64(i), "", 0, nil)
+	}
+
+	results := executor.GetOperationsResults()
+	require.Len(t, results, maxOperationsResults)
+	assert.Equal(t, uint64(5), results[0].ID)
+	assert.Equal(t, uint64(maxOperationsResults+4), results[len(results)-1].ID)
+	assert.Equal(t, resultStatusSkipped, results[0].Status)
+
+	// the returned results are copies
+	results[0].Status = resultStatusFailed
+	assert.Equal(t, resultStatusSkipped, executor.GetOperationsResults()[0].Status)
+}
@@ -4,3 +4,12 @@ import "errors"
 
 // ErrNilMetricsHolder signals that a nil metrics holder was provided
 var ErrNilMetricsHolder = errors.New("nil metrics holder")
+
+// ErrNilTokensRegistry signals that a nil tokens registry was provided
+var ErrNilTokensRegistry = errors.New("nil tokens registry")
+
+// ErrNilRelayersHealth signals that a nil relayers health provider was provided
+var ErrNilRelayersHealth = errors.New("nil relayers health provider")
+
+// ErrNilScCallsInfoProvider signals that a nil SC calls info provider was provided
+var ErrNilScCallsInfoProvider = errors.New("nil SC calls info provider")
@@ -9,15 +9,19 @@ const availableMetrics = "available metrics"
 
 // ArgsRelayerFacade represents the DTO struct used in the relayer facade constructor
 type ArgsRelayerFacade struct {
-	MetricsHolder core.MetricsHolder
-	ApiInterface  string
-	PprofEnabled  bool
+	MetricsHolder  core.MetricsHolder
+	TokensRegistry core.TokensRegistry
+	RelayersHealth core.RelayersHealthProvider
+	ApiInterface   string
+	PprofEnabled   bool
 }
 
 type relayerFacade struct {
-	metricsHolder core.MetricsHolder
-	apiInterface  string
-	pprofEnabled  bool
+	metricsHolder  core.MetricsHolder
+	tokensRegistry core.TokensRegistry
+	relayersHealth core.RelayersHealthProvider
+	apiInterface   string
+	pprofEnabled   bool
 }
 
 // NewRelayerFacade is the implementation of the relayer facade
@@ -25,11 +29,19 @@ func NewRelayerFacade(args ArgsRelayerFacade) (*relayerFacade, error) {
 	if check.IfNil(args.MetricsHolder) {
 		return nil, ErrNilMetricsHolder
 	}
+	if check.IfNil(args.TokensRegistry) {
+		return nil, ErrNilTokensRegistry
+	}
+	if check.IfNil(args.RelayersHealth) {
+		return nil, ErrNilRelayersHealth
+	}
 
 	return &relayerFacade{
-		apiInterface:  args.ApiInterface,
-		pprofEnabled:  args.PprofEnabled,
-		metricsHolder: args.MetricsHolder,
+		apiInterface:   args.ApiInterface,
+		pprofEnabled:   args.PprofEnabled,
+		metricsHolder:  args.MetricsHolder,
+		tokensRegistry: args.TokensRegistry,
+		relayersHealth: args.RelayersHealth,
 	}, nil
 }
 
@@ -59,6 +71,16 @@ func (rf *relayerFacade) GetMetricsList() core.GeneralMetrics {
 	return result
 }
 
+// GetTokens returns the bridged tokens snapshot together with the detected inconsistencies
+func (rf *relayerFacade) GetTokens() *core.TokensRegistrySnapshot {
+	return rf.tokensRegistry.GetTokens()
+}
+
+// GetRelayersHealth returns the health snapshot of the relayers set
+func (rf *relayerFacade) GetRelayersHealth() *core.RelayersHealthSnapshot {
+	return rf.relayersHealth.GetRelayersHealth()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (rf *relayerFacade) IsInterfaceNil() bool {
 	return rf == nil
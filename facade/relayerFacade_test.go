@@ -14,9 +14,11 @@ import (
 
 func createMockArguments() ArgsRelayerFacade {
 	return ArgsRelayerFacade{
-		MetricsHolder: status.NewMetricsHolder(),
-		ApiInterface:  core.WebServerOffString,
-		PprofEnabled:  true,
+		MetricsHolder:  status.NewMetricsHolder(),
+		TokensRegistry: &testsCommon.TokensRegistryStub{},
+		RelayersHealth: &testsCommon.RelayersHealthStub{},
+		ApiInterface:   core.WebServerOffString,
+		PprofEnabled:   true,
 	}
 }
 
@@ -31,6 +33,22 @@ func TestNewRelayerFacade(t *testing.T) {
 		assert.True(t, check.IfNil(facade))
 		assert.True(t, errors.Is(err, ErrNilMetricsHolder))
 	})
+	t.Run("nil tokens registry should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.TokensRegistry = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilTokensRegistry))
+	})
+	t.Run("nil relayers health should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.RelayersHealth = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilRelayersHealth))
+	})
 	t.Run("should work", func(t *testing.T) {
 		args := createMockArguments()
 
@@ -103,3 +121,53 @@ func TestRelayerFacade_GetMetricsList(t *testing.T) {
 	expected[availableMetrics] = []string{"mock1", "mock2"}
 	assert.Equal(t, expected, response)
 }
+
+func TestRelayerFacade_GetTokens(t *testing.T) {
+	t.Parallel()
+
+	expectedSnapshot := &core.TokensRegistrySnapshot{
+		LastUpdateTimestamp: 1234,
+		Tokens: []*core.TokenRegistryEntry{
+			{
+				KDA: core.KDATokenInfo{
+					TokenID: "ETHUSDC-0g3a",
+				},
+			},
+		},
+	}
+	args := createMockArguments()
+	args.TokensRegistry = &testsCommon.TokensRegistryStub{
+		GetTokensCalled: func() *core.TokensRegistrySnapshot {
+			return expectedSnapshot
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	assert.Equal(t, expectedSnapshot, facade.GetTokens())
+}
+
+func TestRelayerFacade_GetRelayersHealth(t *testing.T) {
+	t.Parallel()
+
+	expectedSnapshot := &core.RelayersHealthSnapshot{
+		Quorum:         2,
+		NumWhitelisted: 3,
+		NumJoined:      3,
+		LastBatchID:    44,
+		Relayers: []*core.RelayerHealth{
+			{
+				Address: "klv17la0vdplk320zvy9s7qps5j69ff2yl3dn0drmhyuw57dnhe23g5schkvag",
+				IsSelf:  true,
+			},
+		},
+	}
+	args := createMockArguments()
+	args.RelayersHealth = &testsCommon.RelayersHealthStub{
+		GetRelayersHealthCalled: func() *core.RelayersHealthSnapshot {
+			return expectedSnapshot
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	assert.Equal(t, expectedSnapshot, facade.GetRelayersHealth())
+}
@@ -0,0 +1,89 @@
+package facade
+
+import (
+	"context"
+
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// ArgsScCallsExecutorFacade represents the DTO struct used in the SC calls executor facade constructor
+type ArgsScCallsExecutorFacade struct {
+	MetricsHolder       core.MetricsHolder
+	ScCallsInfoProvider core.ScCallsInfoProvider
+	ApiInterface        string
+	PprofEnabled        bool
+}
+
+type scCallsExecutorFacade struct {
+	metricsHolder       core.MetricsHolder
+	scCallsInfoProvider core.ScCallsInfoProvider
+	apiInterface        string
+	pprofEnabled        bool
+}
+
+// NewScCallsExecutorFacade is the implementation of the SC calls executor facade
+func NewScCallsExecutorFacade(args ArgsScCallsExecutorFacade) (*scCallsExecutorFacade, error) {
+	if check.IfNil(args.MetricsHolder) {
+		return nil, ErrNilMetricsHolder
+	}
+	if check.IfNil(args.ScCallsInfoProvider) {
+		return nil, ErrNilScCallsInfoProvider
+	}
+
+	return &scCallsExecutorFacade{
+		apiInterface:        args.ApiInterface,
+		pprofEnabled:        args.PprofEnabled,
+		metricsHolder:       args.MetricsHolder,
+		scCallsInfoProvider: args.ScCallsInfoProvider,
+	}, nil
+}
+
+// RestApiInterface returns the interface on which the rest API should start on
+func (sf *scCallsExecutorFacade) RestApiInterface() string {
+	return sf.apiInterface
+}
+
+// PprofEnabled returns if profiling mode should be active or not on the application
+func (sf *scCallsExecutorFacade) PprofEnabled() bool {
+	return sf.pprofEnabled
+}
+
+// GetMetrics returns specified metric info. Errors if the metric is not found
+func (sf *scCallsExecutorFacade) GetMetrics(name string) (core.GeneralMetrics, error) {
+	return sf.metricsHolder.GetAllMetrics(name)
+}
+
+// GetMetricsList returns a list of all available metrics
+func (sf *scCallsExecutorFacade) GetMetricsList() core.GeneralMetrics {
+	availableNames := sf.metricsHolder.GetAvailableStatusHandlers()
+	result := make(core.GeneralMetrics)
+	result[availableMetrics] = availableNames
+
+	return result
+}
+
+// GetPendingOperations returns the decoded SC calls waiting to be executed
+func (sf *scCallsExecutorFacade) GetPendingOperations(ctx context.Context) ([]*core.ScCallPendingOperation, error) {
+	return sf.scCallsInfoProvider.GetPendingOperations(ctx)
+}
+
+// GetOperationsResults returns the results of the latest executed SC calls
+func (sf *scCallsExecutorFacade) GetOperationsResults() []*core.ScCallOperationResult {
+	return sf.scCallsInfoProvider.GetOperationsResults()
+}
+
+// GetDeadLetters returns the SC calls that permanently failed and require manual resolution
+func (sf *scCallsExecutorFacade) GetDeadLetters() []core.ScCallExecutionAttempts {
+	return sf.scCallsInfoProvider.GetDeadLetters()
+}
+
+// GetFeesLedger returns the fees spent on the SC calls executions
+func (sf *scCallsExecutorFacade) GetFeesLedger() core.ScCallsFeesLedger {
+	return sf.scCallsInfoProvider.GetFeesLedger()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sf *scCallsExecutorFacade) IsInterfaceNil() bool {
+	return sf == nil
+}
@@ -0,0 +1,116 @@
+package facade
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/status"
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockScCallsExecutorArguments() ArgsScCallsExecutorFacade {
+	return ArgsScCallsExecutorFacade{
+		MetricsHolder:       status.NewMetricsHolder(),
+		ScCallsInfoProvider: &testsCommon.ScCallsInfoProviderStub{},
+		ApiInterface:        core.WebServerOffString,
+		PprofEnabled:        true,
+	}
+}
+
+func TestNewScCallsExecutorFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil metrics holder should error", func(t *testing.T) {
+		args := createMockScCallsExecutorArguments()
+		args.MetricsHolder = nil
+
+		facade, err := NewScCallsExecutorFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilMetricsHolder))
+	})
+	t.Run("nil SC calls info provider should error", func(t *testing.T) {
+		args := createMockScCallsExecutorArguments()
+		args.ScCallsInfoProvider = nil
+
+		facade, err := NewScCallsExecutorFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilScCallsInfoProvider))
+	})
+	t.Run("should work", func(t *testing.T) {
+		args := createMockScCallsExecutorArguments()
+
+		facade, err := NewScCallsExecutorFacade(args)
+		assert.False(t, check.IfNil(facade))
+		assert.Nil(t, err)
+	})
+}
+
+func TestScCallsExecutorFacade_Getters(t *testing.T) {
+	t.Parallel()
+
+	args := createMockScCallsExecutorArguments()
+	facade, _ := NewScCallsExecutorFacade(args)
+
+	assert.Equal(t, args.ApiInterface, facade.RestApiInterface())
+	assert.Equal(t, args.PprofEnabled, facade.PprofEnabled())
+}
+
+func TestScCallsExecutorFacade_GetMetrics(t *testing.T) {
+	t.Parallel()
+
+	sh := testsCommon.NewStatusHandlerMock(core.ScCallsExecutorStatusHandlerName)
+	sh.SetIntMetric(core.MetricScCallsNumPendingOperations, 3)
+	metricHolder := status.NewMetricsHolder()
+	errSetup := metricHolder.AddStatusHandler(sh)
+	require.Nil(t, errSetup)
+
+	args := createMockScCallsExecutorArguments()
+	args.MetricsHolder = metricHolder
+	facade, _ := NewScCallsExecutorFacade(args)
+
+	response, err := facade.GetMetrics(core.ScCallsExecutorStatusHandlerName)
+	require.Nil(t, err)
+	assert.Equal(t, sh.GetAllMetrics(), response)
+
+	expectedList := make(core.GeneralMetrics)
+	expectedList[availableMetrics] = []string{core.ScCallsExecutorStatusHandlerName}
+	assert.Equal(t, expectedList, facade.GetMetricsList())
+}
+
+func TestScCallsExecutorFacade_ScCallsInfo(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	pending := []*core.ScCallPendingOperation{{ID: 1}}
+	results := []*core.ScCallOperationResult{{ID: 2}}
+	deadLetters := []core.ScCallExecutionAttempts{{ID: 3, IsDeadLetter: true}}
+	ledger := core.ScCallsFeesLedger{TotalFees: 4}
+	args := createMockScCallsExecutorArguments()
+	args.ScCallsInfoProvider = &testsCommon.ScCallsInfoProviderStub{
+		GetPendingOperationsCalled: func(ctx context.Context) ([]*core.ScCallPendingOperation, error) {
+			return pending, expectedErr
+		},
+		GetOperationsResultsCalled: func() []*core.ScCallOperationResult {
+			return results
+		},
+		GetDeadLettersCalled: func() []core.ScCallExecutionAttempts {
+			return deadLetters
+		},
+		GetFeesLedgerCalled: func() core.ScCallsFeesLedger {
+			return ledger
+		},
+	}
+	facade, _ := NewScCallsExecutorFacade(args)
+
+	response, err := facade.GetPendingOperations(context.Background())
+	assert.Equal(t, pending, response)
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, results, facade.GetOperationsResults())
+	assert.Equal(t, deadLetters, facade.GetDeadLetters())
+	assert.Equal(t, ledger, facade.GetFeesLedger())
+}
@@ -17,24 +17,33 @@ import (
 	"github.com/klever-io/klv-bridge-eth-go/bridges/ethKC/topology"
 	"github.com/klever-io/klv-bridge-eth-go/clients"
 	balanceValidatorManagement "github.com/klever-io/klv-bridge-eth-go/clients/balanceValidator"
+	"github.com/klever-io/klv-bridge-eth-go/clients/callDataValidator"
 	"github.com/klever-io/klv-bridge-eth-go/clients/chain"
 	"github.com/klever-io/klv-bridge-eth-go/clients/ethereum"
+	"github.com/klever-io/klv-bridge-eth-go/clients/ethereum/eventsSubscriber"
 	"github.com/klever-io/klv-bridge-eth-go/clients/gasManagement"
 	"github.com/klever-io/klv-bridge-eth-go/clients/gasManagement/factory"
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever"
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blocksWatcher"
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/mappers"
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/proxy"
+	"github.com/klever-io/klv-bridge-eth-go/clients/relayersHealth"
 	roleproviders "github.com/klever-io/klv-bridge-eth-go/clients/roleProviders"
+	"github.com/klever-io/klv-bridge-eth-go/clients/tokensCache"
+	"github.com/klever-io/klv-bridge-eth-go/clients/tokensRegistry"
 	"github.com/klever-io/klv-bridge-eth-go/config"
 	"github.com/klever-io/klv-bridge-eth-go/core"
 	"github.com/klever-io/klv-bridge-eth-go/core/converters"
+	coreDisabled "github.com/klever-io/klv-bridge-eth-go/core/disabled"
 	"github.com/klever-io/klv-bridge-eth-go/core/timer"
 	"github.com/klever-io/klv-bridge-eth-go/p2p"
 	"github.com/klever-io/klv-bridge-eth-go/stateMachine"
 	"github.com/klever-io/klv-bridge-eth-go/status"
+	"github.com/klever-io/klv-bridge-eth-go/tracing"
 	chainCore "github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	factoryMarshaller "github.com/multiversx/mx-chain-core-go/marshal/factory"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
@@ -43,6 +52,7 @@ import (
 	antifloodFactory "github.com/multiversx/mx-chain-go/process/throttle/antiflood/factory"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-sdk-go/core/polling"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -64,6 +74,7 @@ type ArgsEthereumToKleverBridge struct {
 	KleverClientStatusHandler core.StatusHandler
 	Erc20ContractsHolder      ethereum.Erc20ContractsHolder
 	ClientWrapper             ethereum.ClientWrapper
+	EthereumLogsSubscriber    eventsSubscriber.LogsSubscriber
 	TimeForBootstrap          time.Duration
 	TimeBeforeRepeatJoin      time.Duration
 	MetricsHolder             core.MetricsHolder
@@ -75,6 +86,7 @@ type ethKleverBridgeComponents struct {
 	messenger                     p2p.NetMessenger
 	statusStorer                  core.Storer
 	kcClient                      ethklever.KCClient
+	uncachedKCClient              ethklever.KCClient
 	ethClient                     ethklever.EthereumClient
 	evmCompatibleChain            chain.Chain
 	kleverMultisigContractAddress address.Address
@@ -83,25 +95,34 @@ type ethKleverBridgeComponents struct {
 	kleverRelayerAddress          address.Address
 	ethereumRelayerAddress        common.Address
 	klvDataGetter                 dataGetter
+	cachedKlvDataGetter           mappers.DataGetter
+	tokensCache                   TokensCache
 	proxy                         proxy.Proxy
 	kleverRoleProvider            KleverRoleProvider
 	ethereumRoleProvider          EthereumRoleProvider
+	tokensRegistry                TokensRegistry
+	relayersHealth                RelayersHealth
+	kcToEthLeaderProvider         *delayedLeaderProvider
+	leaderSelector                topology.LeaderSelector
 	broadcaster                   Broadcaster
 	timer                         core.Timer
 	timeForBootstrap              time.Duration
 	metricsHolder                 core.MetricsHolder
 	addressConverter              core.AddressConverter
+	tracer                        trace.Tracer
 
 	ethtoKleverMachineStates    core.MachineStates
 	ethtoKleverStepDuration     time.Duration
 	ethtoKleverStatusHandler    core.StatusHandler
 	ethtoKleverStateMachine     StateMachine
 	ethtoKleverSignaturesHolder ethklever.SignaturesHolder
+	ethtoKleverSpanAttributes   stateMachine.SpanAttributesProvider
 
-	kcToEthMachineStates core.MachineStates
-	kcToEthStepDuration  time.Duration
-	kcToEthStatusHandler core.StatusHandler
-	kcToEthStateMachine  StateMachine
+	kcToEthMachineStates  core.MachineStates
+	kcToEthStepDuration   time.Duration
+	kcToEthStatusHandler  core.StatusHandler
+	kcToEthStateMachine   StateMachine
+	kcToEthSpanAttributes stateMachine.SpanAttributesProvider
 
 	mutClosableHandlers sync.RWMutex
 	closableHandlers    []io.Closer
@@ -144,6 +165,11 @@ func NewEthKleverBridgeComponents(args ArgsEthereumToKleverBridge) (*ethKleverBr
 
 	components.addClosableComponent(components.timer)
 
+	err = components.createTracer(args)
+	if err != nil {
+		return nil, err
+	}
+
 	err = components.createKleverKeysAndAddresses(args.Configs.GeneralConfig.Klever)
 	if err != nil {
 		return nil, err
@@ -154,6 +180,11 @@ func NewEthKleverBridgeComponents(args ArgsEthereumToKleverBridge) (*ethKleverBr
 		return nil, err
 	}
 
+	err = components.createTokensCache(args)
+	if err != nil {
+		return nil, err
+	}
+
 	err = components.createKleverRoleProvider(args)
 	if err != nil {
 		return nil, err
@@ -169,11 +200,26 @@ func NewEthKleverBridgeComponents(args ArgsEthereumToKleverBridge) (*ethKleverBr
 		return nil, err
 	}
 
+	err = components.createRelayersHealth(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createLeaderSelector(args)
+	if err != nil {
+		return nil, err
+	}
+
 	err = components.createEthereumClient(args)
 	if err != nil {
 		return nil, err
 	}
 
+	err = components.createTokensRegistry(args)
+	if err != nil {
+		return nil, err
+	}
+
 	err = components.createEthereumToKleverBlockchainBridge(args)
 	if err != nil {
 		return nil, err
@@ -225,6 +271,13 @@ func checkArgsEthereumToKleverBridge(args ArgsEthereumToKleverBridge) error {
 	if args.TimeBeforeRepeatJoin < minTimeBeforeRepeatJoin {
 		return fmt.Errorf("%w for TimeBeforeRepeatJoin, received: %v, minimum: %v", errInvalidValue, args.TimeBeforeRepeatJoin, minTimeBeforeRepeatJoin)
 	}
+	leaderSelectionConfig := args.Configs.GeneralConfig.Relayer.LeaderSelection
+	maxInactivity := time.Second * time.Duration(leaderSelectionConfig.MaxInactivityInSeconds)
+	if leaderSelectionConfig.Policy == topology.LivenessPolicy && maxInactivity <= args.TimeBeforeRepeatJoin {
+		// the relayers gossiping only the join messages would be seen as inactive between two join messages
+		return fmt.Errorf("%w for LeaderSelection.MaxInactivityInSeconds, received: %v, it should be greater than TimeBeforeRepeatJoin: %v",
+			errInvalidValue, maxInactivity, args.TimeBeforeRepeatJoin)
+	}
 	if check.IfNil(args.MetricsHolder) {
 		return errNilMetricsHolder
 	}
@@ -235,6 +288,21 @@ func checkArgsEthereumToKleverBridge(args ArgsEthereumToKleverBridge) error {
 	return nil
 }
 
+func (components *ethKleverBridgeComponents) createTracer(args ArgsEthereumToKleverBridge) error {
+	tracingConfig := args.Configs.GeneralConfig.Tracing
+	tracerProvider, err := tracing.NewTracerProvider(tracingConfig)
+	if err != nil {
+		return err
+	}
+
+	components.addClosableComponent(tracerProvider)
+	components.tracer = tracerProvider.Tracer()
+
+	components.baseLogger.Debug("created the tracer", "enabled", tracingConfig.Enabled, "exporter", tracingConfig.Exporter)
+
+	return nil
+}
+
 func (components *ethKleverBridgeComponents) createKleverKeysAndAddresses(chainConfigs config.KleverConfig) error {
 	encodedSk, pbkString, err := tools.LoadSkPkFromPemFile(chainConfigs.PrivateKeyFile, 0, "")
 	if err != nil {
@@ -287,9 +355,41 @@ func (components *ethKleverBridgeComponents) createDataGetter() error {
 	return err
 }
 
+func (components *ethKleverBridgeComponents) createTokensCache(args ArgsEthereumToKleverBridge) error {
+	statusHandler, err := status.NewStatusHandler(core.TokensCacheStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return err
+	}
+
+	err = components.metricsHolder.AddStatusHandler(statusHandler)
+	if err != nil {
+		return err
+	}
+
+	tokensCacheLogId := components.evmCompatibleChain.TokensCacheLogId()
+	cacheExpiration := args.Configs.GeneralConfig.Relayer.TokensCache.CacheExpirationInSeconds
+	argsTokensCache := tokensCache.ArgsTokensCache{
+		Log:           core.NewLoggerWithIdentifier(logger.GetOrCreate(tokensCacheLogId), tokensCacheLogId),
+		StatusHandler: statusHandler,
+		Expiration:    time.Duration(cacheExpiration) * time.Second,
+	}
+	components.tokensCache, err = tokensCache.NewTokensCache(argsTokensCache)
+	if err != nil {
+		return err
+	}
+
+	argsCachedDataGetter := tokensCache.ArgsCachedDataGetter{
+		DataGetter:  components.klvDataGetter,
+		TokensCache: components.tokensCache,
+	}
+	components.cachedKlvDataGetter, err = tokensCache.NewCachedDataGetter(argsCachedDataGetter)
+
+	return err
+}
+
 func (components *ethKleverBridgeComponents) createKCClient(args ArgsEthereumToKleverBridge) error {
 	chainConfigs := args.Configs.GeneralConfig.Klever
-	tokensMapper, err := mappers.NewKCToErc20Mapper(components.klvDataGetter)
+	tokensMapper, err := mappers.NewKCToErc20Mapper(components.cachedKlvDataGetter)
 	if err != nil {
 		return err
 	}
@@ -309,12 +409,50 @@ func (components *ethKleverBridgeComponents) createKCClient(args ArgsEthereumToK
 		ClientAvailabilityAllowDelta: chainConfigs.ClientAvailabilityAllowDelta,
 	}
 
-	components.kcClient, err = klever.NewClient(clientArgs)
-	components.addClosableComponent(components.kcClient)
+	components.uncachedKCClient, err = klever.NewClient(clientArgs)
+	if err != nil {
+		return err
+	}
+	components.addClosableComponent(components.uncachedKCClient)
+
+	argsCachedKCClient := tokensCache.ArgsCachedKCClient{
+		KCClient:    components.uncachedKCClient,
+		TokensCache: components.tokensCache,
+	}
+	components.kcClient, err = tokensCache.NewCachedKCClient(argsCachedKCClient)
 
 	return err
 }
 
+func (components *ethKleverBridgeComponents) createP2PStateStorer(args ArgsEthereumToKleverBridge) core.Storer {
+	if !args.Configs.GeneralConfig.Relayer.P2PStatePersistence.Enabled {
+		return coreDisabled.NewDisabledStorer()
+	}
+
+	return components.statusStorer
+}
+
+func (components *ethKleverBridgeComponents) createSignaturesHolder(args ArgsEthereumToKleverBridge) (SignaturesHolder, error) {
+	if !args.Configs.GeneralConfig.Relayer.P2PStatePersistence.Enabled {
+		return ethklever.NewSignatureHolder(), nil
+	}
+
+	argsSignaturesHolder := ethklever.ArgsPersistentSignaturesHolder{
+		Name:   components.evmCompatibleChain.EvmCompatibleChainToKleverBlockchainName(),
+		Storer: components.statusStorer,
+		Log:    components.baseLogger,
+	}
+
+	signaturesHolder, err := ethklever.NewPersistentSignaturesHolder(argsSignaturesHolder)
+	if err != nil {
+		return nil, err
+	}
+
+	components.addClosableComponent(signaturesHolder)
+
+	return signaturesHolder, nil
+}
+
 func (components *ethKleverBridgeComponents) createEthereumClient(args ArgsEthereumToKleverBridge) error {
 	ethereumConfigs := args.Configs.GeneralConfig.Eth
 
@@ -351,11 +489,18 @@ func (components *ethKleverBridgeComponents) createEthereumClient(args ArgsEther
 		return err
 	}
 
+	marshalizer, err := factoryMarshaller.NewMarshalizer(args.Configs.GeneralConfig.Relayer.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+
 	broadcasterLogId := components.evmCompatibleChain.BroadcasterLogId()
 	ethtokleverName := components.evmCompatibleChain.EvmCompatibleChainToKleverBlockchainName()
 	argsBroadcaster := p2p.ArgsBroadcaster{
 		Messenger:           args.Messenger,
 		Log:                 core.NewLoggerWithIdentifier(logger.GetOrCreate(broadcasterLogId), broadcasterLogId),
+		Marshalizer:         marshalizer,
+		MessagesVersion:     args.Configs.GeneralConfig.P2P.MessagesVersion,
 		KCRoleProvider:      components.kleverRoleProvider,
 		SignatureProcessor:  components.ethereumRoleProvider,
 		KeyGen:              keyGen,
@@ -363,6 +508,9 @@ func (components *ethKleverBridgeComponents) createEthereumClient(args ArgsEther
 		PrivateKey:          components.kleverRelayerPrivateKey,
 		Name:                ethtokleverName,
 		AntifloodComponents: antifloodComponents,
+		ActivityTracker:     components.relayersHealth,
+		NoncesStorer:        components.createP2PStateStorer(args),
+		Tracer:              components.tracer,
 	}
 
 	components.broadcaster, err = p2p.NewBroadcaster(argsBroadcaster)
@@ -377,12 +525,25 @@ func (components *ethKleverBridgeComponents) createEthereumClient(args ArgsEther
 
 	components.ethereumRelayerAddress = cryptoHandler.GetAddress()
 
-	tokensMapper, err := mappers.NewErc20ToKCMapper(components.klvDataGetter)
+	tokensMapper, err := mappers.NewErc20ToKCMapper(components.cachedKlvDataGetter)
+	if err != nil {
+		return err
+	}
+
+	argsCachedClientWrapper := tokensCache.ArgsCachedClientWrapper{
+		ClientWrapper: args.ClientWrapper,
+		TokensCache:   components.tokensCache,
+	}
+	cachedClientWrapper, err := tokensCache.NewCachedClientWrapper(argsCachedClientWrapper)
+	if err != nil {
+		return err
+	}
+
+	signaturesHolder, err := components.createSignaturesHolder(args)
 	if err != nil {
 		return err
 	}
 
-	signaturesHolder := ethklever.NewSignatureHolder()
 	components.ethtoKleverSignaturesHolder = signaturesHolder
 	err = components.broadcaster.AddBroadcastClient(signaturesHolder)
 	if err != nil {
@@ -393,7 +554,7 @@ func (components *ethKleverBridgeComponents) createEthereumClient(args ArgsEther
 
 	ethClientLogId := components.evmCompatibleChain.EvmCompatibleChainClientLogId()
 	argsEthClient := ethereum.ArgsEthereumClient{
-		ClientWrapper:                args.ClientWrapper,
+		ClientWrapper:                cachedClientWrapper,
 		Erc20ContractsHandler:        args.Erc20ContractsHolder,
 		Log:                          core.NewLoggerWithIdentifier(logger.GetOrCreate(ethClientLogId), ethClientLogId),
 		AddressConverter:             components.addressConverter,
@@ -421,8 +582,9 @@ func (components *ethKleverBridgeComponents) createKleverRoleProvider(args ArgsE
 	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(kcRoleProviderLogId), kcRoleProviderLogId)
 
 	argsRoleProvider := roleproviders.ArgsKleverRoleProvider{
-		DataGetter: components.klvDataGetter,
-		Log:        log,
+		DataGetter:  components.klvDataGetter,
+		Log:         log,
+		FetchStakes: configs.Relayer.LeaderSelection.Policy == topology.StakeWeightedPolicy,
 	}
 
 	var err error
@@ -484,6 +646,152 @@ func (components *ethKleverBridgeComponents) createEthereumRoleProvider(args Arg
 	return nil
 }
 
+func (components *ethKleverBridgeComponents) createTokensRegistry(args ArgsEthereumToKleverBridge) error {
+	configs := args.Configs.GeneralConfig
+	tokensRegistryLogId := components.evmCompatibleChain.TokensRegistryLogId()
+	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(tokensRegistryLogId), tokensRegistryLogId)
+
+	argsTokensRegistry := tokensRegistry.ArgsTokensRegistry{
+		Log:                       log,
+		KCDataGetter:              components.klvDataGetter,
+		KCClient:                  components.uncachedKCClient,
+		KleverProxy:               args.Proxy,
+		KleverSafeContractAddress: components.kleverSafeContractAddress,
+		EthereumClient:            args.ClientWrapper,
+		Erc20ContractsHolder:      args.Erc20ContractsHolder,
+		CacheInvalidator:          components.tokensCache,
+	}
+
+	var err error
+	components.tokensRegistry, err = tokensRegistry.NewTokensRegistry(argsTokensRegistry)
+	if err != nil {
+		return err
+	}
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             "tokens registry",
+		PollingInterval:  time.Duration(configs.Relayer.TokensRegistry.PollingIntervalInMillis) * time.Millisecond,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         components.tokensRegistry,
+	}
+
+	pollingHandler, err := polling.NewPollingHandler(argsPollingHandler)
+	if err != nil {
+		return err
+	}
+
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	return nil
+}
+
+func (components *ethKleverBridgeComponents) createRelayersHealth(args ArgsEthereumToKleverBridge) error {
+	relayersHealthLogId := components.evmCompatibleChain.RelayersHealthLogId()
+	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(relayersHealthLogId), relayersHealthLogId)
+
+	// the leader of the Klever Blockchain to Ethereum half-bridge will be provided by its topology handler
+	components.kcToEthLeaderProvider = newDelayedLeaderProvider()
+
+	statusHandler, err := status.NewStatusHandler(core.RelayersHealthStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return err
+	}
+
+	err = components.metricsHolder.AddStatusHandler(statusHandler)
+	if err != nil {
+		return err
+	}
+
+	argsRelayersHealth := relayersHealth.ArgsRelayersHealth{
+		Log:                log,
+		PublicKeysProvider: components.kleverRoleProvider,
+		LeaderProvider:     components.kcToEthLeaderProvider,
+		QuorumProvider:     args.ClientWrapper,
+		StatusHandler:      statusHandler,
+		AddressConverter:   components.addressConverter,
+		OwnAddress:         components.kleverRelayerAddress.Bytes(),
+	}
+
+	components.relayersHealth, err = relayersHealth.NewRelayersHealth(argsRelayersHealth)
+	if err != nil {
+		return err
+	}
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             "relayers health",
+		PollingInterval:  time.Duration(args.Configs.GeneralConfig.Relayer.RelayersHealth.PollingIntervalInMillis) * time.Millisecond,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         components.relayersHealth,
+	}
+
+	pollingHandler, err := polling.NewPollingHandler(argsPollingHandler)
+	if err != nil {
+		return err
+	}
+
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	return nil
+}
+
+func (components *ethKleverBridgeComponents) createLeaderSelector(args ArgsEthereumToKleverBridge) error {
+	leaderSelectionConfig := args.Configs.GeneralConfig.Relayer.LeaderSelection
+	argsLeaderSelector := topology.ArgsLeaderSelectorFactory{
+		Policy:           leaderSelectionConfig.Policy,
+		LivenessProvider: components.relayersHealth,
+		StakeProvider:    components.kleverRoleProvider,
+		Timer:            components.timer,
+		MaxInactivity:    time.Second * time.Duration(leaderSelectionConfig.MaxInactivityInSeconds),
+	}
+
+	var err error
+	components.leaderSelector, err = topology.CreateLeaderSelector(argsLeaderSelector)
+	if err != nil {
+		return err
+	}
+
+	components.baseLogger.Debug("created the leader selector", "policy", leaderSelectionConfig.Policy)
+
+	return nil
+}
+
+func (components *ethKleverBridgeComponents) createLeaderLease(name string, leaseConfig config.LeaderLeaseConfig, log logger.Logger) (LeaderLease, error) {
+	if !leaseConfig.Enabled {
+		return disabled.NewDisabledLeaderLease(), nil
+	}
+
+	argsLeaderLease := topology.ArgsLeaderLease{
+		Name:             name,
+		AddressBytes:     components.kleverRelayerAddress.Bytes(),
+		Broadcaster:      components.broadcaster,
+		Timer:            components.timer,
+		GracePeriod:      time.Second * time.Duration(leaseConfig.GracePeriodInSeconds),
+		MaxLeaseDuration: time.Second * time.Duration(leaseConfig.MaxLeaseDurationInSeconds),
+		Log:              log,
+		AddressConverter: components.addressConverter,
+	}
+
+	leaderLease, err := topology.NewLeaderLease(argsLeaderLease)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.broadcaster.AddLeaseClient(leaderLease)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("created the leader lease",
+		"grace period in seconds", leaseConfig.GracePeriodInSeconds,
+		"max lease duration in seconds", leaseConfig.MaxLeaseDurationInSeconds)
+
+	return leaderLease, nil
+}
+
 func (components *ethKleverBridgeComponents) createEthereumToKleverBlockchainBridge(args ArgsEthereumToKleverBridge) error {
 	ethtokleverName := components.evmCompatibleChain.EvmCompatibleChainToKleverBlockchainName()
 	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(ethtokleverName), ethtokleverName)
@@ -495,6 +803,11 @@ func (components *ethKleverBridgeComponents) createEthereumToKleverBlockchainBri
 
 	components.ethtoKleverStepDuration = time.Duration(configs.StepDurationInMillis) * time.Millisecond
 
+	leaderLease, err := components.createLeaderLease(ethtokleverName, configs.LeaderLease, log)
+	if err != nil {
+		return err
+	}
+
 	argsTopologyHandler := topology.ArgsTopologyHandler{
 		PublicKeysProvider: components.kleverRoleProvider,
 		Timer:              components.timer,
@@ -502,6 +815,8 @@ func (components *ethKleverBridgeComponents) createEthereumToKleverBlockchainBri
 		AddressBytes:       components.kleverRelayerAddress.Bytes(),
 		Log:                log,
 		AddressConverter:   components.addressConverter,
+		LeaderSelector:     components.leaderSelector,
+		LeaseProvider:      leaderLease,
 	}
 
 	topologyHandler, err := topology.NewTopologyHandler(argsTopologyHandler)
@@ -521,7 +836,30 @@ func (components *ethKleverBridgeComponents) createEthereumToKleverBlockchainBri
 
 	timeForTransferExecution := time.Second * time.Duration(args.Configs.GeneralConfig.Eth.IntervalToWaitForTransferInSeconds)
 
-	balanceValidator, err := components.createBalanceValidator()
+	kcClient, err := ethklever.NewTracedKCClient(components.kcClient, components.tracer)
+	if err != nil {
+		return err
+	}
+
+	ethClient, err := ethklever.NewTracedEthereumClient(components.ethClient, components.tracer)
+	if err != nil {
+		return err
+	}
+
+	balanceValidator, err := components.createBalanceValidator(kcClient, ethClient)
+	if err != nil {
+		return err
+	}
+
+	batchNotifier, err := components.createEthereumBatchNotifier(args)
+	if err != nil {
+		return err
+	}
+
+	argsCallDataValidator := callDataValidator.ArgsCallDataValidator{
+		Config: args.Configs.GeneralConfig.Eth.CallDataValidation,
+	}
+	ethCallDataValidator, err := callDataValidator.NewCallDataValidator(argsCallDataValidator)
 	if err != nil {
 		return err
 	}
@@ -529,12 +867,17 @@ func (components *ethKleverBridgeComponents) createEthereumToKleverBlockchainBri
 	argsBridgeExecutor := ethklever.ArgsBridgeExecutor{
 		Log:                        log,
 		TopologyProvider:           topologyHandler,
-		KCClient:                   components.kcClient,
-		EthereumClient:             components.ethClient,
+		KCClient:                   kcClient,
+		EthereumClient:             ethClient,
 		StatusHandler:              components.ethtoKleverStatusHandler,
 		TimeForWaitOnEthereum:      timeForTransferExecution,
 		SignaturesHolder:           disabled.NewDisabledSignaturesHolder(),
 		BalanceValidator:           balanceValidator,
+		BatchNotifier:              batchNotifier,
+		KCActivityNotifier:         disabled.NewDisabledKCActivityNotifier(),
+		CallDataValidator:          ethCallDataValidator,
+		BatchSignaturesTracker:     disabled.NewDisabledBatchSignaturesTracker(),
+		LeaderLease:                leaderLease,
 		MaxQuorumRetriesOnEthereum: args.Configs.GeneralConfig.Eth.MaxRetriesOnQuorumReached,
 		MaxQuorumRetriesOnKC:       args.Configs.GeneralConfig.Klever.MaxRetriesOnQuorumReached,
 		MaxRetriesOnWasProposed:    args.Configs.GeneralConfig.Klever.MaxRetriesOnWasTransferProposed,
@@ -549,6 +892,7 @@ func (components *ethKleverBridgeComponents) createEthereumToKleverBlockchainBri
 	if err != nil {
 		return err
 	}
+	components.ethtoKleverSpanAttributes = bridge
 
 	return nil
 }
@@ -563,6 +907,11 @@ func (components *ethKleverBridgeComponents) createKCToEthereumBridge(args ArgsE
 	}
 
 	components.kcToEthStepDuration = time.Duration(configs.StepDurationInMillis) * time.Millisecond
+	leaderLease, err := components.createLeaderLease(kcToEthName, configs.LeaderLease, log)
+	if err != nil {
+		return err
+	}
+
 	argsTopologyHandler := topology.ArgsTopologyHandler{
 		PublicKeysProvider: components.kleverRoleProvider,
 		Timer:              components.timer,
@@ -570,12 +919,15 @@ func (components *ethKleverBridgeComponents) createKCToEthereumBridge(args ArgsE
 		AddressBytes:       components.kleverRelayerAddress.Bytes(),
 		Log:                log,
 		AddressConverter:   components.addressConverter,
+		LeaderSelector:     components.leaderSelector,
+		LeaseProvider:      leaderLease,
 	}
 
 	topologyHandler, err := topology.NewTopologyHandler(argsTopologyHandler)
 	if err != nil {
 		return err
 	}
+	components.kcToEthLeaderProvider.setLeaderProvider(topologyHandler)
 
 	components.kcToEthStatusHandler, err = status.NewStatusHandler(kcToEthName, components.statusStorer)
 	if err != nil {
@@ -589,7 +941,22 @@ func (components *ethKleverBridgeComponents) createKCToEthereumBridge(args ArgsE
 
 	timeForWaitOnEthereum := time.Second * time.Duration(args.Configs.GeneralConfig.Eth.IntervalToWaitForTransferInSeconds)
 
-	balanceValidator, err := components.createBalanceValidator()
+	kcClient, err := ethklever.NewTracedKCClient(components.kcClient, components.tracer)
+	if err != nil {
+		return err
+	}
+
+	ethClient, err := ethklever.NewTracedEthereumClient(components.ethClient, components.tracer)
+	if err != nil {
+		return err
+	}
+
+	balanceValidator, err := components.createBalanceValidator(kcClient, ethClient)
+	if err != nil {
+		return err
+	}
+
+	kcActivityNotifier, err := components.createKCActivityNotifier(args)
 	if err != nil {
 		return err
 	}
@@ -597,12 +964,17 @@ func (components *ethKleverBridgeComponents) createKCToEthereumBridge(args ArgsE
 	argsBridgeExecutor := ethklever.ArgsBridgeExecutor{
 		Log:                        log,
 		TopologyProvider:           topologyHandler,
-		KCClient:                   components.kcClient,
-		EthereumClient:             components.ethClient,
+		KCClient:                   kcClient,
+		EthereumClient:             ethClient,
 		StatusHandler:              components.kcToEthStatusHandler,
 		TimeForWaitOnEthereum:      timeForWaitOnEthereum,
 		SignaturesHolder:           components.ethtoKleverSignaturesHolder,
 		BalanceValidator:           balanceValidator,
+		BatchNotifier:              disabled.NewDisabledBatchNotifier(),
+		KCActivityNotifier:         kcActivityNotifier,
+		CallDataValidator:          disabled.NewDisabledCallDataValidator(),
+		BatchSignaturesTracker:     components.relayersHealth,
+		LeaderLease:                leaderLease,
 		MaxQuorumRetriesOnEthereum: args.Configs.GeneralConfig.Eth.MaxRetriesOnQuorumReached,
 		MaxQuorumRetriesOnKC:       args.Configs.GeneralConfig.Klever.MaxRetriesOnQuorumReached,
 		MaxRetriesOnWasProposed:    args.Configs.GeneralConfig.Klever.MaxRetriesOnWasTransferProposed,
@@ -617,6 +989,7 @@ func (components *ethKleverBridgeComponents) createKCToEthereumBridge(args ArgsE
 	if err != nil {
 		return err
 	}
+	components.kcToEthSpanAttributes = bridge
 
 	return nil
 }
@@ -661,11 +1034,80 @@ func (components *ethKleverBridgeComponents) Start() error {
 	return nil
 }
 
-func (components *ethKleverBridgeComponents) createBalanceValidator() (ethklever.BalanceValidator, error) {
+func (components *ethKleverBridgeComponents) createEthereumBatchNotifier(args ArgsEthereumToKleverBridge) (ethklever.BatchNotifier, error) {
+	subscriptionConfig := args.Configs.GeneralConfig.Eth.EventsSubscription
+	if !subscriptionConfig.Enabled {
+		return disabled.NewDisabledBatchNotifier(), nil
+	}
+	if args.EthereumLogsSubscriber == nil {
+		return nil, errNilEthereumLogsSubscriber
+	}
+
+	ethEventsSubscriberLogId := components.evmCompatibleChain.EvmCompatibleChainEventsSubscriberLogId()
+	argsEventsSubscriber := eventsSubscriber.ArgsEventsSubscriber{
+		Log:                 core.NewLoggerWithIdentifier(logger.GetOrCreate(ethEventsSubscriberLogId), ethEventsSubscriberLogId),
+		LogsSubscriber:      args.EthereumLogsSubscriber,
+		SafeContractAddress: common.HexToAddress(args.Configs.GeneralConfig.Eth.SafeContractAddress),
+		ResubscribeInterval: time.Second * time.Duration(subscriptionConfig.ResubscribeIntervalInSeconds),
+		MaxWaitTime:         time.Second * time.Duration(subscriptionConfig.MaxWaitTimeInSeconds),
+	}
+
+	subscriber, err := eventsSubscriber.NewEventsSubscriber(argsEventsSubscriber)
+	if err != nil {
+		return nil, err
+	}
+	components.addClosableComponent(subscriber)
+
+	return subscriber, nil
+}
+
+func (components *ethKleverBridgeComponents) createKCActivityNotifier(args ArgsEthereumToKleverBridge) (ethklever.KCActivityNotifier, error) {
+	watcherConfig := args.Configs.GeneralConfig.Klever.BlocksWatcher
+	if !watcherConfig.Enabled {
+		return disabled.NewDisabledKCActivityNotifier(), nil
+	}
+
+	blocksWatcherLogId := components.evmCompatibleChain.KleverBlockchainBlocksWatcherLogId()
+	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(blocksWatcherLogId), blocksWatcherLogId)
+
+	argsBlocksWatcher := blocksWatcher.ArgsBlocksWatcher{
+		Log:                 log,
+		Proxy:               args.Proxy,
+		SafeContractAddress: components.kleverSafeContractAddress,
+		ActivityWindow:      time.Second * time.Duration(watcherConfig.ActivityWindowInSeconds),
+		MaxWaitTime:         time.Second * time.Duration(watcherConfig.MaxWaitTimeInSeconds),
+		MaxBlocksPerPoll:    watcherConfig.MaxBlocksPerPoll,
+	}
+
+	watcher, err := blocksWatcher.NewBlocksWatcher(argsBlocksWatcher)
+	if err != nil {
+		return nil, err
+	}
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             "Klever Blockchain blocks watcher",
+		PollingInterval:  time.Duration(watcherConfig.PollingIntervalInMillis) * time.Millisecond,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         watcher,
+	}
+
+	pollingHandler, err := polling.NewPollingHandler(argsPollingHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	return watcher, nil
+}
+
+func (components *ethKleverBridgeComponents) createBalanceValidator(kcClient ethklever.KCClient, ethClient ethklever.EthereumClient) (ethklever.BalanceValidator, error) {
 	argsBalanceValidator := balanceValidatorManagement.ArgsBalanceValidator{
 		Log:            components.baseLogger,
-		KCClient:       components.kcClient,
-		EthereumClient: components.ethClient,
+		KCClient:       kcClient,
+		EthereumClient: ethClient,
 	}
 
 	return balanceValidatorManagement.NewBalanceValidator(argsBalanceValidator)
@@ -676,11 +1118,13 @@ func (components *ethKleverBridgeComponents) createEthereumToKleverBlockchainSta
 	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(ethtokleverName), ethtokleverName)
 
 	argsStateMachine := stateMachine.ArgsStateMachine{
-		StateMachineName:     ethtokleverName,
-		Steps:                components.ethtoKleverMachineStates,
-		StartStateIdentifier: ethtoklever.GettingPendingBatchFromEthereum,
-		Log:                  log,
-		StatusHandler:        components.ethtoKleverStatusHandler,
+		StateMachineName:       ethtokleverName,
+		Steps:                  components.ethtoKleverMachineStates,
+		StartStateIdentifier:   ethtoklever.GettingPendingBatchFromEthereum,
+		Log:                    log,
+		StatusHandler:          components.ethtoKleverStatusHandler,
+		Tracer:                 components.tracer,
+		SpanAttributesProvider: components.ethtoKleverSpanAttributes,
 	}
 
 	var err error
@@ -713,11 +1157,13 @@ func (components *ethKleverBridgeComponents) createKCToEthereumStateMachine() er
 	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(kcToEthName), kcToEthName)
 
 	argsStateMachine := stateMachine.ArgsStateMachine{
-		StateMachineName:     kcToEthName,
-		Steps:                components.kcToEthMachineStates,
-		StartStateIdentifier: kctoeth.GettingPendingBatchFromKC,
-		Log:                  log,
-		StatusHandler:        components.kcToEthStatusHandler,
+		StateMachineName:       kcToEthName,
+		Steps:                  components.kcToEthMachineStates,
+		StartStateIdentifier:   kctoeth.GettingPendingBatchFromKC,
+		Log:                    log,
+		StatusHandler:          components.kcToEthStatusHandler,
+		Tracer:                 components.tracer,
+		SpanAttributesProvider: components.kcToEthSpanAttributes,
 	}
 
 	var err error
@@ -819,3 +1265,13 @@ func (components *ethKleverBridgeComponents) KleverRelayerAddress() address.Addr
 func (components *ethKleverBridgeComponents) EthereumRelayerAddress() common.Address {
 	return components.ethereumRelayerAddress
 }
+
+// TokensRegistry returns the component holding the bridged tokens information
+func (components *ethKleverBridgeComponents) TokensRegistry() core.TokensRegistry {
+	return components.tokensRegistry
+}
+
+// RelayersHealth returns the component holding the relayers set health information
+func (components *ethKleverBridgeComponents) RelayersHealth() core.RelayersHealthProvider {
+	return components.relayersHealth
+}
@@ -1,23 +1,30 @@
 package factory
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/klever-io/klv-bridge-eth-go/bridges/ethKC/topology"
+	"github.com/klever-io/klv-bridge-eth-go/clients"
 	"github.com/klever-io/klv-bridge-eth-go/clients/chain"
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/proxy"
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/proxy/models"
 	"github.com/klever-io/klv-bridge-eth-go/config"
 	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/p2p"
 	"github.com/klever-io/klv-bridge-eth-go/status"
 	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
 	bridgeTests "github.com/klever-io/klv-bridge-eth-go/testsCommon/bridge"
 	p2pMocks "github.com/klever-io/klv-bridge-eth-go/testsCommon/p2p"
+	"github.com/klever-io/klv-bridge-eth-go/tracing"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	chainConfig "github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/stretchr/testify/assert"
@@ -71,10 +78,23 @@ func createMockEthKleverBridgeArgs() ArgsEthereumToKleverBridge {
 				FinalityCheck:           true,
 			},
 		},
+		P2P: config.ConfigP2P{
+			MessagesVersion: core.EnvelopeMessagesVersion,
+		},
 		Relayer: config.ConfigRelayer{
+			Marshalizer: chainConfig.MarshalizerConfig{
+				Type:           "gogo protobuf",
+				SizeCheckDelta: 10,
+			},
 			RoleProvider: config.RoleProviderConfig{
 				PollingIntervalInMillis: 1000,
 			},
+			TokensRegistry: config.TokensRegistryConfig{
+				PollingIntervalInMillis: 1000,
+			},
+			RelayersHealth: config.RelayersHealthConfig{
+				PollingIntervalInMillis: 1000,
+			},
 		},
 		StateMachine: map[string]config.ConfigStateMachine{
 			"EthereumToKleverBlockchain": stateMachineConfig,
@@ -259,9 +279,227 @@ func TestNewEthKleverBridgeComponents(t *testing.T) {
 		components, err := NewEthKleverBridgeComponents(args)
 		require.Nil(t, err)
 		require.NotNil(t, components)
-		require.Equal(t, 7, len(components.closableHandlers))
+		require.Equal(t, 10, len(components.closableHandlers))
 		require.False(t, check.IfNil(components.ethtoKleverStatusHandler))
 		require.False(t, check.IfNil(components.kcToEthStatusHandler))
+		require.False(t, check.IfNil(components.TokensRegistry()))
+		require.False(t, check.IfNil(components.RelayersHealth()))
+	})
+	t.Run("events subscription enabled without a logs subscriber should error", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthKleverBridgeArgs()
+		args.Configs.GeneralConfig.Eth.EventsSubscription.Enabled = true
+
+		components, err := NewEthKleverBridgeComponents(args)
+		assert.Equal(t, errNilEthereumLogsSubscriber, err)
+		assert.Nil(t, components)
+	})
+	t.Run("events subscription enabled should work", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthKleverBridgeArgs()
+		args.Configs.GeneralConfig.Eth.EventsSubscription = config.EventsSubscriptionConfig{
+			Enabled:                      true,
+			ResubscribeIntervalInSeconds: 1,
+			MaxWaitTimeInSeconds:         1,
+		}
+		args.EthereumLogsSubscriber = &bridgeTests.LogsSubscriberStub{}
+
+		components, err := NewEthKleverBridgeComponents(args)
+		require.Nil(t, err)
+		require.NotNil(t, components)
+		require.Equal(t, 11, len(components.closableHandlers))
+
+		err = components.Close()
+		assert.Nil(t, err)
+	})
+	t.Run("invalid marshalizer type should error", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthKleverBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.Marshalizer.Type = "invalid"
+
+		components, err := NewEthKleverBridgeComponents(args)
+		assert.NotNil(t, err)
+		assert.Nil(t, components)
+	})
+	t.Run("invalid messages version should error", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthKleverBridgeArgs()
+		args.Configs.GeneralConfig.P2P.MessagesVersion = core.EnvelopeMessagesVersion + 1
+
+		components, err := NewEthKleverBridgeComponents(args)
+		assert.ErrorIs(t, err, p2p.ErrInvalidMessagesVersion)
+		assert.Nil(t, components)
+	})
+	t.Run("invalid blocks watcher config should error", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthKleverBridgeArgs()
+		args.Configs.GeneralConfig.Klever.BlocksWatcher = config.BlocksWatcherConfig{
+			Enabled:                 true,
+			PollingIntervalInMillis: 1000,
+			MaxWaitTimeInSeconds:    1,
+		}
+
+		components, err := NewEthKleverBridgeComponents(args)
+		assert.ErrorIs(t, err, clients.ErrInvalidValue)
+		assert.True(t, strings.Contains(err.Error(), "MaxBlocksPerPoll"))
+		assert.Nil(t, components)
+	})
+	t.Run("blocks watcher enabled should work", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthKleverBridgeArgs()
+		args.Configs.GeneralConfig.Klever.BlocksWatcher = config.BlocksWatcherConfig{
+			Enabled:                 true,
+			PollingIntervalInMillis: 1000,
+			ActivityWindowInSeconds: 60,
+			MaxWaitTimeInSeconds:    1,
+			MaxBlocksPerPoll:        10,
+		}
+
+		components, err := NewEthKleverBridgeComponents(args)
+		require.Nil(t, err)
+		require.NotNil(t, components)
+		require.Equal(t, 11, len(components.closableHandlers))
+
+		err = components.Close()
+		assert.Nil(t, err)
+	})
+	t.Run("p2p state persistence enabled should work", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthKleverBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.P2PStatePersistence.Enabled = true
+
+		components, err := NewEthKleverBridgeComponents(args)
+		require.Nil(t, err)
+		require.NotNil(t, components)
+		assert.Equal(t, "*ethKC.persistentSignaturesHolder", fmt.Sprintf("%T", components.ethtoKleverSignaturesHolder))
+
+		err = components.Close()
+		assert.Nil(t, err)
+	})
+	t.Run("unknown leader selection policy should error", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthKleverBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.LeaderSelection.Policy = "unknown"
+
+		components, err := NewEthKleverBridgeComponents(args)
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "unknown leader selection policy"))
+		assert.Nil(t, components)
+	})
+	t.Run("liveness leader selection policy should work", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthKleverBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.LeaderSelection = config.LeaderSelectionConfig{
+			Policy:                 topology.LivenessPolicy,
+			MaxInactivityInSeconds: 900,
+		}
+
+		components, err := NewEthKleverBridgeComponents(args)
+		require.Nil(t, err)
+		require.NotNil(t, components)
+		assert.Equal(t, "*topology.livenessLeaderSelector", fmt.Sprintf("%T", components.leaderSelector))
+
+		err = components.Close()
+		assert.Nil(t, err)
+	})
+	t.Run("liveness leader selection policy with max inactivity not greater than the join interval should error", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthKleverBridgeArgs()
+		args.TimeBeforeRepeatJoin = time.Minute * 5
+		args.Configs.GeneralConfig.Relayer.LeaderSelection = config.LeaderSelectionConfig{
+			Policy:                 topology.LivenessPolicy,
+			MaxInactivityInSeconds: 300,
+		}
+
+		components, err := NewEthKleverBridgeComponents(args)
+		assert.True(t, errors.Is(err, errInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "for LeaderSelection.MaxInactivityInSeconds"))
+		assert.Nil(t, components)
+	})
+	t.Run("stake weighted leader selection policy should work", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthKleverBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.LeaderSelection.Policy = topology.StakeWeightedPolicy
+
+		components, err := NewEthKleverBridgeComponents(args)
+		require.Nil(t, err)
+		require.NotNil(t, components)
+		assert.Equal(t, "*topology.stakeWeightedLeaderSelector", fmt.Sprintf("%T", components.leaderSelector))
+
+		err = components.Close()
+		assert.Nil(t, err)
+	})
+	t.Run("invalid leader lease config should error", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthKleverBridgeArgs()
+		args.Configs.GeneralConfig.StateMachine["KleverBlockchainToEthereum"] = config.ConfigStateMachine{
+			StepDurationInMillis:       1000,
+			IntervalForLeaderInSeconds: 60,
+			LeaderLease: config.LeaderLeaseConfig{
+				Enabled: true,
+			},
+		}
+
+		components, err := NewEthKleverBridgeComponents(args)
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "invalid grace period"))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid tracing config should error", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthKleverBridgeArgs()
+		args.Configs.GeneralConfig.Tracing = config.TracingConfig{
+			Enabled:       true,
+			Exporter:      "unknown",
+			SamplingRatio: 1,
+		}
+
+		components, err := NewEthKleverBridgeComponents(args)
+		assert.True(t, errors.Is(err, tracing.ErrUnknownExporter))
+		assert.Nil(t, components)
+	})
+	t.Run("tracing enabled should work", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthKleverBridgeArgs()
+		args.Configs.GeneralConfig.Tracing = config.TracingConfig{
+			Enabled:       true,
+			Exporter:      tracing.FileExporter,
+			FilePath:      filepath.Join(t.TempDir(), "traces.json"),
+			SamplingRatio: 1,
+		}
+
+		components, err := NewEthKleverBridgeComponents(args)
+		require.Nil(t, err)
+		require.NotNil(t, components)
+
+		_, span := components.tracer.Start(context.Background(), "span")
+		assert.True(t, span.IsRecording())
+		span.End()
+
+		err = components.Close()
+		assert.Nil(t, err)
+	})
+	t.Run("leader lease enabled should work", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthKleverBridgeArgs()
+		stateMachineConfig := config.ConfigStateMachine{
+			StepDurationInMillis:       1000,
+			IntervalForLeaderInSeconds: 60,
+			LeaderLease: config.LeaderLeaseConfig{
+				Enabled:                   true,
+				GracePeriodInSeconds:      60,
+				MaxLeaseDurationInSeconds: 600,
+			},
+		}
+		args.Configs.GeneralConfig.StateMachine["EthereumToKleverBlockchain"] = stateMachineConfig
+		args.Configs.GeneralConfig.StateMachine["KleverBlockchainToEthereum"] = stateMachineConfig
+
+		components, err := NewEthKleverBridgeComponents(args)
+		require.Nil(t, err)
+		require.NotNil(t, components)
+
+		err = components.Close()
+		assert.Nil(t, err)
 	})
 }
 
@@ -274,7 +512,7 @@ func TestEthKleverBridgeComponents_StartAndCloseShouldWork(t *testing.T) {
 
 	err = components.Start()
 	assert.Nil(t, err)
-	assert.Equal(t, 7, len(components.closableHandlers))
+	assert.Equal(t, 10, len(components.closableHandlers))
 
 	time.Sleep(time.Second * 2) // allow go routines to start
 
@@ -2,7 +2,10 @@ package factory
 
 import (
 	"context"
+	"math/big"
+	"time"
 
+	ethklever "github.com/klever-io/klv-bridge-eth-go/bridges/ethKC"
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
 	"github.com/klever-io/klv-bridge-eth-go/core"
 )
@@ -11,6 +14,8 @@ type dataGetter interface {
 	GetTokenIdForErc20Address(ctx context.Context, erc20Address []byte) ([][]byte, error)
 	GetERC20AddressForTokenId(ctx context.Context, tokenId []byte) ([][]byte, error)
 	GetAllStakedRelayers(ctx context.Context) ([][]byte, error)
+	GetAmountStaked(ctx context.Context, relayer []byte) (*big.Int, error)
+	GetAllKnownTokens(ctx context.Context) ([][]byte, error)
 	IsInterfaceNil() bool
 }
 
@@ -19,6 +24,7 @@ type KleverRoleProvider interface {
 	Execute(ctx context.Context) error
 	IsWhitelisted(address address.Address) bool
 	SortedPublicKeys() [][]byte
+	StakeOf(publicKey []byte) *big.Int
 	IsInterfaceNil() bool
 }
 
@@ -29,17 +35,57 @@ type EthereumRoleProvider interface {
 	IsInterfaceNil() bool
 }
 
+// TokensRegistry defines the operations for the tokens registry component
+type TokensRegistry interface {
+	Execute(ctx context.Context) error
+	GetTokens() *core.TokensRegistrySnapshot
+	IsInterfaceNil() bool
+}
+
+// RelayersHealth defines the operations for the relayers health component
+type RelayersHealth interface {
+	Execute(ctx context.Context) error
+	RecordJoin(publicKey []byte)
+	RecordSignature(publicKey []byte, messageHash []byte)
+	RecordBatch(batchID uint64, messageHash []byte)
+	LastActivity(publicKey []byte) time.Time
+	GetRelayersHealth() *core.RelayersHealthSnapshot
+	IsInterfaceNil() bool
+}
+
+// TokensCache defines the operations for the cache of token mappings and contract flags
+type TokensCache interface {
+	Get(key string) (interface{}, bool)
+	Put(key string, value interface{})
+	InvalidateTokens(tokens ...[]byte)
+	InvalidateAll()
+	IsInterfaceNil() bool
+}
+
 // Broadcaster defines a component able to communicate with other such instances and manage signatures and other state related data
 type Broadcaster interface {
 	BroadcastSignature(signature []byte, messageHash []byte)
+	RequestSignatures(messageHash []byte)
 	BroadcastJoinTopic()
 	SortedPublicKeys() [][]byte
 	RegisterOnTopics() error
 	AddBroadcastClient(client core.BroadcastClient) error
+	BroadcastLease(lease *core.LeaderLease)
+	AddLeaseClient(client core.LeaseClient) error
 	Close() error
 	IsInterfaceNil() bool
 }
 
+// LeaderLease defines a component able to track the leader leases of a half-bridge
+type LeaderLease interface {
+	CurrentLeaseHolder() []byte
+	AnnounceLease(batchID uint64)
+	RenewLease(batchID uint64)
+	ReleaseLease(batchID uint64)
+	ProcessLease(publicKey []byte, lease *core.LeaderLease)
+	IsInterfaceNil() bool
+}
+
 // StateMachine defines a state machine component
 type StateMachine interface {
 	Execute(ctx context.Context) error
@@ -51,3 +97,9 @@ type PollingHandler interface {
 	StartProcessingLoop() error
 	IsInterfaceNil() bool
 }
+
+// SignaturesHolder defines the operations of a component able to gather and manage the relayers' signatures
+type SignaturesHolder interface {
+	core.BroadcastClient
+	ethklever.SignaturesHolder
+}
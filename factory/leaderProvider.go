@@ -0,0 +1,42 @@
+package factory
+
+import (
+	"sync"
+
+	"github.com/klever-io/klv-bridge-eth-go/clients/relayersHealth"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// delayedLeaderProvider forwards the CurrentLeader calls to a leader provider set after the construction. It is used
+// by the relayers health component, created before the topology handler that might use it as liveness provider
+type delayedLeaderProvider struct {
+	mut            sync.RWMutex
+	leaderProvider relayersHealth.LeaderProvider
+}
+
+func newDelayedLeaderProvider() *delayedLeaderProvider {
+	return &delayedLeaderProvider{}
+}
+
+func (provider *delayedLeaderProvider) setLeaderProvider(leaderProvider relayersHealth.LeaderProvider) {
+	provider.mut.Lock()
+	provider.leaderProvider = leaderProvider
+	provider.mut.Unlock()
+}
+
+// CurrentLeader returns the current leader or nil if the leader provider was not set
+func (provider *delayedLeaderProvider) CurrentLeader() []byte {
+	provider.mut.RLock()
+	defer provider.mut.RUnlock()
+
+	if check.IfNil(provider.leaderProvider) {
+		return nil
+	}
+
+	return provider.leaderProvider.CurrentLeader()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (provider *delayedLeaderProvider) IsInterfaceNil() bool {
+	return provider == nil
+}
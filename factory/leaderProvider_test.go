@@ -0,0 +1,25 @@
+package factory
+
+import (
+	"testing"
+
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelayedLeaderProvider_CurrentLeader(t *testing.T) {
+	t.Parallel()
+
+	provider := newDelayedLeaderProvider()
+	assert.False(t, check.IfNil(provider))
+	assert.Nil(t, provider.CurrentLeader())
+
+	leader := []byte("leader")
+	provider.setLeaderProvider(&testsCommon.LeaderProviderStub{
+		CurrentLeaderCalled: func() []byte {
+			return leader
+		},
+	})
+	assert.Equal(t, leader, provider.CurrentLeader())
+}
@@ -4,17 +4,26 @@ import (
 	"io"
 
 	"github.com/klever-io/klv-bridge-eth-go/api/gin"
+	"github.com/klever-io/klv-bridge-eth-go/api/shared"
 	"github.com/klever-io/klv-bridge-eth-go/config"
 	"github.com/klever-io/klv-bridge-eth-go/core"
 	"github.com/klever-io/klv-bridge-eth-go/facade"
 )
 
-// StartWebServer creates and starts a web server able to respond with the metrics holder information
-func StartWebServer(configs config.Configs, metricsHolder core.MetricsHolder) (io.Closer, error) {
+// StartWebServer creates and starts a web server able to respond with the metrics holder, tokens registry and
+// relayers health information
+func StartWebServer(
+	configs config.Configs,
+	metricsHolder core.MetricsHolder,
+	tokensRegistry core.TokensRegistry,
+	relayersHealth core.RelayersHealthProvider,
+) (io.Closer, error) {
 	argsFacade := facade.ArgsRelayerFacade{
-		MetricsHolder: metricsHolder,
-		ApiInterface:  configs.FlagsConfig.RestApiInterface,
-		PprofEnabled:  configs.FlagsConfig.EnablePprof,
+		MetricsHolder:  metricsHolder,
+		TokensRegistry: tokensRegistry,
+		RelayersHealth: relayersHealth,
+		ApiInterface:   configs.FlagsConfig.RestApiInterface,
+		PprofEnabled:   configs.FlagsConfig.EnablePprof,
 	}
 
 	relayerFacade, err := facade.NewRelayerFacade(argsFacade)
@@ -22,10 +31,42 @@ func StartWebServer(configs config.Configs, metricsHolder core.MetricsHolder) (i
 		return nil, err
 	}
 
+	return startWebServer(relayerFacade, configs.ApiRoutesConfig, configs.GeneralConfig.WebAntiflood)
+}
+
+// StartScCallsExecutorWebServer creates and starts a web server able to respond with the metrics holder and
+// the SC calls execution information
+func StartScCallsExecutorWebServer(
+	flagsConfig config.ContextFlagsConfig,
+	apiRoutesConfig config.ApiRoutesConfig,
+	antifloodConfig config.WebAntifloodConfig,
+	metricsHolder core.MetricsHolder,
+	scCallsInfoProvider core.ScCallsInfoProvider,
+) (io.Closer, error) {
+	argsFacade := facade.ArgsScCallsExecutorFacade{
+		MetricsHolder:       metricsHolder,
+		ScCallsInfoProvider: scCallsInfoProvider,
+		ApiInterface:        flagsConfig.RestApiInterface,
+		PprofEnabled:        flagsConfig.EnablePprof,
+	}
+
+	scCallsExecutorFacade, err := facade.NewScCallsExecutorFacade(argsFacade)
+	if err != nil {
+		return nil, err
+	}
+
+	return startWebServer(scCallsExecutorFacade, apiRoutesConfig, antifloodConfig)
+}
+
+func startWebServer(
+	facadeHandler shared.FacadeHandler,
+	apiRoutesConfig config.ApiRoutesConfig,
+	antifloodConfig config.WebAntifloodConfig,
+) (io.Closer, error) {
 	httpServerArgs := gin.ArgsNewWebServer{
-		Facade:          relayerFacade,
-		ApiConfig:       configs.ApiRoutesConfig,
-		AntiFloodConfig: configs.GeneralConfig.WebAntiflood,
+		Facade:          facadeHandler,
+		ApiConfig:       apiRoutesConfig,
+		AntiFloodConfig: antifloodConfig,
 	}
 
 	httpServerWrapper, err := gin.NewWebServerHandler(httpServerArgs)
@@ -6,6 +6,7 @@ import (
 	"github.com/klever-io/klv-bridge-eth-go/config"
 	"github.com/klever-io/klv-bridge-eth-go/core"
 	"github.com/klever-io/klv-bridge-eth-go/status"
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,10 +21,44 @@ func TestStartWebServer(t *testing.T) {
 		},
 	}
 
-	webServer, err := StartWebServer(cfg, status.NewMetricsHolder())
+	webServer, err := StartWebServer(cfg, status.NewMetricsHolder(), &testsCommon.TokensRegistryStub{}, &testsCommon.RelayersHealthStub{})
 	assert.Nil(t, err)
 	assert.NotNil(t, webServer)
 
 	err = webServer.Close()
 	assert.Nil(t, err)
 }
+
+func TestStartScCallsExecutorWebServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil SC calls info provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		webServer, err := StartScCallsExecutorWebServer(
+			config.ContextFlagsConfig{RestApiInterface: core.WebServerOffString},
+			config.ApiRoutesConfig{},
+			config.WebAntifloodConfig{},
+			status.NewMetricsHolder(),
+			nil,
+		)
+		assert.NotNil(t, err)
+		assert.Nil(t, webServer)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		webServer, err := StartScCallsExecutorWebServer(
+			config.ContextFlagsConfig{RestApiInterface: core.WebServerOffString},
+			config.ApiRoutesConfig{},
+			config.WebAntifloodConfig{},
+			status.NewMetricsHolder(),
+			&testsCommon.ScCallsInfoProviderStub{},
+		)
+		assert.Nil(t, err)
+		assert.NotNil(t, webServer)
+
+		err = webServer.Close()
+		assert.Nil(t, err)
+	})
+}
@@ -9,17 +9,20 @@ import (
 	"time"
 
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	"github.com/klever-io/klv-bridge-eth-go/core"
 	"github.com/klever-io/klv-bridge-eth-go/integrationTests"
 	"github.com/klever-io/klv-bridge-eth-go/p2p"
 	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
 	p2pMocks "github.com/klever-io/klv-bridge-eth-go/testsCommon/p2p"
 	mockRoleProviders "github.com/klever-io/klv-bridge-eth-go/testsCommon/roleProviders"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	chainConfig "github.com/multiversx/mx-chain-go/config"
 	chainP2P "github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/factory"
 	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 func TestNetworkOfBroadcastersShouldPassTheSignatures(t *testing.T) {
@@ -159,7 +162,7 @@ func TestNetworkOfBroadcastersShouldBootstrapOnLateBroadcasterWhenLateConnecting
 	expectedPkInOrder = copyAndSortBytesSlices(publicKeysBytes)
 
 	integrationTests.Log.Info("creating the late broadcaster")
-	lateBroadcaster, lateSigHolder := createBroadcaster(t, messengers[len(messengers)-1], roleProvider, privateKeys[len(privateKeys)-1])
+	lateBroadcaster, lateSigHolder := createBroadcaster(t, messengers[len(messengers)-1], roleProvider, privateKeys[len(privateKeys)-1], core.EnvelopeMessagesVersion)
 
 	time.Sleep(time.Second)
 	lateBroadcaster.BroadcastJoinTopic()
@@ -181,7 +184,12 @@ func createBroadcasters(
 	broadcasters := make([]integrationTests.Broadcaster, 0, numBroadcasters)
 	signaturesHolders := make([]*testsCommon.SignaturesHolderMock, 0, numBroadcasters)
 	for i := 0; i < numBroadcasters; i++ {
-		b, sigHolder := createBroadcaster(t, messengers[i], roleProvider, privateKeys[i])
+		// mix the messages versions as it happens while the relayers set is upgraded
+		messagesVersion := core.LegacyMessagesVersion
+		if i%2 == 1 {
+			messagesVersion = core.EnvelopeMessagesVersion
+		}
+		b, sigHolder := createBroadcaster(t, messengers[i], roleProvider, privateKeys[i], messagesVersion)
 
 		broadcasters = append(broadcasters, b)
 		signaturesHolders = append(signaturesHolders, sigHolder)
@@ -195,6 +203,7 @@ func createBroadcaster(
 	messenger chainP2P.Messenger,
 	roleProvider *mockRoleProviders.KleverRoleProviderStub,
 	privateKey crypto.PrivateKey,
+	messagesVersion uint32,
 ) (integrationTests.Broadcaster, *testsCommon.SignaturesHolderMock) {
 	cfg := chainConfig.Config{
 		Antiflood: p2pMocks.CreateAntifloodConfig(),
@@ -205,6 +214,8 @@ func createBroadcaster(
 	args := p2p.ArgsBroadcaster{
 		Messenger:           messenger,
 		Log:                 integrationTests.Log,
+		Marshalizer:         &marshal.GogoProtoMarshalizer{},
+		MessagesVersion:     messagesVersion,
 		KCRoleProvider:      roleProvider,
 		KeyGen:              integrationTests.TestKeyGenerator,
 		SingleSigner:        integrationTests.TestSingleSigner,
@@ -212,6 +223,9 @@ func createBroadcaster(
 		SignatureProcessor:  &testsCommon.SignatureProcessorStub{},
 		Name:                "test",
 		AntifloodComponents: ac,
+		ActivityTracker:     &testsCommon.RelayersActivityTrackerStub{},
+		NoncesStorer:        testsCommon.NewStorerMock(),
+		Tracer:              noop.NewTracerProvider().Tracer(""),
 	}
 
 	b, err := p2p.NewBroadcaster(args)
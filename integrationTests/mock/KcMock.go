@@ -97,6 +97,11 @@ func (mock *KleverBlockchainMock) GetNetworkStatus(ctx context.Context) (*models
 	return &models.NodeOverview{}, nil
 }
 
+// GetBlockByNonce implements proxy.Proxy.
+func (mock *KleverBlockchainMock) GetBlockByNonce(ctx context.Context, nonce uint64) (*models.Block, error) {
+	return &models.Block{}, nil
+}
+
 // GetTransactionInfoWithResults implements proxy.Proxy.
 func (mock *KleverBlockchainMock) GetTransactionInfoWithResults(ctx context.Context, hash string) (*models.TransactionData, error) {
 	return &models.TransactionData{}, nil
@@ -187,6 +192,14 @@ func (mock *KleverBlockchainMock) SetLastExecutedEthTxId(lastExecutedEthTxId uin
 	mock.lastExecutedEthTxId = lastExecutedEthTxId
 }
 
+// SetKdaSafeAddress -
+func (mock *KleverBlockchainMock) SetKdaSafeAddress(address address.Address) {
+	mock.mutState.Lock()
+	defer mock.mutState.Unlock()
+
+	mock.kdaSafeAddress = address.Bytes()
+}
+
 // SetQuorum -
 func (mock *KleverBlockchainMock) SetQuorum(quorum int) {
 	mock.mutState.Lock()
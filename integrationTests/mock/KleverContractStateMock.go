@@ -67,6 +67,7 @@ type kleverBlockchainContractStateMock struct {
 	quorum                           int
 	lastExecutedEthBatchId           uint64
 	lastExecutedEthTxId              uint64
+	kdaSafeAddress                   []byte
 
 	ProposeMultiTransferKdaBatchCalled func()
 }
@@ -293,6 +294,10 @@ func (mock *kleverBlockchainContractStateMock) processVmRequests(vmRequest *mode
 		return mock.vmRequestGetLastBatchId(vmRequest), nil
 	case "convertEthToKdaAmount":
 		return mock.vmRequestConvertEthToKdaAmount(vmRequest), nil
+	case "getAllKnownTokens":
+		return mock.vmRequestGetAllKnownTokens(vmRequest), nil
+	case "getKdaSafeAddress":
+		return mock.vmRequestGetKdaSafeAddress(vmRequest), nil
 	}
 
 	return nil, fmt.Errorf("unimplemented function: %s", vmRequest.FuncName)
@@ -568,6 +573,10 @@ func (mock *kleverBlockchainContractStateMock) vmRequestGetLastBatchId(_ *models
 	return createOkVmResponse([][]byte{mock.pendingBatch.Nonce.Bytes()})
 }
 
+func (mock *kleverBlockchainContractStateMock) vmRequestGetKdaSafeAddress(_ *models.VmValueRequest) *models.VmValuesResponseData {
+	return createOkVmResponse([][]byte{mock.kdaSafeAddress})
+}
+
 func (mock *kleverBlockchainContractStateMock) vmRequestConvertEthToKdaAmount(vmRequest *models.VmValueRequest) *models.VmValuesResponseData {
 	// Parse the token from the first argument
 	hexedToken := vmRequest.Args[0]
@@ -585,6 +594,10 @@ func (mock *kleverBlockchainContractStateMock) vmRequestConvertEthToKdaAmount(vm
 	return createOkVmResponse([][]byte{convertedAmount.Bytes()})
 }
 
+func (mock *kleverBlockchainContractStateMock) vmRequestGetAllKnownTokens(_ *models.VmValueRequest) *models.VmValuesResponseData {
+	return createOkVmResponse(mock.getAllKnownTokens())
+}
+
 func getBigIntFromString(data string) *big.Int {
 	buff, err := hex.DecodeString(data)
 	if err != nil {
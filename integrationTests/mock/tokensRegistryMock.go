@@ -3,6 +3,7 @@ package mock
 import (
 	"encoding/hex"
 	"math/big"
+	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/klever-io/klv-bridge-eth-go/integrationTests"
@@ -128,3 +129,18 @@ func (mock *tokensRegistryMock) getMintBalances(ticker string) *big.Int {
 func (mock *tokensRegistryMock) getBurnBalances(ticker string) *big.Int {
 	return mock.burnBalances[ticker]
 }
+
+func (mock *tokensRegistryMock) getAllKnownTokens() [][]byte {
+	tickers := make([]string, 0, len(mock.ethToKC))
+	for _, ticker := range mock.ethToKC {
+		tickers = append(tickers, ticker)
+	}
+	sort.Strings(tickers)
+
+	tokens := make([][]byte, 0, len(tickers))
+	for _, ticker := range tickers {
+		tokens = append(tokens, []byte(ticker))
+	}
+
+	return tokens
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/klever-io/klv-bridge-eth-go/clients/chain"
 	"github.com/klever-io/klv-bridge-eth-go/config"
+	bridgeCore "github.com/klever-io/klv-bridge-eth-go/core"
 	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
 	bridgeTests "github.com/klever-io/klv-bridge-eth-go/testsCommon/bridge"
 	chainConfig "github.com/multiversx/mx-chain-go/config"
@@ -106,7 +107,9 @@ func CreateBridgeComponentsConfig(index int, workingDir string, gasStationURL st
 				FinalityCheck:           true,
 			},
 		},
-		P2P: config.ConfigP2P{},
+		P2P: config.ConfigP2P{
+			MessagesVersion: bridgeCore.EnvelopeMessagesVersion,
+		},
 		StateMachine: map[string]config.ConfigStateMachine{
 			"EthereumToKleverBlockchain": stateMachineConfig,
 			"KleverBlockchainToEthereum": stateMachineConfig,
@@ -119,6 +122,15 @@ func CreateBridgeComponentsConfig(index int, workingDir string, gasStationURL st
 			RoleProvider: config.RoleProviderConfig{
 				PollingIntervalInMillis: 1000,
 			},
+			TokensRegistry: config.TokensRegistryConfig{
+				PollingIntervalInMillis: 1000,
+			},
+			RelayersHealth: config.RelayersHealthConfig{
+				PollingIntervalInMillis: 1000,
+			},
+			P2PStatePersistence: config.P2PStatePersistenceConfig{
+				Enabled: true,
+			},
 		},
 	}
 }
@@ -70,6 +70,7 @@ func NewBridgeComponents(
 			},
 			Proxy:                     chainSimulator.Proxy(),
 			ClientWrapper:             ethereumChain,
+			EthereumLogsSubscriber:    ethBackend.Client(),
 			Messenger:                 messengers[i],
 			StatusStorer:              testsCommon.NewStorerMock(),
 			TimeForBootstrap:          time.Second * 5,
@@ -79,6 +80,11 @@ func NewBridgeComponents(
 			KleverClientStatusHandler: &testsCommon.StatusHandlerStub{},
 		}
 		argsBridgeComponents.Configs.GeneralConfig.Eth.SafeContractAddress = ethSafeContractAddress
+		argsBridgeComponents.Configs.GeneralConfig.Eth.EventsSubscription = config.EventsSubscriptionConfig{
+			Enabled:                      true,
+			ResubscribeIntervalInSeconds: 1,
+			MaxWaitTimeInSeconds:         10,
+		}
 		argsBridgeComponents.Erc20ContractsHolder = erc20ContractsHolder
 		argsBridgeComponents.Configs.GeneralConfig.Klever.NetworkAddress = chainSimulator.GetNetworkAddress()
 		argsBridgeComponents.Configs.GeneralConfig.Klever.SafeContractAddress = kdaSafeAddress.Bech32()
@@ -12,6 +12,8 @@ import (
 
 	"github.com/klever-io/klv-bridge-eth-go/config"
 	"github.com/klever-io/klv-bridge-eth-go/executors/kleverBlockchain/module"
+	"github.com/klever-io/klv-bridge-eth-go/status"
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
 	sdkCore "github.com/multiversx/mx-sdk-go/core"
 	"github.com/stretchr/testify/require"
 )
@@ -135,10 +137,25 @@ func (setup *TestSetup) startScCallerModule() {
 			ExecutionTimeoutInSeconds:  2,
 			TimeInSecondsBetweenChecks: 1,
 		},
+		Concurrency: config.ConcurrencyConfig{
+			NumWorkers:                  4,
+			PreserveOrderPerDestination: true,
+		},
+		RetryPolicy: config.ScCallsRetryPolicyConfig{
+			MaxAttempts:             10,
+			InitialBackoffInSeconds: 1,
+			MaxBackoffInSeconds:     4,
+		},
 	}
 
 	var err error
-	setup.ScCallerModuleInstance, err = module.NewScCallsModule(cfg, log, nil)
+	argsScCallsModule := module.ArgsScCallsModule{
+		Config:        cfg,
+		Storer:        testsCommon.NewStorerMock(),
+		MetricsHolder: status.NewMetricsHolder(),
+		Log:           log,
+	}
+	setup.ScCallerModuleInstance, err = module.NewScCallsModule(argsScCallsModule)
 	require.Nil(setup, err)
 	log.Info("started SC calls module", "monitoring SC proxy address", setup.KCHandler.ScProxyAddress)
 }
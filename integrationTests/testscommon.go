@@ -44,6 +44,7 @@ type Connectable interface {
 // Broadcaster defines a component able to communicate with other such instances and manage signatures and other state related data
 type Broadcaster interface {
 	BroadcastSignature(signature []byte, messageHash []byte)
+	RequestSignatures(messageHash []byte)
 	BroadcastJoinTopic()
 	SortedPublicKeys() [][]byte
 	AddBroadcastClient(client core.BroadcastClient) error
@@ -1,6 +1,8 @@
 package p2p
 
 import (
+	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"sync"
@@ -8,6 +10,7 @@ import (
 
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
 	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/tracing"
 	chainCore "github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/marshal"
@@ -15,19 +18,25 @@ import (
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/factory"
 	logger "github.com/multiversx/mx-chain-logger-go"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
 	joinTopicSuffix        = "_join"
 	signTopicSuffix        = "_sign"
+	sigReqTopicSuffix      = "_sigreq"
+	leaseTopicSuffix       = "_lease"
 	defaultTopicIdentifier = "default"
 	joinTopicMessage       = "join topic"
+	maxOwnSignatures       = 100
 )
 
 // ArgsBroadcaster is the DTO used in the broadcaster constructor
 type ArgsBroadcaster struct {
 	Messenger           NetMessenger
 	Log                 logger.Logger
+	Marshalizer         marshal.Marshalizer
+	MessagesVersion     uint32
 	KCRoleProvider      KCRoleProvider
 	SignatureProcessor  SignatureProcessor
 	KeyGen              crypto.KeyGenerator
@@ -35,6 +44,9 @@ type ArgsBroadcaster struct {
 	PrivateKey          crypto.PrivateKey
 	Name                string
 	AntifloodComponents *factory.AntiFloodComponents
+	ActivityTracker     RelayersActivityTracker
+	NoncesStorer        core.Storer
+	Tracer              trace.Tracer
 }
 
 type broadcaster struct {
@@ -44,11 +56,19 @@ type broadcaster struct {
 	log                logger.Logger
 	kleverRoleProvider KCRoleProvider
 	signatureProcessor SignatureProcessor
+	activityTracker    RelayersActivityTracker
+	tracer             trace.Tracer
 	name               string
 	mutClients         sync.RWMutex
 	clients            []core.BroadcastClient
+	leaseClients       []core.LeaseClient
 	joinTopicName      string
 	signTopicName      string
+	sigReqTopicName    string
+	leaseTopicName     string
+	mutOwnSignatures   sync.RWMutex
+	ownSignatures      map[string][]byte
+	ownSignaturesOrder []string
 }
 
 // NewBroadcaster will create a new broadcaster able to pass messages and signatures
@@ -58,24 +78,44 @@ func NewBroadcaster(args ArgsBroadcaster) (*broadcaster, error) {
 		return nil, err
 	}
 
+	messagesVersion := args.MessagesVersion
+	if messagesVersion == 0 {
+		// an unset value keeps the messages readable by all the relayers
+		messagesVersion = core.LegacyMessagesVersion
+	}
+
+	nonces, err := newNoncesOfPublicKeys(args.NoncesStorer, args.Name, args.Log)
+	if err != nil {
+		return nil, err
+	}
+
 	b := &broadcaster{
 		name:               args.Name,
 		messenger:          args.Messenger,
-		noncesOfPublicKeys: newNoncesOfPublicKeys(),
+		noncesOfPublicKeys: nonces,
 		log:                args.Log,
 		kleverRoleProvider: args.KCRoleProvider,
 		signatureProcessor: args.SignatureProcessor,
+		activityTracker:    args.ActivityTracker,
+		tracer:             args.Tracer,
 		relayerMessageHandler: &relayerMessageHandler{
-			marshalizer:         &marshal.JsonMarshalizer{},
+			marshalizer:         args.Marshalizer,
+			legacyMarshalizer:   &marshal.JsonMarshalizer{},
+			messagesVersion:     messagesVersion,
 			keyGen:              args.KeyGen,
 			singleSigner:        args.SingleSigner,
 			counter:             uint64(time.Now().UnixNano()),
 			privateKey:          args.PrivateKey,
 			antifloodComponents: args.AntifloodComponents,
 		},
-		clients:       make([]core.BroadcastClient, 0),
-		joinTopicName: args.Name + joinTopicSuffix,
-		signTopicName: args.Name + signTopicSuffix,
+		clients:            make([]core.BroadcastClient, 0),
+		leaseClients:       make([]core.LeaseClient, 0),
+		joinTopicName:      args.Name + joinTopicSuffix,
+		signTopicName:      args.Name + signTopicSuffix,
+		sigReqTopicName:    args.Name + sigReqTopicSuffix,
+		leaseTopicName:     args.Name + leaseTopicSuffix,
+		ownSignatures:      make(map[string][]byte),
+		ownSignaturesOrder: make([]string, 0, maxOwnSignatures),
 	}
 	pk := b.privateKey.GeneratePublic()
 	b.publicKeyBytes, err = pk.ToByteArray()
@@ -93,6 +133,12 @@ func checkArgs(args ArgsBroadcaster) error {
 	if check.IfNil(args.Log) {
 		return ErrNilLogger
 	}
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if args.MessagesVersion > core.EnvelopeMessagesVersion {
+		return fmt.Errorf("%w: %d", ErrInvalidMessagesVersion, args.MessagesVersion)
+	}
 	if check.IfNil(args.KeyGen) {
 		return ErrNilKeyGenerator
 	}
@@ -114,13 +160,22 @@ func checkArgs(args ArgsBroadcaster) error {
 	if args.AntifloodComponents == nil {
 		return ErrNilAntifloodComponents
 	}
+	if check.IfNil(args.ActivityTracker) {
+		return ErrNilRelayersActivityTracker
+	}
+	if check.IfNil(args.NoncesStorer) {
+		return ErrNilNoncesStorer
+	}
+	if args.Tracer == nil {
+		return ErrNilTracer
+	}
 
 	return nil
 }
 
 // RegisterOnTopics will register the messenger on all required topics
 func (b *broadcaster) RegisterOnTopics() error {
-	topics := []string{b.joinTopicName, b.signTopicName}
+	topics := []string{b.joinTopicName, b.signTopicName, b.sigReqTopicName, b.leaseTopicName}
 	for _, topic := range topics {
 		err := b.messenger.CreateTopic(topic, true)
 		if err != nil {
@@ -140,7 +195,7 @@ func (b *broadcaster) RegisterOnTopics() error {
 
 // ProcessReceivedMessage will be called by the network messenger whenever a new message is received
 func (b *broadcaster) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer chainCore.PeerID, _ p2p.MessageHandler) error {
-	msg, err := b.preProcessMessage(message, fromConnectedPeer)
+	msg, version, err := b.preProcessMessage(message, fromConnectedPeer)
 	if err != nil {
 		b.log.Debug("got message", "topic", message.Topic(), "error", err)
 		return err
@@ -177,9 +232,14 @@ func (b *broadcaster) ProcessReceivedMessage(message p2p.MessageP2P, fromConnect
 
 	switch message.Topic() {
 	case b.joinTopicName:
+		b.activityTracker.RecordJoin(msg.PublicKeyBytes)
 		b.processJoinMessage(message)
 	case b.signTopicName:
-		b.processSignMessage(msg)
+		b.processSignMessage(msg, version)
+	case b.sigReqTopicName:
+		b.processSignatureRequestMessage(message, msg, version)
+	case b.leaseTopicName:
+		b.processLeaseMessage(msg, version)
 	}
 
 	return nil
@@ -192,9 +252,9 @@ func (b *broadcaster) processJoinMessage(message p2p.MessageP2P) {
 	}
 }
 
-func (b *broadcaster) getEthereumSignature(msg *core.SignedMessage) (*core.EthereumSignature, error) {
+func (b *broadcaster) getEthereumSignature(msg *core.SignedMessage, version uint32) (*core.EthereumSignature, error) {
 	ethSignature := &core.EthereumSignature{}
-	err := b.marshalizer.Unmarshal(ethSignature, msg.Payload)
+	err := b.unmarshalPayload(ethSignature, msg.Payload, version)
 	if err != nil {
 		return nil, err
 	}
@@ -207,14 +267,73 @@ func (b *broadcaster) getEthereumSignature(msg *core.SignedMessage) (*core.Ether
 	return ethSignature, nil
 }
 
-func (b *broadcaster) processSignMessage(msg *core.SignedMessage) {
-	ethSignature, err := b.getEthereumSignature(msg)
+func (b *broadcaster) processSignMessage(msg *core.SignedMessage, version uint32) {
+	_, span := tracing.StartSpan(context.Background(), b.tracer, "p2p.ReceiveSignature", tracing.Relayer(msg.PublicKeyBytes))
+	ethSignature, err := b.getEthereumSignature(msg, version)
 	if err != nil {
+		tracing.EndSpan(span, err)
 		b.log.Debug("received message does not contain a valid signature", "error", err)
 		return
 	}
+	span.SetAttributes(tracing.MessageHash(ethSignature.MessageHash))
+
+	b.activityTracker.RecordSignature(msg.PublicKeyBytes, ethSignature.MessageHash)
 
 	b.notifyClients(msg, ethSignature)
+	tracing.EndSpan(span, nil)
+}
+
+func (b *broadcaster) processSignatureRequestMessage(message p2p.MessageP2P, msg *core.SignedMessage, version uint32) {
+	if bytes.Equal(msg.PublicKeyBytes, b.publicKeyBytes) {
+		return
+	}
+
+	request := &core.SignatureRequest{}
+	err := b.unmarshalPayload(request, msg.Payload, version)
+	if err != nil {
+		b.log.Debug("received message does not contain a valid signature request", "error", err)
+		return
+	}
+
+	b.mutOwnSignatures.RLock()
+	payload, found := b.ownSignatures[string(request.MessageHash)]
+	b.mutOwnSignatures.RUnlock()
+	if !found {
+		return
+	}
+
+	// a fresh signed message is required as the previously broadcast one will be rejected by the nonce check
+	response, err := b.createMessage(payload)
+	if err != nil {
+		b.log.Debug("error creating signature response", "error", err)
+		return
+	}
+
+	err = b.sendSignedMessageToPeer(response, message.Peer())
+	if err != nil {
+		b.log.Debug("error sending signature response",
+			"error", err, "peer", message.Peer().Pretty())
+	}
+}
+
+func (b *broadcaster) processLeaseMessage(msg *core.SignedMessage, version uint32) {
+	if bytes.Equal(msg.PublicKeyBytes, b.publicKeyBytes) {
+		return
+	}
+
+	lease := &core.LeaderLease{}
+	err := b.unmarshalPayload(lease, msg.Payload, version)
+	if err != nil {
+		b.log.Debug("received message does not contain a valid leader lease", "error", err)
+		return
+	}
+
+	b.mutClients.RLock()
+	defer b.mutClients.RUnlock()
+
+	for _, client := range b.leaseClients {
+		client.ProcessLease(msg.PublicKeyBytes, lease)
+	}
 }
 
 func (b *broadcaster) notifyClients(msg *core.SignedMessage, ethMsg *core.EthereumSignature) {
@@ -253,7 +372,7 @@ func (b *broadcaster) retrieveUniqueMessages() map[string]*core.SignedMessage {
 }
 
 func (b *broadcaster) sendSignedMessageToPeer(msg *core.SignedMessage, peerId chainCore.PeerID) error {
-	buff, err := b.marshalizer.Marshal(msg)
+	buff, err := b.marshalSignedMessage(msg, messagesVersionOfPayload(msg.Payload))
 	if err != nil {
 		return err
 	}
@@ -264,20 +383,85 @@ func (b *broadcaster) sendSignedMessageToPeer(msg *core.SignedMessage, peerId ch
 // BroadcastSignature will send the provided signature as payload in a wrapped signed message to the other peers.
 // It will broadcast the message to all available peers
 func (b *broadcaster) BroadcastSignature(signature []byte, messageHash []byte) {
+	_, span := tracing.StartSpan(context.Background(), b.tracer, "p2p.BroadcastSignature",
+		tracing.MessageHash(messageHash), tracing.Relayer(b.publicKeyBytes))
+
 	ethSig := &core.EthereumSignature{
 		Signature:   signature,
 		MessageHash: messageHash,
 	}
 
-	payload, err := b.marshalizer.Marshal(ethSig)
+	payload, err := b.marshalPayload(ethSig, b.messagesVersion)
 	if err != nil {
 		b.log.Error("error creating signature payload", "error", err)
 	}
 
+	b.storeOwnSignature(messageHash, payload)
+
 	err = b.broadcastMessage(payload, b.signTopicName)
 	if err != nil {
 		b.log.Error("error sending signature", "error", err)
 	}
+	tracing.EndSpan(span, err)
+
+	b.activityTracker.RecordSignature(b.publicKeyBytes, messageHash)
+}
+
+func (b *broadcaster) storeOwnSignature(messageHash []byte, payload []byte) {
+	b.mutOwnSignatures.Lock()
+	defer b.mutOwnSignatures.Unlock()
+
+	key := string(messageHash)
+	_, exists := b.ownSignatures[key]
+	if !exists {
+		b.ownSignaturesOrder = append(b.ownSignaturesOrder, key)
+	}
+	b.ownSignatures[key] = payload
+
+	if len(b.ownSignaturesOrder) > maxOwnSignatures {
+		delete(b.ownSignatures, b.ownSignaturesOrder[0])
+		b.ownSignaturesOrder = b.ownSignaturesOrder[1:]
+	}
+}
+
+// RequestSignatures will ask the other peers to send directly their signature on the provided message hash
+func (b *broadcaster) RequestSignatures(messageHash []byte) {
+	_, span := tracing.StartSpan(context.Background(), b.tracer, "p2p.RequestSignatures", tracing.MessageHash(messageHash))
+
+	request := &core.SignatureRequest{
+		MessageHash: messageHash,
+	}
+
+	payload, err := b.marshalPayload(request, b.messagesVersion)
+	if err != nil {
+		tracing.EndSpan(span, err)
+		b.log.Error("error creating signature request payload", "error", err)
+		return
+	}
+
+	err = b.broadcastMessage(payload, b.sigReqTopicName)
+	if err != nil {
+		b.log.Error("error sending signature request", "error", err)
+	}
+	tracing.EndSpan(span, err)
+}
+
+// BroadcastLease will send the provided leader lease as payload in a wrapped signed message to the other peers
+func (b *broadcaster) BroadcastLease(lease *core.LeaderLease) {
+	if lease == nil {
+		return
+	}
+
+	payload, err := b.marshalPayload(lease, b.messagesVersion)
+	if err != nil {
+		b.log.Error("error creating leader lease payload", "error", err)
+		return
+	}
+
+	err = b.broadcastMessage(payload, b.leaseTopicName)
+	if err != nil {
+		b.log.Error("error sending leader lease", "error", err)
+	}
 }
 
 // BroadcastJoinTopic will send the provided signature as payload in a wrapped signed message to the other peers.
@@ -287,6 +471,8 @@ func (b *broadcaster) BroadcastJoinTopic() {
 	if err != nil {
 		b.log.Error("error sending signature", "error", err)
 	}
+
+	b.activityTracker.RecordJoin(b.publicKeyBytes)
 }
 
 func (b *broadcaster) broadcastMessage(payload []byte, topic string) error {
@@ -295,7 +481,7 @@ func (b *broadcaster) broadcastMessage(payload []byte, topic string) error {
 		return err
 	}
 
-	buff, err := b.marshalizer.Marshal(msg)
+	buff, err := b.marshalSignedMessage(msg, b.messagesVersion)
 	if err != nil {
 		return err
 	}
@@ -319,8 +505,23 @@ func (b *broadcaster) AddBroadcastClient(client core.BroadcastClient) error {
 	return nil
 }
 
+// AddLeaseClient will add a client to the list so it can be notified of the newly received leader leases
+func (b *broadcaster) AddLeaseClient(client core.LeaseClient) error {
+	if check.IfNil(client) {
+		return ErrNilLeaseClient
+	}
+
+	b.mutClients.Lock()
+	b.leaseClients = append(b.leaseClients, client)
+	b.mutClients.Unlock()
+
+	return nil
+}
+
 // Close will close any containing members and clean any go routines associated
 func (b *broadcaster) Close() error {
+	_ = b.closeNonces()
+
 	return b.messenger.Close()
 }
 
@@ -1,8 +1,10 @@
 package p2p
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
 	"strings"
 	"testing"
@@ -13,8 +15,10 @@ import (
 	cryptoMocks "github.com/klever-io/klv-bridge-eth-go/testsCommon/crypto"
 	p2pMocks "github.com/klever-io/klv-bridge-eth-go/testsCommon/p2p"
 	roleProvidersMock "github.com/klever-io/klv-bridge-eth-go/testsCommon/roleProviders"
+	"github.com/klever-io/klv-bridge-eth-go/tracing"
 	chainCore "github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	chainConfig "github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/p2p"
@@ -23,6 +27,10 @@ import (
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 func createMockArgsBroadcaster() ArgsBroadcaster {
@@ -33,6 +41,8 @@ func createMockArgsBroadcaster() ArgsBroadcaster {
 	return ArgsBroadcaster{
 		Messenger:           &p2pMocks.MessengerStub{},
 		Log:                 logger.GetOrCreate("test"),
+		Marshalizer:         &testsCommon.MarshalizerMock{},
+		MessagesVersion:     core.LegacyMessagesVersion,
 		KCRoleProvider:      &roleProvidersMock.KleverRoleProviderStub{},
 		KeyGen:              &cryptoMocks.KeyGenStub{},
 		SingleSigner:        &cryptoMocks.SingleSignerStub{},
@@ -40,6 +50,9 @@ func createMockArgsBroadcaster() ArgsBroadcaster {
 		SignatureProcessor:  &testsCommon.SignatureProcessorStub{},
 		Name:                "test",
 		AntifloodComponents: ac,
+		ActivityTracker:     &testsCommon.RelayersActivityTrackerStub{},
+		NoncesStorer:        testsCommon.NewStorerMock(),
+		Tracer:              noop.NewTracerProvider().Tracer(""),
 	}
 }
 
@@ -62,6 +75,31 @@ func TestNewBroadcaster(t *testing.T) {
 		assert.True(t, check.IfNil(b))
 		assert.Equal(t, ErrNilLogger, err)
 	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.Marshalizer = nil
+
+		b, err := NewBroadcaster(args)
+		assert.True(t, check.IfNil(b))
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("invalid messages version should error", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.MessagesVersion = core.EnvelopeMessagesVersion + 1
+
+		b, err := NewBroadcaster(args)
+		assert.True(t, check.IfNil(b))
+		assert.True(t, errors.Is(err, ErrInvalidMessagesVersion))
+	})
+	t.Run("zero messages version should default to the legacy version", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.MessagesVersion = 0
+
+		b, err := NewBroadcaster(args)
+		assert.False(t, check.IfNil(b))
+		assert.Nil(t, err)
+		assert.Equal(t, core.LegacyMessagesVersion, b.messagesVersion)
+	})
 	t.Run("nil key gen should error", func(t *testing.T) {
 		args := createMockArgsBroadcaster()
 		args.KeyGen = nil
@@ -135,6 +173,30 @@ func TestNewBroadcaster(t *testing.T) {
 		assert.True(t, check.IfNil(b))
 		assert.Equal(t, ErrNilAntifloodComponents, err)
 	})
+	t.Run("nil activity tracker should error", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.ActivityTracker = nil
+
+		b, err := NewBroadcaster(args)
+		assert.True(t, check.IfNil(b))
+		assert.Equal(t, ErrNilRelayersActivityTracker, err)
+	})
+	t.Run("nil nonces storer should error", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.NoncesStorer = nil
+
+		b, err := NewBroadcaster(args)
+		assert.True(t, check.IfNil(b))
+		assert.Equal(t, ErrNilNoncesStorer, err)
+	})
+	t.Run("nil tracer should error", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.Tracer = nil
+
+		b, err := NewBroadcaster(args)
+		assert.True(t, check.IfNil(b))
+		assert.Equal(t, ErrNilTracer, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		args := createMockArgsBroadcaster()
 
@@ -194,7 +256,7 @@ func TestBroadcaster_RegisterOnTopics(t *testing.T) {
 		err := b.RegisterOnTopics()
 
 		require.Nil(t, err)
-		topics := []string{args.Name + joinTopicSuffix, args.Name + signTopicSuffix}
+		topics := []string{args.Name + joinTopicSuffix, args.Name + signTopicSuffix, args.Name + sigReqTopicSuffix, args.Name + leaseTopicSuffix}
 		for _, topic := range topics {
 			assert.Equal(t, 1, createTopics[topic])
 			assert.Equal(t, 1, register[topic])
@@ -292,6 +354,12 @@ func TestBroadcaster_ProcessReceivedMessage(t *testing.T) {
 			},
 		}
 		args.AntifloodComponents, _ = factory.NewP2PAntiFloodComponents(context.Background(), cfg, &statusHandler.AppStatusHandlerStub{}, pid)
+		joinedPublicKeys := make([][]byte, 0)
+		args.ActivityTracker = &testsCommon.RelayersActivityTrackerStub{
+			RecordJoinCalled: func(publicKey []byte) {
+				joinedPublicKeys = append(joinedPublicKeys, publicKey)
+			},
+		}
 
 		b, _ := NewBroadcaster(args)
 		err := b.AddBroadcastClient(client)
@@ -313,6 +381,7 @@ func TestBroadcaster_ProcessReceivedMessage(t *testing.T) {
 		err = b.ProcessReceivedMessage(p2pMsg, "", nil)
 		assert.Nil(t, err)
 		assert.True(t, sendWasCalled)
+		assert.Equal(t, [][]byte{msg2.PublicKeyBytes}, joinedPublicKeys)
 
 		assert.Equal(t, [][]byte{msg1.PublicKeyBytes, msg2.PublicKeyBytes}, b.SortedPublicKeys())
 	})
@@ -440,6 +509,12 @@ func TestBroadcaster_ProcessReceivedMessage(t *testing.T) {
 		args.Messenger = &p2pMocks.MessengerStub{}
 
 		processedMessages := make([]*core.SignedMessage, 0)
+		signers := make([][]byte, 0)
+		args.ActivityTracker = &testsCommon.RelayersActivityTrackerStub{
+			RecordSignatureCalled: func(publicKey []byte, messageHash []byte) {
+				signers = append(signers, publicKey)
+			},
+		}
 		b, _ := NewBroadcaster(args)
 		_ = b.AddBroadcastClient(&testsCommon.BroadcastClientStub{
 			ProcessNewMessageCalled: func(msg *core.SignedMessage, ethMsg *core.EthereumSignature) {
@@ -464,6 +539,142 @@ func TestBroadcaster_ProcessReceivedMessage(t *testing.T) {
 
 		assert.Equal(t, [][]byte{msg1.PublicKeyBytes, msg2.PublicKeyBytes}, b.SortedPublicKeys())
 		assert.Equal(t, []*core.SignedMessage{msg2, msg1}, processedMessages)
+		assert.Equal(t, [][]byte{msg2.PublicKeyBytes, msg1.PublicKeyBytes}, signers)
+	})
+	t.Run("signature request for an unknown message hash should not respond", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.Messenger = &p2pMocks.MessengerStub{
+			SendToConnectedPeerCalled: func(topic string, buff []byte, peerID chainCore.PeerID) error {
+				assert.Fail(t, "should have not called SendToConnectedPeer")
+				return nil
+			},
+		}
+		b, _ := NewBroadcaster(args)
+		b.BroadcastSignature([]byte("eth signature"), []byte("eth message"))
+
+		_, buff := createSignedMessageForSignatureRequest(0, []byte("other eth message"))
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff,
+			TopicField: args.Name + sigReqTopicSuffix,
+			PeerField:  "p1",
+		}
+
+		err := b.ProcessReceivedMessage(p2pMsg, "p1", nil)
+		assert.Nil(t, err)
+	})
+	t.Run("signature request should send the own signature to the requesting peer", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		ethSig := []byte("eth signature")
+		ethMsg := []byte("eth message")
+		sig := []byte("signature")
+		args.SingleSigner = &cryptoMocks.SingleSignerStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+				return sig, nil
+			},
+		}
+		var broadcastNonce uint64
+		args.Messenger = &p2pMocks.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+				msg := &core.SignedMessage{}
+				_ = marshalizer.Unmarshal(msg, buff)
+				broadcastNonce = msg.Nonce
+			},
+		}
+		b, _ := NewBroadcaster(args)
+		b.BroadcastSignature(ethSig, ethMsg)
+
+		sendCalled := false
+		b.messenger = &p2pMocks.MessengerStub{
+			SendToConnectedPeerCalled: func(topic string, buff []byte, peerID chainCore.PeerID) error {
+				sendCalled = true
+				assert.Equal(t, args.Name+signTopicSuffix, topic)
+				assert.Equal(t, chainCore.PeerID("p1"), peerID)
+
+				msg := &core.SignedMessage{}
+				err := marshalizer.Unmarshal(msg, buff)
+				require.Nil(t, err)
+				assert.Equal(t, sig, msg.Signature)
+				assert.Greater(t, msg.Nonce, broadcastNonce)
+
+				ethMsgInstance := &core.EthereumSignature{}
+				err = marshalizer.Unmarshal(ethMsgInstance, msg.Payload)
+				require.Nil(t, err)
+				assert.Equal(t, ethSig, ethMsgInstance.Signature)
+				assert.Equal(t, ethMsg, ethMsgInstance.MessageHash)
+
+				return nil
+			},
+		}
+
+		_, buff := createSignedMessageForSignatureRequest(0, ethMsg)
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff,
+			TopicField: args.Name + sigReqTopicSuffix,
+			PeerField:  "p1",
+		}
+
+		err := b.ProcessReceivedMessage(p2pMsg, "p1", nil)
+		assert.Nil(t, err)
+		assert.True(t, sendCalled)
+	})
+	t.Run("leader lease should notify the lease clients", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		b, _ := NewBroadcaster(args)
+
+		lease := &core.LeaderLease{
+			Name:    "half-bridge",
+			BatchID: 37,
+		}
+		var receivedPublicKeys [][]byte
+		var receivedLeases []*core.LeaderLease
+		err := b.AddLeaseClient(&testsCommon.LeaseClientStub{
+			ProcessLeaseCalled: func(publicKey []byte, lease *core.LeaderLease) {
+				receivedPublicKeys = append(receivedPublicKeys, publicKey)
+				receivedLeases = append(receivedLeases, lease)
+			},
+		})
+		require.Nil(t, err)
+
+		msg, buff := createSignedMessageForLeaderLease(0, lease)
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff,
+			TopicField: args.Name + leaseTopicSuffix,
+		}
+
+		err = b.ProcessReceivedMessage(p2pMsg, "", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{msg.PublicKeyBytes}, receivedPublicKeys)
+		assert.Equal(t, []*core.LeaderLease{lease}, receivedLeases)
+	})
+	t.Run("own leader lease should not notify the lease clients", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		b, _ := NewBroadcaster(args)
+
+		err := b.AddLeaseClient(&testsCommon.LeaseClientStub{
+			ProcessLeaseCalled: func(publicKey []byte, lease *core.LeaderLease) {
+				assert.Fail(t, "should have not called ProcessLease")
+			},
+		})
+		require.Nil(t, err)
+
+		msg, _ := createSignedMessageForLeaderLease(0, &core.LeaderLease{Name: "half-bridge"})
+		b.publicKeyBytes = msg.PublicKeyBytes
+		b.processLeaseMessage(msg, core.LegacyMessagesVersion)
+	})
+	t.Run("invalid leader lease should not notify the lease clients", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		b, _ := NewBroadcaster(args)
+
+		err := b.AddLeaseClient(&testsCommon.LeaseClientStub{
+			ProcessLeaseCalled: func(publicKey []byte, lease *core.LeaderLease) {
+				assert.Fail(t, "should have not called ProcessLease")
+			},
+		})
+		require.Nil(t, err)
+
+		msg, _ := createSignedMessageForLeaderLease(0, &core.LeaderLease{Name: "half-bridge"})
+		msg.Payload = []byte("invalid payload")
+		b.processLeaseMessage(msg, core.LegacyMessagesVersion)
 	})
 }
 
@@ -490,10 +701,17 @@ func TestBroadcaster_BroadcastJoinTopic(t *testing.T) {
 			assert.Equal(t, []byte(joinTopicMessage), msg.Payload)
 		},
 	}
+	joinRecorded := false
+	args.ActivityTracker = &testsCommon.RelayersActivityTrackerStub{
+		RecordJoinCalled: func(publicKey []byte) {
+			joinRecorded = true
+		},
+	}
 	b, _ := NewBroadcaster(args)
 
 	b.BroadcastJoinTopic()
 	assert.True(t, broadcastCalled)
+	assert.True(t, joinRecorded)
 }
 
 func TestBroadcaster_BroadcastSignature(t *testing.T) {
@@ -527,10 +745,213 @@ func TestBroadcaster_BroadcastSignature(t *testing.T) {
 			assert.Equal(t, ethMsg, ethMsgInstance.MessageHash)
 		},
 	}
+	var recordedMessageHash []byte
+	args.ActivityTracker = &testsCommon.RelayersActivityTrackerStub{
+		RecordSignatureCalled: func(publicKey []byte, messageHash []byte) {
+			recordedMessageHash = messageHash
+		},
+	}
 	b, _ := NewBroadcaster(args)
 
 	b.BroadcastSignature(ethSig, ethMsg)
 	assert.True(t, broadcastCalled)
+	assert.Equal(t, ethMsg, recordedMessageHash)
+}
+
+func TestBroadcaster_EnvelopeMessagesVersion(t *testing.T) {
+	t.Parallel()
+
+	protoMarshalizer := &marshal.GogoProtoMarshalizer{}
+	ethSig := &core.EthereumSignature{
+		Signature:   []byte("eth signature"),
+		MessageHash: []byte("eth message"),
+	}
+
+	t.Run("broadcast signature should send an enveloped message", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBroadcaster()
+		args.Marshalizer = protoMarshalizer
+		args.MessagesVersion = core.EnvelopeMessagesVersion
+		broadcastCalled := false
+		args.Messenger = &p2pMocks.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+				broadcastCalled = true
+
+				require.Equal(t, byte(core.EnvelopeMessagesVersion), buff[0])
+				envelope := &core.MessageEnvelope{}
+				err := protoMarshalizer.Unmarshal(envelope, buff[1:])
+				require.Nil(t, err)
+				assert.Equal(t, core.EnvelopeMessagesVersion, envelope.Version)
+
+				msg := &core.SignedMessage{}
+				err = protoMarshalizer.Unmarshal(msg, envelope.Data)
+				require.Nil(t, err)
+
+				require.Equal(t, byte(core.EnvelopeMessagesVersion), msg.Payload[0])
+				ethMsgInstance := &core.EthereumSignature{}
+				err = protoMarshalizer.Unmarshal(ethMsgInstance, msg.Payload[1:])
+				require.Nil(t, err)
+				assert.Equal(t, ethSig, ethMsgInstance)
+			},
+		}
+		b, _ := NewBroadcaster(args)
+
+		b.BroadcastSignature(ethSig.Signature, ethSig.MessageHash)
+		assert.True(t, broadcastCalled)
+	})
+	t.Run("should process both legacy and enveloped messages", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBroadcaster()
+		args.Marshalizer = protoMarshalizer
+		args.MessagesVersion = core.EnvelopeMessagesVersion
+		b, _ := NewBroadcaster(args)
+
+		receivedSignatures := make([]*core.EthereumSignature, 0)
+		_ = b.AddBroadcastClient(&testsCommon.BroadcastClientStub{
+			ProcessNewMessageCalled: func(msg *core.SignedMessage, ethMsg *core.EthereumSignature) {
+				receivedSignatures = append(receivedSignatures, ethMsg)
+			},
+		})
+
+		_, legacyBuff := createSignedMessageForEthSig(0)
+		err := b.ProcessReceivedMessage(&p2pMocks.P2PMessageMock{
+			DataField:  legacyBuff,
+			TopicField: args.Name + signTopicSuffix,
+		}, "", nil)
+		require.Nil(t, err)
+
+		payload, _ := b.marshalPayload(ethSig, core.EnvelopeMessagesVersion)
+		msg := &core.SignedMessage{
+			Payload:        payload,
+			PublicKeyBytes: bytes.Repeat([]byte{1}, 32),
+			Signature:      []byte("sig"),
+			Nonce:          34,
+		}
+		envelopedBuff, _ := b.marshalSignedMessage(msg, core.EnvelopeMessagesVersion)
+		err = b.ProcessReceivedMessage(&p2pMocks.P2PMessageMock{
+			DataField:  envelopedBuff,
+			TopicField: args.Name + signTopicSuffix,
+		}, "", nil)
+		require.Nil(t, err)
+
+		require.Equal(t, 2, len(receivedSignatures))
+		assert.Equal(t, []byte("eth sig 0"), receivedSignatures[0].Signature)
+		assert.Equal(t, ethSig, receivedSignatures[1])
+	})
+}
+
+func TestBroadcaster_RequestSignatures(t *testing.T) {
+	t.Parallel()
+
+	broadcastCalled := false
+	ethMsg := []byte("eth message")
+	args := createMockArgsBroadcaster()
+	args.Messenger = &p2pMocks.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			broadcastCalled = true
+			assert.Equal(t, args.Name+sigReqTopicSuffix, topic)
+
+			msg := &core.SignedMessage{}
+			err := marshalizer.Unmarshal(msg, buff)
+			require.Nil(t, err)
+
+			request := &core.SignatureRequest{}
+			err = marshalizer.Unmarshal(request, msg.Payload)
+			require.Nil(t, err)
+			assert.Equal(t, ethMsg, request.MessageHash)
+		},
+	}
+	b, _ := NewBroadcaster(args)
+
+	b.RequestSignatures(ethMsg)
+	assert.True(t, broadcastCalled)
+}
+
+func TestBroadcaster_SignatureSpans(t *testing.T) {
+	t.Parallel()
+
+	recorder := tracetest.NewSpanRecorder()
+	args := createMockArgsBroadcaster()
+	args.Tracer = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer(tracing.TracerName)
+	b, _ := NewBroadcaster(args)
+
+	ethMsg := []byte("eth message")
+	b.BroadcastSignature([]byte("eth signature"), ethMsg)
+	b.RequestSignatures(ethMsg)
+
+	msg, buff := createSignedMessageForEthSig(0)
+	err := b.ProcessReceivedMessage(&p2pMocks.P2PMessageMock{
+		DataField:  buff,
+		TopicField: args.Name + signTopicSuffix,
+	}, "", nil)
+	require.Nil(t, err)
+
+	spans := recorder.Ended()
+	require.Equal(t, 3, len(spans))
+
+	assert.Equal(t, "p2p.BroadcastSignature", spans[0].Name())
+	assert.Equal(t, []attribute.KeyValue{tracing.MessageHash(ethMsg), tracing.Relayer(b.publicKeyBytes)}, spans[0].Attributes())
+	assert.Equal(t, "p2p.RequestSignatures", spans[1].Name())
+	assert.Equal(t, []attribute.KeyValue{tracing.MessageHash(ethMsg)}, spans[1].Attributes())
+	assert.Equal(t, "p2p.ReceiveSignature", spans[2].Name())
+	expectedAttributes := []attribute.KeyValue{
+		tracing.Relayer(msg.PublicKeyBytes),
+		tracing.MessageHash([]byte("eth msg hash")),
+	}
+	assert.Equal(t, expectedAttributes, spans[2].Attributes())
+}
+
+func TestBroadcaster_BroadcastLease(t *testing.T) {
+	t.Parallel()
+
+	broadcastCalled := false
+	lease := &core.LeaderLease{
+		Name:     "half-bridge",
+		BatchID:  37,
+		Released: true,
+	}
+	args := createMockArgsBroadcaster()
+	args.Messenger = &p2pMocks.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			broadcastCalled = true
+			assert.Equal(t, args.Name+leaseTopicSuffix, topic)
+
+			msg := &core.SignedMessage{}
+			err := marshalizer.Unmarshal(msg, buff)
+			require.Nil(t, err)
+
+			receivedLease := &core.LeaderLease{}
+			err = marshalizer.Unmarshal(receivedLease, msg.Payload)
+			require.Nil(t, err)
+			assert.Equal(t, lease, receivedLease)
+		},
+	}
+	b, _ := NewBroadcaster(args)
+
+	b.BroadcastLease(nil)
+	assert.False(t, broadcastCalled)
+
+	b.BroadcastLease(lease)
+	assert.True(t, broadcastCalled)
+}
+
+func TestBroadcaster_StoreOwnSignatureShouldKeepTheLatestHashes(t *testing.T) {
+	t.Parallel()
+
+	b, _ := NewBroadcaster(createMockArgsBroadcaster())
+	for i := 0; i < maxOwnSignatures+5; i++ {
+		b.storeOwnSignature([]byte(fmt.Sprintf("hash %d", i)), []byte(fmt.Sprintf("payload %d", i)))
+	}
+	b.storeOwnSignature([]byte("hash 10"), []byte("payload 10"))
+
+	assert.Equal(t, maxOwnSignatures, len(b.ownSignatures))
+	assert.Equal(t, maxOwnSignatures, len(b.ownSignaturesOrder))
+	_, found := b.ownSignatures["hash 4"]
+	assert.False(t, found)
+	_, found = b.ownSignatures["hash 5"]
+	assert.True(t, found)
 }
 
 func TestBroadcaster_Close(t *testing.T) {
@@ -561,6 +982,16 @@ func TestBroadcaster_AddBroadcastClientNilClient(t *testing.T) {
 	assert.Equal(t, ErrNilBroadcastClient, err)
 }
 
+func TestBroadcaster_AddLeaseClientNilClient(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsBroadcaster()
+	b, _ := NewBroadcaster(args)
+
+	err := b.AddLeaseClient(nil)
+	assert.Equal(t, ErrNilLeaseClient, err)
+}
+
 func TestBroadcaster_ShouldFilterIdenticalMessages(t *testing.T) {
 	t.Parallel()
 
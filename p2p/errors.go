@@ -38,6 +38,12 @@ var ErrEmptyName = errors.New("empty name")
 // ErrNilBroadcastClient signals that a nil broadcast client was provided
 var ErrNilBroadcastClient = errors.New("nil broadcast client")
 
+// ErrNilTracer signals that a nil tracer has been provided
+var ErrNilTracer = errors.New("nil tracer")
+
+// ErrNilLeaseClient signals that a nil lease client was provided
+var ErrNilLeaseClient = errors.New("nil lease client")
+
 // ErrNilStatusHandler signals that a nil status handler was provided
 var ErrNilStatusHandler = errors.New("nil status handler")
 
@@ -52,3 +58,15 @@ var ErrNilBlackListIDsCache = errors.New("nil blacklist cache")
 
 // ErrNilBlackListedPublicKeysCache signals that a nil blacklist public keys cache was provided
 var ErrNilBlackListedPublicKeysCache = errors.New("nil blacklist public keys cache")
+
+// ErrNilRelayersActivityTracker signals that a nil relayers activity tracker was provided
+var ErrNilRelayersActivityTracker = errors.New("nil relayers activity tracker")
+
+// ErrNilMarshalizer signals that a nil marshalizer was provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrInvalidMessagesVersion signals that an invalid messages version was provided
+var ErrInvalidMessagesVersion = errors.New("invalid messages version")
+
+// ErrNilNoncesStorer signals that a nil nonces storer was provided
+var ErrNilNoncesStorer = errors.New("nil nonces storer")
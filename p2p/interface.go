@@ -42,3 +42,17 @@ type PeerDenialEvaluator interface {
 	UpsertPeerID(pid chainCore.PeerID, duration time.Duration) error
 	IsInterfaceNil() bool
 }
+
+// RelayersActivityTracker defines the operations of a component able to track the relayers activity on the p2p network
+type RelayersActivityTracker interface {
+	RecordJoin(publicKey []byte)
+	RecordSignature(publicKey []byte, messageHash []byte)
+	IsInterfaceNil() bool
+}
+
+type throttledWriter interface {
+	MarkDirty()
+	Flush()
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -2,21 +2,60 @@ package p2p
 
 import (
 	"bytes"
+	"encoding/hex"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/core/persistence"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
+const (
+	persistentNoncesKeyPrefix  = "p2pNonces_"
+	minTimeBetweenNoncesWrites = time.Second
+)
+
+// only json marshaller is supported because we used maps
+var noncesMarshaller = &marshal.JsonMarshalizer{}
+
+// noncesOfPublicKeys holds the last nonce seen for each public key. The nonces are saved at most once every
+// minTimeBetweenNoncesWrites, as they change with every received message
 type noncesOfPublicKeys struct {
 	mut    sync.RWMutex
 	nonces map[string]uint64
+	storer core.Storer
+	key    []byte
+	log    logger.Logger
+	writer throttledWriter
 }
 
-func newNoncesOfPublicKeys() *noncesOfPublicKeys {
-	return &noncesOfPublicKeys{
+func newNoncesOfPublicKeys(storer core.Storer, name string, log logger.Logger) (*noncesOfPublicKeys, error) {
+	holder := &noncesOfPublicKeys{
 		nonces: make(map[string]uint64),
+		storer: storer,
+		key:    []byte(persistentNoncesKeyPrefix + name),
+		log:    log,
+	}
+
+	argsWriter := persistence.ArgsThrottledWriter{
+		Storer:               storer,
+		Key:                  holder.key,
+		MinTimeBetweenWrites: minTimeBetweenNoncesWrites,
+		DataProvider:         holder.marshalNonces,
+		Log:                  log,
+	}
+	var err error
+	holder.writer, err = persistence.NewThrottledWriter(argsWriter)
+	if err != nil {
+		return nil, err
 	}
+
+	holder.tryLoadPersistedData()
+
+	return holder, nil
 }
 
 func (holder *noncesOfPublicKeys) processNonce(msg *core.SignedMessage) error {
@@ -31,6 +70,7 @@ func (holder *noncesOfPublicKeys) processNonce(msg *core.SignedMessage) error {
 	}
 
 	holder.nonces[string(msg.PublicKeyBytes)] = msg.Nonce
+	holder.writer.MarkDirty()
 
 	return nil
 }
@@ -51,3 +91,46 @@ func (holder *noncesOfPublicKeys) SortedPublicKeys() [][]byte {
 
 	return publicKeys
 }
+
+func (holder *noncesOfPublicKeys) tryLoadPersistedData() {
+	buff, err := holder.storer.Get(holder.key)
+	if err != nil {
+		holder.log.Debug("noncesOfPublicKeys.tryLoadPersistedData reading from storer", "key", string(holder.key), "error", err)
+		return
+	}
+
+	persistedNonces := make(map[string]uint64)
+	err = noncesMarshaller.Unmarshal(&persistedNonces, buff)
+	if err != nil {
+		holder.log.Debug("noncesOfPublicKeys.tryLoadPersistedData loading from buffer", "key", string(holder.key), "error", err)
+		return
+	}
+
+	for hexPk, nonce := range persistedNonces {
+		pk, errDecode := hex.DecodeString(hexPk)
+		if errDecode != nil {
+			holder.log.Debug("noncesOfPublicKeys.tryLoadPersistedData decoding public key", "key", string(holder.key), "error", errDecode)
+			continue
+		}
+
+		holder.nonces[string(pk)] = nonce
+	}
+
+	holder.log.Debug("noncesOfPublicKeys.tryLoadPersistedData loaded data", "key", string(holder.key), "num public keys", len(holder.nonces))
+}
+
+func (holder *noncesOfPublicKeys) marshalNonces() ([]byte, error) {
+	holder.mut.RLock()
+	persistedNonces := make(map[string]uint64, len(holder.nonces))
+	for pk, nonce := range holder.nonces {
+		persistedNonces[hex.EncodeToString([]byte(pk))] = nonce
+	}
+	holder.mut.RUnlock()
+
+	return noncesMarshaller.Marshal(persistedNonces)
+}
+
+// closeNonces writes the nonces not yet saved
+func (holder *noncesOfPublicKeys) closeNonces() error {
+	return holder.writer.Close()
+}
@@ -0,0 +1,119 @@
+package p2p
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoncesOfPublicKeys_ProcessNonce(t *testing.T) {
+	t.Parallel()
+
+	holder, _ := newNoncesOfPublicKeys(testsCommon.NewStorerMock(), "test", logger.GetOrCreate("test"))
+	msg := &core.SignedMessage{
+		PublicKeyBytes: []byte("pk"),
+		Nonce:          10,
+	}
+
+	assert.Nil(t, holder.processNonce(msg))
+	assert.Equal(t, ErrNonceTooLowInReceivedMessage, holder.processNonce(msg))
+
+	msg.Nonce = 9
+	assert.Equal(t, ErrNonceTooLowInReceivedMessage, holder.processNonce(msg))
+
+	msg.Nonce = 11
+	assert.Nil(t, holder.processNonce(msg))
+}
+
+func TestNoncesOfPublicKeys_PersistedNoncesShouldBeReloaded(t *testing.T) {
+	t.Parallel()
+
+	storer := testsCommon.NewStorerMock()
+	holder, _ := newNoncesOfPublicKeys(storer, "test", logger.GetOrCreate("test"))
+	msg1 := &core.SignedMessage{
+		PublicKeyBytes: []byte("pk1"),
+		Nonce:          10,
+	}
+	msg2 := &core.SignedMessage{
+		PublicKeyBytes: []byte("pk2"),
+		Nonce:          20,
+	}
+	assert.Nil(t, holder.processNonce(msg1))
+	assert.Nil(t, holder.processNonce(msg2))
+	assert.Nil(t, holder.closeNonces())
+
+	reloadedHolder, _ := newNoncesOfPublicKeys(storer, "test", logger.GetOrCreate("test"))
+	assert.Equal(t, [][]byte{[]byte("pk1"), []byte("pk2")}, reloadedHolder.SortedPublicKeys())
+	assert.Equal(t, ErrNonceTooLowInReceivedMessage, reloadedHolder.processNonce(msg1))
+	assert.Equal(t, ErrNonceTooLowInReceivedMessage, reloadedHolder.processNonce(msg2))
+
+	msg1.Nonce = 11
+	assert.Nil(t, reloadedHolder.processNonce(msg1))
+
+	otherHolder, _ := newNoncesOfPublicKeys(storer, "other", logger.GetOrCreate("test"))
+	assert.Empty(t, otherHolder.SortedPublicKeys())
+}
+
+func TestNoncesOfPublicKeys_StorerErrorsShouldNotBlockProcessing(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	storer := &testsCommon.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return []byte("invalid data"), nil
+		},
+		PutCalled: func(key, data []byte) error {
+			return expectedErr
+		},
+	}
+	holder, _ := newNoncesOfPublicKeys(storer, "test", logger.GetOrCreate("test"))
+	assert.Empty(t, holder.SortedPublicKeys())
+
+	msg := &core.SignedMessage{
+		PublicKeyBytes: []byte("pk"),
+		Nonce:          10,
+	}
+	assert.Nil(t, holder.processNonce(msg))
+	assert.Equal(t, [][]byte{[]byte("pk")}, holder.SortedPublicKeys())
+}
+
+func TestNoncesOfPublicKeys_ShouldThrottleTheWrites(t *testing.T) {
+	t.Parallel()
+
+	numPuts := uint32(0)
+	storer := &testsCommon.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, errors.New("missing key")
+		},
+		PutCalled: func(key, data []byte) error {
+			atomic.AddUint32(&numPuts, 1)
+			return nil
+		},
+	}
+	holder, _ := newNoncesOfPublicKeys(storer, "test", logger.GetOrCreate("test"))
+	msg := &core.SignedMessage{
+		PublicKeyBytes: []byte("pk"),
+	}
+	// the first write is done right away
+	msg.Nonce = 1
+	assert.Nil(t, holder.processNonce(msg))
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numPuts))
+
+	for i := 2; i <= 100; i++ {
+		msg.Nonce = uint64(i)
+		assert.Nil(t, holder.processNonce(msg))
+	}
+
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numPuts))
+
+	assert.Nil(t, holder.closeNonces())
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numPuts))
+}
@@ -19,6 +19,8 @@ const absolutMaxSliceSize = 1024
 
 type relayerMessageHandler struct {
 	marshalizer         marshal.Marshalizer
+	legacyMarshalizer   marshal.Marshalizer
+	messagesVersion     uint32
 	keyGen              crypto.KeyGenerator
 	singleSigner        crypto.SingleSigner
 	counter             uint64
@@ -43,25 +45,25 @@ func (rmh *relayerMessageHandler) canProcessMessage(message p2p.MessageP2P, from
 	return nil
 }
 
-// preProcessMessage is able to preprocess the received p2p message
-func (rmh *relayerMessageHandler) preProcessMessage(message p2p.MessageP2P, fromConnectedPeer chainCore.PeerID) (*core.SignedMessage, error) {
-	msg := &core.SignedMessage{}
-	err := rmh.marshalizer.Unmarshal(msg, message.Data())
+// preProcessMessage is able to preprocess the received p2p message. It also returns the messages version
+// used by the sender, needed to decode the message's payload
+func (rmh *relayerMessageHandler) preProcessMessage(message p2p.MessageP2P, fromConnectedPeer chainCore.PeerID) (*core.SignedMessage, uint32, error) {
+	msg, version, err := rmh.unmarshalSignedMessage(message.Data())
 	if err != nil {
 		reason := "unmarshalable data got on request topic " + message.Topic()
 		rmh.antifloodComponents.AntiFloodHandler.BlacklistPeer(message.Peer(), reason, common.InvalidMessageBlacklistDuration)
 		rmh.antifloodComponents.AntiFloodHandler.BlacklistPeer(fromConnectedPeer, reason, common.InvalidMessageBlacklistDuration)
-		return nil, err
+		return nil, 0, err
 	}
 
 	err = checkLengths(msg)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	pk, err := rmh.keyGen.PublicKeyFromByteArray(msg.PublicKeyBytes)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	buffNonce := make([]byte, 8)
@@ -73,10 +75,105 @@ func (rmh *relayerMessageHandler) preProcessMessage(message p2p.MessageP2P, from
 		reason := "unverifiable signature on request topic " + message.Topic()
 		rmh.antifloodComponents.AntiFloodHandler.BlacklistPeer(message.Peer(), reason, common.InvalidMessageBlacklistDuration)
 		rmh.antifloodComponents.AntiFloodHandler.BlacklistPeer(fromConnectedPeer, reason, common.InvalidMessageBlacklistDuration)
+		return nil, 0, err
+	}
+
+	return msg, version, nil
+}
+
+// unmarshalSignedMessage decodes either an enveloped message or a legacy JSON message, as sent by the
+// relayers that were not yet upgraded. The enveloped messages start with their version byte
+func (rmh *relayerMessageHandler) unmarshalSignedMessage(buff []byte) (*core.SignedMessage, uint32, error) {
+	msg := &core.SignedMessage{}
+	if !hasEnvelopeVersionByte(buff) {
+		err := rmh.legacyMarshalizer.Unmarshal(msg, buff)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return msg, core.LegacyMessagesVersion, nil
+	}
+
+	envelope := &core.MessageEnvelope{}
+	err := rmh.marshalizer.Unmarshal(envelope, buff[1:])
+	if err != nil {
+		return nil, 0, err
+	}
+	if envelope.Version != core.EnvelopeMessagesVersion {
+		return nil, 0, fmt.Errorf("%w: %d in envelope", ErrInvalidMessagesVersion, envelope.Version)
+	}
+
+	err = rmh.marshalizer.Unmarshal(msg, envelope.Data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return msg, core.EnvelopeMessagesVersion, nil
+}
+
+// marshalSignedMessage encodes the provided message using the provided messages version
+func (rmh *relayerMessageHandler) marshalSignedMessage(msg *core.SignedMessage, version uint32) ([]byte, error) {
+	if version == core.LegacyMessagesVersion {
+		return rmh.legacyMarshalizer.Marshal(msg)
+	}
+
+	data, err := rmh.marshalizer.Marshal(msg)
+	if err != nil {
 		return nil, err
 	}
 
-	return msg, nil
+	envelope := &core.MessageEnvelope{
+		Version: version,
+		Data:    data,
+	}
+
+	return rmh.marshalWithVersionByte(envelope, version)
+}
+
+// marshalPayload encodes the provided payload using the provided messages version. The payloads of the enveloped
+// messages start with their version byte, so a stored message can be sent again with the version of its payload
+func (rmh *relayerMessageHandler) marshalPayload(payload interface{}, version uint32) ([]byte, error) {
+	if version == core.LegacyMessagesVersion {
+		return rmh.legacyMarshalizer.Marshal(payload)
+	}
+
+	return rmh.marshalWithVersionByte(payload, version)
+}
+
+// unmarshalPayload decodes the provided payload bytes using the provided messages version
+func (rmh *relayerMessageHandler) unmarshalPayload(payload interface{}, buff []byte, version uint32) error {
+	if version == core.LegacyMessagesVersion {
+		return rmh.legacyMarshalizer.Unmarshal(payload, buff)
+	}
+	if !hasEnvelopeVersionByte(buff) {
+		return fmt.Errorf("%w: missing the version byte of the payload", ErrInvalidMessagesVersion)
+	}
+
+	return rmh.marshalizer.Unmarshal(payload, buff[1:])
+}
+
+func (rmh *relayerMessageHandler) marshalWithVersionByte(obj interface{}, version uint32) ([]byte, error) {
+	data, err := rmh.marshalizer.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{byte(version)}, data...), nil
+}
+
+// hasEnvelopeVersionByte returns true if the provided buffer starts with the enveloped messages version byte. The
+// legacy JSON messages and payloads can not start with this byte
+func hasEnvelopeVersionByte(buff []byte) bool {
+	return len(buff) > 0 && buff[0] == byte(core.EnvelopeMessagesVersion)
+}
+
+// messagesVersionOfPayload returns the messages version of an already signed payload
+func messagesVersionOfPayload(payload []byte) uint32 {
+	if hasEnvelopeVersionByte(payload) {
+		return core.EnvelopeMessagesVersion
+	}
+
+	return core.LegacyMessagesVersion
 }
 
 func checkLengths(msg *core.SignedMessage) error {
@@ -3,6 +3,7 @@ package p2p
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	cryptoMocks "github.com/klever-io/klv-bridge-eth-go/testsCommon/crypto"
 	p2pMocks "github.com/klever-io/klv-bridge-eth-go/testsCommon/p2p"
 	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process/mock"
@@ -125,8 +127,9 @@ func TestRelayerMessageHandler_preProcess(t *testing.T) {
 func preProcessUnmarshal(t *testing.T) {
 	blackList := make(map[chainCore.PeerID]string)
 	rmh := &relayerMessageHandler{
-		marshalizer:  &testsCommon.MarshalizerMock{},
-		singleSigner: &cryptoMocks.SingleSignerStub{},
+		marshalizer:       &testsCommon.MarshalizerMock{},
+		legacyMarshalizer: &testsCommon.MarshalizerMock{},
+		singleSigner:      &cryptoMocks.SingleSignerStub{},
 		antifloodComponents: &factory.AntiFloodComponents{
 			AntiFloodHandler: &mock.P2PAntifloodHandlerStub{
 				BlacklistPeerCalled: func(peer chainCore.PeerID, reason string, duration time.Duration) {
@@ -140,7 +143,7 @@ func preProcessUnmarshal(t *testing.T) {
 		DataField: []byte("gibberish"),
 	}
 
-	msg, err := rmh.preProcessMessage(p2pmsg, fromPeer)
+	msg, _, err := rmh.preProcessMessage(p2pmsg, fromPeer)
 	assert.Nil(t, msg)
 	assert.NotNil(t, err)
 
@@ -155,9 +158,10 @@ func preProcessUnmarshal(t *testing.T) {
 
 func preProcessLimits(t *testing.T) {
 	rmh := &relayerMessageHandler{
-		marshalizer:  &testsCommon.MarshalizerMock{},
-		singleSigner: &cryptoMocks.SingleSignerStub{},
-		keyGen:       &cryptoMocks.KeyGenStub{},
+		marshalizer:       &testsCommon.MarshalizerMock{},
+		legacyMarshalizer: &testsCommon.MarshalizerMock{},
+		singleSigner:      &cryptoMocks.SingleSignerStub{},
+		keyGen:            &cryptoMocks.KeyGenStub{},
 	}
 
 	largeBuff := bytes.Repeat([]byte{1}, absolutMaxSliceSize+1)
@@ -193,7 +197,7 @@ func preProcessMessageInvalidLimits(
 		DataField: buff,
 	}
 
-	msg, err := rmh.preProcessMessage(p2pmsg, fromPeer)
+	msg, _, err := rmh.preProcessMessage(p2pmsg, fromPeer)
 	require.Nil(t, msg)
 	assert.True(t, errors.Is(err, ErrInvalidSize))
 
@@ -203,8 +207,9 @@ func preProcessMessageInvalidLimits(
 func preProcessKeygenFails(t *testing.T) {
 	expectedErr := errors.New("expected error")
 	rmh := &relayerMessageHandler{
-		marshalizer:  &testsCommon.MarshalizerMock{},
-		singleSigner: &cryptoMocks.SingleSignerStub{},
+		marshalizer:       &testsCommon.MarshalizerMock{},
+		legacyMarshalizer: &testsCommon.MarshalizerMock{},
+		singleSigner:      &cryptoMocks.SingleSignerStub{},
 		keyGen: &cryptoMocks.KeyGenStub{
 			PublicKeyFromByteArrayStub: func(b []byte) (crypto.PublicKey, error) {
 				return nil, expectedErr
@@ -217,7 +222,7 @@ func preProcessKeygenFails(t *testing.T) {
 		DataField: buff,
 	}
 
-	msg, err := rmh.preProcessMessage(p2pmsg, fromPeer)
+	msg, _, err := rmh.preProcessMessage(p2pmsg, fromPeer)
 	assert.Nil(t, msg)
 	assert.Equal(t, expectedErr, err)
 }
@@ -226,7 +231,8 @@ func preProcessVerifyFails(t *testing.T) {
 	blackList := make(map[chainCore.PeerID]string)
 	expectedErr := errors.New("expected error")
 	rmh := &relayerMessageHandler{
-		marshalizer: &testsCommon.MarshalizerMock{},
+		marshalizer:       &testsCommon.MarshalizerMock{},
+		legacyMarshalizer: &testsCommon.MarshalizerMock{},
 		singleSigner: &cryptoMocks.SingleSignerStub{
 			VerifyCalled: func(public crypto.PublicKey, msg []byte, sig []byte) error {
 				return expectedErr
@@ -248,7 +254,7 @@ func preProcessVerifyFails(t *testing.T) {
 		DataField: buff,
 	}
 
-	msg, err := rmh.preProcessMessage(p2pmsg, fromPeer)
+	msg, _, err := rmh.preProcessMessage(p2pmsg, fromPeer)
 	assert.Nil(t, msg)
 	assert.Equal(t, expectedErr, err)
 
@@ -269,7 +275,8 @@ func preProcessShouldWork(t *testing.T) {
 
 	verifyCalled := false
 	rmh := &relayerMessageHandler{
-		marshalizer: &testsCommon.MarshalizerMock{},
+		marshalizer:       &testsCommon.MarshalizerMock{},
+		legacyMarshalizer: &testsCommon.MarshalizerMock{},
 		singleSigner: &cryptoMocks.SingleSignerStub{
 			VerifyCalled: func(public crypto.PublicKey, msg []byte, sig []byte) error {
 				assert.Equal(t, msg, signedMessage)
@@ -286,12 +293,186 @@ func preProcessShouldWork(t *testing.T) {
 		DataField: buff,
 	}
 
-	msg, err := rmh.preProcessMessage(p2pmsg, fromPeer)
+	msg, version, err := rmh.preProcessMessage(p2pmsg, fromPeer)
 	assert.Equal(t, originalMsg, msg)
+	assert.Equal(t, core.LegacyMessagesVersion, version)
 	assert.Nil(t, err)
 	assert.True(t, verifyCalled)
 }
 
+func TestRelayerMessageHandler_marshalSignedMessage(t *testing.T) {
+	t.Parallel()
+
+	originalMsg, _ := createSignedMessageAndMarshaledBytes(0)
+
+	t.Run("legacy version should use the JSON marshalizer without envelope", func(t *testing.T) {
+		t.Parallel()
+
+		rmh := &relayerMessageHandler{
+			marshalizer:       &marshal.GogoProtoMarshalizer{},
+			legacyMarshalizer: &marshal.JsonMarshalizer{},
+		}
+
+		buff, err := rmh.marshalSignedMessage(originalMsg, core.LegacyMessagesVersion)
+		require.Nil(t, err)
+
+		expectedBuff, _ := json.Marshal(originalMsg)
+		assert.Equal(t, expectedBuff, buff)
+
+		msg, version, err := rmh.unmarshalSignedMessage(buff)
+		assert.Nil(t, err)
+		assert.Equal(t, core.LegacyMessagesVersion, version)
+		assert.Equal(t, originalMsg, msg)
+	})
+	t.Run("envelope version should use the configured marshalizer", func(t *testing.T) {
+		t.Parallel()
+
+		protoMarshalizer := &marshal.GogoProtoMarshalizer{}
+		rmh := &relayerMessageHandler{
+			marshalizer:       protoMarshalizer,
+			legacyMarshalizer: &marshal.JsonMarshalizer{},
+		}
+
+		buff, err := rmh.marshalSignedMessage(originalMsg, core.EnvelopeMessagesVersion)
+		require.Nil(t, err)
+
+		require.Equal(t, byte(core.EnvelopeMessagesVersion), buff[0])
+		envelope := &core.MessageEnvelope{}
+		err = protoMarshalizer.Unmarshal(envelope, buff[1:])
+		require.Nil(t, err)
+		assert.Equal(t, core.EnvelopeMessagesVersion, envelope.Version)
+
+		legacyBuff, _ := json.Marshal(originalMsg)
+		assert.Less(t, len(buff), len(legacyBuff))
+
+		msg, version, err := rmh.unmarshalSignedMessage(buff)
+		assert.Nil(t, err)
+		assert.Equal(t, core.EnvelopeMessagesVersion, version)
+		assert.Equal(t, originalMsg, msg)
+	})
+	t.Run("envelope with invalid data should error", func(t *testing.T) {
+		t.Parallel()
+
+		protoMarshalizer := &marshal.GogoProtoMarshalizer{}
+		rmh := &relayerMessageHandler{
+			marshalizer:       protoMarshalizer,
+			legacyMarshalizer: &marshal.JsonMarshalizer{},
+		}
+		envelope := &core.MessageEnvelope{
+			Version: core.EnvelopeMessagesVersion,
+			Data:    []byte("gibberish"),
+		}
+		buff, _ := protoMarshalizer.Marshal(envelope)
+		buff = append([]byte{byte(core.EnvelopeMessagesVersion)}, buff...)
+
+		msg, _, err := rmh.unmarshalSignedMessage(buff)
+		assert.Nil(t, msg)
+		assert.NotNil(t, err)
+	})
+	t.Run("envelope with another version should error", func(t *testing.T) {
+		t.Parallel()
+
+		protoMarshalizer := &marshal.GogoProtoMarshalizer{}
+		rmh := &relayerMessageHandler{
+			marshalizer:       protoMarshalizer,
+			legacyMarshalizer: &marshal.JsonMarshalizer{},
+		}
+		data, _ := protoMarshalizer.Marshal(originalMsg)
+		envelope := &core.MessageEnvelope{
+			Version: core.EnvelopeMessagesVersion + 1,
+			Data:    data,
+		}
+		buff, _ := protoMarshalizer.Marshal(envelope)
+		buff = append([]byte{byte(core.EnvelopeMessagesVersion)}, buff...)
+
+		msg, _, err := rmh.unmarshalSignedMessage(buff)
+		assert.Nil(t, msg)
+		assert.ErrorIs(t, err, ErrInvalidMessagesVersion)
+	})
+	t.Run("enveloped message without the version byte should not be decoded as an envelope", func(t *testing.T) {
+		t.Parallel()
+
+		protoMarshalizer := &marshal.GogoProtoMarshalizer{}
+		rmh := &relayerMessageHandler{
+			marshalizer:       protoMarshalizer,
+			legacyMarshalizer: &marshal.JsonMarshalizer{},
+		}
+		buff, _ := rmh.marshalSignedMessage(originalMsg, core.EnvelopeMessagesVersion)
+
+		msg, _, err := rmh.unmarshalSignedMessage(buff[1:])
+		assert.Nil(t, msg)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestRelayerMessageHandler_marshalPayload(t *testing.T) {
+	t.Parallel()
+
+	ethSig := &core.EthereumSignature{
+		Signature:   bytes.Repeat([]byte{1}, 65),
+		MessageHash: bytes.Repeat([]byte{2}, 32),
+	}
+	rmh := &relayerMessageHandler{
+		marshalizer:       &marshal.GogoProtoMarshalizer{},
+		legacyMarshalizer: &marshal.JsonMarshalizer{},
+	}
+
+	t.Run("legacy version should use the JSON marshalizer", func(t *testing.T) {
+		t.Parallel()
+
+		payload, err := rmh.marshalPayload(ethSig, core.LegacyMessagesVersion)
+		require.Nil(t, err)
+
+		expectedPayload, _ := json.Marshal(ethSig)
+		assert.Equal(t, expectedPayload, payload)
+
+		recovered := &core.EthereumSignature{}
+		err = rmh.unmarshalPayload(recovered, payload, core.LegacyMessagesVersion)
+		assert.Nil(t, err)
+		assert.Equal(t, ethSig, recovered)
+	})
+	t.Run("envelope version should add the version byte", func(t *testing.T) {
+		t.Parallel()
+
+		payload, err := rmh.marshalPayload(ethSig, core.EnvelopeMessagesVersion)
+		require.Nil(t, err)
+		assert.Equal(t, byte(core.EnvelopeMessagesVersion), payload[0])
+
+		recovered := &core.EthereumSignature{}
+		err = rmh.unmarshalPayload(recovered, payload, core.EnvelopeMessagesVersion)
+		assert.Nil(t, err)
+		assert.Equal(t, ethSig, recovered)
+	})
+	t.Run("envelope payload without the version byte should error", func(t *testing.T) {
+		t.Parallel()
+
+		payload, _ := (&marshal.GogoProtoMarshalizer{}).Marshal(ethSig)
+		payload[0] = 0x0A // make sure the first byte is not the version byte
+
+		err := rmh.unmarshalPayload(&core.EthereumSignature{}, payload, core.EnvelopeMessagesVersion)
+		assert.ErrorIs(t, err, ErrInvalidMessagesVersion)
+	})
+}
+
+func TestMessagesVersionOfPayload(t *testing.T) {
+	t.Parallel()
+
+	ethSig := &core.EthereumSignature{
+		Signature:   bytes.Repeat([]byte{1}, 65),
+		MessageHash: bytes.Repeat([]byte{2}, 32),
+	}
+	rmh := &relayerMessageHandler{
+		marshalizer:       &marshal.GogoProtoMarshalizer{},
+		legacyMarshalizer: &marshal.JsonMarshalizer{},
+	}
+	jsonPayload, _ := rmh.marshalPayload(ethSig, core.LegacyMessagesVersion)
+	envelopePayload, _ := rmh.marshalPayload(ethSig, core.EnvelopeMessagesVersion)
+
+	assert.Equal(t, core.LegacyMessagesVersion, messagesVersionOfPayload(jsonPayload))
+	assert.Equal(t, core.EnvelopeMessagesVersion, messagesVersionOfPayload(envelopePayload))
+	assert.Equal(t, core.LegacyMessagesVersion, messagesVersionOfPayload(nil))
+}
+
 func TestRelayerMessageHandler_createMessage(t *testing.T) {
 	t.Parallel()
 
@@ -396,3 +577,42 @@ func createSignedMessageForEthSig(index int) (*core.SignedMessage, []byte) {
 
 	return msg, buff
 }
+
+func createSignedMessageForSignatureRequest(index int, messageHash []byte) (*core.SignedMessage, []byte) {
+	request := &core.SignatureRequest{
+		MessageHash: messageHash,
+	}
+	payload, _ := marshalizer.Marshal(request)
+
+	// Create PBK with a fixed size of 32 bytes
+	pbkBytes := make([]byte, 32)
+	copy(pbkBytes, fmt.Sprintf("pk %d", index))
+
+	msg := &core.SignedMessage{
+		Payload:        payload,
+		PublicKeyBytes: pbkBytes,
+		Signature:      []byte(fmt.Sprintf("sig %d", index)),
+		Nonce:          34,
+	}
+	buff, _ := marshalizer.Marshal(msg)
+
+	return msg, buff
+}
+
+func createSignedMessageForLeaderLease(index int, lease *core.LeaderLease) (*core.SignedMessage, []byte) {
+	payload, _ := marshalizer.Marshal(lease)
+
+	// Create PBK with a fixed size of 32 bytes
+	pbkBytes := make([]byte, 32)
+	copy(pbkBytes, fmt.Sprintf("pk %d", index))
+
+	msg := &core.SignedMessage{
+		Payload:        payload,
+		PublicKeyBytes: pbkBytes,
+		Signature:      []byte(fmt.Sprintf("sig %d", index)),
+		Nonce:          34,
+	}
+	buff, _ := marshalizer.Marshal(msg)
+
+	return msg, buff
+}
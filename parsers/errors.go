@@ -13,4 +13,6 @@ var (
 	errBufferTooShortForKlvAddress = errors.New("buffer too short for Klever Blockchain address")
 	errBufferTooShortForBigInt     = errors.New("buffer too short while extracting the big.Int value")
 	errBufferLenMismatch           = errors.New("buffer length mismatch")
+	errTrailingBytes               = errors.New("trailing bytes in buffer")
+	errDataForMissingDataMarker    = errors.New("call data fields provided along with the missing data marker")
 )
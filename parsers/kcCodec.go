@@ -18,31 +18,89 @@ type KCCodec struct {
 }
 
 func partiallyDecodeCallData(buff []byte, marker byte) (CallData, error) {
+	_, callData, err := decodeFunctionAndGasLimit(buff, marker)
+
+	return callData, err
+}
+
+func decodeFunctionAndGasLimit(buff []byte, marker byte) ([]byte, CallData, error) {
 	buff, numChars, err := ExtractUint32(buff)
 	if err != nil {
-		return CallData{}, fmt.Errorf("%w for len of call data", err)
+		return nil, CallData{}, fmt.Errorf("%w for len of call data", err)
 	}
 	if numChars != len(buff) {
-		return CallData{}, fmt.Errorf("%w: actual %d, declared %d", errBufferLenMismatch, len(buff), numChars)
+		return nil, CallData{}, fmt.Errorf("%w: actual %d, declared %d", errBufferLenMismatch, len(buff), numChars)
 	}
 
 	buff, function, err := ExtractString(buff)
 	if err != nil {
-		return CallData{}, fmt.Errorf("%w for function", err)
+		return nil, CallData{}, fmt.Errorf("%w for function", err)
 	}
 
-	_, gasLimit, err := ExtractUint64(buff)
+	buff, gasLimit, err := ExtractUint64(buff)
 	if err != nil {
-		return CallData{}, fmt.Errorf("%w for gas limit", err)
+		return nil, CallData{}, fmt.Errorf("%w for gas limit", err)
 	}
 
-	return CallData{
+	return buff, CallData{
 		Type:     marker,
 		Function: function,
 		GasLimit: gasLimit,
 	}, nil
 }
 
+func decodeArguments(buff []byte) ([]string, error) {
+	if len(buff) == 0 {
+		return nil, fmt.Errorf("%w for arguments", errBufferTooShortForMarker)
+	}
+
+	marker := buff[0]
+	buff = buff[1:]
+
+	switch marker {
+	case bridgeCore.MissingDataProtocolMarker:
+		if len(buff) > 0 {
+			return nil, fmt.Errorf("%w: %d bytes after the missing arguments marker", errTrailingBytes, len(buff))
+		}
+
+		return nil, nil
+	case bridgeCore.DataPresentProtocolMarker:
+	default:
+		return nil, fmt.Errorf("%w for arguments: %d", errUnexpectedMarker, marker)
+	}
+
+	buff, numArguments, err := ExtractUint32(buff)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the number of arguments", err)
+	}
+
+	var arguments []string
+	for i := 0; i < numArguments; i++ {
+		var argument string
+		buff, argument, err = ExtractString(buff)
+		if err != nil {
+			return nil, fmt.Errorf("%w for argument %d", err, i)
+		}
+
+		arguments = append(arguments, argument)
+	}
+
+	if len(buff) > 0 {
+		return nil, fmt.Errorf("%w: %d bytes after the last argument", errTrailingBytes, len(buff))
+	}
+
+	return arguments, nil
+}
+
+func appendUint32(buff []byte, value int) []byte {
+	return binary.BigEndian.AppendUint32(buff, uint32(value))
+}
+
+func appendString(buff []byte, value string) []byte {
+	buff = appendUint32(buff, len(value))
+	return append(buff, value...)
+}
+
 // ExtractString will return the string value after extracting the length of the string from the buffer.
 // The buffer returned will be trimmed out of the 4 bytes + the length of the string
 func ExtractString(buff []byte) ([]byte, string, error) {
@@ -157,23 +215,103 @@ func (codec *KCCodec) DecodeProxySCCompleteCallData(buff []byte) (ProxySCComplet
 
 // ExtractGasLimitFromRawCallData will try to extract the gas limit from the provided buffer
 func (codec *KCCodec) ExtractGasLimitFromRawCallData(buff []byte) (uint64, error) {
+	callData, err := codec.PartiallyDecodeRawCallData(buff)
+	if err != nil {
+		return 0, err
+	}
+
+	return callData.GasLimit, nil
+}
+
+// PartiallyDecodeRawCallData will try to extract the function and the gas limit from the provided buffer.
+// The arguments are not decoded
+func (codec *KCCodec) PartiallyDecodeRawCallData(buff []byte) (CallData, error) {
 	if len(buff) == 0 {
-		return 0, errBufferTooShortForMarker
+		return CallData{}, errBufferTooShortForMarker
 	}
 
 	marker := buff[0]
 	buff = buff[1:]
 
 	if marker != bridgeCore.DataPresentProtocolMarker {
-		return 0, fmt.Errorf("%w: %d", errUnexpectedMarker, marker)
+		return CallData{}, fmt.Errorf("%w: %d", errUnexpectedMarker, marker)
+	}
+
+	return partiallyDecodeCallData(buff, marker)
+}
+
+// DecodeCallData will try to fully decode the provided buffer: marker, length, function, gas limit and arguments.
+// The whole buffer should be consumed, trailing bytes are considered an error
+func (codec *KCCodec) DecodeCallData(buff []byte) (CallData, error) {
+	if len(buff) == 0 {
+		return CallData{}, errBufferTooShortForMarker
+	}
+
+	marker := buff[0]
+	buff = buff[1:]
+
+	switch marker {
+	case bridgeCore.MissingDataProtocolMarker:
+		if len(buff) > 0 {
+			return CallData{}, fmt.Errorf("%w: %d bytes after the missing data marker", errTrailingBytes, len(buff))
+		}
+
+		return CallData{
+			Type: marker,
+		}, nil
+	case bridgeCore.DataPresentProtocolMarker:
+	default:
+		return CallData{}, fmt.Errorf("%w: %d", errUnexpectedMarker, marker)
 	}
 
-	callData, err := partiallyDecodeCallData(buff, marker)
+	buff, callData, err := decodeFunctionAndGasLimit(buff, marker)
 	if err != nil {
-		return 0, err
+		return CallData{}, err
 	}
 
-	return callData.GasLimit, nil
+	callData.Arguments, err = decodeArguments(buff)
+	if err != nil {
+		return CallData{}, err
+	}
+
+	return callData, nil
+}
+
+// EncodeCallData will encode the provided call data along with the marker and the length. The result can be decoded
+// back with the DecodeCallData function. A call data without arguments is encoded with the missing data marker in the
+// place of the arguments list, as the contracts require
+func (codec *KCCodec) EncodeCallData(callData CallData) ([]byte, error) {
+	switch callData.Type {
+	case bridgeCore.MissingDataProtocolMarker:
+		if len(callData.Function) > 0 || callData.GasLimit > 0 || len(callData.Arguments) > 0 {
+			return nil, errDataForMissingDataMarker
+		}
+
+		return []byte{bridgeCore.MissingDataProtocolMarker}, nil
+	case bridgeCore.DataPresentProtocolMarker:
+	default:
+		return nil, fmt.Errorf("%w: %d", errUnexpectedMarker, callData.Type)
+	}
+
+	content := make([]byte, 0, bridgeCore.Uint32ArgBytes+len(callData.Function)+bridgeCore.Uint64ArgBytes+1)
+	content = appendString(content, callData.Function)
+	content = binary.BigEndian.AppendUint64(content, callData.GasLimit)
+	if len(callData.Arguments) == 0 {
+		content = append(content, bridgeCore.MissingDataProtocolMarker)
+	} else {
+		content = append(content, bridgeCore.DataPresentProtocolMarker)
+		content = appendUint32(content, len(callData.Arguments))
+		for _, argument := range callData.Arguments {
+			content = appendString(content, argument)
+		}
+	}
+
+	result := make([]byte, 0, 1+bridgeCore.Uint32ArgBytes+len(content))
+	result = append(result, bridgeCore.DataPresentProtocolMarker)
+	result = appendUint32(result, len(content))
+	result = append(result, content...)
+
+	return result, nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
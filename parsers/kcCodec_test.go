@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	bridgeCore "github.com/klever-io/klv-bridge-eth-go/core"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -149,6 +150,40 @@ func TestKCCodec_ExtractGasLimitFromRawCallData(t *testing.T) {
 	})
 }
 
+func TestKCCodec_PartiallyDecodeRawCallData(t *testing.T) {
+	t.Parallel()
+
+	codec := &KCCodec{}
+
+	t.Run("buffer contains missing data marker should error", func(t *testing.T) {
+		t.Parallel()
+
+		callData, err := codec.PartiallyDecodeRawCallData([]byte{0})
+		assert.ErrorIs(t, err, errUnexpectedMarker)
+		assert.Empty(t, callData)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		buff := []byte{
+			1,
+			0, 0, 0, 21,
+			0, 0, 0, 5, 's', 't', 'a', 'k', 'e',
+			0, 0, 0, 0, 0, 0x4c, 0x4b, 0x40, // gas limit is 5000000
+			0, 0, 0, 0, // the arguments are not decoded
+		}
+
+		callData, err := codec.PartiallyDecodeRawCallData(buff)
+		assert.Nil(t, err)
+		expectedCallData := CallData{
+			Type:     1,
+			Function: "stake",
+			GasLimit: 5000000,
+		}
+		assert.Equal(t, expectedCallData, callData)
+	})
+}
+
 func TestKCCodec_DecodeProxySCCompleteCallData(t *testing.T) {
 	t.Parallel()
 
@@ -283,3 +318,308 @@ func TestKCCodec_DecodeProxySCCompleteCallData(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestKCCodec_DecodeCallData(t *testing.T) {
+	t.Parallel()
+
+	codec := &KCCodec{}
+
+	t.Run("empty buffer should error", func(t *testing.T) {
+		t.Parallel()
+
+		callData, err := codec.DecodeCallData(nil)
+		assert.Equal(t, errBufferTooShortForMarker, err)
+		assert.Empty(t, callData)
+	})
+	t.Run("unexpected marker should error", func(t *testing.T) {
+		t.Parallel()
+
+		callData, err := codec.DecodeCallData([]byte{0x03})
+		assert.ErrorIs(t, err, errUnexpectedMarker)
+		assert.Empty(t, callData)
+	})
+	t.Run("missing data marker followed by bytes should error", func(t *testing.T) {
+		t.Parallel()
+
+		callData, err := codec.DecodeCallData([]byte{0x00, 0x01})
+		assert.ErrorIs(t, err, errTrailingBytes)
+		assert.Empty(t, callData)
+	})
+	t.Run("length mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := []byte{
+			1,
+			0, 0, 0, 22,
+			0, 0, 0, 5, 's', 't', 'a', 'k', 'e',
+			0, 0, 0, 0, 0, 0x4c, 0x4b, 0x40,
+			0,
+		}
+
+		callData, err := codec.DecodeCallData(buff)
+		assert.ErrorIs(t, err, errBufferLenMismatch)
+		assert.Empty(t, callData)
+	})
+	t.Run("missing arguments marker should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := []byte{
+			1,
+			0, 0, 0, 17,
+			0, 0, 0, 5, 's', 't', 'a', 'k', 'e',
+			0, 0, 0, 0, 0, 0x4c, 0x4b, 0x40,
+		}
+
+		callData, err := codec.DecodeCallData(buff)
+		assert.ErrorIs(t, err, errBufferTooShortForMarker)
+		assert.Contains(t, err.Error(), "for arguments")
+		assert.Empty(t, callData)
+	})
+	t.Run("unexpected arguments marker should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := []byte{
+			1,
+			0, 0, 0, 18,
+			0, 0, 0, 5, 's', 't', 'a', 'k', 'e',
+			0, 0, 0, 0, 0, 0x4c, 0x4b, 0x40,
+			2,
+		}
+
+		callData, err := codec.DecodeCallData(buff)
+		assert.ErrorIs(t, err, errUnexpectedMarker)
+		assert.Contains(t, err.Error(), "for arguments")
+		assert.Empty(t, callData)
+	})
+	t.Run("trailing bytes after the missing arguments marker should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := []byte{
+			1,
+			0, 0, 0, 19,
+			0, 0, 0, 5, 's', 't', 'a', 'k', 'e',
+			0, 0, 0, 0, 0, 0x4c, 0x4b, 0x40,
+			0, 0,
+		}
+
+		callData, err := codec.DecodeCallData(buff)
+		assert.ErrorIs(t, err, errTrailingBytes)
+		assert.Empty(t, callData)
+	})
+	t.Run("truncated argument should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := []byte{
+			1,
+			0, 0, 0, 29,
+			0, 0, 0, 5, 's', 't', 'a', 'k', 'e',
+			0, 0, 0, 0, 0, 0x4c, 0x4b, 0x40,
+			1,
+			0, 0, 0, 1,
+			0, 0, 0, 5, 'a', 'b', 'c',
+		}
+
+		callData, err := codec.DecodeCallData(buff)
+		assert.ErrorIs(t, err, errBufferTooShortForString)
+		assert.Contains(t, err.Error(), "for argument 0")
+		assert.Empty(t, callData)
+	})
+	t.Run("trailing bytes after the last argument should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := []byte{
+			1,
+			0, 0, 0, 31,
+			0, 0, 0, 5, 's', 't', 'a', 'k', 'e',
+			0, 0, 0, 0, 0, 0x4c, 0x4b, 0x40,
+			1,
+			0, 0, 0, 1,
+			0, 0, 0, 3, 'a', 'b', 'c',
+			0, 0,
+		}
+
+		callData, err := codec.DecodeCallData(buff)
+		assert.ErrorIs(t, err, errTrailingBytes)
+		assert.Empty(t, callData)
+	})
+	t.Run("missing data marker should work", func(t *testing.T) {
+		t.Parallel()
+
+		callData, err := codec.DecodeCallData([]byte{0x00})
+		assert.Nil(t, err)
+		assert.Equal(t, CallData{Type: 0}, callData)
+	})
+	t.Run("no arguments should work", func(t *testing.T) {
+		t.Parallel()
+
+		buff := []byte{
+			1,
+			0, 0, 0, 18,
+			0, 0, 0, 5, 's', 't', 'a', 'k', 'e',
+			0, 0, 0, 0, 0, 0x4c, 0x4b, 0x40,
+			0,
+		}
+
+		callData, err := codec.DecodeCallData(buff)
+		assert.Nil(t, err)
+		expectedCallData := CallData{
+			Type:     1,
+			Function: "stake",
+			GasLimit: 5000000,
+		}
+		assert.Equal(t, expectedCallData, callData)
+	})
+	t.Run("with arguments should work", func(t *testing.T) {
+		t.Parallel()
+
+		buff := []byte{
+			1,
+			0, 0, 0, 33,
+			0, 0, 0, 5, 's', 't', 'a', 'k', 'e',
+			0, 0, 0, 0, 0, 0x4c, 0x4b, 0x40,
+			1,
+			0, 0, 0, 2,
+			0, 0, 0, 3, 'a', 'b', 'c',
+			0, 0, 0, 0,
+		}
+
+		callData, err := codec.DecodeCallData(buff)
+		assert.Nil(t, err)
+		expectedCallData := CallData{
+			Type:      1,
+			Function:  "stake",
+			GasLimit:  5000000,
+			Arguments: []string{"abc", ""},
+		}
+		assert.Equal(t, expectedCallData, callData)
+	})
+}
+
+func TestKCCodec_EncodeCallData(t *testing.T) {
+	t.Parallel()
+
+	codec := &KCCodec{}
+
+	t.Run("unexpected marker should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff, err := codec.EncodeCallData(CallData{Type: 3})
+		assert.ErrorIs(t, err, errUnexpectedMarker)
+		assert.Nil(t, buff)
+	})
+	t.Run("missing data marker with fields should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff, err := codec.EncodeCallData(CallData{Function: "stake"})
+		assert.Equal(t, errDataForMissingDataMarker, err)
+		assert.Nil(t, buff)
+	})
+	t.Run("missing data marker should work", func(t *testing.T) {
+		t.Parallel()
+
+		buff, err := codec.EncodeCallData(CallData{})
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{0x00}, buff)
+	})
+	t.Run("no arguments should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedBuff := []byte{
+			1,
+			0, 0, 0, 18,
+			0, 0, 0, 5, 's', 't', 'a', 'k', 'e',
+			0, 0, 0, 0, 0, 0x4c, 0x4b, 0x40,
+			0,
+		}
+
+		buff, err := codec.EncodeCallData(CallData{
+			Type:      1,
+			Function:  "stake",
+			GasLimit:  5000000,
+			Arguments: make([]string, 0),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, expectedBuff, buff)
+	})
+	t.Run("with arguments should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedBuff := []byte{
+			1,
+			0, 0, 0, 33,
+			0, 0, 0, 5, 's', 't', 'a', 'k', 'e',
+			0, 0, 0, 0, 0, 0x4c, 0x4b, 0x40,
+			1,
+			0, 0, 0, 2,
+			0, 0, 0, 3, 'a', 'b', 'c',
+			0, 0, 0, 0,
+		}
+
+		buff, err := codec.EncodeCallData(CallData{
+			Type:      1,
+			Function:  "stake",
+			GasLimit:  5000000,
+			Arguments: []string{"abc", ""},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, expectedBuff, buff)
+	})
+}
+
+func FuzzKCCodec_DecodeCallData(f *testing.F) {
+	codec := &KCCodec{}
+
+	f.Add([]byte{0x00})
+	f.Add([]byte{1, 0, 0, 0, 18, 0, 0, 0, 5, 's', 't', 'a', 'k', 'e', 0, 0, 0, 0, 0, 0x4c, 0x4b, 0x40, 0})
+	f.Add([]byte{1, 0, 0, 0, 33, 0, 0, 0, 5, 's', 't', 'a', 'k', 'e', 0, 0, 0, 0, 0, 0x4c, 0x4b, 0x40, 1, 0, 0, 0, 2,
+		0, 0, 0, 3, 'a', 'b', 'c', 0, 0, 0, 0})
+	f.Add([]byte{1, 0, 0, 0, 23, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0xff, 0xff, 0xff, 0xff})
+
+	f.Fuzz(func(t *testing.T, buff []byte) {
+		callData, err := codec.DecodeCallData(buff)
+		if err != nil {
+			return
+		}
+
+		encoded, err := codec.EncodeCallData(callData)
+		require.Nil(t, err)
+
+		decoded, err := codec.DecodeCallData(encoded)
+		require.Nil(t, err)
+		require.Equal(t, callData, decoded)
+
+		_ = callData.String()
+		_ = callData.ToReadable()
+	})
+}
+
+func FuzzKCCodec_EncodeDecodeCallData(f *testing.F) {
+	codec := &KCCodec{}
+
+	f.Add("stake", uint64(5000000), []byte("abc"), uint8(2))
+	f.Add("", uint64(0), []byte{}, uint8(0))
+	f.Add("unStake", uint64(0xffffffffffffffff), []byte{0x00, 0xff}, uint8(1))
+
+	f.Fuzz(func(t *testing.T, function string, gasLimit uint64, argument []byte, numArguments uint8) {
+		callData := CallData{
+			Type:     bridgeCore.DataPresentProtocolMarker,
+			Function: function,
+			GasLimit: gasLimit,
+		}
+		for i := 0; i < int(numArguments%16); i++ {
+			callData.Arguments = append(callData.Arguments, string(argument[:len(argument)*i/16]))
+		}
+
+		buff, err := codec.EncodeCallData(callData)
+		require.Nil(t, err)
+
+		decoded, err := codec.DecodeCallData(buff)
+		require.Nil(t, err)
+		require.Equal(t, callData, decoded)
+
+		partiallyDecoded, err := codec.PartiallyDecodeRawCallData(buff)
+		require.Nil(t, err)
+		require.Equal(t, function, partiallyDecoded.Function)
+		require.Equal(t, gasLimit, partiallyDecoded.GasLimit)
+	})
+}
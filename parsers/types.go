@@ -1,11 +1,16 @@
 package parsers
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/big"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/klever-io/klv-bridge-eth-go/clients/klever/blockchain/address"
+	bridgeCore "github.com/klever-io/klv-bridge-eth-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 )
 
@@ -17,6 +22,87 @@ type CallData struct {
 	Arguments []string
 }
 
+// CallDataArgument defines the human-readable version of a call data argument. The text is filled only if the
+// argument contains printable characters
+type CallDataArgument struct {
+	Hex  string `json:"hex"`
+	Text string `json:"text,omitempty"`
+}
+
+// ReadableCallData defines the human-readable version of the call data, suitable for JSON outputs
+type ReadableCallData struct {
+	Type      string             `json:"type"`
+	Function  string             `json:"function,omitempty"`
+	GasLimit  uint64             `json:"gasLimit,omitempty"`
+	Arguments []CallDataArgument `json:"arguments,omitempty"`
+}
+
+// ToReadable returns the human-readable version of the call data
+func (callData CallData) ToReadable() ReadableCallData {
+	result := ReadableCallData{
+		Type:     markerToString(callData.Type),
+		Function: callData.Function,
+		GasLimit: callData.GasLimit,
+	}
+	for _, argument := range callData.Arguments {
+		readableArgument := CallDataArgument{
+			Hex: hex.EncodeToString([]byte(argument)),
+		}
+		if isPrintable(argument) {
+			readableArgument.Text = argument
+		}
+
+		result.Arguments = append(result.Arguments, readableArgument)
+	}
+
+	return result
+}
+
+// String returns the human-readable string version of the call data
+func (callData CallData) String() string {
+	arguments := make([]string, 0, len(callData.Arguments))
+	for _, argument := range callData.Arguments {
+		if isPrintable(argument) {
+			arguments = append(arguments, fmt.Sprintf("%q", argument))
+			continue
+		}
+
+		arguments = append(arguments, "0x"+hex.EncodeToString([]byte(argument)))
+	}
+
+	return fmt.Sprintf("type: %s, function: %s, gas limit: %d, arguments: [%s]",
+		markerToString(callData.Type),
+		callData.Function,
+		callData.GasLimit,
+		strings.Join(arguments, ", "),
+	)
+}
+
+func markerToString(marker byte) string {
+	switch marker {
+	case bridgeCore.MissingDataProtocolMarker:
+		return "missing data"
+	case bridgeCore.DataPresentProtocolMarker:
+		return "data present"
+	default:
+		return fmt.Sprintf("unknown marker %d", marker)
+	}
+}
+
+func isPrintable(value string) bool {
+	if len(value) == 0 || !utf8.ValidString(value) {
+		return false
+	}
+
+	for _, r := range value {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // ProxySCCompleteCallData defines the struct holding Proxy SC complete call data
 type ProxySCCompleteCallData struct {
 	RawCallData []byte
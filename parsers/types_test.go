@@ -60,3 +60,69 @@ func TestProxySCCompleteCallData_String(t *testing.T) {
 		assert.Equal(t, expectedString, callData.String())
 	})
 }
+
+func TestCallData_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing data marker should work", func(t *testing.T) {
+		t.Parallel()
+
+		callData := CallData{}
+
+		expectedString := "type: missing data, function: , gas limit: 0, arguments: []"
+		assert.Equal(t, expectedString, callData.String())
+	})
+	t.Run("with arguments should work", func(t *testing.T) {
+		t.Parallel()
+
+		callData := CallData{
+			Type:      1,
+			Function:  "stake",
+			GasLimit:  5000000,
+			Arguments: []string{"abc", string([]byte{0x00, 0xff}), ""},
+		}
+
+		expectedString := `type: data present, function: stake, gas limit: 5000000, arguments: ["abc", 0x00ff, 0x]`
+		assert.Equal(t, expectedString, callData.String())
+	})
+	t.Run("unknown marker should work", func(t *testing.T) {
+		t.Parallel()
+
+		callData := CallData{
+			Type: 3,
+		}
+
+		expectedString := "type: unknown marker 3, function: , gas limit: 0, arguments: []"
+		assert.Equal(t, expectedString, callData.String())
+	})
+}
+
+func TestCallData_ToReadable(t *testing.T) {
+	t.Parallel()
+
+	callData := CallData{
+		Type:      1,
+		Function:  "stake",
+		GasLimit:  5000000,
+		Arguments: []string{"abc", string([]byte{0x00, 0xff}), "a\nb"},
+	}
+
+	expectedReadable := ReadableCallData{
+		Type:     "data present",
+		Function: "stake",
+		GasLimit: 5000000,
+		Arguments: []CallDataArgument{
+			{
+				Hex:  "616263",
+				Text: "abc",
+			},
+			{
+				Hex: "00ff",
+			},
+			{
+				Hex: "610a62",
+			},
+		},
+	}
+	assert.Equal(t, expectedReadable, callData.ToReadable())
+}
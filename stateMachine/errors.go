@@ -16,3 +16,9 @@ var ErrNilLogger = errors.New("nil logger")
 
 // ErrNilStatusHandler signals that a nil status handler was provided
 var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrNilTracer signals that a nil tracer was provided
+var ErrNilTracer = errors.New("nil tracer")
+
+// ErrNilSpanAttributesProvider signals that a nil span attributes provider was provided
+var ErrNilSpanAttributesProvider = errors.New("nil span attributes provider")
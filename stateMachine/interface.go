@@ -0,0 +1,10 @@
+package stateMachine
+
+import "go.opentelemetry.io/otel/attribute"
+
+// SpanAttributesProvider defines the operations for a component able to provide the tracing attributes
+// of the batch in progress
+type SpanAttributesProvider interface {
+	SpanAttributes() []attribute.KeyValue
+	IsInterfaceNil() bool
+}
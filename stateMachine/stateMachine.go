@@ -5,25 +5,31 @@ import (
 	"fmt"
 
 	"github.com/klever-io/klv-bridge-eth-go/core"
+	"github.com/klever-io/klv-bridge-eth-go/tracing"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	logger "github.com/multiversx/mx-chain-logger-go"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // ArgsStateMachine represents the state machine arguments
 type ArgsStateMachine struct {
-	StateMachineName     string
-	Steps                core.MachineStates
-	StartStateIdentifier core.StepIdentifier
-	Log                  logger.Logger
-	StatusHandler        core.StatusHandler
+	StateMachineName       string
+	Steps                  core.MachineStates
+	StartStateIdentifier   core.StepIdentifier
+	Log                    logger.Logger
+	StatusHandler          core.StatusHandler
+	Tracer                 trace.Tracer
+	SpanAttributesProvider SpanAttributesProvider
 }
 
 type stateMachine struct {
-	stateMachineName string
-	steps            core.MachineStates
-	currentStep      core.Step
-	log              logger.Logger
-	statusHandler    core.StatusHandler
+	stateMachineName       string
+	steps                  core.MachineStates
+	currentStep            core.Step
+	log                    logger.Logger
+	statusHandler          core.StatusHandler
+	tracer                 trace.Tracer
+	spanAttributesProvider SpanAttributesProvider
 }
 
 // NewStateMachine creates a state machine able to execute all provided steps
@@ -34,10 +40,12 @@ func NewStateMachine(args ArgsStateMachine) (*stateMachine, error) {
 	}
 
 	sm := &stateMachine{
-		stateMachineName: args.StateMachineName,
-		steps:            args.Steps,
-		log:              args.Log,
-		statusHandler:    args.StatusHandler,
+		stateMachineName:       args.StateMachineName,
+		steps:                  args.Steps,
+		log:                    args.Log,
+		statusHandler:          args.StatusHandler,
+		tracer:                 args.Tracer,
+		spanAttributesProvider: args.SpanAttributesProvider,
 	}
 	sm.currentStep, err = sm.getNextStep(args.StartStateIdentifier)
 	if err != nil {
@@ -62,6 +70,12 @@ func checkArgs(args ArgsStateMachine) error {
 	if check.IfNil(args.StatusHandler) {
 		return ErrNilStatusHandler
 	}
+	if args.Tracer == nil {
+		return ErrNilTracer
+	}
+	if check.IfNil(args.SpanAttributesProvider) {
+		return ErrNilSpanAttributesProvider
+	}
 
 	return nil
 }
@@ -72,13 +86,23 @@ func (sm *stateMachine) Execute(ctx context.Context) error {
 }
 
 func (sm *stateMachine) executeStep(ctx context.Context) error {
+	stepIdentifier := string(sm.currentStep.Identifier())
 	sm.log.Debug(fmt.Sprintf("%s: executing step", sm.stateMachineName),
-		"step", sm.currentStep.Identifier())
-	sm.statusHandler.SetStringMetric(core.MetricCurrentStateMachineStep, string(sm.currentStep.Identifier()))
+		"step", stepIdentifier)
+	sm.statusHandler.SetStringMetric(core.MetricCurrentStateMachineStep, stepIdentifier)
+
+	ctx = tracing.ContextWithAttributes(ctx, tracing.Direction(sm.stateMachineName))
+	ctx = tracing.ContextWithAttributes(ctx, sm.spanAttributesProvider.SpanAttributes()...)
+	ctx, span := tracing.StartSpan(ctx, sm.tracer, stepIdentifier, tracing.Step(stepIdentifier))
 	nextStepIdentifier := sm.currentStep.Execute(ctx)
 
+	// the step might have fetched a new batch or action
+	span.SetAttributes(sm.spanAttributesProvider.SpanAttributes()...)
+	span.SetAttributes(tracing.NextStep(string(nextStepIdentifier)))
+
 	currentStep, err := sm.getNextStep(nextStepIdentifier)
 	sm.currentStep = currentStep
+	tracing.EndSpan(span, err)
 
 	return err
 }
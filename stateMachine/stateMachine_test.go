@@ -8,8 +8,15 @@ import (
 	"github.com/klever-io/klv-bridge-eth-go/core"
 	"github.com/klever-io/klv-bridge-eth-go/stateMachine"
 	"github.com/klever-io/klv-bridge-eth-go/testsCommon"
+	"github.com/klever-io/klv-bridge-eth-go/tracing"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 func createMockArgs() stateMachine.ArgsStateMachine {
@@ -21,9 +28,11 @@ func createMockArgs() stateMachine.ArgsStateMachine {
 				},
 			},
 		},
-		StartStateIdentifier: "mock",
-		Log:                  logger.GetOrCreate("test"),
-		StatusHandler:        testsCommon.NewStatusHandlerMock("mock"),
+		StartStateIdentifier:   "mock",
+		Log:                    logger.GetOrCreate("test"),
+		StatusHandler:          testsCommon.NewStatusHandlerMock("mock"),
+		Tracer:                 noop.NewTracerProvider().Tracer(""),
+		SpanAttributesProvider: &testsCommon.SpanAttributesProviderStub{},
 	}
 }
 
@@ -80,6 +89,26 @@ func TestNewStateMachine(t *testing.T) {
 		assert.Nil(t, sm)
 		assert.True(t, errors.Is(err, stateMachine.ErrNilStatusHandler))
 	})
+	t.Run("nil tracer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Tracer = nil
+		sm, err := stateMachine.NewStateMachine(args)
+
+		assert.Nil(t, sm)
+		assert.Equal(t, stateMachine.ErrNilTracer, err)
+	})
+	t.Run("nil span attributes provider", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.SpanAttributesProvider = nil
+		sm, err := stateMachine.NewStateMachine(args)
+
+		assert.Nil(t, sm)
+		assert.Equal(t, stateMachine.ErrNilSpanAttributesProvider, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -144,4 +173,51 @@ func TestExecute(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, providedIdentifier2, sm.GetCurrentStepIdentifier())
 	})
+	t.Run("should record a span for each step", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := tracetest.NewSpanRecorder()
+		args := createMockArgs()
+		args.StateMachineName = "direction"
+		args.Tracer = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer(tracing.TracerName)
+		batchID := uint64(0)
+		args.SpanAttributesProvider = &testsCommon.SpanAttributesProviderStub{
+			SpanAttributesCalled: func() []attribute.KeyValue {
+				if batchID == 0 {
+					return nil
+				}
+				return []attribute.KeyValue{tracing.BatchID(batchID)}
+			},
+		}
+		var attributesInStep []attribute.KeyValue
+		args.Steps = map[core.StepIdentifier]core.Step{
+			"mock": &testsCommon.StepMock{
+				ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+					attributesInStep = tracing.AttributesFromContext(ctx)
+					batchID = 37
+					return "missing"
+				},
+				IdentifierCalled: func() core.StepIdentifier {
+					return "mock"
+				},
+			},
+		}
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		err := sm.Execute(context.Background())
+		assert.True(t, errors.Is(err, stateMachine.ErrStepNotFound))
+		assert.Equal(t, []attribute.KeyValue{tracing.Direction("direction")}, attributesInStep)
+
+		spans := recorder.Ended()
+		require.Equal(t, 1, len(spans))
+		assert.Equal(t, "mock", spans[0].Name())
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+		expectedAttributes := []attribute.KeyValue{
+			tracing.Direction("direction"),
+			tracing.Step("mock"),
+			tracing.BatchID(37),
+			tracing.NextStep("missing"),
+		}
+		assert.Equal(t, expectedAttributes, spans[0].Attributes())
+	})
 }
@@ -0,0 +1,54 @@
+package testsCommon
+
+// AttemptsTrackerStub -
+type AttemptsTrackerStub struct {
+	CanExecuteCalled    func(id uint64) bool
+	IsDeadLetterCalled  func(id uint64) bool
+	RecordSuccessCalled func(id uint64)
+	RecordFailureCalled func(id uint64, err error)
+	PruneCalled         func(pendingIDs []uint64)
+}
+
+// CanExecute -
+func (stub *AttemptsTrackerStub) CanExecute(id uint64) bool {
+	if stub.CanExecuteCalled != nil {
+		return stub.CanExecuteCalled(id)
+	}
+
+	return true
+}
+
+// IsDeadLetter -
+func (stub *AttemptsTrackerStub) IsDeadLetter(id uint64) bool {
+	if stub.IsDeadLetterCalled != nil {
+		return stub.IsDeadLetterCalled(id)
+	}
+
+	return false
+}
+
+// RecordSuccess -
+func (stub *AttemptsTrackerStub) RecordSuccess(id uint64) {
+	if stub.RecordSuccessCalled != nil {
+		stub.RecordSuccessCalled(id)
+	}
+}
+
+// RecordFailure -
+func (stub *AttemptsTrackerStub) RecordFailure(id uint64, err error) {
+	if stub.RecordFailureCalled != nil {
+		stub.RecordFailureCalled(id, err)
+	}
+}
+
+// Prune -
+func (stub *AttemptsTrackerStub) Prune(pendingIDs []uint64) {
+	if stub.PruneCalled != nil {
+		stub.PruneCalled(pendingIDs)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *AttemptsTrackerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
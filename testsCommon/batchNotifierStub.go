@@ -0,0 +1,20 @@
+package testsCommon
+
+import "context"
+
+// BatchNotifierStub -
+type BatchNotifierStub struct {
+	WaitForBatchCalled func(ctx context.Context, batchID uint64)
+}
+
+// WaitForBatch -
+func (stub *BatchNotifierStub) WaitForBatch(ctx context.Context, batchID uint64) {
+	if stub.WaitForBatchCalled != nil {
+		stub.WaitForBatchCalled(ctx, batchID)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *BatchNotifierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
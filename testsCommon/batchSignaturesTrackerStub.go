@@ -0,0 +1,18 @@
+package testsCommon
+
+// BatchSignaturesTrackerStub -
+type BatchSignaturesTrackerStub struct {
+	RecordBatchCalled func(batchID uint64, messageHash []byte)
+}
+
+// RecordBatch -
+func (stub *BatchSignaturesTrackerStub) RecordBatch(batchID uint64, messageHash []byte) {
+	if stub.RecordBatchCalled != nil {
+		stub.RecordBatchCalled(batchID, messageHash)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *BatchSignaturesTrackerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
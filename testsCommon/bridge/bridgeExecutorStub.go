@@ -45,6 +45,8 @@ type BridgeExecutorStub struct {
 	ProcessMaxQuorumRetriesOnKCCalled                   func() bool
 	ResetRetriesCountOnKCCalled                         func()
 	GetAndStoreBatchFromEthereumCalled                  func(ctx context.Context, nonce uint64) error
+	WaitForNewBatchOnEthereumCalled                     func(ctx context.Context, nonce uint64)
+	WaitForNewBatchOnKCCalled                           func(ctx context.Context)
 	WasTransferPerformedOnEthereumCalled                func(ctx context.Context) (bool, error)
 	SignTransferOnEthereumCalled                        func() error
 	PerformTransferOnEthereumCalled                     func(ctx context.Context) error
@@ -289,6 +291,22 @@ func (stub *BridgeExecutorStub) GetAndStoreBatchFromEthereum(ctx context.Context
 	return notImplemented
 }
 
+// WaitForNewBatchOnEthereum -
+func (stub *BridgeExecutorStub) WaitForNewBatchOnEthereum(ctx context.Context, nonce uint64) {
+	stub.incrementFunctionCounter()
+	if stub.WaitForNewBatchOnEthereumCalled != nil {
+		stub.WaitForNewBatchOnEthereumCalled(ctx, nonce)
+	}
+}
+
+// WaitForNewBatchOnKC -
+func (stub *BridgeExecutorStub) WaitForNewBatchOnKC(ctx context.Context) {
+	stub.incrementFunctionCounter()
+	if stub.WaitForNewBatchOnKCCalled != nil {
+		stub.WaitForNewBatchOnKCCalled(ctx)
+	}
+}
+
 // WasTransferPerformedOnEthereum -
 func (stub *BridgeExecutorStub) WasTransferPerformedOnEthereum(ctx context.Context) (bool, error) {
 	stub.incrementFunctionCounter()
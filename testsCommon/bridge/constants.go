@@ -10,9 +10,10 @@ var notImplemented = errors.New("method not implemented")
 var CallDataMock = func() []byte {
 	b := []byte{
 		1,
-		0, 0, 0, 28,
+		0, 0, 0, 29,
 		0, 0, 0, 3, 'a', 'b', 'c',
 		0x00, 0x00, 0x00, 0x00, 0x1D, 0xCD, 0x65, 0x00, // gas limit
+		1,          // arguments present marker
 		0, 0, 0, 1, // numArguments
 		0, 0, 0, 5, // argument 0 length
 		'd', 'e', 'f', 'g', 'h', // argument 0 data
@@ -26,6 +27,7 @@ var EthCallDataMock = func() []byte {
 	b := []byte{
 		0, 0, 0, 3, 'a', 'b', 'c',
 		0x00, 0x00, 0x00, 0x00, 0x1D, 0xCD, 0x65, 0x00, // gas limit
+		1,          // arguments present marker
 		0, 0, 0, 1, // numArguments
 		0, 0, 0, 5, // argument 0 length
 		'd', 'e', 'f', 'g', 'h', // argument 0 data
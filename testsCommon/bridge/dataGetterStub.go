@@ -2,6 +2,7 @@ package bridge
 
 import (
 	"context"
+	"math/big"
 )
 
 // DataGetterStub -
@@ -9,6 +10,7 @@ type DataGetterStub struct {
 	GetTokenIdForErc20AddressCalled func(ctx context.Context, erc20Address []byte) ([][]byte, error)
 	GetERC20AddressForTokenIdCalled func(ctx context.Context, tokenId []byte) ([][]byte, error)
 	GetAllStakedRelayersCalled      func(ctx context.Context) ([][]byte, error)
+	GetAmountStakedCalled           func(ctx context.Context, relayer []byte) (*big.Int, error)
 	GetAllKnownTokensCalled         func(ctx context.Context) ([][]byte, error)
 }
 
@@ -37,6 +39,15 @@ func (stub *DataGetterStub) GetAllStakedRelayers(ctx context.Context) ([][]byte,
 	return make([][]byte, 0), nil
 }
 
+// GetAmountStaked -
+func (stub *DataGetterStub) GetAmountStaked(ctx context.Context, relayer []byte) (*big.Int, error) {
+	if stub.GetAmountStakedCalled != nil {
+		return stub.GetAmountStakedCalled(ctx, relayer)
+	}
+
+	return big.NewInt(0), nil
+}
+
 // GetAllKnownTokens -
 func (stub *DataGetterStub) GetAllKnownTokens(ctx context.Context) ([][]byte, error) {
 	if stub.GetAllKnownTokensCalled != nil {
@@ -0,0 +1,22 @@
+package bridge
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// LogsSubscriberStub -
+type LogsSubscriberStub struct {
+	SubscribeFilterLogsCalled func(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error)
+}
+
+// SubscribeFilterLogs -
+func (stub *LogsSubscriberStub) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	if stub.SubscribeFilterLogsCalled != nil {
+		return stub.SubscribeFilterLogsCalled(ctx, q, ch)
+	}
+
+	return nil, notImplemented
+}
@@ -15,6 +15,7 @@ type SafeContractStub struct {
 	MintBurnTokensCalled    func(opts *bind.CallOpts, arg0 common.Address) (bool, error)
 	NativeTokensCalled      func(opts *bind.CallOpts, arg0 common.Address) (bool, error)
 	WhitelistedTokensCalled func(opts *bind.CallOpts, arg0 common.Address) (bool, error)
+	BridgeCalled            func(opts *bind.CallOpts) (common.Address, error)
 }
 
 // TotalBalances -
@@ -70,3 +71,12 @@ func (stub *SafeContractStub) WhitelistedTokens(opts *bind.CallOpts, arg0 common
 
 	return false, nil
 }
+
+// Bridge -
+func (stub *SafeContractStub) Bridge(opts *bind.CallOpts) (common.Address, error) {
+	if stub.BridgeCalled != nil {
+		return stub.BridgeCalled(opts)
+	}
+
+	return common.Address{}, nil
+}
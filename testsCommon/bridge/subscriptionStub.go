@@ -0,0 +1,26 @@
+package bridge
+
+// SubscriptionStub -
+type SubscriptionStub struct {
+	ErrChan           chan error
+	UnsubscribeCalled func()
+}
+
+// NewSubscriptionStub -
+func NewSubscriptionStub() *SubscriptionStub {
+	return &SubscriptionStub{
+		ErrChan: make(chan error, 1),
+	}
+}
+
+// Err -
+func (stub *SubscriptionStub) Err() <-chan error {
+	return stub.ErrChan
+}
+
+// Unsubscribe -
+func (stub *SubscriptionStub) Unsubscribe() {
+	if stub.UnsubscribeCalled != nil {
+		stub.UnsubscribeCalled()
+	}
+}
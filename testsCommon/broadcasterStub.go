@@ -5,10 +5,13 @@ import "github.com/klever-io/klv-bridge-eth-go/core"
 // BroadcasterStub -
 type BroadcasterStub struct {
 	BroadcastSignatureCalled func(signature []byte, messageHash []byte)
+	RequestSignaturesCalled  func(messageHash []byte)
+	BroadcastLeaseCalled     func(lease *core.LeaderLease)
 	BroadcastJoinTopicCalled func()
 	SortedPublicKeysCalled   func() [][]byte
 	RegisterOnTopicsCalled   func() error
 	AddBroadcastClientCalled func(client core.BroadcastClient) error
+	AddLeaseClientCalled     func(client core.LeaseClient) error
 	CloseCalled              func() error
 }
 
@@ -19,6 +22,20 @@ func (bs *BroadcasterStub) BroadcastSignature(signature []byte, messageHash []by
 	}
 }
 
+// RequestSignatures -
+func (bs *BroadcasterStub) RequestSignatures(messageHash []byte) {
+	if bs.RequestSignaturesCalled != nil {
+		bs.RequestSignaturesCalled(messageHash)
+	}
+}
+
+// BroadcastLease -
+func (bs *BroadcasterStub) BroadcastLease(lease *core.LeaderLease) {
+	if bs.BroadcastLeaseCalled != nil {
+		bs.BroadcastLeaseCalled(lease)
+	}
+}
+
 // BroadcastJoinTopic -
 func (bs *BroadcasterStub) BroadcastJoinTopic() {
 	if bs.BroadcastJoinTopicCalled != nil {
@@ -53,6 +70,15 @@ func (bs *BroadcasterStub) AddBroadcastClient(client core.BroadcastClient) error
 	return nil
 }
 
+// AddLeaseClient -
+func (bs *BroadcasterStub) AddLeaseClient(client core.LeaseClient) error {
+	if bs.AddLeaseClientCalled != nil {
+		return bs.AddLeaseClientCalled(client)
+	}
+
+	return nil
+}
+
 // Close -
 func (bs *BroadcasterStub) Close() error {
 	if bs.CloseCalled() != nil {
@@ -0,0 +1,30 @@
+package testsCommon
+
+// CallDataValidatorStub -
+type CallDataValidatorStub struct {
+	ValidateCallDataCalled      func(data []byte) error
+	CheckConfiguredLimitsCalled func(data []byte) error
+}
+
+// ValidateCallData -
+func (stub *CallDataValidatorStub) ValidateCallData(data []byte) error {
+	if stub.ValidateCallDataCalled != nil {
+		return stub.ValidateCallDataCalled(data)
+	}
+
+	return nil
+}
+
+// CheckConfiguredLimits -
+func (stub *CallDataValidatorStub) CheckConfiguredLimits(data []byte) error {
+	if stub.CheckConfiguredLimitsCalled != nil {
+		return stub.CheckConfiguredLimitsCalled(data)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *CallDataValidatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
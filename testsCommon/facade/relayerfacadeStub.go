@@ -6,10 +6,12 @@ import (
 
 // RelayerFacadeStub -
 type RelayerFacadeStub struct {
-	GetMetricsCalled       func(name string) (core.GeneralMetrics, error)
-	GetMetricsListCalled   func() core.GeneralMetrics
-	RestApiInterfaceCalled func() string
-	PprofEnabledCalled     func() bool
+	GetMetricsCalled        func(name string) (core.GeneralMetrics, error)
+	GetMetricsListCalled    func() core.GeneralMetrics
+	RestApiInterfaceCalled  func() string
+	PprofEnabledCalled      func() bool
+	GetTokensCalled         func() *core.TokensRegistrySnapshot
+	GetRelayersHealthCalled func() *core.RelayersHealthSnapshot
 }
 
 // GetMetrics -
@@ -46,6 +48,24 @@ func (stub *RelayerFacadeStub) PprofEnabled() bool {
 	return false
 }
 
+// GetTokens -
+func (stub *RelayerFacadeStub) GetTokens() *core.TokensRegistrySnapshot {
+	if stub.GetTokensCalled != nil {
+		return stub.GetTokensCalled()
+	}
+
+	return &core.TokensRegistrySnapshot{}
+}
+
+// GetRelayersHealth -
+func (stub *RelayerFacadeStub) GetRelayersHealth() *core.RelayersHealthSnapshot {
+	if stub.GetRelayersHealthCalled != nil {
+		return stub.GetRelayersHealthCalled()
+	}
+
+	return &core.RelayersHealthSnapshot{}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (stub *RelayerFacadeStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -0,0 +1,94 @@
+package facade
+
+import (
+	"context"
+
+	"github.com/klever-io/klv-bridge-eth-go/core"
+)
+
+// ScCallsFacadeStub -
+type ScCallsFacadeStub struct {
+	GetMetricsCalled           func(name string) (core.GeneralMetrics, error)
+	GetMetricsListCalled       func() core.GeneralMetrics
+	RestApiInterfaceCalled     func() string
+	PprofEnabledCalled         func() bool
+	GetPendingOperationsCalled func(ctx context.Context) ([]*core.ScCallPendingOperation, error)
+	GetOperationsResultsCalled func() []*core.ScCallOperationResult
+	GetDeadLettersCalled       func() []core.ScCallExecutionAttempts
+	GetFeesLedgerCalled        func() core.ScCallsFeesLedger
+}
+
+// GetMetrics -
+func (stub *ScCallsFacadeStub) GetMetrics(name string) (core.GeneralMetrics, error) {
+	if stub.GetMetricsCalled != nil {
+		return stub.GetMetricsCalled(name)
+	}
+
+	return make(core.GeneralMetrics), nil
+}
+
+// GetMetricsList -
+func (stub *ScCallsFacadeStub) GetMetricsList() core.GeneralMetrics {
+	if stub.GetMetricsListCalled != nil {
+		return stub.GetMetricsListCalled()
+	}
+
+	return make(core.GeneralMetrics)
+}
+
+// RestApiInterface -
+func (stub *ScCallsFacadeStub) RestApiInterface() string {
+	if stub.RestApiInterfaceCalled != nil {
+		return stub.RestApiInterfaceCalled()
+	}
+	return "localhost:8080"
+}
+
+// PprofEnabled -
+func (stub *ScCallsFacadeStub) PprofEnabled() bool {
+	if stub.PprofEnabledCalled != nil {
+		return stub.PprofEnabledCalled()
+	}
+	return false
+}
+
+// GetPendingOperations -
+func (stub *ScCallsFacadeStub) GetPendingOperations(ctx context.Context) ([]*core.ScCallPendingOperation, error) {
+	if stub.GetPendingOperationsCalled != nil {
+		return stub.GetPendingOperationsCalled(ctx)
+	}
+
+	return make([]*core.ScCallPendingOperation, 0), nil
+}
+
+// GetOperationsResults -
+func (stub *ScCallsFacadeStub) GetOperationsResults() []*core.ScCallOperationResult {
+	if stub.GetOperationsResultsCalled != nil {
+		return stub.GetOperationsResultsCalled()
+	}
+
+	return make([]*core.ScCallOperationResult, 0)
+}
+
+// GetDeadLetters -
+func (stub *ScCallsFacadeStub) GetDeadLetters() []core.ScCallExecutionAttempts {
+	if stub.GetDeadLettersCalled != nil {
+		return stub.GetDeadLettersCalled()
+	}
+
+	return make([]core.ScCallExecutionAttempts, 0)
+}
+
+// GetFeesLedger -
+func (stub *ScCallsFacadeStub) GetFeesLedger() core.ScCallsFeesLedger {
+	if stub.GetFeesLedgerCalled != nil {
+		return stub.GetFeesLedgerCalled()
+	}
+
+	return core.ScCallsFeesLedger{}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (stub *ScCallsFacadeStub) IsInterfaceNil() bool {
+	return stub == nil
+}
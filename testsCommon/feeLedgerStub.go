@@ -0,0 +1,58 @@
+package testsCommon
+
+import "github.com/klever-io/klv-bridge-eth-go/core"
+
+// FeeLedgerStub -
+type FeeLedgerStub struct {
+	CanSpendCalled      func() bool
+	TryReserveCalled    func(estimatedFee int64) bool
+	ReleaseCalled       func(estimatedFee int64)
+	RecordFeeCalled     func(token string, destination string, fee int64)
+	GetFeesLedgerCalled func() core.ScCallsFeesLedger
+}
+
+// CanSpend -
+func (stub *FeeLedgerStub) CanSpend() bool {
+	if stub.CanSpendCalled != nil {
+		return stub.CanSpendCalled()
+	}
+
+	return true
+}
+
+// TryReserve -
+func (stub *FeeLedgerStub) TryReserve(estimatedFee int64) bool {
+	if stub.TryReserveCalled != nil {
+		return stub.TryReserveCalled(estimatedFee)
+	}
+
+	return true
+}
+
+// Release -
+func (stub *FeeLedgerStub) Release(estimatedFee int64) {
+	if stub.ReleaseCalled != nil {
+		stub.ReleaseCalled(estimatedFee)
+	}
+}
+
+// RecordFee -
+func (stub *FeeLedgerStub) RecordFee(token string, destination string, fee int64) {
+	if stub.RecordFeeCalled != nil {
+		stub.RecordFeeCalled(token, destination, fee)
+	}
+}
+
+// GetFeesLedger -
+func (stub *FeeLedgerStub) GetFeesLedger() core.ScCallsFeesLedger {
+	if stub.GetFeesLedgerCalled != nil {
+		return stub.GetFeesLedgerCalled()
+	}
+
+	return core.ScCallsFeesLedger{}
+}
+
+// IsInterfaceNil -
+func (stub *FeeLedgerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -22,6 +22,7 @@ type ProxyStub struct {
 	GetTransactionInfoWithResultsCalled func(_ context.Context, _ string) (*models.TransactionData, error)
 	ProcessTransactionStatusCalled      func(ctx context.Context, hexTxHash string) (transaction.Transaction_TXResult, error)
 	EstimateTransactionFeesCalled       func(ctx context.Context, txs *transaction.Transaction) (*transaction.FeesResponse, error)
+	GetBlockByNonceCalled               func(ctx context.Context, nonce uint64) (*models.Block, error)
 }
 
 // GetNetworkConfig -
@@ -96,6 +97,15 @@ func (eps *ProxyStub) GetKDATokenData(ctx context.Context, address address.Addre
 	return nil, fmt.Errorf("not implemented")
 }
 
+// GetBlockByNonce -
+func (eps *ProxyStub) GetBlockByNonce(ctx context.Context, nonce uint64) (*models.Block, error) {
+	if eps.GetBlockByNonceCalled != nil {
+		return eps.GetBlockByNonceCalled(ctx, nonce)
+	}
+
+	return nil, fmt.Errorf("not implemented")
+}
+
 // GetTransactionInfoWithResults -
 func (eps *ProxyStub) GetTransactionInfoWithResults(ctx context.Context, hash string) (*models.TransactionData, error) {
 	if eps.GetTransactionInfoWithResultsCalled != nil {
@@ -0,0 +1,20 @@
+package testsCommon
+
+import "context"
+
+// KCActivityNotifierStub -
+type KCActivityNotifierStub struct {
+	WaitForSafeActivityCalled func(ctx context.Context)
+}
+
+// WaitForSafeActivity -
+func (stub *KCActivityNotifierStub) WaitForSafeActivity(ctx context.Context) {
+	if stub.WaitForSafeActivityCalled != nil {
+		stub.WaitForSafeActivityCalled(ctx)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *KCActivityNotifierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,44 @@
+package testsCommon
+
+// LeaderLeaseStub -
+type LeaderLeaseStub struct {
+	CurrentLeaseHolderCalled func() []byte
+	AnnounceLeaseCalled      func(batchID uint64)
+	RenewLeaseCalled         func(batchID uint64)
+	ReleaseLeaseCalled       func(batchID uint64)
+}
+
+// CurrentLeaseHolder -
+func (stub *LeaderLeaseStub) CurrentLeaseHolder() []byte {
+	if stub.CurrentLeaseHolderCalled != nil {
+		return stub.CurrentLeaseHolderCalled()
+	}
+
+	return nil
+}
+
+// AnnounceLease -
+func (stub *LeaderLeaseStub) AnnounceLease(batchID uint64) {
+	if stub.AnnounceLeaseCalled != nil {
+		stub.AnnounceLeaseCalled(batchID)
+	}
+}
+
+// RenewLease -
+func (stub *LeaderLeaseStub) RenewLease(batchID uint64) {
+	if stub.RenewLeaseCalled != nil {
+		stub.RenewLeaseCalled(batchID)
+	}
+}
+
+// ReleaseLease -
+func (stub *LeaderLeaseStub) ReleaseLease(batchID uint64) {
+	if stub.ReleaseLeaseCalled != nil {
+		stub.ReleaseLeaseCalled(batchID)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *LeaderLeaseStub) IsInterfaceNil() bool {
+	return stub == nil
+}
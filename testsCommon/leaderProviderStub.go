@@ -0,0 +1,20 @@
+package testsCommon
+
+// LeaderProviderStub -
+type LeaderProviderStub struct {
+	CurrentLeaderCalled func() []byte
+}
+
+// CurrentLeader -
+func (stub *LeaderProviderStub) CurrentLeader() []byte {
+	if stub.CurrentLeaderCalled != nil {
+		return stub.CurrentLeaderCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *LeaderProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
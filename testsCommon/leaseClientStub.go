@@ -0,0 +1,20 @@
+package testsCommon
+
+import "github.com/klever-io/klv-bridge-eth-go/core"
+
+// LeaseClientStub -
+type LeaseClientStub struct {
+	ProcessLeaseCalled func(publicKey []byte, lease *core.LeaderLease)
+}
+
+// ProcessLease -
+func (stub *LeaseClientStub) ProcessLease(publicKey []byte, lease *core.LeaderLease) {
+	if stub.ProcessLeaseCalled != nil {
+		stub.ProcessLeaseCalled(publicKey, lease)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *LeaseClientStub) IsInterfaceNil() bool {
+	return stub == nil
+}
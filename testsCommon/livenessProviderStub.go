@@ -0,0 +1,22 @@
+package testsCommon
+
+import "time"
+
+// LivenessProviderStub -
+type LivenessProviderStub struct {
+	LastActivityCalled func(publicKey []byte) time.Time
+}
+
+// LastActivity -
+func (stub *LivenessProviderStub) LastActivity(publicKey []byte) time.Time {
+	if stub.LastActivityCalled != nil {
+		return stub.LastActivityCalled(publicKey)
+	}
+
+	return time.Time{}
+}
+
+// IsInterfaceNil -
+func (stub *LivenessProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -34,6 +34,14 @@ func CreateAntifloodConfig() chainConfig.AntifloodConfig {
 					Topic:             "test_sign",
 					NumMessagesPerSec: 10,
 				},
+				{
+					Topic:             "test_sigreq",
+					NumMessagesPerSec: 10,
+				},
+				{
+					Topic:             "test_lease",
+					NumMessagesPerSec: 10,
+				},
 			},
 		},
 	}
@@ -0,0 +1,26 @@
+package testsCommon
+
+// RelayersActivityTrackerStub -
+type RelayersActivityTrackerStub struct {
+	RecordJoinCalled      func(publicKey []byte)
+	RecordSignatureCalled func(publicKey []byte, messageHash []byte)
+}
+
+// RecordJoin -
+func (stub *RelayersActivityTrackerStub) RecordJoin(publicKey []byte) {
+	if stub.RecordJoinCalled != nil {
+		stub.RecordJoinCalled(publicKey)
+	}
+}
+
+// RecordSignature -
+func (stub *RelayersActivityTrackerStub) RecordSignature(publicKey []byte, messageHash []byte) {
+	if stub.RecordSignatureCalled != nil {
+		stub.RecordSignatureCalled(publicKey, messageHash)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *RelayersActivityTrackerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,22 @@
+package testsCommon
+
+import "github.com/klever-io/klv-bridge-eth-go/core"
+
+// RelayersHealthStub -
+type RelayersHealthStub struct {
+	GetRelayersHealthCalled func() *core.RelayersHealthSnapshot
+}
+
+// GetRelayersHealth -
+func (stub *RelayersHealthStub) GetRelayersHealth() *core.RelayersHealthSnapshot {
+	if stub.GetRelayersHealthCalled != nil {
+		return stub.GetRelayersHealthCalled()
+	}
+
+	return &core.RelayersHealthSnapshot{}
+}
+
+// IsInterfaceNil -
+func (stub *RelayersHealthStub) IsInterfaceNil() bool {
+	return stub == nil
+}
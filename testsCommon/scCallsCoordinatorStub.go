@@ -0,0 +1,30 @@
+package testsCommon
+
+import "context"
+
+// ScCallsCoordinatorStub -
+type ScCallsCoordinatorStub struct {
+	UpdatePendingOperationsCalled func(ctx context.Context, pendingIDs []uint64)
+	ShouldExecuteCalled           func(id uint64) bool
+}
+
+// UpdatePendingOperations -
+func (stub *ScCallsCoordinatorStub) UpdatePendingOperations(ctx context.Context, pendingIDs []uint64) {
+	if stub.UpdatePendingOperationsCalled != nil {
+		stub.UpdatePendingOperationsCalled(ctx, pendingIDs)
+	}
+}
+
+// ShouldExecute -
+func (stub *ScCallsCoordinatorStub) ShouldExecute(id uint64) bool {
+	if stub.ShouldExecuteCalled != nil {
+		return stub.ShouldExecuteCalled(id)
+	}
+
+	return true
+}
+
+// IsInterfaceNil -
+func (stub *ScCallsCoordinatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
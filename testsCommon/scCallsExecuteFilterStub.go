@@ -4,16 +4,16 @@ import "github.com/klever-io/klv-bridge-eth-go/parsers"
 
 // ScCallsExecuteFilterStub -
 type ScCallsExecuteFilterStub struct {
-	ShouldExecuteCalled func(callData parsers.ProxySCCompleteCallData) bool
+	ShouldExecuteCalled func(callData parsers.ProxySCCompleteCallData) (bool, string)
 }
 
 // ShouldExecute -
-func (stub *ScCallsExecuteFilterStub) ShouldExecute(callData parsers.ProxySCCompleteCallData) bool {
+func (stub *ScCallsExecuteFilterStub) ShouldExecute(callData parsers.ProxySCCompleteCallData) (bool, string) {
 	if stub.ShouldExecuteCalled != nil {
 		return stub.ShouldExecuteCalled(callData)
 	}
 
-	return true
+	return true, ""
 }
 
 // IsInterfaceNil -
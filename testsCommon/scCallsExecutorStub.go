@@ -0,0 +1,56 @@
+package testsCommon
+
+import (
+	"context"
+
+	"github.com/klever-io/klv-bridge-eth-go/core"
+)
+
+// ScCallsExecutorStub -
+type ScCallsExecutorStub struct {
+	ExecuteCalled               func(ctx context.Context) error
+	GetPendingOperationsCalled  func(ctx context.Context) ([]*core.ScCallPendingOperation, error)
+	GetOperationsResultsCalled  func() []*core.ScCallOperationResult
+	GetNumSentTransactionCalled func() uint32
+}
+
+// Execute -
+func (stub *ScCallsExecutorStub) Execute(ctx context.Context) error {
+	if stub.ExecuteCalled != nil {
+		return stub.ExecuteCalled(ctx)
+	}
+
+	return nil
+}
+
+// GetPendingOperations -
+func (stub *ScCallsExecutorStub) GetPendingOperations(ctx context.Context) ([]*core.ScCallPendingOperation, error) {
+	if stub.GetPendingOperationsCalled != nil {
+		return stub.GetPendingOperationsCalled(ctx)
+	}
+
+	return make([]*core.ScCallPendingOperation, 0), nil
+}
+
+// GetOperationsResults -
+func (stub *ScCallsExecutorStub) GetOperationsResults() []*core.ScCallOperationResult {
+	if stub.GetOperationsResultsCalled != nil {
+		return stub.GetOperationsResultsCalled()
+	}
+
+	return make([]*core.ScCallOperationResult, 0)
+}
+
+// GetNumSentTransaction -
+func (stub *ScCallsExecutorStub) GetNumSentTransaction() uint32 {
+	if stub.GetNumSentTransactionCalled != nil {
+		return stub.GetNumSentTransactionCalled()
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (stub *ScCallsExecutorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,56 @@
+package testsCommon
+
+import (
+	"context"
+
+	"github.com/klever-io/klv-bridge-eth-go/core"
+)
+
+// ScCallsInfoProviderStub -
+type ScCallsInfoProviderStub struct {
+	GetPendingOperationsCalled func(ctx context.Context) ([]*core.ScCallPendingOperation, error)
+	GetOperationsResultsCalled func() []*core.ScCallOperationResult
+	GetDeadLettersCalled       func() []core.ScCallExecutionAttempts
+	GetFeesLedgerCalled        func() core.ScCallsFeesLedger
+}
+
+// GetPendingOperations -
+func (stub *ScCallsInfoProviderStub) GetPendingOperations(ctx context.Context) ([]*core.ScCallPendingOperation, error) {
+	if stub.GetPendingOperationsCalled != nil {
+		return stub.GetPendingOperationsCalled(ctx)
+	}
+
+	return make([]*core.ScCallPendingOperation, 0), nil
+}
+
+// GetOperationsResults -
+func (stub *ScCallsInfoProviderStub) GetOperationsResults() []*core.ScCallOperationResult {
+	if stub.GetOperationsResultsCalled != nil {
+		return stub.GetOperationsResultsCalled()
+	}
+
+	return make([]*core.ScCallOperationResult, 0)
+}
+
+// GetDeadLetters -
+func (stub *ScCallsInfoProviderStub) GetDeadLetters() []core.ScCallExecutionAttempts {
+	if stub.GetDeadLettersCalled != nil {
+		return stub.GetDeadLettersCalled()
+	}
+
+	return make([]core.ScCallExecutionAttempts, 0)
+}
+
+// GetFeesLedger -
+func (stub *ScCallsInfoProviderStub) GetFeesLedger() core.ScCallsFeesLedger {
+	if stub.GetFeesLedgerCalled != nil {
+		return stub.GetFeesLedgerCalled()
+	}
+
+	return core.ScCallsFeesLedger{}
+}
+
+// IsInterfaceNil -
+func (stub *ScCallsInfoProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,22 @@
+package testsCommon
+
+import "github.com/klever-io/klv-bridge-eth-go/parsers"
+
+// ScCallsPrioritizerStub -
+type ScCallsPrioritizerStub struct {
+	ComputePrioritiesCalled func(pendingOperations map[uint64]parsers.ProxySCCompleteCallData) map[uint64]int64
+}
+
+// ComputePriorities -
+func (stub *ScCallsPrioritizerStub) ComputePriorities(pendingOperations map[uint64]parsers.ProxySCCompleteCallData) map[uint64]int64 {
+	if stub.ComputePrioritiesCalled != nil {
+		return stub.ComputePrioritiesCalled(pendingOperations)
+	}
+
+	return make(map[uint64]int64)
+}
+
+// IsInterfaceNil -
+func (stub *ScCallsPrioritizerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
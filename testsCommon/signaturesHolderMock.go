@@ -64,6 +64,20 @@ func (mock *SignaturesHolderMock) Signatures(msgHash []byte) [][]byte {
 	return result
 }
 
+// PruneExecutedSignatures -
+func (mock *SignaturesHolderMock) PruneExecutedSignatures(msgHash []byte) {
+	mock.mut.Lock()
+	defer mock.mut.Unlock()
+
+	remainingEthMessages := make([]*core.EthereumSignature, 0, len(mock.ethMessages))
+	for _, ethMsg := range mock.ethMessages {
+		if !bytes.Equal(ethMsg.MessageHash, msgHash) {
+			remainingEthMessages = append(remainingEthMessages, ethMsg)
+		}
+	}
+	mock.ethMessages = remainingEthMessages
+}
+
 // ClearStoredSignatures -
 func (mock *SignaturesHolderMock) ClearStoredSignatures() {
 	mock.mut.Lock()
@@ -2,8 +2,9 @@ package testsCommon
 
 // SignaturesHolderStub -
 type SignaturesHolderStub struct {
-	SignaturesCalled            func(messageHash []byte) [][]byte
-	ClearStoredSignaturesCalled func()
+	SignaturesCalled              func(messageHash []byte) [][]byte
+	PruneExecutedSignaturesCalled func(messageHash []byte)
+	ClearStoredSignaturesCalled   func()
 }
 
 // Signatures -
@@ -15,6 +16,13 @@ func (stub *SignaturesHolderStub) Signatures(messageHash []byte) [][]byte {
 	return make([][]byte, 0)
 }
 
+// PruneExecutedSignatures -
+func (stub *SignaturesHolderStub) PruneExecutedSignatures(messageHash []byte) {
+	if stub.PruneExecutedSignaturesCalled != nil {
+		stub.PruneExecutedSignaturesCalled(messageHash)
+	}
+}
+
 // ClearStoredSignatures -
 func (stub *SignaturesHolderStub) ClearStoredSignatures() {
 	if stub.ClearStoredSignaturesCalled != nil {
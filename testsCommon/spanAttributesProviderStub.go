@@ -0,0 +1,22 @@
+package testsCommon
+
+import "go.opentelemetry.io/otel/attribute"
+
+// SpanAttributesProviderStub -
+type SpanAttributesProviderStub struct {
+	SpanAttributesCalled func() []attribute.KeyValue
+}
+
+// SpanAttributes -
+func (stub *SpanAttributesProviderStub) SpanAttributes() []attribute.KeyValue {
+	if stub.SpanAttributesCalled != nil {
+		return stub.SpanAttributesCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *SpanAttributesProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,22 @@
+package testsCommon
+
+import "math/big"
+
+// StakeProviderStub -
+type StakeProviderStub struct {
+	StakeOfCalled func(publicKey []byte) *big.Int
+}
+
+// StakeOf -
+func (stub *StakeProviderStub) StakeOf(publicKey []byte) *big.Int {
+	if stub.StakeOfCalled != nil {
+		return stub.StakeOfCalled(publicKey)
+	}
+
+	return big.NewInt(0)
+}
+
+// IsInterfaceNil -
+func (stub *StakeProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
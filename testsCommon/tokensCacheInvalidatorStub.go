@@ -0,0 +1,18 @@
+package testsCommon
+
+// TokensCacheInvalidatorStub -
+type TokensCacheInvalidatorStub struct {
+	InvalidateTokensCalled func(tokens ...[]byte)
+}
+
+// InvalidateTokens -
+func (stub *TokensCacheInvalidatorStub) InvalidateTokens(tokens ...[]byte) {
+	if stub.InvalidateTokensCalled != nil {
+		stub.InvalidateTokensCalled(tokens...)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *TokensCacheInvalidatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
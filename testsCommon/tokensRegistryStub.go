@@ -0,0 +1,22 @@
+package testsCommon
+
+import "github.com/klever-io/klv-bridge-eth-go/core"
+
+// TokensRegistryStub -
+type TokensRegistryStub struct {
+	GetTokensCalled func() *core.TokensRegistrySnapshot
+}
+
+// GetTokens -
+func (stub *TokensRegistryStub) GetTokens() *core.TokensRegistrySnapshot {
+	if stub.GetTokensCalled != nil {
+		return stub.GetTokensCalled()
+	}
+
+	return &core.TokensRegistrySnapshot{}
+}
+
+// IsInterfaceNil -
+func (stub *TokensRegistryStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,47 @@
+package testsCommon
+
+import "time"
+
+// WsConnStub -
+type WsConnStub struct {
+	ReadMessageCalled     func() (messageType int, p []byte, err error)
+	WriteMessageCalled    func(messageType int, data []byte) error
+	SetReadDeadlineCalled func(t time.Time) error
+	CloseCalled           func() error
+}
+
+// ReadMessage -
+func (stub *WsConnStub) ReadMessage() (messageType int, p []byte, err error) {
+	if stub.ReadMessageCalled != nil {
+		return stub.ReadMessageCalled()
+	}
+
+	return 0, nil, nil
+}
+
+// WriteMessage -
+func (stub *WsConnStub) WriteMessage(messageType int, data []byte) error {
+	if stub.WriteMessageCalled != nil {
+		return stub.WriteMessageCalled(messageType, data)
+	}
+
+	return nil
+}
+
+// SetReadDeadline -
+func (stub *WsConnStub) SetReadDeadline(t time.Time) error {
+	if stub.SetReadDeadlineCalled != nil {
+		return stub.SetReadDeadlineCalled(t)
+	}
+
+	return nil
+}
+
+// Close -
+func (stub *WsConnStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package tracing
+
+import (
+	"encoding/hex"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Direction returns the attribute of the half-bridge direction
+func Direction(direction string) attribute.KeyValue {
+	return attribute.String(directionKey, direction)
+}
+
+// BatchID returns the attribute of the batch ID
+func BatchID(batchID uint64) attribute.KeyValue {
+	return attribute.Int64(batchIDKey, int64(batchID))
+}
+
+// ActionID returns the attribute of the action ID
+func ActionID(actionID uint64) attribute.KeyValue {
+	return attribute.Int64(actionIDKey, int64(actionID))
+}
+
+// Step returns the attribute of the executed state machine step
+func Step(step string) attribute.KeyValue {
+	return attribute.String(stepKey, step)
+}
+
+// NextStep returns the attribute of the state machine step that will be executed next
+func NextStep(step string) attribute.KeyValue {
+	return attribute.String(nextStepKey, step)
+}
+
+// MessageHash returns the attribute of the Ethereum message hash, hex encoded
+func MessageHash(messageHash []byte) attribute.KeyValue {
+	return attribute.String(messageHashKey, hex.EncodeToString(messageHash))
+}
+
+// Relayer returns the attribute of the relayer's public key, hex encoded
+func Relayer(publicKey []byte) attribute.KeyValue {
+	return attribute.String(relayerKey, hex.EncodeToString(publicKey))
+}
+
+// TxHash returns the attribute of a sent transaction hash
+func TxHash(hash string) attribute.KeyValue {
+	return attribute.String(txHashKey, hash)
+}
@@ -0,0 +1,22 @@
+package tracing
+
+const (
+	// TracerName is the instrumentation scope name of the bridge tracer
+	TracerName = "github.com/klever-io/klv-bridge-eth-go"
+
+	// OTLPExporter sends the spans to an OpenTelemetry collector using OTLP over HTTP
+	OTLPExporter = "otlp"
+	// FileExporter writes the spans in a local file, as JSON objects, one per line
+	FileExporter = "file"
+
+	defaultServiceName = "klv-bridge-relayer"
+
+	directionKey   = "bridge.direction"
+	batchIDKey     = "bridge.batch_id"
+	actionIDKey    = "bridge.action_id"
+	stepKey        = "bridge.step"
+	nextStepKey    = "bridge.next_step"
+	messageHashKey = "bridge.message_hash"
+	relayerKey     = "bridge.relayer"
+	txHashKey      = "bridge.tx_hash"
+)
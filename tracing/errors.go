@@ -0,0 +1,15 @@
+package tracing
+
+import "errors"
+
+// ErrUnknownExporter signals that an unknown spans exporter was provided
+var ErrUnknownExporter = errors.New("unknown tracing exporter")
+
+// ErrEmptyFilePath signals that an empty file path was provided
+var ErrEmptyFilePath = errors.New("empty file path")
+
+// ErrEmptyOTLPEndpoint signals that an empty OTLP endpoint was provided
+var ErrEmptyOTLPEndpoint = errors.New("empty OTLP endpoint")
+
+// ErrInvalidSamplingRatio signals that an invalid sampling ratio was provided
+var ErrInvalidSamplingRatio = errors.New("invalid sampling ratio")
@@ -0,0 +1,49 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type attributesKey struct{}
+
+// ContextWithAttributes returns a copy of the context carrying the provided attributes besides the existing ones.
+// All the spans started with StartSpan from the returned context will be tagged with these attributes
+func ContextWithAttributes(ctx context.Context, attributes ...attribute.KeyValue) context.Context {
+	existing := AttributesFromContext(ctx)
+	all := make([]attribute.KeyValue, 0, len(existing)+len(attributes))
+	all = append(all, existing...)
+	all = append(all, attributes...)
+
+	return context.WithValue(ctx, attributesKey{}, all)
+}
+
+// AttributesFromContext returns the attributes carried by the context
+func AttributesFromContext(ctx context.Context) []attribute.KeyValue {
+	attributes, _ := ctx.Value(attributesKey{}).([]attribute.KeyValue)
+	return attributes
+}
+
+// StartSpan starts a new span, child of the span carried by the context (if any), tagged with the attributes
+// carried by the context and the provided ones. The later ones override the attributes with the same key
+func StartSpan(ctx context.Context, tracer trace.Tracer, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	existing := AttributesFromContext(ctx)
+	all := make([]attribute.KeyValue, 0, len(existing)+len(attributes))
+	all = append(all, existing...)
+	all = append(all, attributes...)
+
+	return tracer.Start(ctx, name, trace.WithAttributes(all...))
+}
+
+// EndSpan records the provided error, if any, and ends the span
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,59 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestContextWithAttributes(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, AttributesFromContext(context.Background()))
+
+	ctx := ContextWithAttributes(context.Background(), Direction("direction"))
+	ctxWithBatch := ContextWithAttributes(ctx, BatchID(1))
+	ctxWithOtherBatch := ContextWithAttributes(ctx, BatchID(2))
+
+	assert.Equal(t, []attribute.KeyValue{Direction("direction")}, AttributesFromContext(ctx))
+	assert.Equal(t, []attribute.KeyValue{Direction("direction"), BatchID(1)}, AttributesFromContext(ctxWithBatch))
+	assert.Equal(t, []attribute.KeyValue{Direction("direction"), BatchID(2)}, AttributesFromContext(ctxWithOtherBatch))
+}
+
+func TestStartSpanAndEndSpan(t *testing.T) {
+	t.Parallel()
+
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer(TracerName)
+
+	ctx := ContextWithAttributes(context.Background(), Direction("direction"), BatchID(1))
+	ctx, parent := StartSpan(ctx, tracer, "parent")
+	_, child := StartSpan(ctx, tracer, "child", BatchID(2), TxHash("hash"))
+	EndSpan(child, errors.New("expected error"))
+	EndSpan(parent, nil)
+
+	spans := recorder.Ended()
+	assert.Equal(t, 2, len(spans))
+
+	childSpan, parentSpan := spans[0], spans[1]
+	assert.Equal(t, "child", childSpan.Name())
+	assert.Equal(t, parentSpan.SpanContext().SpanID(), childSpan.Parent().SpanID())
+	assert.Equal(t, codes.Error, childSpan.Status().Code)
+	assert.Equal(t, "expected error", childSpan.Status().Description)
+	assert.Equal(t, 1, len(childSpan.Events()))
+
+	childAttributes := attribute.NewSet(childSpan.Attributes()...)
+	batchID, _ := childAttributes.Value(batchIDKey)
+	assert.Equal(t, int64(2), batchID.AsInt64())
+	txHash, _ := childAttributes.Value(txHashKey)
+	assert.Equal(t, "hash", txHash.AsString())
+
+	assert.Equal(t, codes.Unset, parentSpan.Status().Code)
+	assert.Equal(t, []attribute.KeyValue{Direction("direction"), BatchID(1)}, parentSpan.Attributes())
+}
@@ -0,0 +1,120 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const shutdownTimeout = time.Second * 5
+
+type tracerProvider struct {
+	provider trace.TracerProvider
+	shutdown func(ctx context.Context) error
+	closers  []io.Closer
+}
+
+// NewTracerProvider creates the tracer provider described by the provided config. A disabled config will create
+// a provider whose tracers do not record anything
+func NewTracerProvider(cfg config.TracingConfig) (*tracerProvider, error) {
+	if !cfg.Enabled {
+		return &tracerProvider{
+			provider: noop.NewTracerProvider(),
+		}, nil
+	}
+	if cfg.SamplingRatio <= 0 || cfg.SamplingRatio > 1 {
+		return nil, fmt.Errorf("%w: %v, should be in (0, 1] interval", ErrInvalidSamplingRatio, cfg.SamplingRatio)
+	}
+
+	tp := &tracerProvider{}
+	exporter, err := tp.createExporter(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceName := cfg.ServiceName
+	if len(serviceName) == 0 {
+		serviceName = defaultServiceName
+	}
+
+	sdkProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SamplingRatio))),
+	)
+	tp.provider = sdkProvider
+	tp.shutdown = sdkProvider.Shutdown
+
+	return tp, nil
+}
+
+func (tp *tracerProvider) createExporter(cfg config.TracingConfig) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case OTLPExporter:
+		if len(cfg.OTLPEndpoint) == 0 {
+			return nil, ErrEmptyOTLPEndpoint
+		}
+
+		options := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.OTLPEndpoint)}
+		if cfg.OTLPInsecure {
+			options = append(options, otlptracehttp.WithInsecure())
+		}
+
+		return otlptracehttp.New(context.Background(), options...)
+	case FileExporter:
+		if len(cfg.FilePath) == 0 {
+			return nil, ErrEmptyFilePath
+		}
+
+		file, err := os.OpenFile(cfg.FilePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, err
+		}
+		tp.closers = append(tp.closers, file)
+
+		return stdouttrace.New(stdouttrace.WithWriter(file))
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownExporter, cfg.Exporter)
+	}
+}
+
+// Tracer returns the bridge tracer
+func (tp *tracerProvider) Tracer() trace.Tracer {
+	return tp.provider.Tracer(TracerName)
+}
+
+// Close flushes the recorded spans and closes the exporter
+func (tp *tracerProvider) Close() error {
+	var lastError error
+	if tp.shutdown != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		lastError = tp.shutdown(ctx)
+	}
+
+	for _, closer := range tp.closers {
+		err := closer.Close()
+		if err != nil {
+			lastError = err
+		}
+	}
+
+	return lastError
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tp *tracerProvider) IsInterfaceNil() bool {
+	return tp == nil
+}
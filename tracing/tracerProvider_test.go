@@ -0,0 +1,167 @@
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klever-io/klv-bridge-eth-go/config"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockTracingConfig() config.TracingConfig {
+	return config.TracingConfig{
+		Enabled:       true,
+		ServiceName:   "test",
+		Exporter:      OTLPExporter,
+		OTLPEndpoint:  "localhost:4318",
+		OTLPInsecure:  true,
+		SamplingRatio: 1,
+	}
+}
+
+func TestNewTracerProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled should create a non recording provider", func(t *testing.T) {
+		t.Parallel()
+
+		tp, err := NewTracerProvider(config.TracingConfig{})
+		require.Nil(t, err)
+		assert.False(t, check.IfNil(tp))
+
+		_, span := tp.Tracer().Start(context.Background(), "span")
+		assert.False(t, span.IsRecording())
+		span.End()
+
+		assert.Nil(t, tp.Close())
+	})
+	t.Run("invalid sampling ratio should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockTracingConfig()
+		cfg.SamplingRatio = 0
+		tp, err := NewTracerProvider(cfg)
+		assert.True(t, check.IfNil(tp))
+		assert.True(t, errors.Is(err, ErrInvalidSamplingRatio))
+
+		cfg.SamplingRatio = 1.01
+		tp, err = NewTracerProvider(cfg)
+		assert.True(t, check.IfNil(tp))
+		assert.True(t, errors.Is(err, ErrInvalidSamplingRatio))
+	})
+	t.Run("unknown exporter should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockTracingConfig()
+		cfg.Exporter = "unknown"
+		tp, err := NewTracerProvider(cfg)
+		assert.True(t, check.IfNil(tp))
+		assert.True(t, errors.Is(err, ErrUnknownExporter))
+	})
+	t.Run("empty OTLP endpoint should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockTracingConfig()
+		cfg.OTLPEndpoint = ""
+		tp, err := NewTracerProvider(cfg)
+		assert.True(t, check.IfNil(tp))
+		assert.Equal(t, ErrEmptyOTLPEndpoint, err)
+	})
+	t.Run("empty file path should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockTracingConfig()
+		cfg.Exporter = FileExporter
+		tp, err := NewTracerProvider(cfg)
+		assert.True(t, check.IfNil(tp))
+		assert.Equal(t, ErrEmptyFilePath, err)
+	})
+	t.Run("OTLP exporter should work", func(t *testing.T) {
+		t.Parallel()
+
+		tp, err := NewTracerProvider(createMockTracingConfig())
+		require.Nil(t, err)
+		assert.False(t, check.IfNil(tp))
+
+		_, span := tp.Tracer().Start(context.Background(), "span")
+		assert.True(t, span.IsRecording())
+
+		assert.Nil(t, tp.Close())
+	})
+	t.Run("file exporter should write the spans as JSON lines", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockTracingConfig()
+		cfg.Exporter = FileExporter
+		cfg.FilePath = filepath.Join(t.TempDir(), "traces.json")
+		tp, err := NewTracerProvider(cfg)
+		require.Nil(t, err)
+
+		ctx := ContextWithAttributes(context.Background(), Direction("EthereumToKleverBlockchain"), BatchID(37))
+		ctx, parent := StartSpan(ctx, tp.Tracer(), "parent")
+		_, child := StartSpan(ctx, tp.Tracer(), "child", ActionID(112))
+		EndSpan(child, nil)
+		EndSpan(parent, errors.New("expected error"))
+
+		err = tp.Close()
+		require.Nil(t, err)
+
+		file, err := os.Open(cfg.FilePath)
+		require.Nil(t, err)
+		defer func() {
+			_ = file.Close()
+		}()
+
+		type exportedSpan struct {
+			Name   string
+			Parent struct {
+				SpanID string
+			}
+			SpanContext struct {
+				TraceID string
+				SpanID  string
+			}
+			Attributes []struct {
+				Key   string
+				Value struct {
+					Value interface{}
+				}
+			}
+			Status struct {
+				Code string
+			}
+		}
+
+		spans := make(map[string]exportedSpan)
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			span := exportedSpan{}
+			err = json.Unmarshal(scanner.Bytes(), &span)
+			require.Nil(t, err)
+			spans[span.Name] = span
+		}
+		require.Len(t, spans, 2)
+
+		assert.Equal(t, spans["parent"].SpanContext.TraceID, spans["child"].SpanContext.TraceID)
+		assert.Equal(t, spans["parent"].SpanContext.SpanID, spans["child"].Parent.SpanID)
+		assert.Equal(t, "Error", spans["parent"].Status.Code)
+
+		childAttributes := make(map[string]interface{})
+		for _, attr := range spans["child"].Attributes {
+			childAttributes[attr.Key] = attr.Value.Value
+		}
+		expectedAttributes := map[string]interface{}{
+			directionKey: "EthereumToKleverBlockchain",
+			batchIDKey:   float64(37),
+			actionIDKey:  float64(112),
+		}
+		assert.Equal(t, expectedAttributes, childAttributes)
+	})
+}